@@ -11,22 +11,104 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"path"
 	"strings"
 	"time"
 
+	"shantaram/pkg/money"
+
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gofiber/fiber/v2"
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for AnnouncementSeverity.
+const (
+	AnnouncementSeverityCritical AnnouncementSeverity = "critical"
+	AnnouncementSeverityInfo     AnnouncementSeverity = "info"
+	AnnouncementSeverityWarning  AnnouncementSeverity = "warning"
+)
+
+// Defines values for AnnouncementTarget.
+const (
+	AnnouncementTargetAdmin  AnnouncementTarget = "admin"
+	AnnouncementTargetAll    AnnouncementTarget = "all"
+	AnnouncementTargetPublic AnnouncementTarget = "public"
+)
+
+// Defines values for ConnectionTransport.
+const (
+	ConnectionTransportSse ConnectionTransport = "sse"
+	ConnectionTransportWs  ConnectionTransport = "ws"
+)
+
+// Defines values for DiscountKind.
+const (
+	DiscountKindFixed   DiscountKind = "fixed"
+	DiscountKindPercent DiscountKind = "percent"
+)
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatCsv    ExportFormat = "csv"
+	ExportFormatNdjson ExportFormat = "ndjson"
+	ExportFormatXlsx   ExportFormat = "xlsx"
+)
+
+// Defines values for ExportRows.
+const (
+	ExportRowsItem  ExportRows = "item"
+	ExportRowsOrder ExportRows = "order"
+)
+
+// Defines values for LoyaltyEntryKind.
+const (
+	LoyaltyEntryKindAdjust LoyaltyEntryKind = "adjust"
+	LoyaltyEntryKindEarn   LoyaltyEntryKind = "earn"
+	LoyaltyEntryKindExpire LoyaltyEntryKind = "expire"
+	LoyaltyEntryKindRedeem LoyaltyEntryKind = "redeem"
+)
+
+// Defines values for OrderSort.
+const (
+	OrderSortNewest    OrderSort = "newest"
+	OrderSortOldest    OrderSort = "oldest"
+	OrderSortTotalAsc  OrderSort = "totalAsc"
+	OrderSortTotalDesc OrderSort = "totalDesc"
+)
+
 // Defines values for OrderStatus.
 const (
 	OrderStatusCancelled OrderStatus = "cancelled"
 	OrderStatusClosed    OrderStatus = "closed"
 	OrderStatusOpen      OrderStatus = "open"
+	OrderStatusReady     OrderStatus = "ready"
+)
+
+// Defines values for ReportFormat.
+const (
+	ReportFormatCsv  ReportFormat = "csv"
+	ReportFormatJson ReportFormat = "json"
+)
+
+// Defines values for ReportGranularity.
+const (
+	ReportGranularityDay  ReportGranularity = "day"
+	ReportGranularityHour ReportGranularity = "hour"
+	ReportGranularityWeek ReportGranularity = "week"
+)
+
+// Defines values for WsAnnouncementsChangedMessageEvent.
+const (
+	WsAnnouncementsChangedMessageEventAnnouncementsChanged WsAnnouncementsChangedMessageEvent = "announcements_changed"
+)
+
+// Defines values for WsKitchenChangedMessageEvent.
+const (
+	WsKitchenChangedMessageEventKitchenChanged WsKitchenChangedMessageEvent = "kitchen_changed"
 )
 
 // Defines values for WsMenuChangedMessageEvent.
@@ -39,6 +121,57 @@ const (
 	WsOrdersChangedMessageEventOrdersChanged WsOrdersChangedMessageEvent = "orders_changed"
 )
 
+// Defines values for GetAnnouncementsParamsTarget.
+const (
+	GetAnnouncementsParamsTargetAdmin  GetAnnouncementsParamsTarget = "admin"
+	GetAnnouncementsParamsTargetPublic GetAnnouncementsParamsTarget = "public"
+)
+
+// AddAnnouncementRequest defines model for AddAnnouncementRequest.
+type AddAnnouncementRequest struct {
+	Ends   *time.Time         `json:"ends,omitempty"`
+	Id     openapi_types.UUID `json:"id"`
+	MenuId *string            `json:"menuId,omitempty"`
+
+	// Priority Higher priority announcements are shown first
+	Priority *int                 `json:"priority,omitempty"`
+	Severity AnnouncementSeverity `json:"severity"`
+	Starts   *time.Time           `json:"starts,omitempty"`
+	Target   AnnouncementTarget   `json:"target"`
+	Text     string               `json:"text"`
+}
+
+// AddDiscountRuleRequest defines model for AddDiscountRuleRequest.
+type AddDiscountRuleRequest struct {
+	Active bool       `json:"active"`
+	Ends   *time.Time `json:"ends,omitempty"`
+
+	// FromTime Local time of day the rule starts to apply, requires toTime
+	FromTime *string `json:"fromTime,omitempty"`
+
+	// GroupId Product group the rule applies to, the whole order if omitted
+	GroupId *openapi_types.UUID `json:"groupId,omitempty"`
+	Id      openapi_types.UUID  `json:"id"`
+	Kind    DiscountKind        `json:"kind"`
+	Starts  *time.Time          `json:"starts,omitempty"`
+
+	// Title Shown to customers as the discount line
+	Title  string  `json:"title"`
+	ToTime *string `json:"toTime,omitempty"`
+
+	// Value Percentage for percent discounts, kopecks for fixed ones
+	Value int64 `json:"value"`
+}
+
+// AddIngredientRequest defines model for AddIngredientRequest.
+type AddIngredientRequest struct {
+	Id       openapi_types.UUID `json:"id"`
+	LowStock *int               `json:"lowStock,omitempty"`
+	Stock    int                `json:"stock"`
+	Title    string             `json:"title"`
+	Unit     string             `json:"unit"`
+}
+
 // AddProductGroupRequest defines model for AddProductGroupRequest.
 type AddProductGroupRequest struct {
 	Id     openapi_types.UUID `json:"id"`
@@ -52,28 +185,386 @@ type AddProductRequest struct {
 	Description string             `json:"description"`
 	GroupId     openapi_types.UUID `json:"groupId"`
 	Id          openapi_types.UUID `json:"id"`
-	Price       float64            `json:"price"`
-	Title       string             `json:"title"`
+	MaxQuantity *int               `json:"maxQuantity,omitempty"`
+	PrepMinutes *int               `json:"prepMinutes,omitempty"`
+
+	// Price Amount in kopecks, 100 kopecks make a ruble
+	Price Money  `json:"price"`
+	Title string `json:"title"`
+}
+
+// AddPromoCodeRequest defines model for AddPromoCodeRequest.
+type AddPromoCodeRequest struct {
+	Active bool `json:"active"`
+
+	// Code Case insensitive, stored in upper case
+	Code               string             `json:"code"`
+	Ends               *time.Time         `json:"ends,omitempty"`
+	Id                 openapi_types.UUID `json:"id"`
+	Kind               DiscountKind       `json:"kind"`
+	MaxUses            *int               `json:"maxUses,omitempty"`
+	MaxUsesPerCustomer *int               `json:"maxUsesPerCustomer,omitempty"`
+
+	// MinOrderTotal Amount in kopecks, 100 kopecks make a ruble
+	MinOrderTotal *Money     `json:"minOrderTotal,omitempty"`
+	Starts        *time.Time `json:"starts,omitempty"`
+
+	// Value Percentage for percent discounts, kopecks for fixed ones
+	Value int64 `json:"value"`
+}
+
+// Announcement defines model for Announcement.
+type Announcement struct {
+	Created time.Time          `json:"created"`
+	Ends    *time.Time         `json:"ends,omitempty"`
+	Id      openapi_types.UUID `json:"id"`
+	MenuId  *string            `json:"menuId,omitempty"`
+
+	// Priority Higher priority announcements are shown first
+	Priority int                  `json:"priority"`
+	Severity AnnouncementSeverity `json:"severity"`
+	Starts   *time.Time           `json:"starts,omitempty"`
+	Target   AnnouncementTarget   `json:"target"`
+	Text     string               `json:"text"`
+	Updated  time.Time            `json:"updated"`
+}
+
+// AnnouncementSeverity defines model for AnnouncementSeverity.
+type AnnouncementSeverity string
+
+// AnnouncementTarget defines model for AnnouncementTarget.
+type AnnouncementTarget string
+
+// AnnouncementsResponse defines model for AnnouncementsResponse.
+type AnnouncementsResponse struct {
+	Data []Announcement `json:"data"`
+}
+
+// CancellationReport defines model for CancellationReport.
+type CancellationReport struct {
+	Data []CancellationReportRow `json:"data"`
+}
+
+// CancellationReportRow defines model for CancellationReportRow.
+type CancellationReportRow struct {
+	Cancelled int `json:"cancelled"`
+	Orders    int `json:"orders"`
+
+	// Period Start of the period in the configured timezone
+	Period time.Time `json:"period"`
+
+	// Rate Cancelled share from 0 to 1
+	Rate float64 `json:"rate"`
+}
+
+// ComboChoice defines model for ComboChoice.
+type ComboChoice struct {
+	ProductId openapi_types.UUID `json:"productId"`
+	SlotId    openapi_types.UUID `json:"slotId"`
+}
+
+// ComboSlot defines model for ComboSlot.
+type ComboSlot struct {
+	Id      openapi_types.UUID `json:"id"`
+	Options []ComboSlotOption  `json:"options"`
+	Title   string             `json:"title"`
+}
+
+// ComboSlotOption Either a single product or any product of a group
+type ComboSlotOption struct {
+	GroupId   *openapi_types.UUID `exhaustruct:"optional" json:"groupId,omitempty"`
+	ProductId *openapi_types.UUID `exhaustruct:"optional" json:"productId,omitempty"`
+
+	// Surcharge Amount in kopecks, 100 kopecks make a ruble
+	Surcharge Money `json:"surcharge"`
+}
+
+// Connection defines model for Connection.
+type Connection struct {
+	Channels  []string            `json:"channels"`
+	Connected time.Time           `json:"connected"`
+	Id        openapi_types.UUID  `json:"id"`
+	Ip        string              `json:"ip"`
+	LastPing  time.Time           `json:"lastPing"`
+	Transport ConnectionTransport `json:"transport"`
+	User      *string             `json:"user,omitempty"`
+	UserAgent string              `json:"userAgent"`
+}
+
+// ConnectionTransport defines model for Connection.Transport.
+type ConnectionTransport string
+
+// ConnectionsResponse defines model for ConnectionsResponse.
+type ConnectionsResponse struct {
+	Data []Connection `json:"data"`
+}
+
+// CreateOrderResponse defines model for CreateOrderResponse.
+type CreateOrderResponse struct {
+	EtaMinutes int                `json:"etaMinutes"`
+	Id         openapi_types.UUID `json:"id"`
+	Index      int                `json:"index"`
+	ReadyAt    time.Time          `json:"readyAt"`
+}
+
+// Customer defines model for Customer.
+type Customer struct {
+	Created time.Time          `json:"created"`
+	Id      openapi_types.UUID `json:"id"`
+	Name    *string            `exhaustruct:"optional" json:"name,omitempty"`
+	Phone   string             `json:"phone"`
+}
+
+// CustomerLoginRequest defines model for CustomerLoginRequest.
+type CustomerLoginRequest struct {
+	Code  string `json:"code"`
+	Phone string `json:"phone"`
+}
+
+// CustomerLoginResponse defines model for CustomerLoginResponse.
+type CustomerLoginResponse struct {
+	Customer Customer `json:"customer"`
+	Token    string   `json:"token"`
+}
+
+// CustomerOtpRequest defines model for CustomerOtpRequest.
+type CustomerOtpRequest struct {
+	Phone string `json:"phone"`
+}
+
+// DiscountKind defines model for DiscountKind.
+type DiscountKind string
+
+// DiscountReport defines model for DiscountReport.
+type DiscountReport struct {
+	Data []DiscountReportRow `json:"data"`
+
+	// Points Orders paid in part with loyalty points, points are not a discount and are not part of data and total
+	Points PointsReport `json:"points"`
+
+	// Total Amount in kopecks, 100 kopecks make a ruble
+	Total Money `json:"total"`
+}
+
+// DiscountReportRow defines model for DiscountReportRow.
+type DiscountReportRow struct {
+	// Amount Amount in kopecks, 100 kopecks make a ruble
+	Amount      Money               `json:"amount"`
+	Orders      int                 `json:"orders"`
+	PromoCodeId *openapi_types.UUID `json:"promoCodeId,omitempty"`
+	RuleId      *openapi_types.UUID `json:"ruleId,omitempty"`
+	Title       string              `json:"title"`
+}
+
+// DiscountRule defines model for DiscountRule.
+type DiscountRule struct {
+	Active  bool       `json:"active"`
+	Created time.Time  `json:"created"`
+	Ends    *time.Time `json:"ends,omitempty"`
+
+	// FromTime Local time of day the rule starts to apply, requires toTime
+	FromTime *string `json:"fromTime,omitempty"`
+
+	// GroupId Product group the rule applies to, the whole order if omitted
+	GroupId *openapi_types.UUID `json:"groupId,omitempty"`
+	Id      openapi_types.UUID  `json:"id"`
+	Kind    DiscountKind        `json:"kind"`
+	Starts  *time.Time          `json:"starts,omitempty"`
+
+	// Title Shown to customers as the discount line
+	Title   string    `json:"title"`
+	ToTime  *string   `json:"toTime,omitempty"`
+	Updated time.Time `json:"updated"`
+
+	// Value Percentage for percent discounts, kopecks for fixed ones
+	Value int64 `json:"value"`
+}
+
+// DiscountRulesResponse defines model for DiscountRulesResponse.
+type DiscountRulesResponse struct {
+	Data []DiscountRule `json:"data"`
+}
+
+// EditAnnouncementRequest defines model for EditAnnouncementRequest.
+type EditAnnouncementRequest struct {
+	Ends   *time.Time `json:"ends,omitempty"`
+	MenuId *string    `json:"menuId,omitempty"`
+
+	// Priority Higher priority announcements are shown first
+	Priority *int                 `json:"priority,omitempty"`
+	Severity AnnouncementSeverity `json:"severity"`
+	Starts   *time.Time           `json:"starts,omitempty"`
+	Target   AnnouncementTarget   `json:"target"`
+	Text     string               `json:"text"`
+}
+
+// EditDiscountRuleRequest defines model for EditDiscountRuleRequest.
+type EditDiscountRuleRequest struct {
+	Active bool       `json:"active"`
+	Ends   *time.Time `json:"ends,omitempty"`
+
+	// FromTime Local time of day the rule starts to apply, requires toTime
+	FromTime *string `json:"fromTime,omitempty"`
+
+	// GroupId Product group the rule applies to, the whole order if omitted
+	GroupId *openapi_types.UUID `json:"groupId,omitempty"`
+	Kind    DiscountKind        `json:"kind"`
+	Starts  *time.Time          `json:"starts,omitempty"`
+
+	// Title Shown to customers as the discount line
+	Title  string  `json:"title"`
+	ToTime *string `json:"toTime,omitempty"`
+
+	// Value Percentage for percent discounts, kopecks for fixed ones
+	Value int64 `json:"value"`
+}
+
+// EditIngredientRequest defines model for EditIngredientRequest.
+type EditIngredientRequest struct {
+	LowStock *int   `json:"lowStock,omitempty"`
+	Stock    int    `json:"stock"`
+	Title    string `json:"title"`
+	Unit     string `json:"unit"`
 }
 
 // EditProductGroupRequest defines model for EditProductGroupRequest.
 type EditProductGroupRequest struct {
-	Title string `json:"title"`
+	PrepMinutes *int   `json:"prepMinutes,omitempty"`
+	Title       string `json:"title"`
 }
 
 // EditProductRequest defines model for EditProductRequest.
 type EditProductRequest struct {
-	Available   bool    `json:"available"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price"`
-	Title       string  `json:"title"`
+	Available   bool   `json:"available"`
+	Description string `json:"description"`
+	MaxQuantity *int   `json:"maxQuantity,omitempty"`
+	PrepMinutes *int   `json:"prepMinutes,omitempty"`
+
+	// Price Amount in kopecks, 100 kopecks make a ruble
+	Price Money  `json:"price"`
+	Title string `json:"title"`
+}
+
+// EditPromoCodeRequest defines model for EditPromoCodeRequest.
+type EditPromoCodeRequest struct {
+	Active bool `json:"active"`
+
+	// Code Case insensitive, stored in upper case
+	Code               string       `json:"code"`
+	Ends               *time.Time   `json:"ends,omitempty"`
+	Kind               DiscountKind `json:"kind"`
+	MaxUses            *int         `json:"maxUses,omitempty"`
+	MaxUsesPerCustomer *int         `json:"maxUsesPerCustomer,omitempty"`
+
+	// MinOrderTotal Amount in kopecks, 100 kopecks make a ruble
+	MinOrderTotal *Money     `json:"minOrderTotal,omitempty"`
+	Starts        *time.Time `json:"starts,omitempty"`
+
+	// Value Percentage for percent discounts, kopecks for fixed ones
+	Value int64 `json:"value"`
 }
 
+// ExportFormat defines model for ExportFormat.
+type ExportFormat string
+
+// ExportRows One row per order or one row per line item
+type ExportRows string
+
 // General defines model for General.
 type General struct {
-	Error      bool   `json:"error"`
-	Msg        string `json:"msg"`
-	StatusCode int    `json:"statusCode,omitempty"`
+	// Code Machine readable reason, set for errors the client can handle, e.g. order_too_many_lines, order_line_quantity_exceeded, order_product_quantity_exceeded, order_total_too_low, order_total_too_high, promo_not_found, promo_not_started, promo_expired, promo_min_total, promo_exhausted, promo_customer_limit, combo_choice_missing, combo_choice_invalid, order_product_not_found, out_of_stock
+	Code       *string `exhaustruct:"optional" json:"code,omitempty"`
+	Error      bool    `json:"error"`
+	Msg        string  `json:"msg"`
+	StatusCode int     `json:"statusCode,omitempty"`
+}
+
+// HeatmapCell defines model for HeatmapCell.
+type HeatmapCell struct {
+	Hour   int `json:"hour"`
+	Orders int `json:"orders"`
+
+	// Revenue Amount in kopecks, 100 kopecks make a ruble
+	Revenue Money `json:"revenue"`
+
+	// Weekday ISO weekday, 1 is Monday
+	Weekday int `json:"weekday"`
+}
+
+// HeatmapReport defines model for HeatmapReport.
+type HeatmapReport struct {
+	Data []HeatmapCell `json:"data"`
+}
+
+// Ingredient defines model for Ingredient.
+type Ingredient struct {
+	Created  time.Time          `json:"created"`
+	Id       openapi_types.UUID `json:"id"`
+	LowStock *int               `json:"lowStock,omitempty"`
+	Stock    int                `json:"stock"`
+	Title    string             `json:"title"`
+
+	// Unit e.g. g, ml or pcs
+	Unit    string    `json:"unit"`
+	Updated time.Time `json:"updated"`
+}
+
+// IngredientsResponse defines model for IngredientsResponse.
+type IngredientsResponse struct {
+	Data []Ingredient `json:"data"`
+}
+
+// Job defines model for Job.
+type Job struct {
+	Failures       int        `json:"failures"`
+	LastDurationMs *int64     `json:"lastDurationMs,omitempty"`
+	LastError      *string    `json:"lastError,omitempty"`
+	LastFinished   *time.Time `json:"lastFinished,omitempty"`
+	LastInstance   *string    `json:"lastInstance,omitempty"`
+	LastStarted    *time.Time `json:"lastStarted,omitempty"`
+	Name           string     `json:"name"`
+	NextRun        time.Time  `json:"nextRun"`
+	Running        bool       `json:"running"`
+	Runs           int        `json:"runs"`
+	Schedule       string     `json:"schedule"`
+}
+
+// JobsResponse defines model for JobsResponse.
+type JobsResponse struct {
+	Data []Job `json:"data"`
+}
+
+// KitchenQueueResponse defines model for KitchenQueueResponse.
+type KitchenQueueResponse struct {
+	Data []KitchenTicket `json:"data"`
+}
+
+// KitchenStation defines model for KitchenStation.
+type KitchenStation struct {
+	Id string `json:"id"`
+
+	// PrinterAddress host[:port] of ESC/POS network printer, port defaults to 9100
+	PrinterAddress *string `json:"printerAddress,omitempty"`
+	Title          string  `json:"title"`
+}
+
+// KitchenStationsResponse defines model for KitchenStationsResponse.
+type KitchenStationsResponse struct {
+	Data []KitchenStation `json:"data"`
+}
+
+// KitchenTicket defines model for KitchenTicket.
+type KitchenTicket struct {
+	Amount        int                `json:"amount"`
+	ClientComment *string            `json:"clientComment,omitempty"`
+	ClientName    string             `json:"clientName"`
+	Created       time.Time          `json:"created"`
+	Id            openapi_types.UUID `json:"id"`
+	OrderId       openapi_types.UUID `json:"orderId"`
+	OrderIndex    int                `json:"orderIndex"`
+	ProductId     openapi_types.UUID `json:"productId"`
+	StationId     string             `json:"stationId"`
+	Title         string             `json:"title"`
 }
 
 // LoginRequest defines model for LoginRequest.
@@ -87,6 +578,50 @@ type LoginResponse struct {
 	Token string `json:"token"`
 }
 
+// LoyaltyAccount defines model for LoyaltyAccount.
+type LoyaltyAccount struct {
+	Balance int `json:"balance"`
+
+	// Entries Latest entries, newest first
+	Entries []LoyaltyEntry `json:"entries"`
+}
+
+// LoyaltyAdjustment defines model for LoyaltyAdjustment.
+type LoyaltyAdjustment struct {
+	Comment string `json:"comment"`
+
+	// Points Points to add, negative to take away
+	Points int `json:"points"`
+}
+
+// LoyaltyEntry defines model for LoyaltyEntry.
+type LoyaltyEntry struct {
+	Comment *string             `exhaustruct:"optional" json:"comment,omitempty"`
+	Created time.Time           `json:"created"`
+	Expires *time.Time          `exhaustruct:"optional" json:"expires,omitempty"`
+	Id      int64               `json:"id"`
+	Kind    LoyaltyEntryKind    `json:"kind"`
+	OrderId *openapi_types.UUID `exhaustruct:"optional" json:"orderId,omitempty"`
+
+	// Points Positive entries add points, negative ones take them away
+	Points int `json:"points"`
+}
+
+// LoyaltyEntryKind defines model for LoyaltyEntryKind.
+type LoyaltyEntryKind string
+
+// LoyaltyPolicy One point is worth one ruble
+type LoyaltyPolicy struct {
+	// EarnPercent Share of the order total credited as points when the order is closed, 0 turns earning off
+	EarnPercent int `json:"earnPercent"`
+
+	// ExpireDays Earned points expire after this many days, omit to keep them forever
+	ExpireDays *int `exhaustruct:"optional" json:"expireDays,omitempty"`
+
+	// MaxRedeemPercent Max share of the order total after discounts that can be paid with points
+	MaxRedeemPercent int `json:"maxRedeemPercent"`
+}
+
 // MarkOrderSeenRequest defines model for MarkOrderSeenRequest.
 type MarkOrderSeenRequest struct {
 	Id openapi_types.UUID `json:"id"`
@@ -104,10 +639,16 @@ type MenuResponse struct {
 	Menus []Menu `json:"menus"`
 }
 
+// Money Amount in kopecks, 100 kopecks make a ruble
+type Money = money.Kopecks
+
 // NewOrderItem defines model for NewOrderItem.
 type NewOrderItem struct {
-	Amount int                `json:"amount"`
-	Id     openapi_types.UUID `json:"id"`
+	Amount int `json:"amount"`
+
+	// Choices Chosen products for every slot of a combo product
+	Choices *[]ComboChoice     `exhaustruct:"optional" json:"choices,omitempty"`
+	Id      openapi_types.UUID `json:"id"`
 }
 
 // NewOrderRequest defines model for NewOrderRequest.
@@ -116,42 +657,144 @@ type NewOrderRequest struct {
 	Id      openapi_types.UUID `json:"id"`
 	Items   []NewOrderItem     `json:"items"`
 	Name    string             `json:"name"`
+
+	// PickupAt Start of the chosen pickup slot, as soon as possible if omitted
+	PickupAt *time.Time `json:"pickupAt,omitempty"`
+
+	// Points Loyalty points to pay with, capped by the balance and the loyalty policy
+	Points    *int    `json:"points,omitempty"`
+	PromoCode *string `json:"promoCode,omitempty"`
+}
+
+// OpeningException defines model for OpeningException.
+type OpeningException struct {
+	Closes *string            `json:"closes,omitempty"`
+	Day    openapi_types.Date `json:"day"`
+
+	// Opens Closed all day if omitted
+	Opens *string `json:"opens,omitempty"`
+	Title *string `json:"title,omitempty"`
+}
+
+// OpeningHoursResponse defines model for OpeningHoursResponse.
+type OpeningHoursResponse struct {
+	Exceptions []OpeningException `json:"exceptions"`
+	Timezone   string             `json:"timezone"`
+	Weekly     []WeeklyHours      `json:"weekly"`
 }
 
 // Order defines model for Order.
 type Order struct {
-	ClientComment *string            `json:"clientComment,omitempty"`
-	ClientName    string             `json:"clientName"`
-	Created       time.Time          `json:"created"`
-	Id            openapi_types.UUID `json:"id"`
-	Index         int                `json:"index"`
-	Items         []OrderItem        `json:"items"`
-	Seen          bool               `json:"seen"`
-	Status        OrderStatus        `json:"status"`
-	TableID       *string            `json:"tableID,omitempty"`
+	ClientComment *string             `json:"clientComment,omitempty"`
+	ClientName    string              `json:"clientName"`
+	Created       time.Time           `json:"created"`
+	CustomerId    *openapi_types.UUID `json:"customerId,omitempty"`
+	Discounts     []OrderDiscount     `json:"discounts"`
+	EtaMinutes    *int                `json:"etaMinutes,omitempty"`
+	Id            openapi_types.UUID  `json:"id"`
+	Index         int                 `json:"index"`
+	Items         []OrderItem         `json:"items"`
+	PickupAt      *time.Time          `json:"pickupAt,omitempty"`
+	ReadyAt       *time.Time          `json:"readyAt,omitempty"`
+	Seen          bool                `json:"seen"`
+	Status        OrderStatus         `json:"status"`
+	TableID       *string             `json:"tableID,omitempty"`
+
+	// Total Amount in kopecks, 100 kopecks make a ruble
+	Total Money `json:"total"`
+}
+
+// OrderDiscount defines model for OrderDiscount.
+type OrderDiscount struct {
+	// Amount Amount in kopecks, 100 kopecks make a ruble
+	Amount Money `json:"amount"`
+
+	// Points Loyalty points paid, set on the points line only
+	Points      *int                `exhaustruct:"optional" json:"points,omitempty"`
+	PromoCode   *string             `exhaustruct:"optional" json:"promoCode,omitempty"`
+	PromoCodeId *openapi_types.UUID `exhaustruct:"optional" json:"promoCodeId,omitempty"`
+	RuleId      *openapi_types.UUID `exhaustruct:"optional" json:"ruleId,omitempty"`
+	Title       string              `json:"title"`
 }
 
 // OrderItem defines model for OrderItem.
 type OrderItem struct {
-	Amount int                `json:"amount"`
+	Amount int `json:"amount"`
+
+	// Components Products of a combo, the price already includes their surcharges
+	Components *[]OrderItemComponent `exhaustruct:"optional" json:"components,omitempty"`
+	Id         openapi_types.UUID    `json:"id"`
+
+	// Price Amount in kopecks, 100 kopecks make a ruble
+	Price Money  `json:"price"`
+	Title string `json:"title"`
+}
+
+// OrderItemComponent defines model for OrderItemComponent.
+type OrderItemComponent struct {
 	Id     openapi_types.UUID `json:"id"`
-	Price  float64            `json:"price"`
-	Title  string             `json:"title"`
+	SlotId openapi_types.UUID `json:"slotId"`
+
+	// Surcharge Amount in kopecks, 100 kopecks make a ruble
+	Surcharge Money  `json:"surcharge"`
+	Title     string `json:"title"`
+}
+
+// OrderPolicy defines model for OrderPolicy.
+type OrderPolicy struct {
+	// MaxLineQuantity Max quantity of a single line, products may set a lower limit
+	MaxLineQuantity int `json:"maxLineQuantity"`
+
+	// MaxLines Max number of distinct lines in an order
+	MaxLines int `json:"maxLines"`
+
+	// MaxTotal Amount in kopecks, 100 kopecks make a ruble
+	MaxTotal *Money `json:"maxTotal,omitempty"`
+
+	// MinTotal Amount in kopecks, 100 kopecks make a ruble
+	MinTotal *Money `json:"minTotal,omitempty"`
 }
 
+// OrderSort defines model for OrderSort.
+type OrderSort string
+
 // OrderStatus defines model for OrderStatus.
 type OrderStatus string
 
 // OrdersResponse defines model for OrdersResponse.
 type OrdersResponse struct {
-	Data       []Order `json:"data"`
-	TotalCount int     `json:"totalCount"`
+	Data []Order `json:"data"`
+
+	// NextCursor Missing on the last page
+	NextCursor *string `exhaustruct:"optional" json:"nextCursor,omitempty"`
+
+	// TotalCount Number of orders matching the filters, only set when asked for with withTotal
+	TotalCount *int `exhaustruct:"optional" json:"totalCount,omitempty"`
 }
 
 // Params defines model for Params.
 type Params struct {
+	// ClosedReason Human readable reason shown to customers while closed
+	ClosedReason   *string    `json:"closedReason,omitempty"`
+	ClosedUntil    *time.Time `json:"closedUntil,omitempty"`
 	HeaderDeadline *time.Time `json:"headerDeadline,omitempty"`
 	HeaderText     *string    `json:"headerText,omitempty"`
+	IsOpen         bool       `json:"isOpen"`
+
+	// NextOpening Next time ordering opens, absent while open or when no opening is scheduled
+	NextOpening    *time.Time `json:"nextOpening,omitempty"`
+	OrderingPaused bool       `json:"orderingPaused"`
+	SlotMaxItems   *int       `json:"slotMaxItems,omitempty"`
+	SlotMaxOrders  *int       `json:"slotMaxOrders,omitempty"`
+	SlotMinutes    int        `json:"slotMinutes"`
+}
+
+// PointsReport Orders paid in part with loyalty points, points are not a discount and are not part of data and total
+type PointsReport struct {
+	// Amount Amount in kopecks, 100 kopecks make a ruble
+	Amount Money `json:"amount"`
+	Orders int   `json:"orders"`
+	Points int   `json:"points"`
 }
 
 // Product defines model for Product.
@@ -161,54 +804,317 @@ type Product struct {
 	Description string             `json:"description"`
 	Id          openapi_types.UUID `json:"id"`
 	Index       int                `json:"index"`
-	Price       float64            `json:"price"`
-	Title       string             `json:"title"`
-	Updated     time.Time          `json:"updated"`
+
+	// LowStock Admins are alerted when the stock drops to this level
+	LowStock *int `json:"lowStock,omitempty"`
+
+	// MaxQuantity Max quantity of the product in one order
+	MaxQuantity *int `json:"maxQuantity,omitempty"`
+	PrepMinutes *int `json:"prepMinutes,omitempty"`
+
+	// Price Amount in kopecks, 100 kopecks make a ruble
+	Price Money `json:"price"`
+
+	// Slots Set for combo products, one product is chosen for every slot
+	Slots *[]ComboSlot `exhaustruct:"optional" json:"slots,omitempty"`
+
+	// Stock Portions left, not set when stock is not tracked
+	Stock   *int      `json:"stock,omitempty"`
+	Title   string    `json:"title"`
+	Updated time.Time `json:"updated"`
 }
 
 // ProductGroup defines model for ProductGroup.
 type ProductGroup struct {
-	Created  time.Time          `json:"created"`
-	Id       openapi_types.UUID `json:"id"`
-	Products []Product          `json:"products"`
-	Title    string             `json:"title"`
-	Updated  time.Time          `json:"updated"`
+	Created     time.Time          `json:"created"`
+	Id          openapi_types.UUID `json:"id"`
+	PrepMinutes *int               `json:"prepMinutes,omitempty"`
+	Products    []Product          `json:"products"`
+	StationId   *string            `json:"stationId,omitempty"`
+	Title       string             `json:"title"`
+	Updated     time.Time          `json:"updated"`
 }
 
-// SetHeaderTextRequest defines model for SetHeaderTextRequest.
-type SetHeaderTextRequest struct {
-	Deadline *time.Time `json:"deadline,omitempty"`
-	Text     *string    `json:"text,omitempty"`
-}
+// PromoCode defines model for PromoCode.
+type PromoCode struct {
+	Active bool `json:"active"`
 
-// SetMenuOrderingRequest defines model for SetMenuOrderingRequest.
-type SetMenuOrderingRequest struct {
-	MenuId          string               `json:"menuId"`
-	ProductGroupIds []openapi_types.UUID `json:"productGroupIds"`
-}
+	// Code Case insensitive, stored in upper case
+	Code               string             `json:"code"`
+	Created            time.Time          `json:"created"`
+	Ends               *time.Time         `json:"ends,omitempty"`
+	Id                 openapi_types.UUID `json:"id"`
+	Kind               DiscountKind       `json:"kind"`
+	MaxUses            *int               `json:"maxUses,omitempty"`
+	MaxUsesPerCustomer *int               `json:"maxUsesPerCustomer,omitempty"`
 
-// SetOrderStatusRequest defines model for SetOrderStatusRequest.
-type SetOrderStatusRequest struct {
-	Id     openapi_types.UUID `json:"id"`
-	Status OrderStatus        `json:"status"`
-}
+	// MinOrderTotal Amount in kopecks, 100 kopecks make a ruble
+	MinOrderTotal *Money     `json:"minOrderTotal,omitempty"`
+	Starts        *time.Time `json:"starts,omitempty"`
+	Updated       time.Time  `json:"updated"`
 
-// SetProductGroupOrderingRequest defines model for SetProductGroupOrderingRequest.
-type SetProductGroupOrderingRequest struct {
-	ProductGroupId openapi_types.UUID   `json:"productGroupId"`
-	ProductIds     []openapi_types.UUID `json:"productIds"`
+	// Uses Orders the code was used in, cancelled orders excluded
+	Uses int `json:"uses"`
+
+	// Value Percentage for percent discounts, kopecks for fixed ones
+	Value int64 `json:"value"`
 }
 
-// WsMenuChangedMessage defines model for WsMenuChangedMessage.
-type WsMenuChangedMessage struct {
-	Event WsMenuChangedMessageEvent `json:"event"`
-	Id    string                    `exhaustruct:"optional" json:"id"`
+// PromoCodesResponse defines model for PromoCodesResponse.
+type PromoCodesResponse struct {
+	Data []PromoCode `json:"data"`
 }
 
-// WsMenuChangedMessageEvent defines model for WsMenuChangedMessage.Event.
-type WsMenuChangedMessageEvent string
+// Quote defines model for Quote.
+type Quote struct {
+	// Discount Amount in kopecks, 100 kopecks make a ruble
+	Discount  Money           `json:"discount"`
+	Discounts []OrderDiscount `json:"discounts"`
+	Items     []OrderItem     `json:"items"`
 
-// WsMessage defines model for WsMessage.
+	// PointsBalance Points of the logged in customer before this order
+	PointsBalance *int `exhaustruct:"optional" json:"pointsBalance,omitempty"`
+
+	// Subtotal Amount in kopecks, 100 kopecks make a ruble
+	Subtotal Money `json:"subtotal"`
+
+	// Total Amount in kopecks, 100 kopecks make a ruble
+	Total Money `json:"total"`
+}
+
+// QuoteRequest defines model for QuoteRequest.
+type QuoteRequest struct {
+	Items []NewOrderItem `json:"items"`
+
+	// Points Loyalty points to pay with, capped by the balance and the loyalty policy
+	Points    *int    `json:"points,omitempty"`
+	PromoCode *string `json:"promoCode,omitempty"`
+}
+
+// Recipe defines model for Recipe.
+type Recipe struct {
+	Items     []RecipeItem       `json:"items"`
+	ProductId openapi_types.UUID `json:"productId"`
+}
+
+// RecipeItem defines model for RecipeItem.
+type RecipeItem struct {
+	IngredientId openapi_types.UUID `json:"ingredientId"`
+
+	// Quantity Ingredient units per portion
+	Quantity int `json:"quantity"`
+}
+
+// RecipesResponse defines model for RecipesResponse.
+type RecipesResponse struct {
+	Data []Recipe `json:"data"`
+}
+
+// ReorderRequest defines model for ReorderRequest.
+type ReorderRequest struct {
+	Comment *string `exhaustruct:"optional" json:"comment,omitempty"`
+
+	// PickupAt Start of the chosen pickup slot, as soon as possible if omitted
+	PickupAt *time.Time `exhaustruct:"optional" json:"pickupAt,omitempty"`
+}
+
+// ReportFormat defines model for ReportFormat.
+type ReportFormat string
+
+// ReportGranularity defines model for ReportGranularity.
+type ReportGranularity string
+
+// SalesReport defines model for SalesReport.
+type SalesReport struct {
+	Data []SalesReportRow `json:"data"`
+}
+
+// SalesReportRow defines model for SalesReportRow.
+type SalesReportRow struct {
+	// AverageCheck Amount in kopecks, 100 kopecks make a ruble
+	AverageCheck Money `json:"averageCheck"`
+	Orders       int   `json:"orders"`
+
+	// Period Start of the period in the configured timezone
+	Period time.Time `json:"period"`
+
+	// Revenue Amount in kopecks, 100 kopecks make a ruble
+	Revenue Money `json:"revenue"`
+}
+
+// SetCapacityRequest defines model for SetCapacityRequest.
+type SetCapacityRequest struct {
+	MaxItems    *int `json:"maxItems,omitempty"`
+	MaxOrders   *int `json:"maxOrders,omitempty"`
+	SlotMinutes int  `json:"slotMinutes"`
+}
+
+// SetClosedUntilRequest defines model for SetClosedUntilRequest.
+type SetClosedUntilRequest struct {
+	Reason *string `json:"reason,omitempty"`
+
+	// Until Ordering is closed until this time, omit to reopen
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// SetComboSlotsRequest defines model for SetComboSlotsRequest.
+type SetComboSlotsRequest struct {
+	Slots []ComboSlot `json:"slots"`
+}
+
+// SetHeaderTextRequest defines model for SetHeaderTextRequest.
+type SetHeaderTextRequest struct {
+	Deadline *time.Time `json:"deadline,omitempty"`
+	Text     *string    `json:"text,omitempty"`
+}
+
+// SetMenuOrderingRequest defines model for SetMenuOrderingRequest.
+type SetMenuOrderingRequest struct {
+	MenuId          string               `json:"menuId"`
+	ProductGroupIds []openapi_types.UUID `json:"productGroupIds"`
+}
+
+// SetOrderStatusRequest defines model for SetOrderStatusRequest.
+type SetOrderStatusRequest struct {
+	Id     openapi_types.UUID `json:"id"`
+	Status OrderStatus        `json:"status"`
+}
+
+// SetOrderingPausedRequest defines model for SetOrderingPausedRequest.
+type SetOrderingPausedRequest struct {
+	Paused bool `json:"paused"`
+}
+
+// SetProductGroupOrderingRequest defines model for SetProductGroupOrderingRequest.
+type SetProductGroupOrderingRequest struct {
+	ProductGroupId openapi_types.UUID   `json:"productGroupId"`
+	ProductIds     []openapi_types.UUID `json:"productIds"`
+}
+
+// SetProductGroupStationRequest defines model for SetProductGroupStationRequest.
+type SetProductGroupStationRequest struct {
+	StationId *string `json:"stationId,omitempty"`
+}
+
+// SetProductRecipeRequest defines model for SetProductRecipeRequest.
+type SetProductRecipeRequest struct {
+	Items []RecipeItem `json:"items"`
+}
+
+// SetProductStockRequest defines model for SetProductStockRequest.
+type SetProductStockRequest struct {
+	LowStock *int `json:"lowStock,omitempty"`
+	Stock    *int `json:"stock,omitempty"`
+}
+
+// SetWeeklyHoursRequest defines model for SetWeeklyHoursRequest.
+type SetWeeklyHoursRequest struct {
+	Data []WeeklyHours `json:"data"`
+}
+
+// Slot defines model for Slot.
+type Slot struct {
+	Available bool      `json:"available"`
+	End       time.Time `json:"end"`
+	Items     int       `json:"items"`
+	Orders    int       `json:"orders"`
+	Start     time.Time `json:"start"`
+}
+
+// SlotsResponse defines model for SlotsResponse.
+type SlotsResponse struct {
+	Data           []Slot `json:"data"`
+	OrderingPaused bool   `json:"orderingPaused"`
+	SlotMinutes    int    `json:"slotMinutes"`
+}
+
+// StatusDurationReport defines model for StatusDurationReport.
+type StatusDurationReport struct {
+	Data []StatusDurationRow `json:"data"`
+}
+
+// StatusDurationRow defines model for StatusDurationRow.
+type StatusDurationRow struct {
+	P50         float64     `json:"p50"`
+	P90         float64     `json:"p90"`
+	P95         float64     `json:"p95"`
+	Status      OrderStatus `json:"status"`
+	Transitions int         `json:"transitions"`
+}
+
+// TopGroupRow defines model for TopGroupRow.
+type TopGroupRow struct {
+	GroupId  openapi_types.UUID `json:"groupId"`
+	Quantity int                `json:"quantity"`
+
+	// Revenue Amount in kopecks, 100 kopecks make a ruble
+	Revenue Money  `json:"revenue"`
+	Title   string `json:"title"`
+}
+
+// TopGroupsReport defines model for TopGroupsReport.
+type TopGroupsReport struct {
+	Data []TopGroupRow `json:"data"`
+}
+
+// TopProductRow defines model for TopProductRow.
+type TopProductRow struct {
+	ProductId openapi_types.UUID `json:"productId"`
+	Quantity  int                `json:"quantity"`
+
+	// Revenue Amount in kopecks, 100 kopecks make a ruble
+	Revenue Money  `json:"revenue"`
+	Title   string `json:"title"`
+}
+
+// TopProductsReport defines model for TopProductsReport.
+type TopProductsReport struct {
+	Data []TopProductRow `json:"data"`
+}
+
+// UpdateCustomerRequest defines model for UpdateCustomerRequest.
+type UpdateCustomerRequest struct {
+	Name string `json:"name"`
+}
+
+// WeeklyHours defines model for WeeklyHours.
+type WeeklyHours struct {
+	Closes string `json:"closes"`
+	Opens  string `json:"opens"`
+
+	// Weekday 0 is Sunday
+	Weekday int `json:"weekday"`
+}
+
+// WsAnnouncementsChangedMessage defines model for WsAnnouncementsChangedMessage.
+type WsAnnouncementsChangedMessage struct {
+	Event WsAnnouncementsChangedMessageEvent `json:"event"`
+	Id    string                             `exhaustruct:"optional" json:"id"`
+}
+
+// WsAnnouncementsChangedMessageEvent defines model for WsAnnouncementsChangedMessage.Event.
+type WsAnnouncementsChangedMessageEvent string
+
+// WsKitchenChangedMessage defines model for WsKitchenChangedMessage.
+type WsKitchenChangedMessage struct {
+	Event     WsKitchenChangedMessageEvent `json:"event"`
+	Id        string                       `exhaustruct:"optional" json:"id"`
+	StationId string                       `json:"stationId"`
+}
+
+// WsKitchenChangedMessageEvent defines model for WsKitchenChangedMessage.Event.
+type WsKitchenChangedMessageEvent string
+
+// WsMenuChangedMessage defines model for WsMenuChangedMessage.
+type WsMenuChangedMessage struct {
+	Event WsMenuChangedMessageEvent `json:"event"`
+	Id    string                    `exhaustruct:"optional" json:"id"`
+}
+
+// WsMenuChangedMessageEvent defines model for WsMenuChangedMessage.Event.
+type WsMenuChangedMessageEvent string
+
+// WsMessage defines model for WsMessage.
 type WsMessage struct {
 	Event string `json:"event"`
 	Id    string `exhaustruct:"optional" json:"id"`
@@ -224,12 +1130,230 @@ type WsOrdersChangedMessage struct {
 // WsOrdersChangedMessageEvent defines model for WsOrdersChangedMessage.Event.
 type WsOrdersChangedMessageEvent string
 
+// ZReport defines model for ZReport.
+type ZReport struct {
+	Created time.Time `json:"created"`
+
+	// Day Business day, it ends at the configured cutoff of the next calendar day
+	Day    openapi_types.Date `json:"day"`
+	Ends   time.Time          `json:"ends"`
+	Starts time.Time          `json:"starts"`
+
+	// Summary Totals exclude cancelled orders
+	Summary ZReportSummary `json:"summary"`
+}
+
+// ZReportStatusRow defines model for ZReportStatusRow.
+type ZReportStatusRow struct {
+	// Discount Amount in kopecks, 100 kopecks make a ruble
+	Discount Money `json:"discount"`
+
+	// Gross Amount in kopecks, 100 kopecks make a ruble
+	Gross  Money `json:"gross"`
+	Orders int   `json:"orders"`
+
+	// Points Amount in kopecks, 100 kopecks make a ruble
+	Points Money       `json:"points"`
+	Status OrderStatus `json:"status"`
+}
+
+// ZReportSummary Totals exclude cancelled orders
+type ZReportSummary struct {
+	// AverageCheck Amount in kopecks, 100 kopecks make a ruble
+	AverageCheck Money `json:"averageCheck"`
+
+	// Discount Amount in kopecks, 100 kopecks make a ruble
+	Discount Money `json:"discount"`
+
+	// Gross Amount in kopecks, 100 kopecks make a ruble
+	Gross  Money `json:"gross"`
+	Orders int   `json:"orders"`
+
+	// Points Amount in kopecks, 100 kopecks make a ruble
+	Points Money `json:"points"`
+
+	// Revenue Amount in kopecks, 100 kopecks make a ruble
+	Revenue  Money              `json:"revenue"`
+	Statuses []ZReportStatusRow `json:"statuses"`
+	TopItems []TopProductRow    `json:"topItems"`
+}
+
+// ZReportsResponse defines model for ZReportsResponse.
+type ZReportsResponse struct {
+	Data []ZReport `json:"data"`
+}
+
+// GetAnnouncementsParams defines parameters for GetAnnouncements.
+type GetAnnouncementsParams struct {
+	// Target Admin announcements require authorization
+	Target *GetAnnouncementsParamsTarget `form:"target,omitempty" json:"target,omitempty"`
+
+	// MenuId Include announcements scoped to this menu
+	MenuId *string `form:"menuId,omitempty" json:"menuId,omitempty"`
+}
+
+// GetAnnouncementsParamsTarget defines parameters for GetAnnouncements.
+type GetAnnouncementsParamsTarget string
+
+// GetCustomerOrdersParams defines parameters for GetCustomerOrders.
+type GetCustomerOrdersParams struct {
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetDiscountReportParams defines parameters for GetDiscountReport.
+type GetDiscountReportParams struct {
+	From time.Time `form:"from" json:"from"`
+	To   time.Time `form:"to" json:"to"`
+}
+
 // GetOrdersParams defines parameters for GetOrders.
 type GetOrdersParams struct {
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
-	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor  *string        `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit   *int           `form:"limit,omitempty" json:"limit,omitempty"`
+	Sort    *OrderSort     `form:"sort,omitempty" json:"sort,omitempty"`
+	Status  *[]OrderStatus `form:"status,omitempty" json:"status,omitempty"`
+	Seen    *bool          `form:"seen,omitempty" json:"seen,omitempty"`
+	From    *time.Time     `form:"from,omitempty" json:"from,omitempty"`
+	To      *time.Time     `form:"to,omitempty" json:"to,omitempty"`
+	TableId *string        `form:"tableId,omitempty" json:"tableId,omitempty"`
+
+	// Q Substring of the client name or comment
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// ProductId Product ordered directly or as a combo component
+	ProductId  *openapi_types.UUID `form:"productId,omitempty" json:"productId,omitempty"`
+	MinTotal   *Money              `form:"minTotal,omitempty" json:"minTotal,omitempty"`
+	MaxTotal   *Money              `form:"maxTotal,omitempty" json:"maxTotal,omitempty"`
+	CustomerId *openapi_types.UUID `form:"customerId,omitempty" json:"customerId,omitempty"`
+
+	// WithTotal Count every matching order in totalCount, the count reads all matches and is best asked for with the first page only
+	WithTotal *bool `form:"withTotal,omitempty" json:"withTotal,omitempty"`
+}
+
+// ExportOrdersParams defines parameters for ExportOrders.
+type ExportOrdersParams struct {
+	From    time.Time      `form:"from" json:"from"`
+	To      time.Time      `form:"to" json:"to"`
+	Format  *ExportFormat  `form:"format,omitempty" json:"format,omitempty"`
+	Rows    *ExportRows    `form:"rows,omitempty" json:"rows,omitempty"`
+	Status  *[]OrderStatus `form:"status,omitempty" json:"status,omitempty"`
+	Seen    *bool          `form:"seen,omitempty" json:"seen,omitempty"`
+	TableId *string        `form:"tableId,omitempty" json:"tableId,omitempty"`
+
+	// Q Substring of the client name or comment
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// ProductId Product ordered directly or as a combo component
+	ProductId *openapi_types.UUID `form:"productId,omitempty" json:"productId,omitempty"`
+	MinTotal  *Money              `form:"minTotal,omitempty" json:"minTotal,omitempty"`
+	MaxTotal  *Money              `form:"maxTotal,omitempty" json:"maxTotal,omitempty"`
+}
+
+// GetCancellationReportParams defines parameters for GetCancellationReport.
+type GetCancellationReportParams struct {
+	From        time.Time          `form:"from" json:"from"`
+	To          time.Time          `form:"to" json:"to"`
+	Granularity *ReportGranularity `form:"granularity,omitempty" json:"granularity,omitempty"`
+	Format      *ReportFormat      `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetHeatmapReportParams defines parameters for GetHeatmapReport.
+type GetHeatmapReportParams struct {
+	From   time.Time     `form:"from" json:"from"`
+	To     time.Time     `form:"to" json:"to"`
+	Format *ReportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetSalesReportParams defines parameters for GetSalesReport.
+type GetSalesReportParams struct {
+	From        time.Time          `form:"from" json:"from"`
+	To          time.Time          `form:"to" json:"to"`
+	Granularity *ReportGranularity `form:"granularity,omitempty" json:"granularity,omitempty"`
+	Format      *ReportFormat      `form:"format,omitempty" json:"format,omitempty"`
 }
 
+// GetStatusDurationReportParams defines parameters for GetStatusDurationReport.
+type GetStatusDurationReportParams struct {
+	From   time.Time     `form:"from" json:"from"`
+	To     time.Time     `form:"to" json:"to"`
+	Format *ReportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetTopGroupsReportParams defines parameters for GetTopGroupsReport.
+type GetTopGroupsReportParams struct {
+	From   time.Time     `form:"from" json:"from"`
+	To     time.Time     `form:"to" json:"to"`
+	Limit  *int          `form:"limit,omitempty" json:"limit,omitempty"`
+	Format *ReportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetTopProductsReportParams defines parameters for GetTopProductsReport.
+type GetTopProductsReportParams struct {
+	From   time.Time     `form:"from" json:"from"`
+	To     time.Time     `form:"to" json:"to"`
+	Limit  *int          `form:"limit,omitempty" json:"limit,omitempty"`
+	Format *ReportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetSlotsParams defines parameters for GetSlots.
+type GetSlotsParams struct {
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To   *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetZReportsParams defines parameters for GetZReports.
+type GetZReportsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetZReportParams defines parameters for GetZReport.
+type GetZReportParams struct {
+	Format *ReportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// AddAnnouncementJSONRequestBody defines body for AddAnnouncement for application/json ContentType.
+type AddAnnouncementJSONRequestBody = AddAnnouncementRequest
+
+// EditAnnouncementJSONRequestBody defines body for EditAnnouncement for application/json ContentType.
+type EditAnnouncementJSONRequestBody = EditAnnouncementRequest
+
+// CustomerLoginJSONRequestBody defines body for CustomerLogin for application/json ContentType.
+type CustomerLoginJSONRequestBody = CustomerLoginRequest
+
+// UpdateCustomerJSONRequestBody defines body for UpdateCustomer for application/json ContentType.
+type UpdateCustomerJSONRequestBody = UpdateCustomerRequest
+
+// ReorderJSONRequestBody defines body for Reorder for application/json ContentType.
+type ReorderJSONRequestBody = ReorderRequest
+
+// RequestCustomerOtpJSONRequestBody defines body for RequestCustomerOtp for application/json ContentType.
+type RequestCustomerOtpJSONRequestBody = CustomerOtpRequest
+
+// AdjustCustomerLoyaltyJSONRequestBody defines body for AdjustCustomerLoyalty for application/json ContentType.
+type AdjustCustomerLoyaltyJSONRequestBody = LoyaltyAdjustment
+
+// AddDiscountRuleJSONRequestBody defines body for AddDiscountRule for application/json ContentType.
+type AddDiscountRuleJSONRequestBody = AddDiscountRuleRequest
+
+// EditDiscountRuleJSONRequestBody defines body for EditDiscountRule for application/json ContentType.
+type EditDiscountRuleJSONRequestBody = EditDiscountRuleRequest
+
+// SaveOpeningExceptionJSONRequestBody defines body for SaveOpeningException for application/json ContentType.
+type SaveOpeningExceptionJSONRequestBody = OpeningException
+
+// SetWeeklyHoursJSONRequestBody defines body for SetWeeklyHours for application/json ContentType.
+type SetWeeklyHoursJSONRequestBody = SetWeeklyHoursRequest
+
+// AddIngredientJSONRequestBody defines body for AddIngredient for application/json ContentType.
+type AddIngredientJSONRequestBody = AddIngredientRequest
+
+// EditIngredientJSONRequestBody defines body for EditIngredient for application/json ContentType.
+type EditIngredientJSONRequestBody = EditIngredientRequest
+
+// SaveKitchenStationJSONRequestBody defines body for SaveKitchenStation for application/json ContentType.
+type SaveKitchenStationJSONRequestBody = KitchenStation
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequest
 
@@ -242,6 +1366,15 @@ type AddProductJSONRequestBody = AddProductRequest
 // EditProductJSONRequestBody defines body for EditProduct for application/json ContentType.
 type EditProductJSONRequestBody = EditProductRequest
 
+// SetProductRecipeJSONRequestBody defines body for SetProductRecipe for application/json ContentType.
+type SetProductRecipeJSONRequestBody = SetProductRecipeRequest
+
+// SetComboSlotsJSONRequestBody defines body for SetComboSlots for application/json ContentType.
+type SetComboSlotsJSONRequestBody = SetComboSlotsRequest
+
+// SetProductStockJSONRequestBody defines body for SetProductStock for application/json ContentType.
+type SetProductStockJSONRequestBody = SetProductStockRequest
+
 // AddProductGroupJSONRequestBody defines body for AddProductGroup for application/json ContentType.
 type AddProductGroupJSONRequestBody = AddProductGroupRequest
 
@@ -251,18 +1384,45 @@ type SetProductGroupOrderingJSONRequestBody = SetProductGroupOrderingRequest
 // EditProductGroupJSONRequestBody defines body for EditProductGroup for application/json ContentType.
 type EditProductGroupJSONRequestBody = EditProductGroupRequest
 
+// SetProductGroupStationJSONRequestBody defines body for SetProductGroupStation for application/json ContentType.
+type SetProductGroupStationJSONRequestBody = SetProductGroupStationRequest
+
 // CreateOrderJSONRequestBody defines body for CreateOrder for application/json ContentType.
 type CreateOrderJSONRequestBody = NewOrderRequest
 
+// QuoteOrderJSONRequestBody defines body for QuoteOrder for application/json ContentType.
+type QuoteOrderJSONRequestBody = QuoteRequest
+
 // MarkOrderSeenJSONRequestBody defines body for MarkOrderSeen for application/json ContentType.
 type MarkOrderSeenJSONRequestBody = MarkOrderSeenRequest
 
 // SetOrderStatusJSONRequestBody defines body for SetOrderStatus for application/json ContentType.
 type SetOrderStatusJSONRequestBody = SetOrderStatusRequest
 
+// SetCapacityJSONRequestBody defines body for SetCapacity for application/json ContentType.
+type SetCapacityJSONRequestBody = SetCapacityRequest
+
+// SetClosedUntilJSONRequestBody defines body for SetClosedUntil for application/json ContentType.
+type SetClosedUntilJSONRequestBody = SetClosedUntilRequest
+
 // SetHeaderTextJSONRequestBody defines body for SetHeaderText for application/json ContentType.
 type SetHeaderTextJSONRequestBody = SetHeaderTextRequest
 
+// SetLoyaltyPolicyJSONRequestBody defines body for SetLoyaltyPolicy for application/json ContentType.
+type SetLoyaltyPolicyJSONRequestBody = LoyaltyPolicy
+
+// SetOrderPolicyJSONRequestBody defines body for SetOrderPolicy for application/json ContentType.
+type SetOrderPolicyJSONRequestBody = OrderPolicy
+
+// SetOrderingPausedJSONRequestBody defines body for SetOrderingPaused for application/json ContentType.
+type SetOrderingPausedJSONRequestBody = SetOrderingPausedRequest
+
+// AddPromoCodeJSONRequestBody defines body for AddPromoCode for application/json ContentType.
+type AddPromoCodeJSONRequestBody = AddPromoCodeRequest
+
+// EditPromoCodeJSONRequestBody defines body for EditPromoCode for application/json ContentType.
+type EditPromoCodeJSONRequestBody = EditPromoCodeRequest
+
 // AsWsOrdersChangedMessage returns the union data inside the WsMessage as a WsOrdersChangedMessage
 func (t WsMessage) AsWsOrdersChangedMessage() (WsOrdersChangedMessage, error) {
 	var body WsOrdersChangedMessage
@@ -323,6 +1483,66 @@ func (t *WsMessage) MergeWsMenuChangedMessage(v WsMenuChangedMessage) error {
 	return err
 }
 
+// AsWsKitchenChangedMessage returns the union data inside the WsMessage as a WsKitchenChangedMessage
+func (t WsMessage) AsWsKitchenChangedMessage() (WsKitchenChangedMessage, error) {
+	var body WsKitchenChangedMessage
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromWsKitchenChangedMessage overwrites any union data inside the WsMessage as the provided WsKitchenChangedMessage
+func (t *WsMessage) FromWsKitchenChangedMessage(v WsKitchenChangedMessage) error {
+	t.Event = "kitchen_changed"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeWsKitchenChangedMessage performs a merge with any union data inside the WsMessage, using the provided WsKitchenChangedMessage
+func (t *WsMessage) MergeWsKitchenChangedMessage(v WsKitchenChangedMessage) error {
+	t.Event = "kitchen_changed"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsWsAnnouncementsChangedMessage returns the union data inside the WsMessage as a WsAnnouncementsChangedMessage
+func (t WsMessage) AsWsAnnouncementsChangedMessage() (WsAnnouncementsChangedMessage, error) {
+	var body WsAnnouncementsChangedMessage
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromWsAnnouncementsChangedMessage overwrites any union data inside the WsMessage as the provided WsAnnouncementsChangedMessage
+func (t *WsMessage) FromWsAnnouncementsChangedMessage(v WsAnnouncementsChangedMessage) error {
+	t.Event = "announcements_changed"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeWsAnnouncementsChangedMessage performs a merge with any union data inside the WsMessage, using the provided WsAnnouncementsChangedMessage
+func (t *WsMessage) MergeWsAnnouncementsChangedMessage(v WsAnnouncementsChangedMessage) error {
+	t.Event = "announcements_changed"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t WsMessage) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"event"`
@@ -337,6 +1557,10 @@ func (t WsMessage) ValueByDiscriminator() (interface{}, error) {
 		return nil, err
 	}
 	switch discriminator {
+	case "announcements_changed":
+		return t.AsWsAnnouncementsChangedMessage()
+	case "kitchen_changed":
+		return t.AsWsKitchenChangedMessage()
 	case "menu_changed":
 		return t.AsWsMenuChangedMessage()
 	case "orders_changed":
@@ -403,12 +1627,123 @@ func (t *WsMessage) UnmarshalJSON(b []byte) error {
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get currently visible announcements
+	// (GET /announcements)
+	GetAnnouncements(c *fiber.Ctx, params GetAnnouncementsParams) error
+	// Add announcement
+	// (POST /announcements)
+	AddAnnouncement(c *fiber.Ctx) error
+	// Get all announcements including scheduled and expired
+	// (GET /announcements/all)
+	GetAllAnnouncements(c *fiber.Ctx) error
+	// Delete announcement
+	// (DELETE /announcements/{announcementId})
+	DeleteAnnouncement(c *fiber.Ctx, announcementId openapi_types.UUID) error
+	// Edit announcement
+	// (PUT /announcements/{announcementId})
+	EditAnnouncement(c *fiber.Ctx, announcementId openapi_types.UUID) error
+	// Get realtime connections
+	// (GET /connections)
+	GetConnections(c *fiber.Ctx) error
+	// Force realtime connection to disconnect
+	// (DELETE /connections/{id})
+	KickConnection(c *fiber.Ctx, id openapi_types.UUID) error
+	// Log in with the one-time code, the account is created on first login
+	// (POST /customer/login)
+	CustomerLogin(c *fiber.Ctx) error
+	// Points balance and latest ledger entries of the logged in customer
+	// (GET /customer/loyalty)
+	GetCustomerLoyalty(c *fiber.Ctx) error
+	// Get the logged in customer
+	// (GET /customer/me)
+	GetCustomer(c *fiber.Ctx) error
+	// Update the logged in customer
+	// (PUT /customer/me)
+	UpdateCustomer(c *fiber.Ctx) error
+	// Orders of the logged in customer, newest first
+	// (GET /customer/orders)
+	GetCustomerOrders(c *fiber.Ctx, params GetCustomerOrdersParams) error
+	// Place a new order with the items of a past one at current prices
+	// (POST /customer/orders/{id}/reorder)
+	Reorder(c *fiber.Ctx, id openapi_types.UUID) error
+	// Send a one-time login code to the phone
+	// (POST /customer/otp)
+	RequestCustomerOtp(c *fiber.Ctx) error
+	// Points balance and latest ledger entries of a customer
+	// (GET /customers/{id}/loyalty)
+	GetCustomerLoyaltyById(c *fiber.Ctx, id openapi_types.UUID) error
+	// Add or take away points of a customer by hand
+	// (POST /customers/{id}/loyalty/adjust)
+	AdjustCustomerLoyalty(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get all automatic discount rules
+	// (GET /discountRules)
+	GetDiscountRules(c *fiber.Ctx) error
+	// Add automatic discount rule
+	// (POST /discountRules)
+	AddDiscountRule(c *fiber.Ctx) error
+	// Delete automatic discount rule
+	// (DELETE /discountRules/{ruleId})
+	DeleteDiscountRule(c *fiber.Ctx, ruleId openapi_types.UUID) error
+	// Edit automatic discount rule
+	// (PUT /discountRules/{ruleId})
+	EditDiscountRule(c *fiber.Ctx, ruleId openapi_types.UUID) error
+	// Discounts granted in a period, grouped by promo code and rule
+	// (GET /discounts/report)
+	GetDiscountReport(c *fiber.Ctx, params GetDiscountReportParams) error
 	// Health check
 	// (GET /healthz)
 	HealthCheck(c *fiber.Ctx) error
+	// Get opening hours and exceptions
+	// (GET /hours)
+	GetOpeningHours(c *fiber.Ctx) error
+	// Create or update opening hours exception
+	// (POST /hours/exceptions)
+	SaveOpeningException(c *fiber.Ctx) error
+	// Delete opening hours exception
+	// (DELETE /hours/exceptions/{day})
+	DeleteOpeningException(c *fiber.Ctx, day openapi_types.Date) error
+	// Replace weekly opening hours
+	// (POST /hours/weekly)
+	SetWeeklyHours(c *fiber.Ctx) error
+	// Get ingredients
+	// (GET /ingredients)
+	GetIngredients(c *fiber.Ctx) error
+	// Add ingredient
+	// (POST /ingredients)
+	AddIngredient(c *fiber.Ctx) error
+	// Delete ingredient
+	// (DELETE /ingredients/{ingredientId})
+	DeleteIngredient(c *fiber.Ctx, ingredientId openapi_types.UUID) error
+	// Edit ingredient
+	// (PUT /ingredients/{ingredientId})
+	EditIngredient(c *fiber.Ctx, ingredientId openapi_types.UUID) error
+	// Get background jobs
+	// (GET /jobs)
+	GetJobs(c *fiber.Ctx) error
+	// Run background job now
+	// (POST /jobs/{name}/trigger)
+	TriggerJob(c *fiber.Ctx, name string) error
+	// Get kitchen stations
+	// (GET /kds/stations)
+	GetKitchenStations(c *fiber.Ctx) error
+	// Create or update kitchen station
+	// (POST /kds/stations)
+	SaveKitchenStation(c *fiber.Ctx) error
+	// Delete kitchen station
+	// (DELETE /kds/stations/{stationId})
+	DeleteKitchenStation(c *fiber.Ctx, stationId string) error
+	// Get pending tickets of kitchen station
+	// (GET /kds/stations/{stationId}/queue)
+	GetKitchenQueue(c *fiber.Ctx, stationId string) error
+	// Mark kitchen ticket as done
+	// (POST /kds/tickets/{ticketId}/bump)
+	BumpKitchenTicket(c *fiber.Ctx, ticketId openapi_types.UUID) error
 	// Login
 	// (POST /login)
 	Login(c *fiber.Ctx) error
+	// Get the rules of the loyalty program
+	// (GET /loyaltyPolicy)
+	GetLoyaltyPolicy(c *fiber.Ctx) error
 	// Get site menu
 	// (GET /menu)
 	GetMenu(c *fiber.Ctx) error
@@ -424,6 +1759,15 @@ type ServerInterface interface {
 	// Edit product
 	// (PUT /menu/product/{productId})
 	EditProduct(c *fiber.Ctx, productId openapi_types.UUID) error
+	// Replace ingredients consumed by one portion of the product
+	// (PUT /menu/product/{productId}/recipe)
+	SetProductRecipe(c *fiber.Ctx, productId openapi_types.UUID) error
+	// Replace combo slots of the product, an empty list turns it into a regular product
+	// (PUT /menu/product/{productId}/slots)
+	SetComboSlots(c *fiber.Ctx, productId openapi_types.UUID) error
+	// Set stock of the product, without stock the product is not tracked
+	// (POST /menu/product/{productId}/stock)
+	SetProductStock(c *fiber.Ctx, productId openapi_types.UUID) error
 	// Add product group
 	// (POST /menu/productGroup)
 	AddProductGroup(c *fiber.Ctx) error
@@ -436,9 +1780,15 @@ type ServerInterface interface {
 	// Edit product group
 	// (PUT /menu/productGroup/{productGroupId})
 	EditProductGroup(c *fiber.Ctx, productGroupId openapi_types.UUID) error
+	// Assign product group to kitchen station
+	// (POST /menu/productGroup/{productGroupId}/station)
+	SetProductGroupStation(c *fiber.Ctx, productGroupId openapi_types.UUID) error
 	// Create new order
 	// (POST /order)
 	CreateOrder(c *fiber.Ctx) error
+	// Price an order with all applicable discounts without placing it
+	// (POST /order/quote)
+	QuoteOrder(c *fiber.Ctx) error
 	// Mark order as seen
 	// (POST /order/seen)
 	MarkOrderSeen(c *fiber.Ctx) error
@@ -451,15 +1801,81 @@ type ServerInterface interface {
 	// Get order by ID
 	// (GET /order/{id})
 	GetOrder(c *fiber.Ctx, id openapi_types.UUID) error
-	// Get paginated orders
+	// Reprint order kitchen tickets and receipt
+	// (POST /order/{id}/print)
+	PrintOrder(c *fiber.Ctx, id openapi_types.UUID) error
+	// Get limits every order must satisfy
+	// (GET /orderPolicy)
+	GetOrderPolicy(c *fiber.Ctx) error
+	// Search orders, pages are fetched with nextCursor of the previous page
 	// (GET /orders)
 	GetOrders(c *fiber.Ctx, params GetOrdersParams) error
+	// Stream orders created in [from, to) matching the filters, oldest first
+	// (GET /orders/export)
+	ExportOrders(c *fiber.Ctx, params ExportOrdersParams) error
 	// Get params
 	// (GET /params)
 	GetParams(c *fiber.Ctx) error
+	// Set kitchen capacity per pickup slot
+	// (POST /params/setCapacity)
+	SetCapacity(c *fiber.Ctx) error
+	// Close online ordering until the given time
+	// (POST /params/setClosedUntil)
+	SetClosedUntil(c *fiber.Ctx) error
 	// Set header text
 	// (POST /params/setHeaderText)
 	SetHeaderText(c *fiber.Ctx) error
+	// Set the rules of the loyalty program
+	// (POST /params/setLoyaltyPolicy)
+	SetLoyaltyPolicy(c *fiber.Ctx) error
+	// Set limits every order must satisfy
+	// (POST /params/setOrderPolicy)
+	SetOrderPolicy(c *fiber.Ctx) error
+	// Pause or resume online ordering
+	// (POST /params/setOrderingPaused)
+	SetOrderingPaused(c *fiber.Ctx) error
+	// Get all promo codes
+	// (GET /promoCodes)
+	GetPromoCodes(c *fiber.Ctx) error
+	// Add promo code
+	// (POST /promoCodes)
+	AddPromoCode(c *fiber.Ctx) error
+	// Delete promo code
+	// (DELETE /promoCodes/{promoCodeId})
+	DeletePromoCode(c *fiber.Ctx, promoCodeId openapi_types.UUID) error
+	// Edit promo code
+	// (PUT /promoCodes/{promoCodeId})
+	EditPromoCode(c *fiber.Ctx, promoCodeId openapi_types.UUID) error
+	// Get recipes of all products
+	// (GET /recipes)
+	GetRecipes(c *fiber.Ctx) error
+	// Share of cancelled orders by period
+	// (GET /reports/cancellations)
+	GetCancellationReport(c *fiber.Ctx, params GetCancellationReportParams) error
+	// Orders and revenue by weekday and hour
+	// (GET /reports/heatmap)
+	GetHeatmapReport(c *fiber.Ctx, params GetHeatmapReportParams) error
+	// Revenue, order count and average check by period, cancelled orders are excluded
+	// (GET /reports/sales)
+	GetSalesReport(c *fiber.Ctx, params GetSalesReportParams) error
+	// Time orders spend in every status, in seconds
+	// (GET /reports/statusDurations)
+	GetStatusDurationReport(c *fiber.Ctx, params GetStatusDurationReportParams) error
+	// Best selling product groups by revenue before discounts
+	// (GET /reports/topGroups)
+	GetTopGroupsReport(c *fiber.Ctx, params GetTopGroupsReportParams) error
+	// Best selling products by revenue before discounts
+	// (GET /reports/topProducts)
+	GetTopProductsReport(c *fiber.Ctx, params GetTopProductsReportParams) error
+	// Get pickup slots availability
+	// (GET /slots)
+	GetSlots(c *fiber.Ctx, params GetSlotsParams) error
+	// Latest end-of-day reports
+	// (GET /zReports)
+	GetZReports(c *fiber.Ctx, params GetZReportsParams) error
+	// End-of-day report of a business day
+	// (GET /zReports/{day})
+	GetZReport(c *fiber.Ctx, day openapi_types.Date, params GetZReportParams) error
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -469,132 +1885,182 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc fiber.Handler
 
-// HealthCheck operation middleware
-func (siw *ServerInterfaceWrapper) HealthCheck(c *fiber.Ctx) error {
+// GetAnnouncements operation middleware
+func (siw *ServerInterfaceWrapper) GetAnnouncements(c *fiber.Ctx) error {
 
-	return siw.Handler.HealthCheck(c)
-}
+	var err error
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(c *fiber.Ctx) error {
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnnouncementsParams
 
-	return siw.Handler.Login(c)
-}
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-// GetMenu operation middleware
-func (siw *ServerInterfaceWrapper) GetMenu(c *fiber.Ctx) error {
+	// ------------- Optional query parameter "target" -------------
 
-	return siw.Handler.GetMenu(c)
-}
+	err = runtime.BindQueryParameter("form", true, false, "target", query, &params.Target)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter target: %w", err).Error())
+	}
 
-// SetMenuOrdering operation middleware
-func (siw *ServerInterfaceWrapper) SetMenuOrdering(c *fiber.Ctx) error {
+	// ------------- Optional query parameter "menuId" -------------
 
-	return siw.Handler.SetMenuOrdering(c)
+	err = runtime.BindQueryParameter("form", true, false, "menuId", query, &params.MenuId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter menuId: %w", err).Error())
+	}
+
+	return siw.Handler.GetAnnouncements(c, params)
 }
 
-// AddProduct operation middleware
-func (siw *ServerInterfaceWrapper) AddProduct(c *fiber.Ctx) error {
+// AddAnnouncement operation middleware
+func (siw *ServerInterfaceWrapper) AddAnnouncement(c *fiber.Ctx) error {
 
-	return siw.Handler.AddProduct(c)
+	return siw.Handler.AddAnnouncement(c)
 }
 
-// DeleteProduct operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProduct(c *fiber.Ctx) error {
+// GetAllAnnouncements operation middleware
+func (siw *ServerInterfaceWrapper) GetAllAnnouncements(c *fiber.Ctx) error {
+
+	return siw.Handler.GetAllAnnouncements(c)
+}
+
+// DeleteAnnouncement operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAnnouncement(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "productId" -------------
-	var productId openapi_types.UUID
+	// ------------- Path parameter "announcementId" -------------
+	var announcementId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Params("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "announcementId", c.Params("announcementId"), &announcementId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productId: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter announcementId: %w", err).Error())
 	}
 
-	return siw.Handler.DeleteProduct(c, productId)
+	return siw.Handler.DeleteAnnouncement(c, announcementId)
 }
 
-// EditProduct operation middleware
-func (siw *ServerInterfaceWrapper) EditProduct(c *fiber.Ctx) error {
+// EditAnnouncement operation middleware
+func (siw *ServerInterfaceWrapper) EditAnnouncement(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "productId" -------------
-	var productId openapi_types.UUID
+	// ------------- Path parameter "announcementId" -------------
+	var announcementId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Params("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "announcementId", c.Params("announcementId"), &announcementId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productId: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter announcementId: %w", err).Error())
 	}
 
-	return siw.Handler.EditProduct(c, productId)
-}
-
-// AddProductGroup operation middleware
-func (siw *ServerInterfaceWrapper) AddProductGroup(c *fiber.Ctx) error {
-
-	return siw.Handler.AddProductGroup(c)
+	return siw.Handler.EditAnnouncement(c, announcementId)
 }
 
-// SetProductGroupOrdering operation middleware
-func (siw *ServerInterfaceWrapper) SetProductGroupOrdering(c *fiber.Ctx) error {
+// GetConnections operation middleware
+func (siw *ServerInterfaceWrapper) GetConnections(c *fiber.Ctx) error {
 
-	return siw.Handler.SetProductGroupOrdering(c)
+	return siw.Handler.GetConnections(c)
 }
 
-// DeleteProductGroup operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProductGroup(c *fiber.Ctx) error {
+// KickConnection operation middleware
+func (siw *ServerInterfaceWrapper) KickConnection(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "productGroupId" -------------
-	var productGroupId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "productGroupId", c.Params("productGroupId"), &productGroupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productGroupId: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	return siw.Handler.DeleteProductGroup(c, productGroupId)
+	return siw.Handler.KickConnection(c, id)
 }
 
-// EditProductGroup operation middleware
-func (siw *ServerInterfaceWrapper) EditProductGroup(c *fiber.Ctx) error {
+// CustomerLogin operation middleware
+func (siw *ServerInterfaceWrapper) CustomerLogin(c *fiber.Ctx) error {
+
+	return siw.Handler.CustomerLogin(c)
+}
+
+// GetCustomerLoyalty operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomerLoyalty(c *fiber.Ctx) error {
+
+	return siw.Handler.GetCustomerLoyalty(c)
+}
+
+// GetCustomer operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomer(c *fiber.Ctx) error {
+
+	return siw.Handler.GetCustomer(c)
+}
+
+// UpdateCustomer operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCustomer(c *fiber.Ctx) error {
+
+	return siw.Handler.UpdateCustomer(c)
+}
+
+// GetCustomerOrders operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomerOrders(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "productGroupId" -------------
-	var productGroupId openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCustomerOrdersParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "productGroupId", c.Params("productGroupId"), &productGroupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productGroupId: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	return siw.Handler.EditProductGroup(c, productGroupId)
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-// CreateOrder operation middleware
-func (siw *ServerInterfaceWrapper) CreateOrder(c *fiber.Ctx) error {
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
 
-	return siw.Handler.CreateOrder(c)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.GetCustomerOrders(c, params)
 }
 
-// MarkOrderSeen operation middleware
-func (siw *ServerInterfaceWrapper) MarkOrderSeen(c *fiber.Ctx) error {
+// Reorder operation middleware
+func (siw *ServerInterfaceWrapper) Reorder(c *fiber.Ctx) error {
 
-	return siw.Handler.MarkOrderSeen(c)
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	return siw.Handler.Reorder(c, id)
 }
 
-// SetOrderStatus operation middleware
-func (siw *ServerInterfaceWrapper) SetOrderStatus(c *fiber.Ctx) error {
+// RequestCustomerOtp operation middleware
+func (siw *ServerInterfaceWrapper) RequestCustomerOtp(c *fiber.Ctx) error {
 
-	return siw.Handler.SetOrderStatus(c)
+	return siw.Handler.RequestCustomerOtp(c)
 }
 
-// DeleteOrder operation middleware
-func (siw *ServerInterfaceWrapper) DeleteOrder(c *fiber.Ctx) error {
+// GetCustomerLoyaltyById operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomerLoyaltyById(c *fiber.Ctx) error {
 
 	var err error
 
@@ -606,11 +2072,11 @@ func (siw *ServerInterfaceWrapper) DeleteOrder(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	return siw.Handler.DeleteOrder(c, id)
+	return siw.Handler.GetCustomerLoyaltyById(c, id)
 }
 
-// GetOrder operation middleware
-func (siw *ServerInterfaceWrapper) GetOrder(c *fiber.Ctx) error {
+// AdjustCustomerLoyalty operation middleware
+func (siw *ServerInterfaceWrapper) AdjustCustomerLoyalty(c *fiber.Ctx) error {
 
 	var err error
 
@@ -622,16 +2088,60 @@ func (siw *ServerInterfaceWrapper) GetOrder(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	return siw.Handler.GetOrder(c, id)
+	return siw.Handler.AdjustCustomerLoyalty(c, id)
 }
 
-// GetOrders operation middleware
-func (siw *ServerInterfaceWrapper) GetOrders(c *fiber.Ctx) error {
+// GetDiscountRules operation middleware
+func (siw *ServerInterfaceWrapper) GetDiscountRules(c *fiber.Ctx) error {
+
+	return siw.Handler.GetDiscountRules(c)
+}
+
+// AddDiscountRule operation middleware
+func (siw *ServerInterfaceWrapper) AddDiscountRule(c *fiber.Ctx) error {
+
+	return siw.Handler.AddDiscountRule(c)
+}
+
+// DeleteDiscountRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDiscountRule(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", c.Params("ruleId"), &ruleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ruleId: %w", err).Error())
+	}
+
+	return siw.Handler.DeleteDiscountRule(c, ruleId)
+}
+
+// EditDiscountRule operation middleware
+func (siw *ServerInterfaceWrapper) EditDiscountRule(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", c.Params("ruleId"), &ruleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ruleId: %w", err).Error())
+	}
+
+	return siw.Handler.EditDiscountRule(c, ruleId)
+}
+
+// GetDiscountReport operation middleware
+func (siw *ServerInterfaceWrapper) GetDiscountReport(c *fiber.Ctx) error {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetOrdersParams
+	var params GetDiscountReportParams
 
 	var query url.Values
 	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
@@ -639,1107 +2149,7407 @@ func (siw *ServerInterfaceWrapper) GetOrders(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Required query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument from is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", query, &params.From)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Required query parameter "to" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument to is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", query, &params.To)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
 	}
 
-	return siw.Handler.GetOrders(c, params)
+	return siw.Handler.GetDiscountReport(c, params)
 }
 
-// GetParams operation middleware
-func (siw *ServerInterfaceWrapper) GetParams(c *fiber.Ctx) error {
+// HealthCheck operation middleware
+func (siw *ServerInterfaceWrapper) HealthCheck(c *fiber.Ctx) error {
 
-	return siw.Handler.GetParams(c)
+	return siw.Handler.HealthCheck(c)
 }
 
-// SetHeaderText operation middleware
-func (siw *ServerInterfaceWrapper) SetHeaderText(c *fiber.Ctx) error {
+// GetOpeningHours operation middleware
+func (siw *ServerInterfaceWrapper) GetOpeningHours(c *fiber.Ctx) error {
 
-	return siw.Handler.SetHeaderText(c)
+	return siw.Handler.GetOpeningHours(c)
 }
 
-// FiberServerOptions provides options for the Fiber server.
-type FiberServerOptions struct {
-	BaseURL     string
-	Middlewares []MiddlewareFunc
-}
+// SaveOpeningException operation middleware
+func (siw *ServerInterfaceWrapper) SaveOpeningException(c *fiber.Ctx) error {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router fiber.Router, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
+	return siw.Handler.SaveOpeningException(c)
 }
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
-
-	for _, m := range options.Middlewares {
-		router.Use(fiber.Handler(m))
-	}
+// DeleteOpeningException operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOpeningException(c *fiber.Ctx) error {
 
-	router.Get(options.BaseURL+"/healthz", wrapper.HealthCheck)
+	var err error
 
-	router.Post(options.BaseURL+"/login", wrapper.Login)
+	// ------------- Path parameter "day" -------------
+	var day openapi_types.Date
 
-	router.Get(options.BaseURL+"/menu", wrapper.GetMenu)
+	err = runtime.BindStyledParameterWithOptions("simple", "day", c.Params("day"), &day, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter day: %w", err).Error())
+	}
 
-	router.Post(options.BaseURL+"/menu/ordering", wrapper.SetMenuOrdering)
+	return siw.Handler.DeleteOpeningException(c, day)
+}
 
-	router.Post(options.BaseURL+"/menu/product", wrapper.AddProduct)
+// SetWeeklyHours operation middleware
+func (siw *ServerInterfaceWrapper) SetWeeklyHours(c *fiber.Ctx) error {
 
-	router.Delete(options.BaseURL+"/menu/product/:productId", wrapper.DeleteProduct)
+	return siw.Handler.SetWeeklyHours(c)
+}
 
-	router.Put(options.BaseURL+"/menu/product/:productId", wrapper.EditProduct)
+// GetIngredients operation middleware
+func (siw *ServerInterfaceWrapper) GetIngredients(c *fiber.Ctx) error {
 
-	router.Post(options.BaseURL+"/menu/productGroup", wrapper.AddProductGroup)
+	return siw.Handler.GetIngredients(c)
+}
 
-	router.Post(options.BaseURL+"/menu/productGroup/ordering", wrapper.SetProductGroupOrdering)
+// AddIngredient operation middleware
+func (siw *ServerInterfaceWrapper) AddIngredient(c *fiber.Ctx) error {
 
-	router.Delete(options.BaseURL+"/menu/productGroup/:productGroupId", wrapper.DeleteProductGroup)
+	return siw.Handler.AddIngredient(c)
+}
 
-	router.Put(options.BaseURL+"/menu/productGroup/:productGroupId", wrapper.EditProductGroup)
+// DeleteIngredient operation middleware
+func (siw *ServerInterfaceWrapper) DeleteIngredient(c *fiber.Ctx) error {
 
-	router.Post(options.BaseURL+"/order", wrapper.CreateOrder)
+	var err error
 
-	router.Post(options.BaseURL+"/order/seen", wrapper.MarkOrderSeen)
+	// ------------- Path parameter "ingredientId" -------------
+	var ingredientId openapi_types.UUID
 
-	router.Post(options.BaseURL+"/order/setStatus", wrapper.SetOrderStatus)
+	err = runtime.BindStyledParameterWithOptions("simple", "ingredientId", c.Params("ingredientId"), &ingredientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ingredientId: %w", err).Error())
+	}
 
-	router.Delete(options.BaseURL+"/order/:id", wrapper.DeleteOrder)
+	return siw.Handler.DeleteIngredient(c, ingredientId)
+}
 
-	router.Get(options.BaseURL+"/order/:id", wrapper.GetOrder)
+// EditIngredient operation middleware
+func (siw *ServerInterfaceWrapper) EditIngredient(c *fiber.Ctx) error {
 
-	router.Get(options.BaseURL+"/orders", wrapper.GetOrders)
+	var err error
 
-	router.Get(options.BaseURL+"/params", wrapper.GetParams)
+	// ------------- Path parameter "ingredientId" -------------
+	var ingredientId openapi_types.UUID
 
-	router.Post(options.BaseURL+"/params/setHeaderText", wrapper.SetHeaderText)
+	err = runtime.BindStyledParameterWithOptions("simple", "ingredientId", c.Params("ingredientId"), &ingredientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ingredientId: %w", err).Error())
+	}
 
+	return siw.Handler.EditIngredient(c, ingredientId)
 }
 
-type HealthCheckRequestObject struct {
-}
+// GetJobs operation middleware
+func (siw *ServerInterfaceWrapper) GetJobs(c *fiber.Ctx) error {
 
-type HealthCheckResponseObject interface {
-	VisitHealthCheckResponse(ctx *fiber.Ctx) error
+	return siw.Handler.GetJobs(c)
 }
 
-type HealthCheck200Response struct {
-}
+// TriggerJob operation middleware
+func (siw *ServerInterfaceWrapper) TriggerJob(c *fiber.Ctx) error {
 
-func (response HealthCheck200Response) VisitHealthCheckResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
-	return nil
-}
+	var err error
 
-type HealthCheck500JSONResponse General
+	// ------------- Path parameter "name" -------------
+	var name string
 
-func (response HealthCheck500JSONResponse) VisitHealthCheckResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Params("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter name: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.TriggerJob(c, name)
 }
 
-type LoginRequestObject struct {
-	Body *LoginJSONRequestBody
-}
+// GetKitchenStations operation middleware
+func (siw *ServerInterfaceWrapper) GetKitchenStations(c *fiber.Ctx) error {
 
-type LoginResponseObject interface {
-	VisitLoginResponse(ctx *fiber.Ctx) error
+	return siw.Handler.GetKitchenStations(c)
 }
 
-type Login200JSONResponse LoginResponse
-
-func (response Login200JSONResponse) VisitLoginResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+// SaveKitchenStation operation middleware
+func (siw *ServerInterfaceWrapper) SaveKitchenStation(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.SaveKitchenStation(c)
 }
 
-type Login400JSONResponse General
-
-func (response Login400JSONResponse) VisitLoginResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+// DeleteKitchenStation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteKitchenStation(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
-}
+	var err error
 
-type Login401JSONResponse General
+	// ------------- Path parameter "stationId" -------------
+	var stationId string
 
-func (response Login401JSONResponse) VisitLoginResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	err = runtime.BindStyledParameterWithOptions("simple", "stationId", c.Params("stationId"), &stationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter stationId: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.DeleteKitchenStation(c, stationId)
 }
 
-type Login500JSONResponse General
+// GetKitchenQueue operation middleware
+func (siw *ServerInterfaceWrapper) GetKitchenQueue(c *fiber.Ctx) error {
 
-func (response Login500JSONResponse) VisitLoginResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "stationId" -------------
+	var stationId string
 
-type GetMenuRequestObject struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "stationId", c.Params("stationId"), &stationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter stationId: %w", err).Error())
+	}
 
-type GetMenuResponseObject interface {
-	VisitGetMenuResponse(ctx *fiber.Ctx) error
+	return siw.Handler.GetKitchenQueue(c, stationId)
 }
 
-type GetMenu200JSONResponse MenuResponse
+// BumpKitchenTicket operation middleware
+func (siw *ServerInterfaceWrapper) BumpKitchenTicket(c *fiber.Ctx) error {
 
-func (response GetMenu200JSONResponse) VisitGetMenuResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "ticketId" -------------
+	var ticketId openapi_types.UUID
 
-type GetMenu400JSONResponse General
-
-func (response GetMenu400JSONResponse) VisitGetMenuResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", c.Params("ticketId"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ticketId: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.BumpKitchenTicket(c, ticketId)
 }
 
-type GetMenu401JSONResponse General
-
-func (response GetMenu401JSONResponse) VisitGetMenuResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.Login(c)
 }
 
-type GetMenu404JSONResponse General
-
-func (response GetMenu404JSONResponse) VisitGetMenuResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+// GetLoyaltyPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetLoyaltyPolicy(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.GetLoyaltyPolicy(c)
 }
 
-type GetMenu500JSONResponse General
-
-func (response GetMenu500JSONResponse) VisitGetMenuResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+// GetMenu operation middleware
+func (siw *ServerInterfaceWrapper) GetMenu(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.GetMenu(c)
 }
 
-type SetMenuOrderingRequestObject struct {
-	Body *SetMenuOrderingJSONRequestBody
-}
+// SetMenuOrdering operation middleware
+func (siw *ServerInterfaceWrapper) SetMenuOrdering(c *fiber.Ctx) error {
 
-type SetMenuOrderingResponseObject interface {
-	VisitSetMenuOrderingResponse(ctx *fiber.Ctx) error
+	return siw.Handler.SetMenuOrdering(c)
 }
 
-type SetMenuOrdering200Response struct {
-}
+// AddProduct operation middleware
+func (siw *ServerInterfaceWrapper) AddProduct(c *fiber.Ctx) error {
 
-func (response SetMenuOrdering200Response) VisitSetMenuOrderingResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
-	return nil
+	return siw.Handler.AddProduct(c)
 }
 
-type SetMenuOrdering400JSONResponse General
-
-func (response SetMenuOrdering400JSONResponse) VisitSetMenuOrderingResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+// DeleteProduct operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProduct(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
-}
+	var err error
 
-type SetMenuOrdering401JSONResponse General
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-func (response SetMenuOrdering401JSONResponse) VisitSetMenuOrderingResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Params("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productId: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.DeleteProduct(c, productId)
 }
 
-type SetMenuOrdering404JSONResponse General
+// EditProduct operation middleware
+func (siw *ServerInterfaceWrapper) EditProduct(c *fiber.Ctx) error {
 
-func (response SetMenuOrdering404JSONResponse) VisitSetMenuOrderingResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	var err error
 
-	return ctx.JSON(&response)
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Params("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productId: %w", err).Error())
+	}
+
+	return siw.Handler.EditProduct(c, productId)
 }
 
-type SetMenuOrdering500JSONResponse General
+// SetProductRecipe operation middleware
+func (siw *ServerInterfaceWrapper) SetProductRecipe(c *fiber.Ctx) error {
 
-func (response SetMenuOrdering500JSONResponse) VisitSetMenuOrderingResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-type AddProductRequestObject struct {
-	Body *AddProductJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Params("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productId: %w", err).Error())
+	}
 
-type AddProductResponseObject interface {
-	VisitAddProductResponse(ctx *fiber.Ctx) error
+	return siw.Handler.SetProductRecipe(c, productId)
 }
 
-type AddProduct200Response struct {
-}
+// SetComboSlots operation middleware
+func (siw *ServerInterfaceWrapper) SetComboSlots(c *fiber.Ctx) error {
 
-func (response AddProduct200Response) VisitAddProductResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
-	return nil
-}
+	var err error
 
-type AddProduct400JSONResponse General
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-func (response AddProduct400JSONResponse) VisitAddProductResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Params("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productId: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.SetComboSlots(c, productId)
 }
 
-type AddProduct401JSONResponse General
-
-func (response AddProduct401JSONResponse) VisitAddProductResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+// SetProductStock operation middleware
+func (siw *ServerInterfaceWrapper) SetProductStock(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
-}
+	var err error
 
-type AddProduct404JSONResponse General
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-func (response AddProduct404JSONResponse) VisitAddProductResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Params("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productId: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.SetProductStock(c, productId)
 }
 
-type AddProduct500JSONResponse General
-
-func (response AddProduct500JSONResponse) VisitAddProductResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+// AddProductGroup operation middleware
+func (siw *ServerInterfaceWrapper) AddProductGroup(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.AddProductGroup(c)
 }
 
-type DeleteProductRequestObject struct {
-	ProductId openapi_types.UUID `json:"productId"`
-}
+// SetProductGroupOrdering operation middleware
+func (siw *ServerInterfaceWrapper) SetProductGroupOrdering(c *fiber.Ctx) error {
 
-type DeleteProductResponseObject interface {
-	VisitDeleteProductResponse(ctx *fiber.Ctx) error
+	return siw.Handler.SetProductGroupOrdering(c)
 }
 
-type DeleteProduct200Response struct {
-}
+// DeleteProductGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductGroup(c *fiber.Ctx) error {
 
-func (response DeleteProduct200Response) VisitDeleteProductResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
-	return nil
-}
+	var err error
 
-type DeleteProduct400JSONResponse General
+	// ------------- Path parameter "productGroupId" -------------
+	var productGroupId openapi_types.UUID
 
-func (response DeleteProduct400JSONResponse) VisitDeleteProductResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "productGroupId", c.Params("productGroupId"), &productGroupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productGroupId: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.DeleteProductGroup(c, productGroupId)
 }
 
-type DeleteProduct401JSONResponse General
-
-func (response DeleteProduct401JSONResponse) VisitDeleteProductResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+// EditProductGroup operation middleware
+func (siw *ServerInterfaceWrapper) EditProductGroup(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
-}
+	var err error
 
-type DeleteProduct404JSONResponse General
+	// ------------- Path parameter "productGroupId" -------------
+	var productGroupId openapi_types.UUID
 
-func (response DeleteProduct404JSONResponse) VisitDeleteProductResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "productGroupId", c.Params("productGroupId"), &productGroupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productGroupId: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.EditProductGroup(c, productGroupId)
 }
 
-type DeleteProduct500JSONResponse General
+// SetProductGroupStation operation middleware
+func (siw *ServerInterfaceWrapper) SetProductGroupStation(c *fiber.Ctx) error {
 
-func (response DeleteProduct500JSONResponse) VisitDeleteProductResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "productGroupId" -------------
+	var productGroupId openapi_types.UUID
 
-type EditProductRequestObject struct {
-	ProductId openapi_types.UUID `json:"productId"`
-	Body      *EditProductJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "productGroupId", c.Params("productGroupId"), &productGroupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productGroupId: %w", err).Error())
+	}
 
-type EditProductResponseObject interface {
-	VisitEditProductResponse(ctx *fiber.Ctx) error
+	return siw.Handler.SetProductGroupStation(c, productGroupId)
 }
 
-type EditProduct200Response struct {
-}
+// CreateOrder operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrder(c *fiber.Ctx) error {
 
-func (response EditProduct200Response) VisitEditProductResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
-	return nil
+	return siw.Handler.CreateOrder(c)
 }
 
-type EditProduct400JSONResponse General
-
-func (response EditProduct400JSONResponse) VisitEditProductResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+// QuoteOrder operation middleware
+func (siw *ServerInterfaceWrapper) QuoteOrder(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.QuoteOrder(c)
 }
 
-type EditProduct401JSONResponse General
-
-func (response EditProduct401JSONResponse) VisitEditProductResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+// MarkOrderSeen operation middleware
+func (siw *ServerInterfaceWrapper) MarkOrderSeen(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.MarkOrderSeen(c)
 }
 
-type EditProduct404JSONResponse General
-
-func (response EditProduct404JSONResponse) VisitEditProductResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+// SetOrderStatus operation middleware
+func (siw *ServerInterfaceWrapper) SetOrderStatus(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.SetOrderStatus(c)
 }
 
-type EditProduct500JSONResponse General
+// DeleteOrder operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrder(c *fiber.Ctx) error {
 
-func (response EditProduct500JSONResponse) VisitEditProductResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type AddProductGroupRequestObject struct {
-	Body *AddProductGroupJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type AddProductGroupResponseObject interface {
-	VisitAddProductGroupResponse(ctx *fiber.Ctx) error
+	return siw.Handler.DeleteOrder(c, id)
 }
 
-type AddProductGroup200Response struct {
-}
+// GetOrder operation middleware
+func (siw *ServerInterfaceWrapper) GetOrder(c *fiber.Ctx) error {
 
-func (response AddProductGroup200Response) VisitAddProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
-	return nil
-}
+	var err error
 
-type AddProductGroup400JSONResponse General
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (response AddProductGroup400JSONResponse) VisitAddProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.GetOrder(c, id)
 }
 
-type AddProductGroup401JSONResponse General
-
-func (response AddProductGroup401JSONResponse) VisitAddProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+// PrintOrder operation middleware
+func (siw *ServerInterfaceWrapper) PrintOrder(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
-}
+	var err error
 
-type AddProductGroup404JSONResponse General
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (response AddProductGroup404JSONResponse) VisitAddProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.PrintOrder(c, id)
 }
 
-type AddProductGroup500JSONResponse General
-
-func (response AddProductGroup500JSONResponse) VisitAddProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+// GetOrderPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetOrderPolicy(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.GetOrderPolicy(c)
 }
 
-type SetProductGroupOrderingRequestObject struct {
-	Body *SetProductGroupOrderingJSONRequestBody
-}
+// GetOrders operation middleware
+func (siw *ServerInterfaceWrapper) GetOrders(c *fiber.Ctx) error {
 
-type SetProductGroupOrderingResponseObject interface {
-	VisitSetProductGroupOrderingResponse(ctx *fiber.Ctx) error
-}
+	var err error
 
-type SetProductGroupOrdering200Response struct {
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrdersParams
 
-func (response SetProductGroupOrdering200Response) VisitSetProductGroupOrderingResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
-	return nil
-}
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-type SetProductGroupOrdering400JSONResponse General
+	// ------------- Optional query parameter "cursor" -------------
 
-func (response SetProductGroupOrdering400JSONResponse) VisitSetProductGroupOrderingResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type SetProductGroupOrdering401JSONResponse General
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
 
-func (response SetProductGroupOrdering401JSONResponse) VisitSetProductGroupOrderingResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	// ------------- Optional query parameter "sort" -------------
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "sort", query, &params.Sort)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter sort: %w", err).Error())
+	}
 
-type SetProductGroupOrdering404JSONResponse General
+	// ------------- Optional query parameter "status" -------------
 
-func (response SetProductGroupOrdering404JSONResponse) VisitSetProductGroupOrderingResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Optional query parameter "seen" -------------
 
-type SetProductGroupOrdering500JSONResponse General
+	err = runtime.BindQueryParameter("form", true, false, "seen", query, &params.Seen)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter seen: %w", err).Error())
+	}
 
-func (response SetProductGroupOrdering500JSONResponse) VisitSetProductGroupOrderingResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	// ------------- Optional query parameter "from" -------------
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
 
-type DeleteProductGroupRequestObject struct {
-	ProductGroupId openapi_types.UUID `json:"productGroupId"`
-}
+	// ------------- Optional query parameter "to" -------------
 
-type DeleteProductGroupResponseObject interface {
-	VisitDeleteProductGroupResponse(ctx *fiber.Ctx) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
 
-type DeleteProductGroup200Response struct {
-}
+	// ------------- Optional query parameter "tableId" -------------
 
-func (response DeleteProductGroup200Response) VisitDeleteProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "tableId", query, &params.TableId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tableId: %w", err).Error())
+	}
 
-type DeleteProductGroup400JSONResponse General
+	// ------------- Optional query parameter "q" -------------
 
-func (response DeleteProductGroup400JSONResponse) VisitDeleteProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	err = runtime.BindQueryParameter("form", true, false, "q", query, &params.Q)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter q: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Optional query parameter "productId" -------------
 
-type DeleteProductGroup401JSONResponse General
+	err = runtime.BindQueryParameter("form", true, false, "productId", query, &params.ProductId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productId: %w", err).Error())
+	}
 
-func (response DeleteProductGroup401JSONResponse) VisitDeleteProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	// ------------- Optional query parameter "minTotal" -------------
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "minTotal", query, &params.MinTotal)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter minTotal: %w", err).Error())
+	}
 
-type DeleteProductGroup404JSONResponse General
+	// ------------- Optional query parameter "maxTotal" -------------
 
-func (response DeleteProductGroup404JSONResponse) VisitDeleteProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	err = runtime.BindQueryParameter("form", true, false, "maxTotal", query, &params.MaxTotal)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter maxTotal: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Optional query parameter "customerId" -------------
 
-type DeleteProductGroup500JSONResponse General
+	err = runtime.BindQueryParameter("form", true, false, "customerId", query, &params.CustomerId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter customerId: %w", err).Error())
+	}
 
-func (response DeleteProductGroup500JSONResponse) VisitDeleteProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	// ------------- Optional query parameter "withTotal" -------------
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "withTotal", query, &params.WithTotal)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter withTotal: %w", err).Error())
+	}
 
-type EditProductGroupRequestObject struct {
-	ProductGroupId openapi_types.UUID `json:"productGroupId"`
-	Body           *EditProductGroupJSONRequestBody
+	return siw.Handler.GetOrders(c, params)
 }
 
-type EditProductGroupResponseObject interface {
-	VisitEditProductGroupResponse(ctx *fiber.Ctx) error
-}
+// ExportOrders operation middleware
+func (siw *ServerInterfaceWrapper) ExportOrders(c *fiber.Ctx) error {
 
-type EditProductGroup200Response struct {
-}
+	var err error
 
-func (response EditProductGroup200Response) VisitEditProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportOrdersParams
 
-type EditProductGroup400JSONResponse General
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-func (response EditProductGroup400JSONResponse) VisitEditProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	// ------------- Required query parameter "from" -------------
 
-	return ctx.JSON(&response)
-}
+	if paramValue := c.Query("from"); paramValue != "" {
 
-type EditProductGroup401JSONResponse General
+	} else {
+		err = fmt.Errorf("Query argument from is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-func (response EditProductGroup401JSONResponse) VisitEditProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	err = runtime.BindQueryParameter("form", true, true, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Required query parameter "to" -------------
 
-type EditProductGroup404JSONResponse General
+	if paramValue := c.Query("to"); paramValue != "" {
 
-func (response EditProductGroup404JSONResponse) VisitEditProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	} else {
+		err = fmt.Errorf("Query argument to is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
 
-type EditProductGroup500JSONResponse General
+	// ------------- Optional query parameter "format" -------------
 
-func (response EditProductGroup500JSONResponse) VisitEditProductGroupResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Optional query parameter "rows" -------------
 
-type CreateOrderRequestObject struct {
-	Body *CreateOrderJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "rows", query, &params.Rows)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter rows: %w", err).Error())
+	}
 
-type CreateOrderResponseObject interface {
-	VisitCreateOrderResponse(ctx *fiber.Ctx) error
-}
+	// ------------- Optional query parameter "status" -------------
 
-type CreateOrder200Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
 
-func (response CreateOrder200Response) VisitCreateOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
-	return nil
-}
+	// ------------- Optional query parameter "seen" -------------
 
-type CreateOrder400JSONResponse General
+	err = runtime.BindQueryParameter("form", true, false, "seen", query, &params.Seen)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter seen: %w", err).Error())
+	}
 
-func (response CreateOrder400JSONResponse) VisitCreateOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	// ------------- Optional query parameter "tableId" -------------
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "tableId", query, &params.TableId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tableId: %w", err).Error())
+	}
 
-type CreateOrder401JSONResponse General
+	// ------------- Optional query parameter "q" -------------
 
-func (response CreateOrder401JSONResponse) VisitCreateOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	err = runtime.BindQueryParameter("form", true, false, "q", query, &params.Q)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter q: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Optional query parameter "productId" -------------
 
-type CreateOrder500JSONResponse General
+	err = runtime.BindQueryParameter("form", true, false, "productId", query, &params.ProductId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter productId: %w", err).Error())
+	}
 
-func (response CreateOrder500JSONResponse) VisitCreateOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	// ------------- Optional query parameter "minTotal" -------------
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "minTotal", query, &params.MinTotal)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter minTotal: %w", err).Error())
+	}
 
-type MarkOrderSeenRequestObject struct {
-	Body *MarkOrderSeenJSONRequestBody
-}
+	// ------------- Optional query parameter "maxTotal" -------------
 
-type MarkOrderSeenResponseObject interface {
-	VisitMarkOrderSeenResponse(ctx *fiber.Ctx) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "maxTotal", query, &params.MaxTotal)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter maxTotal: %w", err).Error())
+	}
 
-type MarkOrderSeen200Response struct {
+	return siw.Handler.ExportOrders(c, params)
 }
 
-func (response MarkOrderSeen200Response) VisitMarkOrderSeenResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
-	return nil
-}
+// GetParams operation middleware
+func (siw *ServerInterfaceWrapper) GetParams(c *fiber.Ctx) error {
 
-type MarkOrderSeen400JSONResponse General
+	return siw.Handler.GetParams(c)
+}
 
-func (response MarkOrderSeen400JSONResponse) VisitMarkOrderSeenResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+// SetCapacity operation middleware
+func (siw *ServerInterfaceWrapper) SetCapacity(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.SetCapacity(c)
 }
 
-type MarkOrderSeen401JSONResponse General
-
-func (response MarkOrderSeen401JSONResponse) VisitMarkOrderSeenResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+// SetClosedUntil operation middleware
+func (siw *ServerInterfaceWrapper) SetClosedUntil(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.SetClosedUntil(c)
 }
 
-type MarkOrderSeen500JSONResponse General
-
-func (response MarkOrderSeen500JSONResponse) VisitMarkOrderSeenResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+// SetHeaderText operation middleware
+func (siw *ServerInterfaceWrapper) SetHeaderText(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
+	return siw.Handler.SetHeaderText(c)
 }
 
-type SetOrderStatusRequestObject struct {
-	Body *SetOrderStatusJSONRequestBody
-}
+// SetLoyaltyPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetLoyaltyPolicy(c *fiber.Ctx) error {
 
-type SetOrderStatusResponseObject interface {
-	VisitSetOrderStatusResponse(ctx *fiber.Ctx) error
+	return siw.Handler.SetLoyaltyPolicy(c)
 }
 
-type SetOrderStatus200Response struct {
+// SetOrderPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetOrderPolicy(c *fiber.Ctx) error {
+
+	return siw.Handler.SetOrderPolicy(c)
 }
 
-func (response SetOrderStatus200Response) VisitSetOrderStatusResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
-	return nil
+// SetOrderingPaused operation middleware
+func (siw *ServerInterfaceWrapper) SetOrderingPaused(c *fiber.Ctx) error {
+
+	return siw.Handler.SetOrderingPaused(c)
 }
 
-type SetOrderStatus400JSONResponse General
+// GetPromoCodes operation middleware
+func (siw *ServerInterfaceWrapper) GetPromoCodes(c *fiber.Ctx) error {
 
-func (response SetOrderStatus400JSONResponse) VisitSetOrderStatusResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	return siw.Handler.GetPromoCodes(c)
+}
 
-	return ctx.JSON(&response)
+// AddPromoCode operation middleware
+func (siw *ServerInterfaceWrapper) AddPromoCode(c *fiber.Ctx) error {
+
+	return siw.Handler.AddPromoCode(c)
 }
 
-type SetOrderStatus401JSONResponse General
+// DeletePromoCode operation middleware
+func (siw *ServerInterfaceWrapper) DeletePromoCode(c *fiber.Ctx) error {
 
-func (response SetOrderStatus401JSONResponse) VisitSetOrderStatusResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	var err error
 
-	return ctx.JSON(&response)
+	// ------------- Path parameter "promoCodeId" -------------
+	var promoCodeId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "promoCodeId", c.Params("promoCodeId"), &promoCodeId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter promoCodeId: %w", err).Error())
+	}
+
+	return siw.Handler.DeletePromoCode(c, promoCodeId)
 }
 
-type SetOrderStatus500JSONResponse General
+// EditPromoCode operation middleware
+func (siw *ServerInterfaceWrapper) EditPromoCode(c *fiber.Ctx) error {
 
-func (response SetOrderStatus500JSONResponse) VisitSetOrderStatusResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "promoCodeId" -------------
+	var promoCodeId openapi_types.UUID
 
-type DeleteOrderRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "promoCodeId", c.Params("promoCodeId"), &promoCodeId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter promoCodeId: %w", err).Error())
+	}
 
-type DeleteOrderResponseObject interface {
-	VisitDeleteOrderResponse(ctx *fiber.Ctx) error
+	return siw.Handler.EditPromoCode(c, promoCodeId)
 }
 
-type DeleteOrder200Response struct {
-}
+// GetRecipes operation middleware
+func (siw *ServerInterfaceWrapper) GetRecipes(c *fiber.Ctx) error {
 
-func (response DeleteOrder200Response) VisitDeleteOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
-	return nil
+	return siw.Handler.GetRecipes(c)
 }
 
-type DeleteOrder400JSONResponse General
+// GetCancellationReport operation middleware
+func (siw *ServerInterfaceWrapper) GetCancellationReport(c *fiber.Ctx) error {
 
-func (response DeleteOrder400JSONResponse) VisitDeleteOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCancellationReportParams
 
-type DeleteOrder401JSONResponse General
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-func (response DeleteOrder401JSONResponse) VisitDeleteOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	// ------------- Required query parameter "from" -------------
 
-	return ctx.JSON(&response)
-}
+	if paramValue := c.Query("from"); paramValue != "" {
 
-type DeleteOrder404JSONResponse General
+	} else {
+		err = fmt.Errorf("Query argument from is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-func (response DeleteOrder404JSONResponse) VisitDeleteOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	err = runtime.BindQueryParameter("form", true, true, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Required query parameter "to" -------------
 
-type DeleteOrder500JSONResponse General
+	if paramValue := c.Query("to"); paramValue != "" {
 
-func (response DeleteOrder500JSONResponse) VisitDeleteOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	} else {
+		err = fmt.Errorf("Query argument to is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
 
-type GetOrderRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	// ------------- Optional query parameter "granularity" -------------
 
-type GetOrderResponseObject interface {
-	VisitGetOrderResponse(ctx *fiber.Ctx) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "granularity", query, &params.Granularity)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter granularity: %w", err).Error())
+	}
 
-type GetOrder200JSONResponse Order
+	// ------------- Optional query parameter "format" -------------
 
-func (response GetOrder200JSONResponse) VisitGetOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.GetCancellationReport(c, params)
 }
 
-type GetOrder400JSONResponse General
+// GetHeatmapReport operation middleware
+func (siw *ServerInterfaceWrapper) GetHeatmapReport(c *fiber.Ctx) error {
 
-func (response GetOrder400JSONResponse) VisitGetOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHeatmapReportParams
 
-type GetOrder401JSONResponse General
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-func (response GetOrder401JSONResponse) VisitGetOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	// ------------- Required query parameter "from" -------------
 
-	return ctx.JSON(&response)
-}
+	if paramValue := c.Query("from"); paramValue != "" {
 
-type GetOrder404JSONResponse General
+	} else {
+		err = fmt.Errorf("Query argument from is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-func (response GetOrder404JSONResponse) VisitGetOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	err = runtime.BindQueryParameter("form", true, true, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Required query parameter "to" -------------
 
-type GetOrder500JSONResponse General
+	if paramValue := c.Query("to"); paramValue != "" {
 
-func (response GetOrder500JSONResponse) VisitGetOrderResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	} else {
+		err = fmt.Errorf("Query argument to is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
 
-type GetOrdersRequestObject struct {
-	Params GetOrdersParams
-}
+	// ------------- Optional query parameter "format" -------------
 
-type GetOrdersResponseObject interface {
-	VisitGetOrdersResponse(ctx *fiber.Ctx) error
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
+	}
+
+	return siw.Handler.GetHeatmapReport(c, params)
 }
 
-type GetOrders200JSONResponse OrdersResponse
+// GetSalesReport operation middleware
+func (siw *ServerInterfaceWrapper) GetSalesReport(c *fiber.Ctx) error {
 
-func (response GetOrders200JSONResponse) VisitGetOrdersResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSalesReportParams
 
-type GetOrders400JSONResponse General
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-func (response GetOrders400JSONResponse) VisitGetOrdersResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	// ------------- Required query parameter "from" -------------
 
-	return ctx.JSON(&response)
-}
+	if paramValue := c.Query("from"); paramValue != "" {
 
-type GetOrders401JSONResponse General
+	} else {
+		err = fmt.Errorf("Query argument from is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-func (response GetOrders401JSONResponse) VisitGetOrdersResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	err = runtime.BindQueryParameter("form", true, true, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Required query parameter "to" -------------
 
-type GetOrders404JSONResponse General
+	if paramValue := c.Query("to"); paramValue != "" {
 
-func (response GetOrders404JSONResponse) VisitGetOrdersResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	} else {
+		err = fmt.Errorf("Query argument to is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
 
-type GetOrders500JSONResponse General
+	// ------------- Optional query parameter "granularity" -------------
 
-func (response GetOrders500JSONResponse) VisitGetOrdersResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	err = runtime.BindQueryParameter("form", true, false, "granularity", query, &params.Granularity)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter granularity: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Optional query parameter "format" -------------
 
-type GetParamsRequestObject struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
+	}
 
-type GetParamsResponseObject interface {
-	VisitGetParamsResponse(ctx *fiber.Ctx) error
+	return siw.Handler.GetSalesReport(c, params)
+}
+
+// GetStatusDurationReport operation middleware
+func (siw *ServerInterfaceWrapper) GetStatusDurationReport(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatusDurationReportParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument from is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument to is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
+	}
+
+	return siw.Handler.GetStatusDurationReport(c, params)
+}
+
+// GetTopGroupsReport operation middleware
+func (siw *ServerInterfaceWrapper) GetTopGroupsReport(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTopGroupsReportParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument from is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument to is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
+	}
+
+	return siw.Handler.GetTopGroupsReport(c, params)
+}
+
+// GetTopProductsReport operation middleware
+func (siw *ServerInterfaceWrapper) GetTopProductsReport(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTopProductsReportParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument from is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument to is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
+	}
+
+	return siw.Handler.GetTopProductsReport(c, params)
+}
+
+// GetSlots operation middleware
+func (siw *ServerInterfaceWrapper) GetSlots(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSlotsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	return siw.Handler.GetSlots(c, params)
+}
+
+// GetZReports operation middleware
+func (siw *ServerInterfaceWrapper) GetZReports(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetZReportsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.GetZReports(c, params)
+}
+
+// GetZReport operation middleware
+func (siw *ServerInterfaceWrapper) GetZReport(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "day" -------------
+	var day openapi_types.Date
+
+	err = runtime.BindStyledParameterWithOptions("simple", "day", c.Params("day"), &day, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter day: %w", err).Error())
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetZReportParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
+	}
+
+	return siw.Handler.GetZReport(c, day, params)
+}
+
+// FiberServerOptions provides options for the Fiber server.
+type FiberServerOptions struct {
+	BaseURL     string
+	Middlewares []MiddlewareFunc
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router fiber.Router, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	for _, m := range options.Middlewares {
+		router.Use(fiber.Handler(m))
+	}
+
+	router.Get(options.BaseURL+"/announcements", wrapper.GetAnnouncements)
+
+	router.Post(options.BaseURL+"/announcements", wrapper.AddAnnouncement)
+
+	router.Get(options.BaseURL+"/announcements/all", wrapper.GetAllAnnouncements)
+
+	router.Delete(options.BaseURL+"/announcements/:announcementId", wrapper.DeleteAnnouncement)
+
+	router.Put(options.BaseURL+"/announcements/:announcementId", wrapper.EditAnnouncement)
+
+	router.Get(options.BaseURL+"/connections", wrapper.GetConnections)
+
+	router.Delete(options.BaseURL+"/connections/:id", wrapper.KickConnection)
+
+	router.Post(options.BaseURL+"/customer/login", wrapper.CustomerLogin)
+
+	router.Get(options.BaseURL+"/customer/loyalty", wrapper.GetCustomerLoyalty)
+
+	router.Get(options.BaseURL+"/customer/me", wrapper.GetCustomer)
+
+	router.Put(options.BaseURL+"/customer/me", wrapper.UpdateCustomer)
+
+	router.Get(options.BaseURL+"/customer/orders", wrapper.GetCustomerOrders)
+
+	router.Post(options.BaseURL+"/customer/orders/:id/reorder", wrapper.Reorder)
+
+	router.Post(options.BaseURL+"/customer/otp", wrapper.RequestCustomerOtp)
+
+	router.Get(options.BaseURL+"/customers/:id/loyalty", wrapper.GetCustomerLoyaltyById)
+
+	router.Post(options.BaseURL+"/customers/:id/loyalty/adjust", wrapper.AdjustCustomerLoyalty)
+
+	router.Get(options.BaseURL+"/discountRules", wrapper.GetDiscountRules)
+
+	router.Post(options.BaseURL+"/discountRules", wrapper.AddDiscountRule)
+
+	router.Delete(options.BaseURL+"/discountRules/:ruleId", wrapper.DeleteDiscountRule)
+
+	router.Put(options.BaseURL+"/discountRules/:ruleId", wrapper.EditDiscountRule)
+
+	router.Get(options.BaseURL+"/discounts/report", wrapper.GetDiscountReport)
+
+	router.Get(options.BaseURL+"/healthz", wrapper.HealthCheck)
+
+	router.Get(options.BaseURL+"/hours", wrapper.GetOpeningHours)
+
+	router.Post(options.BaseURL+"/hours/exceptions", wrapper.SaveOpeningException)
+
+	router.Delete(options.BaseURL+"/hours/exceptions/:day", wrapper.DeleteOpeningException)
+
+	router.Post(options.BaseURL+"/hours/weekly", wrapper.SetWeeklyHours)
+
+	router.Get(options.BaseURL+"/ingredients", wrapper.GetIngredients)
+
+	router.Post(options.BaseURL+"/ingredients", wrapper.AddIngredient)
+
+	router.Delete(options.BaseURL+"/ingredients/:ingredientId", wrapper.DeleteIngredient)
+
+	router.Put(options.BaseURL+"/ingredients/:ingredientId", wrapper.EditIngredient)
+
+	router.Get(options.BaseURL+"/jobs", wrapper.GetJobs)
+
+	router.Post(options.BaseURL+"/jobs/:name/trigger", wrapper.TriggerJob)
+
+	router.Get(options.BaseURL+"/kds/stations", wrapper.GetKitchenStations)
+
+	router.Post(options.BaseURL+"/kds/stations", wrapper.SaveKitchenStation)
+
+	router.Delete(options.BaseURL+"/kds/stations/:stationId", wrapper.DeleteKitchenStation)
+
+	router.Get(options.BaseURL+"/kds/stations/:stationId/queue", wrapper.GetKitchenQueue)
+
+	router.Post(options.BaseURL+"/kds/tickets/:ticketId/bump", wrapper.BumpKitchenTicket)
+
+	router.Post(options.BaseURL+"/login", wrapper.Login)
+
+	router.Get(options.BaseURL+"/loyaltyPolicy", wrapper.GetLoyaltyPolicy)
+
+	router.Get(options.BaseURL+"/menu", wrapper.GetMenu)
+
+	router.Post(options.BaseURL+"/menu/ordering", wrapper.SetMenuOrdering)
+
+	router.Post(options.BaseURL+"/menu/product", wrapper.AddProduct)
+
+	router.Delete(options.BaseURL+"/menu/product/:productId", wrapper.DeleteProduct)
+
+	router.Put(options.BaseURL+"/menu/product/:productId", wrapper.EditProduct)
+
+	router.Put(options.BaseURL+"/menu/product/:productId/recipe", wrapper.SetProductRecipe)
+
+	router.Put(options.BaseURL+"/menu/product/:productId/slots", wrapper.SetComboSlots)
+
+	router.Post(options.BaseURL+"/menu/product/:productId/stock", wrapper.SetProductStock)
+
+	router.Post(options.BaseURL+"/menu/productGroup", wrapper.AddProductGroup)
+
+	router.Post(options.BaseURL+"/menu/productGroup/ordering", wrapper.SetProductGroupOrdering)
+
+	router.Delete(options.BaseURL+"/menu/productGroup/:productGroupId", wrapper.DeleteProductGroup)
+
+	router.Put(options.BaseURL+"/menu/productGroup/:productGroupId", wrapper.EditProductGroup)
+
+	router.Post(options.BaseURL+"/menu/productGroup/:productGroupId/station", wrapper.SetProductGroupStation)
+
+	router.Post(options.BaseURL+"/order", wrapper.CreateOrder)
+
+	router.Post(options.BaseURL+"/order/quote", wrapper.QuoteOrder)
+
+	router.Post(options.BaseURL+"/order/seen", wrapper.MarkOrderSeen)
+
+	router.Post(options.BaseURL+"/order/setStatus", wrapper.SetOrderStatus)
+
+	router.Delete(options.BaseURL+"/order/:id", wrapper.DeleteOrder)
+
+	router.Get(options.BaseURL+"/order/:id", wrapper.GetOrder)
+
+	router.Post(options.BaseURL+"/order/:id/print", wrapper.PrintOrder)
+
+	router.Get(options.BaseURL+"/orderPolicy", wrapper.GetOrderPolicy)
+
+	router.Get(options.BaseURL+"/orders", wrapper.GetOrders)
+
+	router.Get(options.BaseURL+"/orders/export", wrapper.ExportOrders)
+
+	router.Get(options.BaseURL+"/params", wrapper.GetParams)
+
+	router.Post(options.BaseURL+"/params/setCapacity", wrapper.SetCapacity)
+
+	router.Post(options.BaseURL+"/params/setClosedUntil", wrapper.SetClosedUntil)
+
+	router.Post(options.BaseURL+"/params/setHeaderText", wrapper.SetHeaderText)
+
+	router.Post(options.BaseURL+"/params/setLoyaltyPolicy", wrapper.SetLoyaltyPolicy)
+
+	router.Post(options.BaseURL+"/params/setOrderPolicy", wrapper.SetOrderPolicy)
+
+	router.Post(options.BaseURL+"/params/setOrderingPaused", wrapper.SetOrderingPaused)
+
+	router.Get(options.BaseURL+"/promoCodes", wrapper.GetPromoCodes)
+
+	router.Post(options.BaseURL+"/promoCodes", wrapper.AddPromoCode)
+
+	router.Delete(options.BaseURL+"/promoCodes/:promoCodeId", wrapper.DeletePromoCode)
+
+	router.Put(options.BaseURL+"/promoCodes/:promoCodeId", wrapper.EditPromoCode)
+
+	router.Get(options.BaseURL+"/recipes", wrapper.GetRecipes)
+
+	router.Get(options.BaseURL+"/reports/cancellations", wrapper.GetCancellationReport)
+
+	router.Get(options.BaseURL+"/reports/heatmap", wrapper.GetHeatmapReport)
+
+	router.Get(options.BaseURL+"/reports/sales", wrapper.GetSalesReport)
+
+	router.Get(options.BaseURL+"/reports/statusDurations", wrapper.GetStatusDurationReport)
+
+	router.Get(options.BaseURL+"/reports/topGroups", wrapper.GetTopGroupsReport)
+
+	router.Get(options.BaseURL+"/reports/topProducts", wrapper.GetTopProductsReport)
+
+	router.Get(options.BaseURL+"/slots", wrapper.GetSlots)
+
+	router.Get(options.BaseURL+"/zReports", wrapper.GetZReports)
+
+	router.Get(options.BaseURL+"/zReports/:day", wrapper.GetZReport)
+
+}
+
+type GetAnnouncementsRequestObject struct {
+	Params GetAnnouncementsParams
+}
+
+type GetAnnouncementsResponseObject interface {
+	VisitGetAnnouncementsResponse(ctx *fiber.Ctx) error
+}
+
+type GetAnnouncements200JSONResponse AnnouncementsResponse
+
+func (response GetAnnouncements200JSONResponse) VisitGetAnnouncementsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetAnnouncements400JSONResponse General
+
+func (response GetAnnouncements400JSONResponse) VisitGetAnnouncementsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetAnnouncements401JSONResponse General
+
+func (response GetAnnouncements401JSONResponse) VisitGetAnnouncementsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetAnnouncements500JSONResponse General
+
+func (response GetAnnouncements500JSONResponse) VisitGetAnnouncementsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AddAnnouncementRequestObject struct {
+	Body *AddAnnouncementJSONRequestBody
+}
+
+type AddAnnouncementResponseObject interface {
+	VisitAddAnnouncementResponse(ctx *fiber.Ctx) error
+}
+
+type AddAnnouncement200Response struct {
+}
+
+func (response AddAnnouncement200Response) VisitAddAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type AddAnnouncement400JSONResponse General
+
+func (response AddAnnouncement400JSONResponse) VisitAddAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddAnnouncement401JSONResponse General
+
+func (response AddAnnouncement401JSONResponse) VisitAddAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddAnnouncement500JSONResponse General
+
+func (response AddAnnouncement500JSONResponse) VisitAddAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetAllAnnouncementsRequestObject struct {
+}
+
+type GetAllAnnouncementsResponseObject interface {
+	VisitGetAllAnnouncementsResponse(ctx *fiber.Ctx) error
+}
+
+type GetAllAnnouncements200JSONResponse AnnouncementsResponse
+
+func (response GetAllAnnouncements200JSONResponse) VisitGetAllAnnouncementsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetAllAnnouncements400JSONResponse General
+
+func (response GetAllAnnouncements400JSONResponse) VisitGetAllAnnouncementsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetAllAnnouncements401JSONResponse General
+
+func (response GetAllAnnouncements401JSONResponse) VisitGetAllAnnouncementsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetAllAnnouncements500JSONResponse General
+
+func (response GetAllAnnouncements500JSONResponse) VisitGetAllAnnouncementsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteAnnouncementRequestObject struct {
+	AnnouncementId openapi_types.UUID `json:"announcementId"`
+}
+
+type DeleteAnnouncementResponseObject interface {
+	VisitDeleteAnnouncementResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteAnnouncement200Response struct {
+}
+
+func (response DeleteAnnouncement200Response) VisitDeleteAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type DeleteAnnouncement400JSONResponse General
+
+func (response DeleteAnnouncement400JSONResponse) VisitDeleteAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteAnnouncement401JSONResponse General
+
+func (response DeleteAnnouncement401JSONResponse) VisitDeleteAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteAnnouncement404JSONResponse General
+
+func (response DeleteAnnouncement404JSONResponse) VisitDeleteAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteAnnouncement500JSONResponse General
+
+func (response DeleteAnnouncement500JSONResponse) VisitDeleteAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type EditAnnouncementRequestObject struct {
+	AnnouncementId openapi_types.UUID `json:"announcementId"`
+	Body           *EditAnnouncementJSONRequestBody
+}
+
+type EditAnnouncementResponseObject interface {
+	VisitEditAnnouncementResponse(ctx *fiber.Ctx) error
+}
+
+type EditAnnouncement200Response struct {
+}
+
+func (response EditAnnouncement200Response) VisitEditAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type EditAnnouncement400JSONResponse General
+
+func (response EditAnnouncement400JSONResponse) VisitEditAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type EditAnnouncement401JSONResponse General
+
+func (response EditAnnouncement401JSONResponse) VisitEditAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type EditAnnouncement404JSONResponse General
+
+func (response EditAnnouncement404JSONResponse) VisitEditAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type EditAnnouncement500JSONResponse General
+
+func (response EditAnnouncement500JSONResponse) VisitEditAnnouncementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetConnectionsRequestObject struct {
+}
+
+type GetConnectionsResponseObject interface {
+	VisitGetConnectionsResponse(ctx *fiber.Ctx) error
+}
+
+type GetConnections200JSONResponse ConnectionsResponse
+
+func (response GetConnections200JSONResponse) VisitGetConnectionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetConnections400JSONResponse General
+
+func (response GetConnections400JSONResponse) VisitGetConnectionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetConnections401JSONResponse General
+
+func (response GetConnections401JSONResponse) VisitGetConnectionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetConnections500JSONResponse General
+
+func (response GetConnections500JSONResponse) VisitGetConnectionsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type KickConnectionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type KickConnectionResponseObject interface {
+	VisitKickConnectionResponse(ctx *fiber.Ctx) error
+}
+
+type KickConnection200Response struct {
+}
+
+func (response KickConnection200Response) VisitKickConnectionResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type KickConnection400JSONResponse General
+
+func (response KickConnection400JSONResponse) VisitKickConnectionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type KickConnection401JSONResponse General
+
+func (response KickConnection401JSONResponse) VisitKickConnectionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type KickConnection404JSONResponse General
+
+func (response KickConnection404JSONResponse) VisitKickConnectionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type KickConnection500JSONResponse General
+
+func (response KickConnection500JSONResponse) VisitKickConnectionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type CustomerLoginRequestObject struct {
+	Body *CustomerLoginJSONRequestBody
+}
+
+type CustomerLoginResponseObject interface {
+	VisitCustomerLoginResponse(ctx *fiber.Ctx) error
+}
+
+type CustomerLogin200JSONResponse CustomerLoginResponse
+
+func (response CustomerLogin200JSONResponse) VisitCustomerLoginResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type CustomerLogin400JSONResponse General
+
+func (response CustomerLogin400JSONResponse) VisitCustomerLoginResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CustomerLogin429JSONResponse General
+
+func (response CustomerLogin429JSONResponse) VisitCustomerLoginResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type CustomerLogin500JSONResponse General
+
+func (response CustomerLogin500JSONResponse) VisitCustomerLoginResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomerLoyaltyRequestObject struct {
+}
+
+type GetCustomerLoyaltyResponseObject interface {
+	VisitGetCustomerLoyaltyResponse(ctx *fiber.Ctx) error
+}
+
+type GetCustomerLoyalty200JSONResponse LoyaltyAccount
+
+func (response GetCustomerLoyalty200JSONResponse) VisitGetCustomerLoyaltyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomerLoyalty401JSONResponse General
+
+func (response GetCustomerLoyalty401JSONResponse) VisitGetCustomerLoyaltyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomerLoyalty500JSONResponse General
+
+func (response GetCustomerLoyalty500JSONResponse) VisitGetCustomerLoyaltyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomerRequestObject struct {
+}
+
+type GetCustomerResponseObject interface {
+	VisitGetCustomerResponse(ctx *fiber.Ctx) error
+}
+
+type GetCustomer200JSONResponse Customer
+
+func (response GetCustomer200JSONResponse) VisitGetCustomerResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomer401JSONResponse General
+
+func (response GetCustomer401JSONResponse) VisitGetCustomerResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomer500JSONResponse General
+
+func (response GetCustomer500JSONResponse) VisitGetCustomerResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateCustomerRequestObject struct {
+	Body *UpdateCustomerJSONRequestBody
+}
+
+type UpdateCustomerResponseObject interface {
+	VisitUpdateCustomerResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateCustomer200JSONResponse Customer
+
+func (response UpdateCustomer200JSONResponse) VisitUpdateCustomerResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateCustomer400JSONResponse General
+
+func (response UpdateCustomer400JSONResponse) VisitUpdateCustomerResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateCustomer401JSONResponse General
+
+func (response UpdateCustomer401JSONResponse) VisitUpdateCustomerResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateCustomer500JSONResponse General
+
+func (response UpdateCustomer500JSONResponse) VisitUpdateCustomerResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomerOrdersRequestObject struct {
+	Params GetCustomerOrdersParams
+}
+
+type GetCustomerOrdersResponseObject interface {
+	VisitGetCustomerOrdersResponse(ctx *fiber.Ctx) error
+}
+
+type GetCustomerOrders200JSONResponse OrdersResponse
+
+func (response GetCustomerOrders200JSONResponse) VisitGetCustomerOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomerOrders400JSONResponse General
+
+func (response GetCustomerOrders400JSONResponse) VisitGetCustomerOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomerOrders401JSONResponse General
+
+func (response GetCustomerOrders401JSONResponse) VisitGetCustomerOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomerOrders500JSONResponse General
+
+func (response GetCustomerOrders500JSONResponse) VisitGetCustomerOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ReorderRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *ReorderJSONRequestBody
+}
+
+type ReorderResponseObject interface {
+	VisitReorderResponse(ctx *fiber.Ctx) error
+}
+
+type Reorder200JSONResponse CreateOrderResponse
+
+func (response Reorder200JSONResponse) VisitReorderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type Reorder400JSONResponse General
+
+func (response Reorder400JSONResponse) VisitReorderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type Reorder401JSONResponse General
+
+func (response Reorder401JSONResponse) VisitReorderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type Reorder404JSONResponse General
+
+func (response Reorder404JSONResponse) VisitReorderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type Reorder500JSONResponse General
+
+func (response Reorder500JSONResponse) VisitReorderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type RequestCustomerOtpRequestObject struct {
+	Body *RequestCustomerOtpJSONRequestBody
+}
+
+type RequestCustomerOtpResponseObject interface {
+	VisitRequestCustomerOtpResponse(ctx *fiber.Ctx) error
+}
+
+type RequestCustomerOtp200Response struct {
+}
+
+func (response RequestCustomerOtp200Response) VisitRequestCustomerOtpResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type RequestCustomerOtp400JSONResponse General
+
+func (response RequestCustomerOtp400JSONResponse) VisitRequestCustomerOtpResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type RequestCustomerOtp429JSONResponse General
+
+func (response RequestCustomerOtp429JSONResponse) VisitRequestCustomerOtpResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(429)
+
+	return ctx.JSON(&response)
+}
+
+type RequestCustomerOtp500JSONResponse General
+
+func (response RequestCustomerOtp500JSONResponse) VisitRequestCustomerOtpResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomerLoyaltyByIdRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetCustomerLoyaltyByIdResponseObject interface {
+	VisitGetCustomerLoyaltyByIdResponse(ctx *fiber.Ctx) error
+}
+
+type GetCustomerLoyaltyById200JSONResponse LoyaltyAccount
+
+func (response GetCustomerLoyaltyById200JSONResponse) VisitGetCustomerLoyaltyByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomerLoyaltyById401JSONResponse General
+
+func (response GetCustomerLoyaltyById401JSONResponse) VisitGetCustomerLoyaltyByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomerLoyaltyById404JSONResponse General
+
+func (response GetCustomerLoyaltyById404JSONResponse) VisitGetCustomerLoyaltyByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetCustomerLoyaltyById500JSONResponse General
+
+func (response GetCustomerLoyaltyById500JSONResponse) VisitGetCustomerLoyaltyByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AdjustCustomerLoyaltyRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *AdjustCustomerLoyaltyJSONRequestBody
+}
+
+type AdjustCustomerLoyaltyResponseObject interface {
+	VisitAdjustCustomerLoyaltyResponse(ctx *fiber.Ctx) error
+}
+
+type AdjustCustomerLoyalty200JSONResponse LoyaltyAccount
+
+func (response AdjustCustomerLoyalty200JSONResponse) VisitAdjustCustomerLoyaltyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AdjustCustomerLoyalty400JSONResponse General
+
+func (response AdjustCustomerLoyalty400JSONResponse) VisitAdjustCustomerLoyaltyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AdjustCustomerLoyalty401JSONResponse General
+
+func (response AdjustCustomerLoyalty401JSONResponse) VisitAdjustCustomerLoyaltyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AdjustCustomerLoyalty404JSONResponse General
+
+func (response AdjustCustomerLoyalty404JSONResponse) VisitAdjustCustomerLoyaltyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AdjustCustomerLoyalty500JSONResponse General
+
+func (response AdjustCustomerLoyalty500JSONResponse) VisitAdjustCustomerLoyaltyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetDiscountRulesRequestObject struct {
+}
+
+type GetDiscountRulesResponseObject interface {
+	VisitGetDiscountRulesResponse(ctx *fiber.Ctx) error
+}
+
+type GetDiscountRules200JSONResponse DiscountRulesResponse
+
+func (response GetDiscountRules200JSONResponse) VisitGetDiscountRulesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetDiscountRules401JSONResponse General
+
+func (response GetDiscountRules401JSONResponse) VisitGetDiscountRulesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetDiscountRules500JSONResponse General
+
+func (response GetDiscountRules500JSONResponse) VisitGetDiscountRulesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AddDiscountRuleRequestObject struct {
+	Body *AddDiscountRuleJSONRequestBody
+}
+
+type AddDiscountRuleResponseObject interface {
+	VisitAddDiscountRuleResponse(ctx *fiber.Ctx) error
+}
+
+type AddDiscountRule200Response struct {
+}
+
+func (response AddDiscountRule200Response) VisitAddDiscountRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type AddDiscountRule400JSONResponse General
+
+func (response AddDiscountRule400JSONResponse) VisitAddDiscountRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddDiscountRule401JSONResponse General
+
+func (response AddDiscountRule401JSONResponse) VisitAddDiscountRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddDiscountRule500JSONResponse General
+
+func (response AddDiscountRule500JSONResponse) VisitAddDiscountRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteDiscountRuleRequestObject struct {
+	RuleId openapi_types.UUID `json:"ruleId"`
+}
+
+type DeleteDiscountRuleResponseObject interface {
+	VisitDeleteDiscountRuleResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteDiscountRule200Response struct {
+}
+
+func (response DeleteDiscountRule200Response) VisitDeleteDiscountRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type DeleteDiscountRule401JSONResponse General
+
+func (response DeleteDiscountRule401JSONResponse) VisitDeleteDiscountRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteDiscountRule404JSONResponse General
+
+func (response DeleteDiscountRule404JSONResponse) VisitDeleteDiscountRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteDiscountRule500JSONResponse General
+
+func (response DeleteDiscountRule500JSONResponse) VisitDeleteDiscountRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type EditDiscountRuleRequestObject struct {
+	RuleId openapi_types.UUID `json:"ruleId"`
+	Body   *EditDiscountRuleJSONRequestBody
+}
+
+type EditDiscountRuleResponseObject interface {
+	VisitEditDiscountRuleResponse(ctx *fiber.Ctx) error
+}
+
+type EditDiscountRule200Response struct {
+}
+
+func (response EditDiscountRule200Response) VisitEditDiscountRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type EditDiscountRule400JSONResponse General
+
+func (response EditDiscountRule400JSONResponse) VisitEditDiscountRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type EditDiscountRule401JSONResponse General
+
+func (response EditDiscountRule401JSONResponse) VisitEditDiscountRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type EditDiscountRule404JSONResponse General
+
+func (response EditDiscountRule404JSONResponse) VisitEditDiscountRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type EditDiscountRule500JSONResponse General
+
+func (response EditDiscountRule500JSONResponse) VisitEditDiscountRuleResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetDiscountReportRequestObject struct {
+	Params GetDiscountReportParams
+}
+
+type GetDiscountReportResponseObject interface {
+	VisitGetDiscountReportResponse(ctx *fiber.Ctx) error
+}
+
+type GetDiscountReport200JSONResponse DiscountReport
+
+func (response GetDiscountReport200JSONResponse) VisitGetDiscountReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetDiscountReport400JSONResponse General
+
+func (response GetDiscountReport400JSONResponse) VisitGetDiscountReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetDiscountReport401JSONResponse General
+
+func (response GetDiscountReport401JSONResponse) VisitGetDiscountReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetDiscountReport500JSONResponse General
+
+func (response GetDiscountReport500JSONResponse) VisitGetDiscountReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type HealthCheckRequestObject struct {
+}
+
+type HealthCheckResponseObject interface {
+	VisitHealthCheckResponse(ctx *fiber.Ctx) error
+}
+
+type HealthCheck200Response struct {
+}
+
+func (response HealthCheck200Response) VisitHealthCheckResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type HealthCheck500JSONResponse General
+
+func (response HealthCheck500JSONResponse) VisitHealthCheckResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOpeningHoursRequestObject struct {
+}
+
+type GetOpeningHoursResponseObject interface {
+	VisitGetOpeningHoursResponse(ctx *fiber.Ctx) error
+}
+
+type GetOpeningHours200JSONResponse OpeningHoursResponse
+
+func (response GetOpeningHours200JSONResponse) VisitGetOpeningHoursResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOpeningHours400JSONResponse General
+
+func (response GetOpeningHours400JSONResponse) VisitGetOpeningHoursResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOpeningHours500JSONResponse General
+
+func (response GetOpeningHours500JSONResponse) VisitGetOpeningHoursResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SaveOpeningExceptionRequestObject struct {
+	Body *SaveOpeningExceptionJSONRequestBody
+}
+
+type SaveOpeningExceptionResponseObject interface {
+	VisitSaveOpeningExceptionResponse(ctx *fiber.Ctx) error
+}
+
+type SaveOpeningException200Response struct {
+}
+
+func (response SaveOpeningException200Response) VisitSaveOpeningExceptionResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SaveOpeningException400JSONResponse General
+
+func (response SaveOpeningException400JSONResponse) VisitSaveOpeningExceptionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SaveOpeningException401JSONResponse General
+
+func (response SaveOpeningException401JSONResponse) VisitSaveOpeningExceptionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SaveOpeningException500JSONResponse General
+
+func (response SaveOpeningException500JSONResponse) VisitSaveOpeningExceptionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteOpeningExceptionRequestObject struct {
+	Day openapi_types.Date `json:"day"`
+}
+
+type DeleteOpeningExceptionResponseObject interface {
+	VisitDeleteOpeningExceptionResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteOpeningException200Response struct {
+}
+
+func (response DeleteOpeningException200Response) VisitDeleteOpeningExceptionResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type DeleteOpeningException400JSONResponse General
+
+func (response DeleteOpeningException400JSONResponse) VisitDeleteOpeningExceptionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteOpeningException401JSONResponse General
+
+func (response DeleteOpeningException401JSONResponse) VisitDeleteOpeningExceptionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteOpeningException500JSONResponse General
+
+func (response DeleteOpeningException500JSONResponse) VisitDeleteOpeningExceptionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetWeeklyHoursRequestObject struct {
+	Body *SetWeeklyHoursJSONRequestBody
+}
+
+type SetWeeklyHoursResponseObject interface {
+	VisitSetWeeklyHoursResponse(ctx *fiber.Ctx) error
+}
+
+type SetWeeklyHours200Response struct {
+}
+
+func (response SetWeeklyHours200Response) VisitSetWeeklyHoursResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetWeeklyHours400JSONResponse General
+
+func (response SetWeeklyHours400JSONResponse) VisitSetWeeklyHoursResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetWeeklyHours401JSONResponse General
+
+func (response SetWeeklyHours401JSONResponse) VisitSetWeeklyHoursResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetWeeklyHours500JSONResponse General
+
+func (response SetWeeklyHours500JSONResponse) VisitSetWeeklyHoursResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetIngredientsRequestObject struct {
+}
+
+type GetIngredientsResponseObject interface {
+	VisitGetIngredientsResponse(ctx *fiber.Ctx) error
+}
+
+type GetIngredients200JSONResponse IngredientsResponse
+
+func (response GetIngredients200JSONResponse) VisitGetIngredientsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetIngredients401JSONResponse General
+
+func (response GetIngredients401JSONResponse) VisitGetIngredientsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetIngredients500JSONResponse General
+
+func (response GetIngredients500JSONResponse) VisitGetIngredientsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AddIngredientRequestObject struct {
+	Body *AddIngredientJSONRequestBody
+}
+
+type AddIngredientResponseObject interface {
+	VisitAddIngredientResponse(ctx *fiber.Ctx) error
+}
+
+type AddIngredient200Response struct {
+}
+
+func (response AddIngredient200Response) VisitAddIngredientResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type AddIngredient400JSONResponse General
+
+func (response AddIngredient400JSONResponse) VisitAddIngredientResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddIngredient401JSONResponse General
+
+func (response AddIngredient401JSONResponse) VisitAddIngredientResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddIngredient500JSONResponse General
+
+func (response AddIngredient500JSONResponse) VisitAddIngredientResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteIngredientRequestObject struct {
+	IngredientId openapi_types.UUID `json:"ingredientId"`
+}
+
+type DeleteIngredientResponseObject interface {
+	VisitDeleteIngredientResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteIngredient200Response struct {
+}
+
+func (response DeleteIngredient200Response) VisitDeleteIngredientResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type DeleteIngredient401JSONResponse General
+
+func (response DeleteIngredient401JSONResponse) VisitDeleteIngredientResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteIngredient404JSONResponse General
+
+func (response DeleteIngredient404JSONResponse) VisitDeleteIngredientResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteIngredient500JSONResponse General
+
+func (response DeleteIngredient500JSONResponse) VisitDeleteIngredientResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type EditIngredientRequestObject struct {
+	IngredientId openapi_types.UUID `json:"ingredientId"`
+	Body         *EditIngredientJSONRequestBody
+}
+
+type EditIngredientResponseObject interface {
+	VisitEditIngredientResponse(ctx *fiber.Ctx) error
+}
+
+type EditIngredient200Response struct {
+}
+
+func (response EditIngredient200Response) VisitEditIngredientResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type EditIngredient400JSONResponse General
+
+func (response EditIngredient400JSONResponse) VisitEditIngredientResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type EditIngredient401JSONResponse General
+
+func (response EditIngredient401JSONResponse) VisitEditIngredientResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type EditIngredient404JSONResponse General
+
+func (response EditIngredient404JSONResponse) VisitEditIngredientResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type EditIngredient500JSONResponse General
+
+func (response EditIngredient500JSONResponse) VisitEditIngredientResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetJobsRequestObject struct {
+}
+
+type GetJobsResponseObject interface {
+	VisitGetJobsResponse(ctx *fiber.Ctx) error
+}
+
+type GetJobs200JSONResponse JobsResponse
+
+func (response GetJobs200JSONResponse) VisitGetJobsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetJobs400JSONResponse General
+
+func (response GetJobs400JSONResponse) VisitGetJobsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetJobs401JSONResponse General
+
+func (response GetJobs401JSONResponse) VisitGetJobsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetJobs500JSONResponse General
+
+func (response GetJobs500JSONResponse) VisitGetJobsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type TriggerJobRequestObject struct {
+	Name string `json:"name"`
+}
+
+type TriggerJobResponseObject interface {
+	VisitTriggerJobResponse(ctx *fiber.Ctx) error
+}
+
+type TriggerJob200JSONResponse Job
+
+func (response TriggerJob200JSONResponse) VisitTriggerJobResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type TriggerJob400JSONResponse General
+
+func (response TriggerJob400JSONResponse) VisitTriggerJobResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type TriggerJob401JSONResponse General
+
+func (response TriggerJob401JSONResponse) VisitTriggerJobResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type TriggerJob404JSONResponse General
+
+func (response TriggerJob404JSONResponse) VisitTriggerJobResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type TriggerJob409JSONResponse General
+
+func (response TriggerJob409JSONResponse) VisitTriggerJobResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type TriggerJob500JSONResponse General
+
+func (response TriggerJob500JSONResponse) VisitTriggerJobResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetKitchenStationsRequestObject struct {
+}
+
+type GetKitchenStationsResponseObject interface {
+	VisitGetKitchenStationsResponse(ctx *fiber.Ctx) error
+}
+
+type GetKitchenStations200JSONResponse KitchenStationsResponse
+
+func (response GetKitchenStations200JSONResponse) VisitGetKitchenStationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetKitchenStations400JSONResponse General
+
+func (response GetKitchenStations400JSONResponse) VisitGetKitchenStationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetKitchenStations401JSONResponse General
+
+func (response GetKitchenStations401JSONResponse) VisitGetKitchenStationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetKitchenStations500JSONResponse General
+
+func (response GetKitchenStations500JSONResponse) VisitGetKitchenStationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SaveKitchenStationRequestObject struct {
+	Body *SaveKitchenStationJSONRequestBody
+}
+
+type SaveKitchenStationResponseObject interface {
+	VisitSaveKitchenStationResponse(ctx *fiber.Ctx) error
+}
+
+type SaveKitchenStation200Response struct {
+}
+
+func (response SaveKitchenStation200Response) VisitSaveKitchenStationResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SaveKitchenStation400JSONResponse General
+
+func (response SaveKitchenStation400JSONResponse) VisitSaveKitchenStationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SaveKitchenStation401JSONResponse General
+
+func (response SaveKitchenStation401JSONResponse) VisitSaveKitchenStationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SaveKitchenStation500JSONResponse General
+
+func (response SaveKitchenStation500JSONResponse) VisitSaveKitchenStationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteKitchenStationRequestObject struct {
+	StationId string `json:"stationId"`
+}
+
+type DeleteKitchenStationResponseObject interface {
+	VisitDeleteKitchenStationResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteKitchenStation200Response struct {
+}
+
+func (response DeleteKitchenStation200Response) VisitDeleteKitchenStationResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type DeleteKitchenStation400JSONResponse General
+
+func (response DeleteKitchenStation400JSONResponse) VisitDeleteKitchenStationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteKitchenStation401JSONResponse General
+
+func (response DeleteKitchenStation401JSONResponse) VisitDeleteKitchenStationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteKitchenStation404JSONResponse General
+
+func (response DeleteKitchenStation404JSONResponse) VisitDeleteKitchenStationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteKitchenStation500JSONResponse General
+
+func (response DeleteKitchenStation500JSONResponse) VisitDeleteKitchenStationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetKitchenQueueRequestObject struct {
+	StationId string `json:"stationId"`
+}
+
+type GetKitchenQueueResponseObject interface {
+	VisitGetKitchenQueueResponse(ctx *fiber.Ctx) error
+}
+
+type GetKitchenQueue200JSONResponse KitchenQueueResponse
+
+func (response GetKitchenQueue200JSONResponse) VisitGetKitchenQueueResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetKitchenQueue400JSONResponse General
+
+func (response GetKitchenQueue400JSONResponse) VisitGetKitchenQueueResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetKitchenQueue401JSONResponse General
+
+func (response GetKitchenQueue401JSONResponse) VisitGetKitchenQueueResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetKitchenQueue404JSONResponse General
+
+func (response GetKitchenQueue404JSONResponse) VisitGetKitchenQueueResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetKitchenQueue500JSONResponse General
+
+func (response GetKitchenQueue500JSONResponse) VisitGetKitchenQueueResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type BumpKitchenTicketRequestObject struct {
+	TicketId openapi_types.UUID `json:"ticketId"`
+}
+
+type BumpKitchenTicketResponseObject interface {
+	VisitBumpKitchenTicketResponse(ctx *fiber.Ctx) error
+}
+
+type BumpKitchenTicket200Response struct {
+}
+
+func (response BumpKitchenTicket200Response) VisitBumpKitchenTicketResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type BumpKitchenTicket400JSONResponse General
+
+func (response BumpKitchenTicket400JSONResponse) VisitBumpKitchenTicketResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type BumpKitchenTicket401JSONResponse General
+
+func (response BumpKitchenTicket401JSONResponse) VisitBumpKitchenTicketResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type BumpKitchenTicket404JSONResponse General
+
+func (response BumpKitchenTicket404JSONResponse) VisitBumpKitchenTicketResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type BumpKitchenTicket500JSONResponse General
+
+func (response BumpKitchenTicket500JSONResponse) VisitBumpKitchenTicketResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type LoginRequestObject struct {
+	Body *LoginJSONRequestBody
+}
+
+type LoginResponseObject interface {
+	VisitLoginResponse(ctx *fiber.Ctx) error
+}
+
+type Login200JSONResponse LoginResponse
+
+func (response Login200JSONResponse) VisitLoginResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type Login400JSONResponse General
+
+func (response Login400JSONResponse) VisitLoginResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type Login401JSONResponse General
+
+func (response Login401JSONResponse) VisitLoginResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type Login500JSONResponse General
+
+func (response Login500JSONResponse) VisitLoginResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetLoyaltyPolicyRequestObject struct {
+}
+
+type GetLoyaltyPolicyResponseObject interface {
+	VisitGetLoyaltyPolicyResponse(ctx *fiber.Ctx) error
+}
+
+type GetLoyaltyPolicy200JSONResponse LoyaltyPolicy
+
+func (response GetLoyaltyPolicy200JSONResponse) VisitGetLoyaltyPolicyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetLoyaltyPolicy500JSONResponse General
+
+func (response GetLoyaltyPolicy500JSONResponse) VisitGetLoyaltyPolicyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetMenuRequestObject struct {
+}
+
+type GetMenuResponseObject interface {
+	VisitGetMenuResponse(ctx *fiber.Ctx) error
+}
+
+type GetMenu200JSONResponse MenuResponse
+
+func (response GetMenu200JSONResponse) VisitGetMenuResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetMenu400JSONResponse General
+
+func (response GetMenu400JSONResponse) VisitGetMenuResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetMenu401JSONResponse General
+
+func (response GetMenu401JSONResponse) VisitGetMenuResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetMenu404JSONResponse General
+
+func (response GetMenu404JSONResponse) VisitGetMenuResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetMenu500JSONResponse General
+
+func (response GetMenu500JSONResponse) VisitGetMenuResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetMenuOrderingRequestObject struct {
+	Body *SetMenuOrderingJSONRequestBody
+}
+
+type SetMenuOrderingResponseObject interface {
+	VisitSetMenuOrderingResponse(ctx *fiber.Ctx) error
+}
+
+type SetMenuOrdering200Response struct {
+}
+
+func (response SetMenuOrdering200Response) VisitSetMenuOrderingResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetMenuOrdering400JSONResponse General
+
+func (response SetMenuOrdering400JSONResponse) VisitSetMenuOrderingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetMenuOrdering401JSONResponse General
+
+func (response SetMenuOrdering401JSONResponse) VisitSetMenuOrderingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetMenuOrdering404JSONResponse General
+
+func (response SetMenuOrdering404JSONResponse) VisitSetMenuOrderingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SetMenuOrdering500JSONResponse General
+
+func (response SetMenuOrdering500JSONResponse) VisitSetMenuOrderingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AddProductRequestObject struct {
+	Body *AddProductJSONRequestBody
+}
+
+type AddProductResponseObject interface {
+	VisitAddProductResponse(ctx *fiber.Ctx) error
+}
+
+type AddProduct200Response struct {
+}
+
+func (response AddProduct200Response) VisitAddProductResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type AddProduct400JSONResponse General
+
+func (response AddProduct400JSONResponse) VisitAddProductResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddProduct401JSONResponse General
+
+func (response AddProduct401JSONResponse) VisitAddProductResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddProduct404JSONResponse General
+
+func (response AddProduct404JSONResponse) VisitAddProductResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AddProduct500JSONResponse General
+
+func (response AddProduct500JSONResponse) VisitAddProductResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteProductRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+}
+
+type DeleteProductResponseObject interface {
+	VisitDeleteProductResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteProduct200Response struct {
+}
+
+func (response DeleteProduct200Response) VisitDeleteProductResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type DeleteProduct400JSONResponse General
+
+func (response DeleteProduct400JSONResponse) VisitDeleteProductResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteProduct401JSONResponse General
+
+func (response DeleteProduct401JSONResponse) VisitDeleteProductResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteProduct404JSONResponse General
+
+func (response DeleteProduct404JSONResponse) VisitDeleteProductResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteProduct500JSONResponse General
+
+func (response DeleteProduct500JSONResponse) VisitDeleteProductResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type EditProductRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+	Body      *EditProductJSONRequestBody
+}
+
+type EditProductResponseObject interface {
+	VisitEditProductResponse(ctx *fiber.Ctx) error
+}
+
+type EditProduct200Response struct {
+}
+
+func (response EditProduct200Response) VisitEditProductResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type EditProduct400JSONResponse General
+
+func (response EditProduct400JSONResponse) VisitEditProductResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type EditProduct401JSONResponse General
+
+func (response EditProduct401JSONResponse) VisitEditProductResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type EditProduct404JSONResponse General
+
+func (response EditProduct404JSONResponse) VisitEditProductResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type EditProduct500JSONResponse General
+
+func (response EditProduct500JSONResponse) VisitEditProductResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductRecipeRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+	Body      *SetProductRecipeJSONRequestBody
+}
+
+type SetProductRecipeResponseObject interface {
+	VisitSetProductRecipeResponse(ctx *fiber.Ctx) error
+}
+
+type SetProductRecipe200Response struct {
+}
+
+func (response SetProductRecipe200Response) VisitSetProductRecipeResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetProductRecipe400JSONResponse General
+
+func (response SetProductRecipe400JSONResponse) VisitSetProductRecipeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductRecipe401JSONResponse General
+
+func (response SetProductRecipe401JSONResponse) VisitSetProductRecipeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductRecipe404JSONResponse General
+
+func (response SetProductRecipe404JSONResponse) VisitSetProductRecipeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductRecipe500JSONResponse General
+
+func (response SetProductRecipe500JSONResponse) VisitSetProductRecipeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetComboSlotsRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+	Body      *SetComboSlotsJSONRequestBody
+}
+
+type SetComboSlotsResponseObject interface {
+	VisitSetComboSlotsResponse(ctx *fiber.Ctx) error
+}
+
+type SetComboSlots200Response struct {
+}
+
+func (response SetComboSlots200Response) VisitSetComboSlotsResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetComboSlots400JSONResponse General
+
+func (response SetComboSlots400JSONResponse) VisitSetComboSlotsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetComboSlots401JSONResponse General
+
+func (response SetComboSlots401JSONResponse) VisitSetComboSlotsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetComboSlots404JSONResponse General
+
+func (response SetComboSlots404JSONResponse) VisitSetComboSlotsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SetComboSlots500JSONResponse General
+
+func (response SetComboSlots500JSONResponse) VisitSetComboSlotsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductStockRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+	Body      *SetProductStockJSONRequestBody
+}
+
+type SetProductStockResponseObject interface {
+	VisitSetProductStockResponse(ctx *fiber.Ctx) error
+}
+
+type SetProductStock200Response struct {
+}
+
+func (response SetProductStock200Response) VisitSetProductStockResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetProductStock400JSONResponse General
+
+func (response SetProductStock400JSONResponse) VisitSetProductStockResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductStock401JSONResponse General
+
+func (response SetProductStock401JSONResponse) VisitSetProductStockResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductStock404JSONResponse General
+
+func (response SetProductStock404JSONResponse) VisitSetProductStockResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductStock500JSONResponse General
+
+func (response SetProductStock500JSONResponse) VisitSetProductStockResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AddProductGroupRequestObject struct {
+	Body *AddProductGroupJSONRequestBody
+}
+
+type AddProductGroupResponseObject interface {
+	VisitAddProductGroupResponse(ctx *fiber.Ctx) error
+}
+
+type AddProductGroup200Response struct {
+}
+
+func (response AddProductGroup200Response) VisitAddProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type AddProductGroup400JSONResponse General
+
+func (response AddProductGroup400JSONResponse) VisitAddProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddProductGroup401JSONResponse General
+
+func (response AddProductGroup401JSONResponse) VisitAddProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddProductGroup404JSONResponse General
+
+func (response AddProductGroup404JSONResponse) VisitAddProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AddProductGroup500JSONResponse General
+
+func (response AddProductGroup500JSONResponse) VisitAddProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductGroupOrderingRequestObject struct {
+	Body *SetProductGroupOrderingJSONRequestBody
+}
+
+type SetProductGroupOrderingResponseObject interface {
+	VisitSetProductGroupOrderingResponse(ctx *fiber.Ctx) error
+}
+
+type SetProductGroupOrdering200Response struct {
+}
+
+func (response SetProductGroupOrdering200Response) VisitSetProductGroupOrderingResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetProductGroupOrdering400JSONResponse General
+
+func (response SetProductGroupOrdering400JSONResponse) VisitSetProductGroupOrderingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductGroupOrdering401JSONResponse General
+
+func (response SetProductGroupOrdering401JSONResponse) VisitSetProductGroupOrderingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductGroupOrdering404JSONResponse General
+
+func (response SetProductGroupOrdering404JSONResponse) VisitSetProductGroupOrderingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductGroupOrdering500JSONResponse General
+
+func (response SetProductGroupOrdering500JSONResponse) VisitSetProductGroupOrderingResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteProductGroupRequestObject struct {
+	ProductGroupId openapi_types.UUID `json:"productGroupId"`
+}
+
+type DeleteProductGroupResponseObject interface {
+	VisitDeleteProductGroupResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteProductGroup200Response struct {
+}
+
+func (response DeleteProductGroup200Response) VisitDeleteProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type DeleteProductGroup400JSONResponse General
+
+func (response DeleteProductGroup400JSONResponse) VisitDeleteProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteProductGroup401JSONResponse General
+
+func (response DeleteProductGroup401JSONResponse) VisitDeleteProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteProductGroup404JSONResponse General
+
+func (response DeleteProductGroup404JSONResponse) VisitDeleteProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteProductGroup500JSONResponse General
+
+func (response DeleteProductGroup500JSONResponse) VisitDeleteProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type EditProductGroupRequestObject struct {
+	ProductGroupId openapi_types.UUID `json:"productGroupId"`
+	Body           *EditProductGroupJSONRequestBody
+}
+
+type EditProductGroupResponseObject interface {
+	VisitEditProductGroupResponse(ctx *fiber.Ctx) error
+}
+
+type EditProductGroup200Response struct {
+}
+
+func (response EditProductGroup200Response) VisitEditProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type EditProductGroup400JSONResponse General
+
+func (response EditProductGroup400JSONResponse) VisitEditProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type EditProductGroup401JSONResponse General
+
+func (response EditProductGroup401JSONResponse) VisitEditProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type EditProductGroup404JSONResponse General
+
+func (response EditProductGroup404JSONResponse) VisitEditProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type EditProductGroup500JSONResponse General
+
+func (response EditProductGroup500JSONResponse) VisitEditProductGroupResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductGroupStationRequestObject struct {
+	ProductGroupId openapi_types.UUID `json:"productGroupId"`
+	Body           *SetProductGroupStationJSONRequestBody
+}
+
+type SetProductGroupStationResponseObject interface {
+	VisitSetProductGroupStationResponse(ctx *fiber.Ctx) error
+}
+
+type SetProductGroupStation200Response struct {
+}
+
+func (response SetProductGroupStation200Response) VisitSetProductGroupStationResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetProductGroupStation400JSONResponse General
+
+func (response SetProductGroupStation400JSONResponse) VisitSetProductGroupStationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductGroupStation401JSONResponse General
+
+func (response SetProductGroupStation401JSONResponse) VisitSetProductGroupStationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductGroupStation404JSONResponse General
+
+func (response SetProductGroupStation404JSONResponse) VisitSetProductGroupStationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type SetProductGroupStation500JSONResponse General
+
+func (response SetProductGroupStation500JSONResponse) VisitSetProductGroupStationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrderRequestObject struct {
+	Body *CreateOrderJSONRequestBody
+}
+
+type CreateOrderResponseObject interface {
+	VisitCreateOrderResponse(ctx *fiber.Ctx) error
+}
+
+type CreateOrder200JSONResponse CreateOrderResponse
+
+func (response CreateOrder200JSONResponse) VisitCreateOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrder400JSONResponse General
+
+func (response CreateOrder400JSONResponse) VisitCreateOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrder401JSONResponse General
+
+func (response CreateOrder401JSONResponse) VisitCreateOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrder500JSONResponse General
+
+func (response CreateOrder500JSONResponse) VisitCreateOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type QuoteOrderRequestObject struct {
+	Body *QuoteOrderJSONRequestBody
+}
+
+type QuoteOrderResponseObject interface {
+	VisitQuoteOrderResponse(ctx *fiber.Ctx) error
+}
+
+type QuoteOrder200JSONResponse Quote
+
+func (response QuoteOrder200JSONResponse) VisitQuoteOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type QuoteOrder400JSONResponse General
+
+func (response QuoteOrder400JSONResponse) VisitQuoteOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type QuoteOrder500JSONResponse General
+
+func (response QuoteOrder500JSONResponse) VisitQuoteOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type MarkOrderSeenRequestObject struct {
+	Body *MarkOrderSeenJSONRequestBody
+}
+
+type MarkOrderSeenResponseObject interface {
+	VisitMarkOrderSeenResponse(ctx *fiber.Ctx) error
+}
+
+type MarkOrderSeen200Response struct {
+}
+
+func (response MarkOrderSeen200Response) VisitMarkOrderSeenResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type MarkOrderSeen400JSONResponse General
+
+func (response MarkOrderSeen400JSONResponse) VisitMarkOrderSeenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type MarkOrderSeen401JSONResponse General
+
+func (response MarkOrderSeen401JSONResponse) VisitMarkOrderSeenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type MarkOrderSeen500JSONResponse General
+
+func (response MarkOrderSeen500JSONResponse) VisitMarkOrderSeenResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetOrderStatusRequestObject struct {
+	Body *SetOrderStatusJSONRequestBody
+}
+
+type SetOrderStatusResponseObject interface {
+	VisitSetOrderStatusResponse(ctx *fiber.Ctx) error
+}
+
+type SetOrderStatus200Response struct {
+}
+
+func (response SetOrderStatus200Response) VisitSetOrderStatusResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetOrderStatus400JSONResponse General
+
+func (response SetOrderStatus400JSONResponse) VisitSetOrderStatusResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetOrderStatus401JSONResponse General
+
+func (response SetOrderStatus401JSONResponse) VisitSetOrderStatusResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetOrderStatus500JSONResponse General
+
+func (response SetOrderStatus500JSONResponse) VisitSetOrderStatusResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteOrderRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteOrderResponseObject interface {
+	VisitDeleteOrderResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteOrder200Response struct {
+}
+
+func (response DeleteOrder200Response) VisitDeleteOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type DeleteOrder400JSONResponse General
+
+func (response DeleteOrder400JSONResponse) VisitDeleteOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteOrder401JSONResponse General
+
+func (response DeleteOrder401JSONResponse) VisitDeleteOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteOrder404JSONResponse General
+
+func (response DeleteOrder404JSONResponse) VisitDeleteOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteOrder500JSONResponse General
+
+func (response DeleteOrder500JSONResponse) VisitDeleteOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrderRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetOrderResponseObject interface {
+	VisitGetOrderResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrder200JSONResponse Order
+
+func (response GetOrder200JSONResponse) VisitGetOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrder400JSONResponse General
+
+func (response GetOrder400JSONResponse) VisitGetOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrder401JSONResponse General
+
+func (response GetOrder401JSONResponse) VisitGetOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrder404JSONResponse General
+
+func (response GetOrder404JSONResponse) VisitGetOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrder500JSONResponse General
+
+func (response GetOrder500JSONResponse) VisitGetOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PrintOrderRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type PrintOrderResponseObject interface {
+	VisitPrintOrderResponse(ctx *fiber.Ctx) error
+}
+
+type PrintOrder200Response struct {
+}
+
+func (response PrintOrder200Response) VisitPrintOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type PrintOrder400JSONResponse General
+
+func (response PrintOrder400JSONResponse) VisitPrintOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PrintOrder401JSONResponse General
+
+func (response PrintOrder401JSONResponse) VisitPrintOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PrintOrder404JSONResponse General
+
+func (response PrintOrder404JSONResponse) VisitPrintOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PrintOrder500JSONResponse General
+
+func (response PrintOrder500JSONResponse) VisitPrintOrderResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrderPolicyRequestObject struct {
+}
+
+type GetOrderPolicyResponseObject interface {
+	VisitGetOrderPolicyResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrderPolicy200JSONResponse OrderPolicy
+
+func (response GetOrderPolicy200JSONResponse) VisitGetOrderPolicyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrderPolicy500JSONResponse General
+
+func (response GetOrderPolicy500JSONResponse) VisitGetOrderPolicyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrdersRequestObject struct {
+	Params GetOrdersParams
+}
+
+type GetOrdersResponseObject interface {
+	VisitGetOrdersResponse(ctx *fiber.Ctx) error
+}
+
+type GetOrders200JSONResponse OrdersResponse
+
+func (response GetOrders200JSONResponse) VisitGetOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrders400JSONResponse General
+
+func (response GetOrders400JSONResponse) VisitGetOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrders401JSONResponse General
+
+func (response GetOrders401JSONResponse) VisitGetOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrders404JSONResponse General
+
+func (response GetOrders404JSONResponse) VisitGetOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetOrders500JSONResponse General
+
+func (response GetOrders500JSONResponse) VisitGetOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ExportOrdersRequestObject struct {
+	Params ExportOrdersParams
+}
+
+type ExportOrdersResponseObject interface {
+	VisitExportOrdersResponse(ctx *fiber.Ctx) error
+}
+
+type ExportOrders200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportOrders200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse) VisitExportOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type ExportOrders200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportOrders200ApplicationxNdjsonResponse) VisitExportOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type ExportOrders200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportOrders200TextcsvResponse) VisitExportOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type ExportOrders400JSONResponse General
+
+func (response ExportOrders400JSONResponse) VisitExportOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ExportOrders401JSONResponse General
+
+func (response ExportOrders401JSONResponse) VisitExportOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ExportOrders500JSONResponse General
+
+func (response ExportOrders500JSONResponse) VisitExportOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetParamsRequestObject struct {
+}
+
+type GetParamsResponseObject interface {
+	VisitGetParamsResponse(ctx *fiber.Ctx) error
 }
 
 type GetParams200JSONResponse Params
 
-func (response GetParams200JSONResponse) VisitGetParamsResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+func (response GetParams200JSONResponse) VisitGetParamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetParams400JSONResponse General
+
+func (response GetParams400JSONResponse) VisitGetParamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetParams401JSONResponse General
+
+func (response GetParams401JSONResponse) VisitGetParamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetParams404JSONResponse General
+
+func (response GetParams404JSONResponse) VisitGetParamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetParams500JSONResponse General
+
+func (response GetParams500JSONResponse) VisitGetParamsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetCapacityRequestObject struct {
+	Body *SetCapacityJSONRequestBody
+}
+
+type SetCapacityResponseObject interface {
+	VisitSetCapacityResponse(ctx *fiber.Ctx) error
+}
+
+type SetCapacity200Response struct {
+}
+
+func (response SetCapacity200Response) VisitSetCapacityResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetCapacity400JSONResponse General
+
+func (response SetCapacity400JSONResponse) VisitSetCapacityResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetCapacity401JSONResponse General
+
+func (response SetCapacity401JSONResponse) VisitSetCapacityResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetCapacity500JSONResponse General
+
+func (response SetCapacity500JSONResponse) VisitSetCapacityResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetClosedUntilRequestObject struct {
+	Body *SetClosedUntilJSONRequestBody
+}
+
+type SetClosedUntilResponseObject interface {
+	VisitSetClosedUntilResponse(ctx *fiber.Ctx) error
+}
+
+type SetClosedUntil200Response struct {
+}
+
+func (response SetClosedUntil200Response) VisitSetClosedUntilResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetClosedUntil400JSONResponse General
+
+func (response SetClosedUntil400JSONResponse) VisitSetClosedUntilResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetClosedUntil401JSONResponse General
+
+func (response SetClosedUntil401JSONResponse) VisitSetClosedUntilResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetClosedUntil500JSONResponse General
+
+func (response SetClosedUntil500JSONResponse) VisitSetClosedUntilResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetHeaderTextRequestObject struct {
+	Body *SetHeaderTextJSONRequestBody
+}
+
+type SetHeaderTextResponseObject interface {
+	VisitSetHeaderTextResponse(ctx *fiber.Ctx) error
+}
+
+type SetHeaderText200Response struct {
+}
+
+func (response SetHeaderText200Response) VisitSetHeaderTextResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetHeaderText400JSONResponse General
+
+func (response SetHeaderText400JSONResponse) VisitSetHeaderTextResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetHeaderText401JSONResponse General
+
+func (response SetHeaderText401JSONResponse) VisitSetHeaderTextResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetHeaderText500JSONResponse General
+
+func (response SetHeaderText500JSONResponse) VisitSetHeaderTextResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetLoyaltyPolicyRequestObject struct {
+	Body *SetLoyaltyPolicyJSONRequestBody
+}
+
+type SetLoyaltyPolicyResponseObject interface {
+	VisitSetLoyaltyPolicyResponse(ctx *fiber.Ctx) error
+}
+
+type SetLoyaltyPolicy200Response struct {
+}
+
+func (response SetLoyaltyPolicy200Response) VisitSetLoyaltyPolicyResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetLoyaltyPolicy400JSONResponse General
+
+func (response SetLoyaltyPolicy400JSONResponse) VisitSetLoyaltyPolicyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetLoyaltyPolicy401JSONResponse General
+
+func (response SetLoyaltyPolicy401JSONResponse) VisitSetLoyaltyPolicyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetLoyaltyPolicy500JSONResponse General
+
+func (response SetLoyaltyPolicy500JSONResponse) VisitSetLoyaltyPolicyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetOrderPolicyRequestObject struct {
+	Body *SetOrderPolicyJSONRequestBody
+}
+
+type SetOrderPolicyResponseObject interface {
+	VisitSetOrderPolicyResponse(ctx *fiber.Ctx) error
+}
+
+type SetOrderPolicy200Response struct {
+}
+
+func (response SetOrderPolicy200Response) VisitSetOrderPolicyResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetOrderPolicy400JSONResponse General
+
+func (response SetOrderPolicy400JSONResponse) VisitSetOrderPolicyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetOrderPolicy401JSONResponse General
+
+func (response SetOrderPolicy401JSONResponse) VisitSetOrderPolicyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetOrderPolicy500JSONResponse General
+
+func (response SetOrderPolicy500JSONResponse) VisitSetOrderPolicyResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type SetOrderingPausedRequestObject struct {
+	Body *SetOrderingPausedJSONRequestBody
+}
+
+type SetOrderingPausedResponseObject interface {
+	VisitSetOrderingPausedResponse(ctx *fiber.Ctx) error
+}
+
+type SetOrderingPaused200Response struct {
+}
+
+func (response SetOrderingPaused200Response) VisitSetOrderingPausedResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type SetOrderingPaused400JSONResponse General
+
+func (response SetOrderingPaused400JSONResponse) VisitSetOrderingPausedResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetOrderingPaused401JSONResponse General
+
+func (response SetOrderingPaused401JSONResponse) VisitSetOrderingPausedResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetOrderingPaused500JSONResponse General
+
+func (response SetOrderingPaused500JSONResponse) VisitSetOrderingPausedResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetPromoCodesRequestObject struct {
+}
+
+type GetPromoCodesResponseObject interface {
+	VisitGetPromoCodesResponse(ctx *fiber.Ctx) error
+}
+
+type GetPromoCodes200JSONResponse PromoCodesResponse
+
+func (response GetPromoCodes200JSONResponse) VisitGetPromoCodesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetPromoCodes401JSONResponse General
+
+func (response GetPromoCodes401JSONResponse) VisitGetPromoCodesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetPromoCodes500JSONResponse General
+
+func (response GetPromoCodes500JSONResponse) VisitGetPromoCodesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type AddPromoCodeRequestObject struct {
+	Body *AddPromoCodeJSONRequestBody
+}
+
+type AddPromoCodeResponseObject interface {
+	VisitAddPromoCodeResponse(ctx *fiber.Ctx) error
+}
+
+type AddPromoCode200Response struct {
+}
+
+func (response AddPromoCode200Response) VisitAddPromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type AddPromoCode400JSONResponse General
+
+func (response AddPromoCode400JSONResponse) VisitAddPromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddPromoCode401JSONResponse General
+
+func (response AddPromoCode401JSONResponse) VisitAddPromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddPromoCode409JSONResponse General
+
+func (response AddPromoCode409JSONResponse) VisitAddPromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type AddPromoCode500JSONResponse General
+
+func (response AddPromoCode500JSONResponse) VisitAddPromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeletePromoCodeRequestObject struct {
+	PromoCodeId openapi_types.UUID `json:"promoCodeId"`
+}
+
+type DeletePromoCodeResponseObject interface {
+	VisitDeletePromoCodeResponse(ctx *fiber.Ctx) error
+}
+
+type DeletePromoCode200Response struct {
+}
+
+func (response DeletePromoCode200Response) VisitDeletePromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type DeletePromoCode401JSONResponse General
+
+func (response DeletePromoCode401JSONResponse) VisitDeletePromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeletePromoCode404JSONResponse General
+
+func (response DeletePromoCode404JSONResponse) VisitDeletePromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeletePromoCode500JSONResponse General
+
+func (response DeletePromoCode500JSONResponse) VisitDeletePromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type EditPromoCodeRequestObject struct {
+	PromoCodeId openapi_types.UUID `json:"promoCodeId"`
+	Body        *EditPromoCodeJSONRequestBody
+}
+
+type EditPromoCodeResponseObject interface {
+	VisitEditPromoCodeResponse(ctx *fiber.Ctx) error
+}
+
+type EditPromoCode200Response struct {
+}
+
+func (response EditPromoCode200Response) VisitEditPromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Status(200)
+	return nil
+}
+
+type EditPromoCode400JSONResponse General
+
+func (response EditPromoCode400JSONResponse) VisitEditPromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type EditPromoCode401JSONResponse General
+
+func (response EditPromoCode401JSONResponse) VisitEditPromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type EditPromoCode404JSONResponse General
+
+func (response EditPromoCode404JSONResponse) VisitEditPromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type EditPromoCode409JSONResponse General
+
+func (response EditPromoCode409JSONResponse) VisitEditPromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type EditPromoCode500JSONResponse General
+
+func (response EditPromoCode500JSONResponse) VisitEditPromoCodeResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetRecipesRequestObject struct {
+}
+
+type GetRecipesResponseObject interface {
+	VisitGetRecipesResponse(ctx *fiber.Ctx) error
+}
+
+type GetRecipes200JSONResponse RecipesResponse
+
+func (response GetRecipes200JSONResponse) VisitGetRecipesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetRecipes401JSONResponse General
+
+func (response GetRecipes401JSONResponse) VisitGetRecipesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetRecipes500JSONResponse General
+
+func (response GetRecipes500JSONResponse) VisitGetRecipesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetCancellationReportRequestObject struct {
+	Params GetCancellationReportParams
+}
+
+type GetCancellationReportResponseObject interface {
+	VisitGetCancellationReportResponse(ctx *fiber.Ctx) error
+}
+
+type GetCancellationReport200JSONResponse CancellationReport
+
+func (response GetCancellationReport200JSONResponse) VisitGetCancellationReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetCancellationReport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetCancellationReport200TextcsvResponse) VisitGetCancellationReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type GetCancellationReport400JSONResponse General
+
+func (response GetCancellationReport400JSONResponse) VisitGetCancellationReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetCancellationReport401JSONResponse General
+
+func (response GetCancellationReport401JSONResponse) VisitGetCancellationReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetCancellationReport500JSONResponse General
+
+func (response GetCancellationReport500JSONResponse) VisitGetCancellationReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetHeatmapReportRequestObject struct {
+	Params GetHeatmapReportParams
+}
+
+type GetHeatmapReportResponseObject interface {
+	VisitGetHeatmapReportResponse(ctx *fiber.Ctx) error
+}
+
+type GetHeatmapReport200JSONResponse HeatmapReport
+
+func (response GetHeatmapReport200JSONResponse) VisitGetHeatmapReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetHeatmapReport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetHeatmapReport200TextcsvResponse) VisitGetHeatmapReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type GetHeatmapReport400JSONResponse General
+
+func (response GetHeatmapReport400JSONResponse) VisitGetHeatmapReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetHeatmapReport401JSONResponse General
+
+func (response GetHeatmapReport401JSONResponse) VisitGetHeatmapReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetHeatmapReport500JSONResponse General
+
+func (response GetHeatmapReport500JSONResponse) VisitGetHeatmapReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetSalesReportRequestObject struct {
+	Params GetSalesReportParams
+}
+
+type GetSalesReportResponseObject interface {
+	VisitGetSalesReportResponse(ctx *fiber.Ctx) error
+}
+
+type GetSalesReport200JSONResponse SalesReport
+
+func (response GetSalesReport200JSONResponse) VisitGetSalesReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetSalesReport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetSalesReport200TextcsvResponse) VisitGetSalesReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type GetSalesReport400JSONResponse General
+
+func (response GetSalesReport400JSONResponse) VisitGetSalesReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetSalesReport401JSONResponse General
+
+func (response GetSalesReport401JSONResponse) VisitGetSalesReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetSalesReport500JSONResponse General
+
+func (response GetSalesReport500JSONResponse) VisitGetSalesReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetStatusDurationReportRequestObject struct {
+	Params GetStatusDurationReportParams
+}
+
+type GetStatusDurationReportResponseObject interface {
+	VisitGetStatusDurationReportResponse(ctx *fiber.Ctx) error
+}
+
+type GetStatusDurationReport200JSONResponse StatusDurationReport
+
+func (response GetStatusDurationReport200JSONResponse) VisitGetStatusDurationReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetStatusDurationReport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetStatusDurationReport200TextcsvResponse) VisitGetStatusDurationReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type GetStatusDurationReport400JSONResponse General
+
+func (response GetStatusDurationReport400JSONResponse) VisitGetStatusDurationReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetStatusDurationReport401JSONResponse General
+
+func (response GetStatusDurationReport401JSONResponse) VisitGetStatusDurationReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetStatusDurationReport500JSONResponse General
+
+func (response GetStatusDurationReport500JSONResponse) VisitGetStatusDurationReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetTopGroupsReportRequestObject struct {
+	Params GetTopGroupsReportParams
+}
+
+type GetTopGroupsReportResponseObject interface {
+	VisitGetTopGroupsReportResponse(ctx *fiber.Ctx) error
+}
+
+type GetTopGroupsReport200JSONResponse TopGroupsReport
+
+func (response GetTopGroupsReport200JSONResponse) VisitGetTopGroupsReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetTopGroupsReport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetTopGroupsReport200TextcsvResponse) VisitGetTopGroupsReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type GetTopGroupsReport400JSONResponse General
+
+func (response GetTopGroupsReport400JSONResponse) VisitGetTopGroupsReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetTopGroupsReport401JSONResponse General
+
+func (response GetTopGroupsReport401JSONResponse) VisitGetTopGroupsReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetTopGroupsReport500JSONResponse General
+
+func (response GetTopGroupsReport500JSONResponse) VisitGetTopGroupsReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetTopProductsReportRequestObject struct {
+	Params GetTopProductsReportParams
+}
+
+type GetTopProductsReportResponseObject interface {
+	VisitGetTopProductsReportResponse(ctx *fiber.Ctx) error
+}
+
+type GetTopProductsReport200JSONResponse TopProductsReport
+
+func (response GetTopProductsReport200JSONResponse) VisitGetTopProductsReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetTopProductsReport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetTopProductsReport200TextcsvResponse) VisitGetTopProductsReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type GetTopProductsReport400JSONResponse General
+
+func (response GetTopProductsReport400JSONResponse) VisitGetTopProductsReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetTopProductsReport401JSONResponse General
+
+func (response GetTopProductsReport401JSONResponse) VisitGetTopProductsReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetTopProductsReport500JSONResponse General
+
+func (response GetTopProductsReport500JSONResponse) VisitGetTopProductsReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetSlotsRequestObject struct {
+	Params GetSlotsParams
+}
+
+type GetSlotsResponseObject interface {
+	VisitGetSlotsResponse(ctx *fiber.Ctx) error
+}
+
+type GetSlots200JSONResponse SlotsResponse
+
+func (response GetSlots200JSONResponse) VisitGetSlotsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetSlots400JSONResponse General
+
+func (response GetSlots400JSONResponse) VisitGetSlotsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetSlots500JSONResponse General
+
+func (response GetSlots500JSONResponse) VisitGetSlotsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetZReportsRequestObject struct {
+	Params GetZReportsParams
+}
+
+type GetZReportsResponseObject interface {
+	VisitGetZReportsResponse(ctx *fiber.Ctx) error
+}
+
+type GetZReports200JSONResponse ZReportsResponse
+
+func (response GetZReports200JSONResponse) VisitGetZReportsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetZReports401JSONResponse General
+
+func (response GetZReports401JSONResponse) VisitGetZReportsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetZReports500JSONResponse General
+
+func (response GetZReports500JSONResponse) VisitGetZReportsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetZReportRequestObject struct {
+	Day    openapi_types.Date `json:"day"`
+	Params GetZReportParams
+}
+
+type GetZReportResponseObject interface {
+	VisitGetZReportResponse(ctx *fiber.Ctx) error
+}
+
+type GetZReport200JSONResponse ZReport
+
+func (response GetZReport200JSONResponse) VisitGetZReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetZReport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetZReport200TextcsvResponse) VisitGetZReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type GetZReport401JSONResponse General
+
+func (response GetZReport401JSONResponse) VisitGetZReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetZReport404JSONResponse General
+
+func (response GetZReport404JSONResponse) VisitGetZReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetZReport500JSONResponse General
+
+func (response GetZReport500JSONResponse) VisitGetZReportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get currently visible announcements
+	// (GET /announcements)
+	GetAnnouncements(ctx context.Context, request GetAnnouncementsRequestObject) (GetAnnouncementsResponseObject, error)
+	// Add announcement
+	// (POST /announcements)
+	AddAnnouncement(ctx context.Context, request AddAnnouncementRequestObject) (AddAnnouncementResponseObject, error)
+	// Get all announcements including scheduled and expired
+	// (GET /announcements/all)
+	GetAllAnnouncements(ctx context.Context, request GetAllAnnouncementsRequestObject) (GetAllAnnouncementsResponseObject, error)
+	// Delete announcement
+	// (DELETE /announcements/{announcementId})
+	DeleteAnnouncement(ctx context.Context, request DeleteAnnouncementRequestObject) (DeleteAnnouncementResponseObject, error)
+	// Edit announcement
+	// (PUT /announcements/{announcementId})
+	EditAnnouncement(ctx context.Context, request EditAnnouncementRequestObject) (EditAnnouncementResponseObject, error)
+	// Get realtime connections
+	// (GET /connections)
+	GetConnections(ctx context.Context, request GetConnectionsRequestObject) (GetConnectionsResponseObject, error)
+	// Force realtime connection to disconnect
+	// (DELETE /connections/{id})
+	KickConnection(ctx context.Context, request KickConnectionRequestObject) (KickConnectionResponseObject, error)
+	// Log in with the one-time code, the account is created on first login
+	// (POST /customer/login)
+	CustomerLogin(ctx context.Context, request CustomerLoginRequestObject) (CustomerLoginResponseObject, error)
+	// Points balance and latest ledger entries of the logged in customer
+	// (GET /customer/loyalty)
+	GetCustomerLoyalty(ctx context.Context, request GetCustomerLoyaltyRequestObject) (GetCustomerLoyaltyResponseObject, error)
+	// Get the logged in customer
+	// (GET /customer/me)
+	GetCustomer(ctx context.Context, request GetCustomerRequestObject) (GetCustomerResponseObject, error)
+	// Update the logged in customer
+	// (PUT /customer/me)
+	UpdateCustomer(ctx context.Context, request UpdateCustomerRequestObject) (UpdateCustomerResponseObject, error)
+	// Orders of the logged in customer, newest first
+	// (GET /customer/orders)
+	GetCustomerOrders(ctx context.Context, request GetCustomerOrdersRequestObject) (GetCustomerOrdersResponseObject, error)
+	// Place a new order with the items of a past one at current prices
+	// (POST /customer/orders/{id}/reorder)
+	Reorder(ctx context.Context, request ReorderRequestObject) (ReorderResponseObject, error)
+	// Send a one-time login code to the phone
+	// (POST /customer/otp)
+	RequestCustomerOtp(ctx context.Context, request RequestCustomerOtpRequestObject) (RequestCustomerOtpResponseObject, error)
+	// Points balance and latest ledger entries of a customer
+	// (GET /customers/{id}/loyalty)
+	GetCustomerLoyaltyById(ctx context.Context, request GetCustomerLoyaltyByIdRequestObject) (GetCustomerLoyaltyByIdResponseObject, error)
+	// Add or take away points of a customer by hand
+	// (POST /customers/{id}/loyalty/adjust)
+	AdjustCustomerLoyalty(ctx context.Context, request AdjustCustomerLoyaltyRequestObject) (AdjustCustomerLoyaltyResponseObject, error)
+	// Get all automatic discount rules
+	// (GET /discountRules)
+	GetDiscountRules(ctx context.Context, request GetDiscountRulesRequestObject) (GetDiscountRulesResponseObject, error)
+	// Add automatic discount rule
+	// (POST /discountRules)
+	AddDiscountRule(ctx context.Context, request AddDiscountRuleRequestObject) (AddDiscountRuleResponseObject, error)
+	// Delete automatic discount rule
+	// (DELETE /discountRules/{ruleId})
+	DeleteDiscountRule(ctx context.Context, request DeleteDiscountRuleRequestObject) (DeleteDiscountRuleResponseObject, error)
+	// Edit automatic discount rule
+	// (PUT /discountRules/{ruleId})
+	EditDiscountRule(ctx context.Context, request EditDiscountRuleRequestObject) (EditDiscountRuleResponseObject, error)
+	// Discounts granted in a period, grouped by promo code and rule
+	// (GET /discounts/report)
+	GetDiscountReport(ctx context.Context, request GetDiscountReportRequestObject) (GetDiscountReportResponseObject, error)
+	// Health check
+	// (GET /healthz)
+	HealthCheck(ctx context.Context, request HealthCheckRequestObject) (HealthCheckResponseObject, error)
+	// Get opening hours and exceptions
+	// (GET /hours)
+	GetOpeningHours(ctx context.Context, request GetOpeningHoursRequestObject) (GetOpeningHoursResponseObject, error)
+	// Create or update opening hours exception
+	// (POST /hours/exceptions)
+	SaveOpeningException(ctx context.Context, request SaveOpeningExceptionRequestObject) (SaveOpeningExceptionResponseObject, error)
+	// Delete opening hours exception
+	// (DELETE /hours/exceptions/{day})
+	DeleteOpeningException(ctx context.Context, request DeleteOpeningExceptionRequestObject) (DeleteOpeningExceptionResponseObject, error)
+	// Replace weekly opening hours
+	// (POST /hours/weekly)
+	SetWeeklyHours(ctx context.Context, request SetWeeklyHoursRequestObject) (SetWeeklyHoursResponseObject, error)
+	// Get ingredients
+	// (GET /ingredients)
+	GetIngredients(ctx context.Context, request GetIngredientsRequestObject) (GetIngredientsResponseObject, error)
+	// Add ingredient
+	// (POST /ingredients)
+	AddIngredient(ctx context.Context, request AddIngredientRequestObject) (AddIngredientResponseObject, error)
+	// Delete ingredient
+	// (DELETE /ingredients/{ingredientId})
+	DeleteIngredient(ctx context.Context, request DeleteIngredientRequestObject) (DeleteIngredientResponseObject, error)
+	// Edit ingredient
+	// (PUT /ingredients/{ingredientId})
+	EditIngredient(ctx context.Context, request EditIngredientRequestObject) (EditIngredientResponseObject, error)
+	// Get background jobs
+	// (GET /jobs)
+	GetJobs(ctx context.Context, request GetJobsRequestObject) (GetJobsResponseObject, error)
+	// Run background job now
+	// (POST /jobs/{name}/trigger)
+	TriggerJob(ctx context.Context, request TriggerJobRequestObject) (TriggerJobResponseObject, error)
+	// Get kitchen stations
+	// (GET /kds/stations)
+	GetKitchenStations(ctx context.Context, request GetKitchenStationsRequestObject) (GetKitchenStationsResponseObject, error)
+	// Create or update kitchen station
+	// (POST /kds/stations)
+	SaveKitchenStation(ctx context.Context, request SaveKitchenStationRequestObject) (SaveKitchenStationResponseObject, error)
+	// Delete kitchen station
+	// (DELETE /kds/stations/{stationId})
+	DeleteKitchenStation(ctx context.Context, request DeleteKitchenStationRequestObject) (DeleteKitchenStationResponseObject, error)
+	// Get pending tickets of kitchen station
+	// (GET /kds/stations/{stationId}/queue)
+	GetKitchenQueue(ctx context.Context, request GetKitchenQueueRequestObject) (GetKitchenQueueResponseObject, error)
+	// Mark kitchen ticket as done
+	// (POST /kds/tickets/{ticketId}/bump)
+	BumpKitchenTicket(ctx context.Context, request BumpKitchenTicketRequestObject) (BumpKitchenTicketResponseObject, error)
+	// Login
+	// (POST /login)
+	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	// Get the rules of the loyalty program
+	// (GET /loyaltyPolicy)
+	GetLoyaltyPolicy(ctx context.Context, request GetLoyaltyPolicyRequestObject) (GetLoyaltyPolicyResponseObject, error)
+	// Get site menu
+	// (GET /menu)
+	GetMenu(ctx context.Context, request GetMenuRequestObject) (GetMenuResponseObject, error)
+	// Set menu ordering
+	// (POST /menu/ordering)
+	SetMenuOrdering(ctx context.Context, request SetMenuOrderingRequestObject) (SetMenuOrderingResponseObject, error)
+	// Add product
+	// (POST /menu/product)
+	AddProduct(ctx context.Context, request AddProductRequestObject) (AddProductResponseObject, error)
+	// Delete product
+	// (DELETE /menu/product/{productId})
+	DeleteProduct(ctx context.Context, request DeleteProductRequestObject) (DeleteProductResponseObject, error)
+	// Edit product
+	// (PUT /menu/product/{productId})
+	EditProduct(ctx context.Context, request EditProductRequestObject) (EditProductResponseObject, error)
+	// Replace ingredients consumed by one portion of the product
+	// (PUT /menu/product/{productId}/recipe)
+	SetProductRecipe(ctx context.Context, request SetProductRecipeRequestObject) (SetProductRecipeResponseObject, error)
+	// Replace combo slots of the product, an empty list turns it into a regular product
+	// (PUT /menu/product/{productId}/slots)
+	SetComboSlots(ctx context.Context, request SetComboSlotsRequestObject) (SetComboSlotsResponseObject, error)
+	// Set stock of the product, without stock the product is not tracked
+	// (POST /menu/product/{productId}/stock)
+	SetProductStock(ctx context.Context, request SetProductStockRequestObject) (SetProductStockResponseObject, error)
+	// Add product group
+	// (POST /menu/productGroup)
+	AddProductGroup(ctx context.Context, request AddProductGroupRequestObject) (AddProductGroupResponseObject, error)
+	// Set product group ordering
+	// (POST /menu/productGroup/ordering)
+	SetProductGroupOrdering(ctx context.Context, request SetProductGroupOrderingRequestObject) (SetProductGroupOrderingResponseObject, error)
+	// Delete product group
+	// (DELETE /menu/productGroup/{productGroupId})
+	DeleteProductGroup(ctx context.Context, request DeleteProductGroupRequestObject) (DeleteProductGroupResponseObject, error)
+	// Edit product group
+	// (PUT /menu/productGroup/{productGroupId})
+	EditProductGroup(ctx context.Context, request EditProductGroupRequestObject) (EditProductGroupResponseObject, error)
+	// Assign product group to kitchen station
+	// (POST /menu/productGroup/{productGroupId}/station)
+	SetProductGroupStation(ctx context.Context, request SetProductGroupStationRequestObject) (SetProductGroupStationResponseObject, error)
+	// Create new order
+	// (POST /order)
+	CreateOrder(ctx context.Context, request CreateOrderRequestObject) (CreateOrderResponseObject, error)
+	// Price an order with all applicable discounts without placing it
+	// (POST /order/quote)
+	QuoteOrder(ctx context.Context, request QuoteOrderRequestObject) (QuoteOrderResponseObject, error)
+	// Mark order as seen
+	// (POST /order/seen)
+	MarkOrderSeen(ctx context.Context, request MarkOrderSeenRequestObject) (MarkOrderSeenResponseObject, error)
+	// Set order status
+	// (POST /order/setStatus)
+	SetOrderStatus(ctx context.Context, request SetOrderStatusRequestObject) (SetOrderStatusResponseObject, error)
+	// Delete order
+	// (DELETE /order/{id})
+	DeleteOrder(ctx context.Context, request DeleteOrderRequestObject) (DeleteOrderResponseObject, error)
+	// Get order by ID
+	// (GET /order/{id})
+	GetOrder(ctx context.Context, request GetOrderRequestObject) (GetOrderResponseObject, error)
+	// Reprint order kitchen tickets and receipt
+	// (POST /order/{id}/print)
+	PrintOrder(ctx context.Context, request PrintOrderRequestObject) (PrintOrderResponseObject, error)
+	// Get limits every order must satisfy
+	// (GET /orderPolicy)
+	GetOrderPolicy(ctx context.Context, request GetOrderPolicyRequestObject) (GetOrderPolicyResponseObject, error)
+	// Search orders, pages are fetched with nextCursor of the previous page
+	// (GET /orders)
+	GetOrders(ctx context.Context, request GetOrdersRequestObject) (GetOrdersResponseObject, error)
+	// Stream orders created in [from, to) matching the filters, oldest first
+	// (GET /orders/export)
+	ExportOrders(ctx context.Context, request ExportOrdersRequestObject) (ExportOrdersResponseObject, error)
+	// Get params
+	// (GET /params)
+	GetParams(ctx context.Context, request GetParamsRequestObject) (GetParamsResponseObject, error)
+	// Set kitchen capacity per pickup slot
+	// (POST /params/setCapacity)
+	SetCapacity(ctx context.Context, request SetCapacityRequestObject) (SetCapacityResponseObject, error)
+	// Close online ordering until the given time
+	// (POST /params/setClosedUntil)
+	SetClosedUntil(ctx context.Context, request SetClosedUntilRequestObject) (SetClosedUntilResponseObject, error)
+	// Set header text
+	// (POST /params/setHeaderText)
+	SetHeaderText(ctx context.Context, request SetHeaderTextRequestObject) (SetHeaderTextResponseObject, error)
+	// Set the rules of the loyalty program
+	// (POST /params/setLoyaltyPolicy)
+	SetLoyaltyPolicy(ctx context.Context, request SetLoyaltyPolicyRequestObject) (SetLoyaltyPolicyResponseObject, error)
+	// Set limits every order must satisfy
+	// (POST /params/setOrderPolicy)
+	SetOrderPolicy(ctx context.Context, request SetOrderPolicyRequestObject) (SetOrderPolicyResponseObject, error)
+	// Pause or resume online ordering
+	// (POST /params/setOrderingPaused)
+	SetOrderingPaused(ctx context.Context, request SetOrderingPausedRequestObject) (SetOrderingPausedResponseObject, error)
+	// Get all promo codes
+	// (GET /promoCodes)
+	GetPromoCodes(ctx context.Context, request GetPromoCodesRequestObject) (GetPromoCodesResponseObject, error)
+	// Add promo code
+	// (POST /promoCodes)
+	AddPromoCode(ctx context.Context, request AddPromoCodeRequestObject) (AddPromoCodeResponseObject, error)
+	// Delete promo code
+	// (DELETE /promoCodes/{promoCodeId})
+	DeletePromoCode(ctx context.Context, request DeletePromoCodeRequestObject) (DeletePromoCodeResponseObject, error)
+	// Edit promo code
+	// (PUT /promoCodes/{promoCodeId})
+	EditPromoCode(ctx context.Context, request EditPromoCodeRequestObject) (EditPromoCodeResponseObject, error)
+	// Get recipes of all products
+	// (GET /recipes)
+	GetRecipes(ctx context.Context, request GetRecipesRequestObject) (GetRecipesResponseObject, error)
+	// Share of cancelled orders by period
+	// (GET /reports/cancellations)
+	GetCancellationReport(ctx context.Context, request GetCancellationReportRequestObject) (GetCancellationReportResponseObject, error)
+	// Orders and revenue by weekday and hour
+	// (GET /reports/heatmap)
+	GetHeatmapReport(ctx context.Context, request GetHeatmapReportRequestObject) (GetHeatmapReportResponseObject, error)
+	// Revenue, order count and average check by period, cancelled orders are excluded
+	// (GET /reports/sales)
+	GetSalesReport(ctx context.Context, request GetSalesReportRequestObject) (GetSalesReportResponseObject, error)
+	// Time orders spend in every status, in seconds
+	// (GET /reports/statusDurations)
+	GetStatusDurationReport(ctx context.Context, request GetStatusDurationReportRequestObject) (GetStatusDurationReportResponseObject, error)
+	// Best selling product groups by revenue before discounts
+	// (GET /reports/topGroups)
+	GetTopGroupsReport(ctx context.Context, request GetTopGroupsReportRequestObject) (GetTopGroupsReportResponseObject, error)
+	// Best selling products by revenue before discounts
+	// (GET /reports/topProducts)
+	GetTopProductsReport(ctx context.Context, request GetTopProductsReportRequestObject) (GetTopProductsReportResponseObject, error)
+	// Get pickup slots availability
+	// (GET /slots)
+	GetSlots(ctx context.Context, request GetSlotsRequestObject) (GetSlotsResponseObject, error)
+	// Latest end-of-day reports
+	// (GET /zReports)
+	GetZReports(ctx context.Context, request GetZReportsRequestObject) (GetZReportsResponseObject, error)
+	// End-of-day report of a business day
+	// (GET /zReports/{day})
+	GetZReport(ctx context.Context, request GetZReportRequestObject) (GetZReportResponseObject, error)
+}
+
+type StrictHandlerFunc func(ctx *fiber.Ctx, args interface{}) (interface{}, error)
+
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAnnouncements operation middleware
+func (sh *strictHandler) GetAnnouncements(ctx *fiber.Ctx, params GetAnnouncementsParams) error {
+	var request GetAnnouncementsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnnouncements(ctx.UserContext(), request.(GetAnnouncementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnnouncements")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAnnouncementsResponseObject); ok {
+		if err := validResponse.VisitGetAnnouncementsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddAnnouncement operation middleware
+func (sh *strictHandler) AddAnnouncement(ctx *fiber.Ctx) error {
+	var request AddAnnouncementRequestObject
+
+	var body AddAnnouncementJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AddAnnouncement(ctx.UserContext(), request.(AddAnnouncementRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddAnnouncement")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AddAnnouncementResponseObject); ok {
+		if err := validResponse.VisitAddAnnouncementResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAllAnnouncements operation middleware
+func (sh *strictHandler) GetAllAnnouncements(ctx *fiber.Ctx) error {
+	var request GetAllAnnouncementsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAllAnnouncements(ctx.UserContext(), request.(GetAllAnnouncementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAllAnnouncements")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAllAnnouncementsResponseObject); ok {
+		if err := validResponse.VisitGetAllAnnouncementsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteAnnouncement operation middleware
+func (sh *strictHandler) DeleteAnnouncement(ctx *fiber.Ctx, announcementId openapi_types.UUID) error {
+	var request DeleteAnnouncementRequestObject
+
+	request.AnnouncementId = announcementId
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAnnouncement(ctx.UserContext(), request.(DeleteAnnouncementRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAnnouncement")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteAnnouncementResponseObject); ok {
+		if err := validResponse.VisitDeleteAnnouncementResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EditAnnouncement operation middleware
+func (sh *strictHandler) EditAnnouncement(ctx *fiber.Ctx, announcementId openapi_types.UUID) error {
+	var request EditAnnouncementRequestObject
+
+	request.AnnouncementId = announcementId
+
+	var body EditAnnouncementJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.EditAnnouncement(ctx.UserContext(), request.(EditAnnouncementRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EditAnnouncement")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EditAnnouncementResponseObject); ok {
+		if err := validResponse.VisitEditAnnouncementResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetConnections operation middleware
+func (sh *strictHandler) GetConnections(ctx *fiber.Ctx) error {
+	var request GetConnectionsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnections(ctx.UserContext(), request.(GetConnectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnections")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetConnectionsResponseObject); ok {
+		if err := validResponse.VisitGetConnectionsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// KickConnection operation middleware
+func (sh *strictHandler) KickConnection(ctx *fiber.Ctx, id openapi_types.UUID) error {
+	var request KickConnectionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.KickConnection(ctx.UserContext(), request.(KickConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "KickConnection")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(KickConnectionResponseObject); ok {
+		if err := validResponse.VisitKickConnectionResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CustomerLogin operation middleware
+func (sh *strictHandler) CustomerLogin(ctx *fiber.Ctx) error {
+	var request CustomerLoginRequestObject
+
+	var body CustomerLoginJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CustomerLogin(ctx.UserContext(), request.(CustomerLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CustomerLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CustomerLoginResponseObject); ok {
+		if err := validResponse.VisitCustomerLoginResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCustomerLoyalty operation middleware
+func (sh *strictHandler) GetCustomerLoyalty(ctx *fiber.Ctx) error {
+	var request GetCustomerLoyaltyRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCustomerLoyalty(ctx.UserContext(), request.(GetCustomerLoyaltyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCustomerLoyalty")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCustomerLoyaltyResponseObject); ok {
+		if err := validResponse.VisitGetCustomerLoyaltyResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCustomer operation middleware
+func (sh *strictHandler) GetCustomer(ctx *fiber.Ctx) error {
+	var request GetCustomerRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCustomer(ctx.UserContext(), request.(GetCustomerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCustomer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCustomerResponseObject); ok {
+		if err := validResponse.VisitGetCustomerResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateCustomer operation middleware
+func (sh *strictHandler) UpdateCustomer(ctx *fiber.Ctx) error {
+	var request UpdateCustomerRequestObject
+
+	var body UpdateCustomerJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCustomer(ctx.UserContext(), request.(UpdateCustomerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCustomer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateCustomerResponseObject); ok {
+		if err := validResponse.VisitUpdateCustomerResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCustomerOrders operation middleware
+func (sh *strictHandler) GetCustomerOrders(ctx *fiber.Ctx, params GetCustomerOrdersParams) error {
+	var request GetCustomerOrdersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCustomerOrders(ctx.UserContext(), request.(GetCustomerOrdersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCustomerOrders")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCustomerOrdersResponseObject); ok {
+		if err := validResponse.VisitGetCustomerOrdersResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// Reorder operation middleware
+func (sh *strictHandler) Reorder(ctx *fiber.Ctx, id openapi_types.UUID) error {
+	var request ReorderRequestObject
+
+	request.Id = id
+
+	var body ReorderJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.Reorder(ctx.UserContext(), request.(ReorderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Reorder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReorderResponseObject); ok {
+		if err := validResponse.VisitReorderResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RequestCustomerOtp operation middleware
+func (sh *strictHandler) RequestCustomerOtp(ctx *fiber.Ctx) error {
+	var request RequestCustomerOtpRequestObject
+
+	var body RequestCustomerOtpJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestCustomerOtp(ctx.UserContext(), request.(RequestCustomerOtpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestCustomerOtp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RequestCustomerOtpResponseObject); ok {
+		if err := validResponse.VisitRequestCustomerOtpResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCustomerLoyaltyById operation middleware
+func (sh *strictHandler) GetCustomerLoyaltyById(ctx *fiber.Ctx, id openapi_types.UUID) error {
+	var request GetCustomerLoyaltyByIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCustomerLoyaltyById(ctx.UserContext(), request.(GetCustomerLoyaltyByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCustomerLoyaltyById")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCustomerLoyaltyByIdResponseObject); ok {
+		if err := validResponse.VisitGetCustomerLoyaltyByIdResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdjustCustomerLoyalty operation middleware
+func (sh *strictHandler) AdjustCustomerLoyalty(ctx *fiber.Ctx, id openapi_types.UUID) error {
+	var request AdjustCustomerLoyaltyRequestObject
+
+	request.Id = id
+
+	var body AdjustCustomerLoyaltyJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AdjustCustomerLoyalty(ctx.UserContext(), request.(AdjustCustomerLoyaltyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdjustCustomerLoyalty")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AdjustCustomerLoyaltyResponseObject); ok {
+		if err := validResponse.VisitAdjustCustomerLoyaltyResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetDiscountRules operation middleware
+func (sh *strictHandler) GetDiscountRules(ctx *fiber.Ctx) error {
+	var request GetDiscountRulesRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDiscountRules(ctx.UserContext(), request.(GetDiscountRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDiscountRules")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetDiscountRulesResponseObject); ok {
+		if err := validResponse.VisitGetDiscountRulesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddDiscountRule operation middleware
+func (sh *strictHandler) AddDiscountRule(ctx *fiber.Ctx) error {
+	var request AddDiscountRuleRequestObject
+
+	var body AddDiscountRuleJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AddDiscountRule(ctx.UserContext(), request.(AddDiscountRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddDiscountRule")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AddDiscountRuleResponseObject); ok {
+		if err := validResponse.VisitAddDiscountRuleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteDiscountRule operation middleware
+func (sh *strictHandler) DeleteDiscountRule(ctx *fiber.Ctx, ruleId openapi_types.UUID) error {
+	var request DeleteDiscountRuleRequestObject
+
+	request.RuleId = ruleId
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDiscountRule(ctx.UserContext(), request.(DeleteDiscountRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDiscountRule")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteDiscountRuleResponseObject); ok {
+		if err := validResponse.VisitDeleteDiscountRuleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EditDiscountRule operation middleware
+func (sh *strictHandler) EditDiscountRule(ctx *fiber.Ctx, ruleId openapi_types.UUID) error {
+	var request EditDiscountRuleRequestObject
+
+	request.RuleId = ruleId
+
+	var body EditDiscountRuleJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.EditDiscountRule(ctx.UserContext(), request.(EditDiscountRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EditDiscountRule")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EditDiscountRuleResponseObject); ok {
+		if err := validResponse.VisitEditDiscountRuleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetDiscountReport operation middleware
+func (sh *strictHandler) GetDiscountReport(ctx *fiber.Ctx, params GetDiscountReportParams) error {
+	var request GetDiscountReportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDiscountReport(ctx.UserContext(), request.(GetDiscountReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDiscountReport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetDiscountReportResponseObject); ok {
+		if err := validResponse.VisitGetDiscountReportResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// HealthCheck operation middleware
+func (sh *strictHandler) HealthCheck(ctx *fiber.Ctx) error {
+	var request HealthCheckRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.HealthCheck(ctx.UserContext(), request.(HealthCheckRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HealthCheck")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(HealthCheckResponseObject); ok {
+		if err := validResponse.VisitHealthCheckResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOpeningHours operation middleware
+func (sh *strictHandler) GetOpeningHours(ctx *fiber.Ctx) error {
+	var request GetOpeningHoursRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOpeningHours(ctx.UserContext(), request.(GetOpeningHoursRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOpeningHours")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOpeningHoursResponseObject); ok {
+		if err := validResponse.VisitGetOpeningHoursResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SaveOpeningException operation middleware
+func (sh *strictHandler) SaveOpeningException(ctx *fiber.Ctx) error {
+	var request SaveOpeningExceptionRequestObject
+
+	var body SaveOpeningExceptionJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SaveOpeningException(ctx.UserContext(), request.(SaveOpeningExceptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SaveOpeningException")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SaveOpeningExceptionResponseObject); ok {
+		if err := validResponse.VisitSaveOpeningExceptionResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteOpeningException operation middleware
+func (sh *strictHandler) DeleteOpeningException(ctx *fiber.Ctx, day openapi_types.Date) error {
+	var request DeleteOpeningExceptionRequestObject
+
+	request.Day = day
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOpeningException(ctx.UserContext(), request.(DeleteOpeningExceptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOpeningException")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteOpeningExceptionResponseObject); ok {
+		if err := validResponse.VisitDeleteOpeningExceptionResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetWeeklyHours operation middleware
+func (sh *strictHandler) SetWeeklyHours(ctx *fiber.Ctx) error {
+	var request SetWeeklyHoursRequestObject
+
+	var body SetWeeklyHoursJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SetWeeklyHours(ctx.UserContext(), request.(SetWeeklyHoursRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetWeeklyHours")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetWeeklyHoursResponseObject); ok {
+		if err := validResponse.VisitSetWeeklyHoursResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetIngredients operation middleware
+func (sh *strictHandler) GetIngredients(ctx *fiber.Ctx) error {
+	var request GetIngredientsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIngredients(ctx.UserContext(), request.(GetIngredientsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIngredients")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetIngredientsResponseObject); ok {
+		if err := validResponse.VisitGetIngredientsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddIngredient operation middleware
+func (sh *strictHandler) AddIngredient(ctx *fiber.Ctx) error {
+	var request AddIngredientRequestObject
+
+	var body AddIngredientJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AddIngredient(ctx.UserContext(), request.(AddIngredientRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddIngredient")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AddIngredientResponseObject); ok {
+		if err := validResponse.VisitAddIngredientResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteIngredient operation middleware
+func (sh *strictHandler) DeleteIngredient(ctx *fiber.Ctx, ingredientId openapi_types.UUID) error {
+	var request DeleteIngredientRequestObject
+
+	request.IngredientId = ingredientId
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteIngredient(ctx.UserContext(), request.(DeleteIngredientRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteIngredient")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteIngredientResponseObject); ok {
+		if err := validResponse.VisitDeleteIngredientResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EditIngredient operation middleware
+func (sh *strictHandler) EditIngredient(ctx *fiber.Ctx, ingredientId openapi_types.UUID) error {
+	var request EditIngredientRequestObject
+
+	request.IngredientId = ingredientId
+
+	var body EditIngredientJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.EditIngredient(ctx.UserContext(), request.(EditIngredientRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EditIngredient")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EditIngredientResponseObject); ok {
+		if err := validResponse.VisitEditIngredientResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetJobs operation middleware
+func (sh *strictHandler) GetJobs(ctx *fiber.Ctx) error {
+	var request GetJobsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJobs(ctx.UserContext(), request.(GetJobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJobs")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetJobsResponseObject); ok {
+		if err := validResponse.VisitGetJobsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TriggerJob operation middleware
+func (sh *strictHandler) TriggerJob(ctx *fiber.Ctx, name string) error {
+	var request TriggerJobRequestObject
+
+	request.Name = name
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.TriggerJob(ctx.UserContext(), request.(TriggerJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TriggerJob")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TriggerJobResponseObject); ok {
+		if err := validResponse.VisitTriggerJobResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetKitchenStations operation middleware
+func (sh *strictHandler) GetKitchenStations(ctx *fiber.Ctx) error {
+	var request GetKitchenStationsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetKitchenStations(ctx.UserContext(), request.(GetKitchenStationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetKitchenStations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetKitchenStationsResponseObject); ok {
+		if err := validResponse.VisitGetKitchenStationsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SaveKitchenStation operation middleware
+func (sh *strictHandler) SaveKitchenStation(ctx *fiber.Ctx) error {
+	var request SaveKitchenStationRequestObject
+
+	var body SaveKitchenStationJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SaveKitchenStation(ctx.UserContext(), request.(SaveKitchenStationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SaveKitchenStation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SaveKitchenStationResponseObject); ok {
+		if err := validResponse.VisitSaveKitchenStationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteKitchenStation operation middleware
+func (sh *strictHandler) DeleteKitchenStation(ctx *fiber.Ctx, stationId string) error {
+	var request DeleteKitchenStationRequestObject
+
+	request.StationId = stationId
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteKitchenStation(ctx.UserContext(), request.(DeleteKitchenStationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteKitchenStation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteKitchenStationResponseObject); ok {
+		if err := validResponse.VisitDeleteKitchenStationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetKitchenQueue operation middleware
+func (sh *strictHandler) GetKitchenQueue(ctx *fiber.Ctx, stationId string) error {
+	var request GetKitchenQueueRequestObject
+
+	request.StationId = stationId
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetKitchenQueue(ctx.UserContext(), request.(GetKitchenQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetKitchenQueue")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetKitchenQueueResponseObject); ok {
+		if err := validResponse.VisitGetKitchenQueueResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// BumpKitchenTicket operation middleware
+func (sh *strictHandler) BumpKitchenTicket(ctx *fiber.Ctx, ticketId openapi_types.UUID) error {
+	var request BumpKitchenTicketRequestObject
+
+	request.TicketId = ticketId
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.BumpKitchenTicket(ctx.UserContext(), request.(BumpKitchenTicketRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BumpKitchenTicket")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(BumpKitchenTicketResponseObject); ok {
+		if err := validResponse.VisitBumpKitchenTicketResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// Login operation middleware
+func (sh *strictHandler) Login(ctx *fiber.Ctx) error {
+	var request LoginRequestObject
+
+	var body LoginJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.Login(ctx.UserContext(), request.(LoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Login")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LoginResponseObject); ok {
+		if err := validResponse.VisitLoginResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetLoyaltyPolicy operation middleware
+func (sh *strictHandler) GetLoyaltyPolicy(ctx *fiber.Ctx) error {
+	var request GetLoyaltyPolicyRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLoyaltyPolicy(ctx.UserContext(), request.(GetLoyaltyPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLoyaltyPolicy")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetLoyaltyPolicyResponseObject); ok {
+		if err := validResponse.VisitGetLoyaltyPolicyResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetMenu operation middleware
+func (sh *strictHandler) GetMenu(ctx *fiber.Ctx) error {
+	var request GetMenuRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMenu(ctx.UserContext(), request.(GetMenuRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMenu")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetMenuResponseObject); ok {
+		if err := validResponse.VisitGetMenuResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetMenuOrdering operation middleware
+func (sh *strictHandler) SetMenuOrdering(ctx *fiber.Ctx) error {
+	var request SetMenuOrderingRequestObject
+
+	var body SetMenuOrderingJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SetMenuOrdering(ctx.UserContext(), request.(SetMenuOrderingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetMenuOrdering")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetMenuOrderingResponseObject); ok {
+		if err := validResponse.VisitSetMenuOrderingResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddProduct operation middleware
+func (sh *strictHandler) AddProduct(ctx *fiber.Ctx) error {
+	var request AddProductRequestObject
+
+	var body AddProductJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AddProduct(ctx.UserContext(), request.(AddProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddProduct")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AddProductResponseObject); ok {
+		if err := validResponse.VisitAddProductResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteProduct operation middleware
+func (sh *strictHandler) DeleteProduct(ctx *fiber.Ctx, productId openapi_types.UUID) error {
+	var request DeleteProductRequestObject
+
+	request.ProductId = productId
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProduct(ctx.UserContext(), request.(DeleteProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProduct")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteProductResponseObject); ok {
+		if err := validResponse.VisitDeleteProductResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EditProduct operation middleware
+func (sh *strictHandler) EditProduct(ctx *fiber.Ctx, productId openapi_types.UUID) error {
+	var request EditProductRequestObject
+
+	request.ProductId = productId
+
+	var body EditProductJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.EditProduct(ctx.UserContext(), request.(EditProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EditProduct")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EditProductResponseObject); ok {
+		if err := validResponse.VisitEditProductResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetProductRecipe operation middleware
+func (sh *strictHandler) SetProductRecipe(ctx *fiber.Ctx, productId openapi_types.UUID) error {
+	var request SetProductRecipeRequestObject
+
+	request.ProductId = productId
+
+	var body SetProductRecipeJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SetProductRecipe(ctx.UserContext(), request.(SetProductRecipeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetProductRecipe")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetProductRecipeResponseObject); ok {
+		if err := validResponse.VisitSetProductRecipeResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetComboSlots operation middleware
+func (sh *strictHandler) SetComboSlots(ctx *fiber.Ctx, productId openapi_types.UUID) error {
+	var request SetComboSlotsRequestObject
+
+	request.ProductId = productId
+
+	var body SetComboSlotsJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SetComboSlots(ctx.UserContext(), request.(SetComboSlotsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetComboSlots")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetComboSlotsResponseObject); ok {
+		if err := validResponse.VisitSetComboSlotsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetProductStock operation middleware
+func (sh *strictHandler) SetProductStock(ctx *fiber.Ctx, productId openapi_types.UUID) error {
+	var request SetProductStockRequestObject
+
+	request.ProductId = productId
+
+	var body SetProductStockJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SetProductStock(ctx.UserContext(), request.(SetProductStockRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetProductStock")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetProductStockResponseObject); ok {
+		if err := validResponse.VisitSetProductStockResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddProductGroup operation middleware
+func (sh *strictHandler) AddProductGroup(ctx *fiber.Ctx) error {
+	var request AddProductGroupRequestObject
+
+	var body AddProductGroupJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.AddProductGroup(ctx.UserContext(), request.(AddProductGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddProductGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AddProductGroupResponseObject); ok {
+		if err := validResponse.VisitAddProductGroupResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetProductGroupOrdering operation middleware
+func (sh *strictHandler) SetProductGroupOrdering(ctx *fiber.Ctx) error {
+	var request SetProductGroupOrderingRequestObject
+
+	var body SetProductGroupOrderingJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SetProductGroupOrdering(ctx.UserContext(), request.(SetProductGroupOrderingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetProductGroupOrdering")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetProductGroupOrderingResponseObject); ok {
+		if err := validResponse.VisitSetProductGroupOrderingResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteProductGroup operation middleware
+func (sh *strictHandler) DeleteProductGroup(ctx *fiber.Ctx, productGroupId openapi_types.UUID) error {
+	var request DeleteProductGroupRequestObject
+
+	request.ProductGroupId = productGroupId
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProductGroup(ctx.UserContext(), request.(DeleteProductGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProductGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteProductGroupResponseObject); ok {
+		if err := validResponse.VisitDeleteProductGroupResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EditProductGroup operation middleware
+func (sh *strictHandler) EditProductGroup(ctx *fiber.Ctx, productGroupId openapi_types.UUID) error {
+	var request EditProductGroupRequestObject
+
+	request.ProductGroupId = productGroupId
+
+	var body EditProductGroupJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.EditProductGroup(ctx.UserContext(), request.(EditProductGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EditProductGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EditProductGroupResponseObject); ok {
+		if err := validResponse.VisitEditProductGroupResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetProductGroupStation operation middleware
+func (sh *strictHandler) SetProductGroupStation(ctx *fiber.Ctx, productGroupId openapi_types.UUID) error {
+	var request SetProductGroupStationRequestObject
+
+	request.ProductGroupId = productGroupId
+
+	var body SetProductGroupStationJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SetProductGroupStation(ctx.UserContext(), request.(SetProductGroupStationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetProductGroupStation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetProductGroupStationResponseObject); ok {
+		if err := validResponse.VisitSetProductGroupStationResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateOrder operation middleware
+func (sh *strictHandler) CreateOrder(ctx *fiber.Ctx) error {
+	var request CreateOrderRequestObject
+
+	var body CreateOrderJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOrder(ctx.UserContext(), request.(CreateOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateOrderResponseObject); ok {
+		if err := validResponse.VisitCreateOrderResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// QuoteOrder operation middleware
+func (sh *strictHandler) QuoteOrder(ctx *fiber.Ctx) error {
+	var request QuoteOrderRequestObject
+
+	var body QuoteOrderJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.QuoteOrder(ctx.UserContext(), request.(QuoteOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QuoteOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(QuoteOrderResponseObject); ok {
+		if err := validResponse.VisitQuoteOrderResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MarkOrderSeen operation middleware
+func (sh *strictHandler) MarkOrderSeen(ctx *fiber.Ctx) error {
+	var request MarkOrderSeenRequestObject
 
-	return ctx.JSON(&response)
-}
+	var body MarkOrderSeenJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
 
-type GetParams400JSONResponse General
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.MarkOrderSeen(ctx.UserContext(), request.(MarkOrderSeenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MarkOrderSeen")
+	}
 
-func (response GetParams400JSONResponse) VisitGetParamsResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	response, err := handler(ctx, request)
 
-	return ctx.JSON(&response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MarkOrderSeenResponseObject); ok {
+		if err := validResponse.VisitMarkOrderSeenResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetParams401JSONResponse General
-
-func (response GetParams401JSONResponse) VisitGetParamsResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+// SetOrderStatus operation middleware
+func (sh *strictHandler) SetOrderStatus(ctx *fiber.Ctx) error {
+	var request SetOrderStatusRequestObject
 
-	return ctx.JSON(&response)
-}
+	var body SetOrderStatusJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
 
-type GetParams404JSONResponse General
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.SetOrderStatus(ctx.UserContext(), request.(SetOrderStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetOrderStatus")
+	}
 
-func (response GetParams404JSONResponse) VisitGetParamsResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	response, err := handler(ctx, request)
 
-	return ctx.JSON(&response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetOrderStatusResponseObject); ok {
+		if err := validResponse.VisitSetOrderStatusResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetParams500JSONResponse General
+// DeleteOrder operation middleware
+func (sh *strictHandler) DeleteOrder(ctx *fiber.Ctx, id openapi_types.UUID) error {
+	var request DeleteOrderRequestObject
 
-func (response GetParams500JSONResponse) VisitGetParamsResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	request.Id = id
 
-	return ctx.JSON(&response)
-}
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOrder(ctx.UserContext(), request.(DeleteOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOrder")
+	}
 
-type SetHeaderTextRequestObject struct {
-	Body *SetHeaderTextJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type SetHeaderTextResponseObject interface {
-	VisitSetHeaderTextResponse(ctx *fiber.Ctx) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteOrderResponseObject); ok {
+		if err := validResponse.VisitDeleteOrderResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type SetHeaderText200Response struct {
-}
+// GetOrder operation middleware
+func (sh *strictHandler) GetOrder(ctx *fiber.Ctx, id openapi_types.UUID) error {
+	var request GetOrderRequestObject
 
-func (response SetHeaderText200Response) VisitSetHeaderTextResponse(ctx *fiber.Ctx) error {
-	ctx.Status(200)
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrder(ctx.UserContext(), request.(GetOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderResponseObject); ok {
+		if err := validResponse.VisitGetOrderResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type SetHeaderText400JSONResponse General
+// PrintOrder operation middleware
+func (sh *strictHandler) PrintOrder(ctx *fiber.Ctx, id openapi_types.UUID) error {
+	var request PrintOrderRequestObject
 
-func (response SetHeaderText400JSONResponse) VisitSetHeaderTextResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	request.Id = id
 
-	return ctx.JSON(&response)
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PrintOrder(ctx.UserContext(), request.(PrintOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PrintOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PrintOrderResponseObject); ok {
+		if err := validResponse.VisitPrintOrderResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type SetHeaderText401JSONResponse General
+// GetOrderPolicy operation middleware
+func (sh *strictHandler) GetOrderPolicy(ctx *fiber.Ctx) error {
+	var request GetOrderPolicyRequestObject
 
-func (response SetHeaderText401JSONResponse) VisitSetHeaderTextResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrderPolicy(ctx.UserContext(), request.(GetOrderPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrderPolicy")
+	}
 
-	return ctx.JSON(&response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderPolicyResponseObject); ok {
+		if err := validResponse.VisitGetOrderPolicyResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type SetHeaderText500JSONResponse General
+// GetOrders operation middleware
+func (sh *strictHandler) GetOrders(ctx *fiber.Ctx, params GetOrdersParams) error {
+	var request GetOrdersRequestObject
 
-func (response SetHeaderText500JSONResponse) VisitSetHeaderTextResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	request.Params = params
 
-	return ctx.JSON(&response)
-}
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrders(ctx.UserContext(), request.(GetOrdersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrders")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Health check
-	// (GET /healthz)
-	HealthCheck(ctx context.Context, request HealthCheckRequestObject) (HealthCheckResponseObject, error)
-	// Login
-	// (POST /login)
-	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
-	// Get site menu
-	// (GET /menu)
-	GetMenu(ctx context.Context, request GetMenuRequestObject) (GetMenuResponseObject, error)
-	// Set menu ordering
-	// (POST /menu/ordering)
-	SetMenuOrdering(ctx context.Context, request SetMenuOrderingRequestObject) (SetMenuOrderingResponseObject, error)
-	// Add product
-	// (POST /menu/product)
-	AddProduct(ctx context.Context, request AddProductRequestObject) (AddProductResponseObject, error)
-	// Delete product
-	// (DELETE /menu/product/{productId})
-	DeleteProduct(ctx context.Context, request DeleteProductRequestObject) (DeleteProductResponseObject, error)
-	// Edit product
-	// (PUT /menu/product/{productId})
-	EditProduct(ctx context.Context, request EditProductRequestObject) (EditProductResponseObject, error)
-	// Add product group
-	// (POST /menu/productGroup)
-	AddProductGroup(ctx context.Context, request AddProductGroupRequestObject) (AddProductGroupResponseObject, error)
-	// Set product group ordering
-	// (POST /menu/productGroup/ordering)
-	SetProductGroupOrdering(ctx context.Context, request SetProductGroupOrderingRequestObject) (SetProductGroupOrderingResponseObject, error)
-	// Delete product group
-	// (DELETE /menu/productGroup/{productGroupId})
-	DeleteProductGroup(ctx context.Context, request DeleteProductGroupRequestObject) (DeleteProductGroupResponseObject, error)
-	// Edit product group
-	// (PUT /menu/productGroup/{productGroupId})
-	EditProductGroup(ctx context.Context, request EditProductGroupRequestObject) (EditProductGroupResponseObject, error)
-	// Create new order
-	// (POST /order)
-	CreateOrder(ctx context.Context, request CreateOrderRequestObject) (CreateOrderResponseObject, error)
-	// Mark order as seen
-	// (POST /order/seen)
-	MarkOrderSeen(ctx context.Context, request MarkOrderSeenRequestObject) (MarkOrderSeenResponseObject, error)
-	// Set order status
-	// (POST /order/setStatus)
-	SetOrderStatus(ctx context.Context, request SetOrderStatusRequestObject) (SetOrderStatusResponseObject, error)
-	// Delete order
-	// (DELETE /order/{id})
-	DeleteOrder(ctx context.Context, request DeleteOrderRequestObject) (DeleteOrderResponseObject, error)
-	// Get order by ID
-	// (GET /order/{id})
-	GetOrder(ctx context.Context, request GetOrderRequestObject) (GetOrderResponseObject, error)
-	// Get paginated orders
-	// (GET /orders)
-	GetOrders(ctx context.Context, request GetOrdersRequestObject) (GetOrdersResponseObject, error)
-	// Get params
-	// (GET /params)
-	GetParams(ctx context.Context, request GetParamsRequestObject) (GetParamsResponseObject, error)
-	// Set header text
-	// (POST /params/setHeaderText)
-	SetHeaderText(ctx context.Context, request SetHeaderTextRequestObject) (SetHeaderTextResponseObject, error)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrdersResponseObject); ok {
+		if err := validResponse.VisitGetOrdersResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StrictHandlerFunc func(ctx *fiber.Ctx, args interface{}) (interface{}, error)
+// ExportOrders operation middleware
+func (sh *strictHandler) ExportOrders(ctx *fiber.Ctx, params ExportOrdersParams) error {
+	var request ExportOrdersRequestObject
 
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportOrders(ctx.UserContext(), request.(ExportOrdersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportOrders")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	response, err := handler(ctx, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ExportOrdersResponseObject); ok {
+		if err := validResponse.VisitExportOrdersResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// HealthCheck operation middleware
-func (sh *strictHandler) HealthCheck(ctx *fiber.Ctx) error {
-	var request HealthCheckRequestObject
+// GetParams operation middleware
+func (sh *strictHandler) GetParams(ctx *fiber.Ctx) error {
+	var request GetParamsRequestObject
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.HealthCheck(ctx.UserContext(), request.(HealthCheckRequestObject))
+		return sh.ssi.GetParams(ctx.UserContext(), request.(GetParamsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "HealthCheck")
+		handler = middleware(handler, "GetParams")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(HealthCheckResponseObject); ok {
-		if err := validResponse.VisitHealthCheckResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetParamsResponseObject); ok {
+		if err := validResponse.VisitGetParamsResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -1748,29 +9558,29 @@ func (sh *strictHandler) HealthCheck(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// Login operation middleware
-func (sh *strictHandler) Login(ctx *fiber.Ctx) error {
-	var request LoginRequestObject
+// SetCapacity operation middleware
+func (sh *strictHandler) SetCapacity(ctx *fiber.Ctx) error {
+	var request SetCapacityRequestObject
 
-	var body LoginJSONRequestBody
+	var body SetCapacityJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.Login(ctx.UserContext(), request.(LoginRequestObject))
+		return sh.ssi.SetCapacity(ctx.UserContext(), request.(SetCapacityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Login")
+		handler = middleware(handler, "SetCapacity")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(LoginResponseObject); ok {
-		if err := validResponse.VisitLoginResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(SetCapacityResponseObject); ok {
+		if err := validResponse.VisitSetCapacityResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -1779,23 +9589,29 @@ func (sh *strictHandler) Login(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// GetMenu operation middleware
-func (sh *strictHandler) GetMenu(ctx *fiber.Ctx) error {
-	var request GetMenuRequestObject
+// SetClosedUntil operation middleware
+func (sh *strictHandler) SetClosedUntil(ctx *fiber.Ctx) error {
+	var request SetClosedUntilRequestObject
+
+	var body SetClosedUntilJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMenu(ctx.UserContext(), request.(GetMenuRequestObject))
+		return sh.ssi.SetClosedUntil(ctx.UserContext(), request.(SetClosedUntilRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMenu")
+		handler = middleware(handler, "SetClosedUntil")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetMenuResponseObject); ok {
-		if err := validResponse.VisitGetMenuResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(SetClosedUntilResponseObject); ok {
+		if err := validResponse.VisitSetClosedUntilResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -1804,29 +9620,29 @@ func (sh *strictHandler) GetMenu(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// SetMenuOrdering operation middleware
-func (sh *strictHandler) SetMenuOrdering(ctx *fiber.Ctx) error {
-	var request SetMenuOrderingRequestObject
+// SetHeaderText operation middleware
+func (sh *strictHandler) SetHeaderText(ctx *fiber.Ctx) error {
+	var request SetHeaderTextRequestObject
 
-	var body SetMenuOrderingJSONRequestBody
+	var body SetHeaderTextJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.SetMenuOrdering(ctx.UserContext(), request.(SetMenuOrderingRequestObject))
+		return sh.ssi.SetHeaderText(ctx.UserContext(), request.(SetHeaderTextRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SetMenuOrdering")
+		handler = middleware(handler, "SetHeaderText")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SetMenuOrderingResponseObject); ok {
-		if err := validResponse.VisitSetMenuOrderingResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(SetHeaderTextResponseObject); ok {
+		if err := validResponse.VisitSetHeaderTextResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -1835,29 +9651,29 @@ func (sh *strictHandler) SetMenuOrdering(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// AddProduct operation middleware
-func (sh *strictHandler) AddProduct(ctx *fiber.Ctx) error {
-	var request AddProductRequestObject
+// SetLoyaltyPolicy operation middleware
+func (sh *strictHandler) SetLoyaltyPolicy(ctx *fiber.Ctx) error {
+	var request SetLoyaltyPolicyRequestObject
 
-	var body AddProductJSONRequestBody
+	var body SetLoyaltyPolicyJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.AddProduct(ctx.UserContext(), request.(AddProductRequestObject))
+		return sh.ssi.SetLoyaltyPolicy(ctx.UserContext(), request.(SetLoyaltyPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddProduct")
+		handler = middleware(handler, "SetLoyaltyPolicy")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AddProductResponseObject); ok {
-		if err := validResponse.VisitAddProductResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(SetLoyaltyPolicyResponseObject); ok {
+		if err := validResponse.VisitSetLoyaltyPolicyResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -1866,25 +9682,29 @@ func (sh *strictHandler) AddProduct(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// DeleteProduct operation middleware
-func (sh *strictHandler) DeleteProduct(ctx *fiber.Ctx, productId openapi_types.UUID) error {
-	var request DeleteProductRequestObject
+// SetOrderPolicy operation middleware
+func (sh *strictHandler) SetOrderPolicy(ctx *fiber.Ctx) error {
+	var request SetOrderPolicyRequestObject
 
-	request.ProductId = productId
+	var body SetOrderPolicyJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProduct(ctx.UserContext(), request.(DeleteProductRequestObject))
+		return sh.ssi.SetOrderPolicy(ctx.UserContext(), request.(SetOrderPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProduct")
+		handler = middleware(handler, "SetOrderPolicy")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProductResponseObject); ok {
-		if err := validResponse.VisitDeleteProductResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(SetOrderPolicyResponseObject); ok {
+		if err := validResponse.VisitSetOrderPolicyResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -1893,31 +9713,29 @@ func (sh *strictHandler) DeleteProduct(ctx *fiber.Ctx, productId openapi_types.U
 	return nil
 }
 
-// EditProduct operation middleware
-func (sh *strictHandler) EditProduct(ctx *fiber.Ctx, productId openapi_types.UUID) error {
-	var request EditProductRequestObject
-
-	request.ProductId = productId
+// SetOrderingPaused operation middleware
+func (sh *strictHandler) SetOrderingPaused(ctx *fiber.Ctx) error {
+	var request SetOrderingPausedRequestObject
 
-	var body EditProductJSONRequestBody
+	var body SetOrderingPausedJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.EditProduct(ctx.UserContext(), request.(EditProductRequestObject))
+		return sh.ssi.SetOrderingPaused(ctx.UserContext(), request.(SetOrderingPausedRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "EditProduct")
+		handler = middleware(handler, "SetOrderingPaused")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(EditProductResponseObject); ok {
-		if err := validResponse.VisitEditProductResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(SetOrderingPausedResponseObject); ok {
+		if err := validResponse.VisitSetOrderingPausedResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -1926,29 +9744,23 @@ func (sh *strictHandler) EditProduct(ctx *fiber.Ctx, productId openapi_types.UUI
 	return nil
 }
 
-// AddProductGroup operation middleware
-func (sh *strictHandler) AddProductGroup(ctx *fiber.Ctx) error {
-	var request AddProductGroupRequestObject
-
-	var body AddProductGroupJSONRequestBody
-	if err := ctx.BodyParser(&body); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	}
-	request.Body = &body
+// GetPromoCodes operation middleware
+func (sh *strictHandler) GetPromoCodes(ctx *fiber.Ctx) error {
+	var request GetPromoCodesRequestObject
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.AddProductGroup(ctx.UserContext(), request.(AddProductGroupRequestObject))
+		return sh.ssi.GetPromoCodes(ctx.UserContext(), request.(GetPromoCodesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddProductGroup")
+		handler = middleware(handler, "GetPromoCodes")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AddProductGroupResponseObject); ok {
-		if err := validResponse.VisitAddProductGroupResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetPromoCodesResponseObject); ok {
+		if err := validResponse.VisitGetPromoCodesResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -1957,29 +9769,29 @@ func (sh *strictHandler) AddProductGroup(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// SetProductGroupOrdering operation middleware
-func (sh *strictHandler) SetProductGroupOrdering(ctx *fiber.Ctx) error {
-	var request SetProductGroupOrderingRequestObject
+// AddPromoCode operation middleware
+func (sh *strictHandler) AddPromoCode(ctx *fiber.Ctx) error {
+	var request AddPromoCodeRequestObject
 
-	var body SetProductGroupOrderingJSONRequestBody
+	var body AddPromoCodeJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.SetProductGroupOrdering(ctx.UserContext(), request.(SetProductGroupOrderingRequestObject))
+		return sh.ssi.AddPromoCode(ctx.UserContext(), request.(AddPromoCodeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SetProductGroupOrdering")
+		handler = middleware(handler, "AddPromoCode")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SetProductGroupOrderingResponseObject); ok {
-		if err := validResponse.VisitSetProductGroupOrderingResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(AddPromoCodeResponseObject); ok {
+		if err := validResponse.VisitAddPromoCodeResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -1988,25 +9800,25 @@ func (sh *strictHandler) SetProductGroupOrdering(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// DeleteProductGroup operation middleware
-func (sh *strictHandler) DeleteProductGroup(ctx *fiber.Ctx, productGroupId openapi_types.UUID) error {
-	var request DeleteProductGroupRequestObject
+// DeletePromoCode operation middleware
+func (sh *strictHandler) DeletePromoCode(ctx *fiber.Ctx, promoCodeId openapi_types.UUID) error {
+	var request DeletePromoCodeRequestObject
 
-	request.ProductGroupId = productGroupId
+	request.PromoCodeId = promoCodeId
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProductGroup(ctx.UserContext(), request.(DeleteProductGroupRequestObject))
+		return sh.ssi.DeletePromoCode(ctx.UserContext(), request.(DeletePromoCodeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProductGroup")
+		handler = middleware(handler, "DeletePromoCode")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProductGroupResponseObject); ok {
-		if err := validResponse.VisitDeleteProductGroupResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(DeletePromoCodeResponseObject); ok {
+		if err := validResponse.VisitDeletePromoCodeResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2015,31 +9827,31 @@ func (sh *strictHandler) DeleteProductGroup(ctx *fiber.Ctx, productGroupId opena
 	return nil
 }
 
-// EditProductGroup operation middleware
-func (sh *strictHandler) EditProductGroup(ctx *fiber.Ctx, productGroupId openapi_types.UUID) error {
-	var request EditProductGroupRequestObject
+// EditPromoCode operation middleware
+func (sh *strictHandler) EditPromoCode(ctx *fiber.Ctx, promoCodeId openapi_types.UUID) error {
+	var request EditPromoCodeRequestObject
 
-	request.ProductGroupId = productGroupId
+	request.PromoCodeId = promoCodeId
 
-	var body EditProductGroupJSONRequestBody
+	var body EditPromoCodeJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.EditProductGroup(ctx.UserContext(), request.(EditProductGroupRequestObject))
+		return sh.ssi.EditPromoCode(ctx.UserContext(), request.(EditPromoCodeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "EditProductGroup")
+		handler = middleware(handler, "EditPromoCode")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(EditProductGroupResponseObject); ok {
-		if err := validResponse.VisitEditProductGroupResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(EditPromoCodeResponseObject); ok {
+		if err := validResponse.VisitEditPromoCodeResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2048,29 +9860,50 @@ func (sh *strictHandler) EditProductGroup(ctx *fiber.Ctx, productGroupId openapi
 	return nil
 }
 
-// CreateOrder operation middleware
-func (sh *strictHandler) CreateOrder(ctx *fiber.Ctx) error {
-	var request CreateOrderRequestObject
+// GetRecipes operation middleware
+func (sh *strictHandler) GetRecipes(ctx *fiber.Ctx) error {
+	var request GetRecipesRequestObject
 
-	var body CreateOrderJSONRequestBody
-	if err := ctx.BodyParser(&body); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecipes(ctx.UserContext(), request.(GetRecipesRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecipes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetRecipesResponseObject); ok {
+		if err := validResponse.VisitGetRecipesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCancellationReport operation middleware
+func (sh *strictHandler) GetCancellationReport(ctx *fiber.Ctx, params GetCancellationReportParams) error {
+	var request GetCancellationReportRequestObject
+
+	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateOrder(ctx.UserContext(), request.(CreateOrderRequestObject))
+		return sh.ssi.GetCancellationReport(ctx.UserContext(), request.(GetCancellationReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateOrder")
+		handler = middleware(handler, "GetCancellationReport")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(CreateOrderResponseObject); ok {
-		if err := validResponse.VisitCreateOrderResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetCancellationReportResponseObject); ok {
+		if err := validResponse.VisitGetCancellationReportResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2079,29 +9912,25 @@ func (sh *strictHandler) CreateOrder(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// MarkOrderSeen operation middleware
-func (sh *strictHandler) MarkOrderSeen(ctx *fiber.Ctx) error {
-	var request MarkOrderSeenRequestObject
+// GetHeatmapReport operation middleware
+func (sh *strictHandler) GetHeatmapReport(ctx *fiber.Ctx, params GetHeatmapReportParams) error {
+	var request GetHeatmapReportRequestObject
 
-	var body MarkOrderSeenJSONRequestBody
-	if err := ctx.BodyParser(&body); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.MarkOrderSeen(ctx.UserContext(), request.(MarkOrderSeenRequestObject))
+		return sh.ssi.GetHeatmapReport(ctx.UserContext(), request.(GetHeatmapReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MarkOrderSeen")
+		handler = middleware(handler, "GetHeatmapReport")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(MarkOrderSeenResponseObject); ok {
-		if err := validResponse.VisitMarkOrderSeenResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetHeatmapReportResponseObject); ok {
+		if err := validResponse.VisitGetHeatmapReportResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2110,29 +9939,25 @@ func (sh *strictHandler) MarkOrderSeen(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// SetOrderStatus operation middleware
-func (sh *strictHandler) SetOrderStatus(ctx *fiber.Ctx) error {
-	var request SetOrderStatusRequestObject
+// GetSalesReport operation middleware
+func (sh *strictHandler) GetSalesReport(ctx *fiber.Ctx, params GetSalesReportParams) error {
+	var request GetSalesReportRequestObject
 
-	var body SetOrderStatusJSONRequestBody
-	if err := ctx.BodyParser(&body); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.SetOrderStatus(ctx.UserContext(), request.(SetOrderStatusRequestObject))
+		return sh.ssi.GetSalesReport(ctx.UserContext(), request.(GetSalesReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SetOrderStatus")
+		handler = middleware(handler, "GetSalesReport")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SetOrderStatusResponseObject); ok {
-		if err := validResponse.VisitSetOrderStatusResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetSalesReportResponseObject); ok {
+		if err := validResponse.VisitGetSalesReportResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2141,25 +9966,25 @@ func (sh *strictHandler) SetOrderStatus(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// DeleteOrder operation middleware
-func (sh *strictHandler) DeleteOrder(ctx *fiber.Ctx, id openapi_types.UUID) error {
-	var request DeleteOrderRequestObject
+// GetStatusDurationReport operation middleware
+func (sh *strictHandler) GetStatusDurationReport(ctx *fiber.Ctx, params GetStatusDurationReportParams) error {
+	var request GetStatusDurationReportRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteOrder(ctx.UserContext(), request.(DeleteOrderRequestObject))
+		return sh.ssi.GetStatusDurationReport(ctx.UserContext(), request.(GetStatusDurationReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteOrder")
+		handler = middleware(handler, "GetStatusDurationReport")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteOrderResponseObject); ok {
-		if err := validResponse.VisitDeleteOrderResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetStatusDurationReportResponseObject); ok {
+		if err := validResponse.VisitGetStatusDurationReportResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2168,25 +9993,25 @@ func (sh *strictHandler) DeleteOrder(ctx *fiber.Ctx, id openapi_types.UUID) erro
 	return nil
 }
 
-// GetOrder operation middleware
-func (sh *strictHandler) GetOrder(ctx *fiber.Ctx, id openapi_types.UUID) error {
-	var request GetOrderRequestObject
+// GetTopGroupsReport operation middleware
+func (sh *strictHandler) GetTopGroupsReport(ctx *fiber.Ctx, params GetTopGroupsReportParams) error {
+	var request GetTopGroupsReportRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetOrder(ctx.UserContext(), request.(GetOrderRequestObject))
+		return sh.ssi.GetTopGroupsReport(ctx.UserContext(), request.(GetTopGroupsReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetOrder")
+		handler = middleware(handler, "GetTopGroupsReport")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetOrderResponseObject); ok {
-		if err := validResponse.VisitGetOrderResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetTopGroupsReportResponseObject); ok {
+		if err := validResponse.VisitGetTopGroupsReportResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2195,25 +10020,25 @@ func (sh *strictHandler) GetOrder(ctx *fiber.Ctx, id openapi_types.UUID) error {
 	return nil
 }
 
-// GetOrders operation middleware
-func (sh *strictHandler) GetOrders(ctx *fiber.Ctx, params GetOrdersParams) error {
-	var request GetOrdersRequestObject
+// GetTopProductsReport operation middleware
+func (sh *strictHandler) GetTopProductsReport(ctx *fiber.Ctx, params GetTopProductsReportParams) error {
+	var request GetTopProductsReportRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetOrders(ctx.UserContext(), request.(GetOrdersRequestObject))
+		return sh.ssi.GetTopProductsReport(ctx.UserContext(), request.(GetTopProductsReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetOrders")
+		handler = middleware(handler, "GetTopProductsReport")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetOrdersResponseObject); ok {
-		if err := validResponse.VisitGetOrdersResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetTopProductsReportResponseObject); ok {
+		if err := validResponse.VisitGetTopProductsReportResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2222,23 +10047,25 @@ func (sh *strictHandler) GetOrders(ctx *fiber.Ctx, params GetOrdersParams) error
 	return nil
 }
 
-// GetParams operation middleware
-func (sh *strictHandler) GetParams(ctx *fiber.Ctx) error {
-	var request GetParamsRequestObject
+// GetSlots operation middleware
+func (sh *strictHandler) GetSlots(ctx *fiber.Ctx, params GetSlotsParams) error {
+	var request GetSlotsRequestObject
+
+	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetParams(ctx.UserContext(), request.(GetParamsRequestObject))
+		return sh.ssi.GetSlots(ctx.UserContext(), request.(GetSlotsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetParams")
+		handler = middleware(handler, "GetSlots")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetParamsResponseObject); ok {
-		if err := validResponse.VisitGetParamsResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetSlotsResponseObject); ok {
+		if err := validResponse.VisitGetSlotsResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2247,29 +10074,53 @@ func (sh *strictHandler) GetParams(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// SetHeaderText operation middleware
-func (sh *strictHandler) SetHeaderText(ctx *fiber.Ctx) error {
-	var request SetHeaderTextRequestObject
+// GetZReports operation middleware
+func (sh *strictHandler) GetZReports(ctx *fiber.Ctx, params GetZReportsParams) error {
+	var request GetZReportsRequestObject
 
-	var body SetHeaderTextJSONRequestBody
-	if err := ctx.BodyParser(&body); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetZReports(ctx.UserContext(), request.(GetZReportsRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetZReports")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetZReportsResponseObject); ok {
+		if err := validResponse.VisitGetZReportsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetZReport operation middleware
+func (sh *strictHandler) GetZReport(ctx *fiber.Ctx, day openapi_types.Date, params GetZReportParams) error {
+	var request GetZReportRequestObject
+
+	request.Day = day
+	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.SetHeaderText(ctx.UserContext(), request.(SetHeaderTextRequestObject))
+		return sh.ssi.GetZReport(ctx.UserContext(), request.(GetZReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SetHeaderText")
+		handler = middleware(handler, "GetZReport")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SetHeaderTextResponseObject); ok {
-		if err := validResponse.VisitSetHeaderTextResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetZReportResponseObject); ok {
+		if err := validResponse.VisitGetZReportResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2281,38 +10132,160 @@ func (sh *strictHandler) SetHeaderText(ctx *fiber.Ctx) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xb3W/bNhD/VwRuj0rsbNmL39qkSw2sH5g37KEIBkY822wlUiWpNG7g/33gUZ82Zctx",
-	"VBiLXoqgJu/zd8c76vhIIpmkUoAwmkweiY6WkFD88xVjH5VkWWRulMzSP+FrBtrYX1IlU1CGA67jzP47",
-	"lyqhhkxIlnFGQmJWKZAJ0UZxsSDrkCQgsiku3frJcBOD55d1SBR8zbgCRiafCNLNyRSbbktO8u4zRMaS",
-	"qwRvlZneUx7TuwbXOyljoMJSYKAjxVPDpfAKvLAGmXbTu6N5UsUjaKxkMrMChiThgidZQibjcp/IkjtQ",
-	"h9quELvY1VS0kCGsWcdn3jeMm07A6ChauyNrnPryZM9mP8bONyBA0XhbZVBKKr+6iV541dSGmkxfSVaX",
-	"mQsDC1AkJA9nMuEGktSsyMSoDDb1cCwd/QY1n+B/yAUXrQ5LqdbfpPJngkyDEjTpYNlyZVhR3CGMTqXQ",
-	"4MGo/AKigx9xmY/+O6q+fFAM1AxAHJcjt0PWyxBEts0AQ9uxMpDgHz8rmJMJ+WlUZfhRnt5H9fhFODsu",
-	"VCm6qpLWUYm6gH4uWZsq7a6xmb67RmiWLU02BHMkfaK8h2/oxamBxJNkEpkJdGuZFC7CzTDqmut9xsoZ",
-	"7JKsFVuRTBJw0j318Ckt3MnUDVt5wNMtgFGSPIIdW5/2yMijc8xBmKsdmrsV7/2ihCRSQA00rcOogTPD",
-	"UaInW1IwePDk2IONvNPCGhpJq3YAuNTcifjMLbXk7Rk0ve7oM6diZcKSacPouZB7fbsv4J4YZDtP9qOK",
-	"qCKvlYd4e+jWzWzPbWETxyciUzRMFEuN5ouoiCCOoZ7vKz2QiG5Pk4waehiqfIgy0tD4qs3oG3ZAlo09",
-	"Pu0/UkWdRE2Bl0AZqGugLOYCukeg2/cXPBi/p7YFcIfcwTXjwblhX5F5fO54DjCHJEvZIYrtCv6NNmx/",
-	"hVvPF4Uct+1Oc5XJdt7vKW2njuvBBZQ3lPq0/5bdS8m7GngG5m0ZSa1FBTs4PE33wJyBseUaJiMuFq1C",
-	"7LgySGswmbKm3/Z6e2+NiBbeZNFizFqSP/aK5AmHtw8jOZkWeesBttcDTRscEkrP65QNORpcfHr+oy3A",
-	"rpZULIC9A63pwnN0wn1ePRYns/X9v5Hb5T2MfX0Rts805WeRZLAAcQYPRtEzQxeOycOSZtooPImIxAxJ",
-	"Y7LearFRmLCt7bMalWowbpNtwgU17iYgoWlqZcmDptShBUJe84REYqmxf7MrSTa2O9db665c1Z1rtA6J",
-	"FPBhTiafduO6le6+bR5d1rdhm69PyqdejffjdMNRp4TUNRYyc+k6U2GoK8BcT0hmSyqMrQvtIaViMiFL",
-	"Y1I9GY108cuZTu/OVVY7SatdwauPUxKSe1Aaay1ycT4+HyPEUhA05WRCfj0fn1/irZBZolqjJdDYLL/j",
-	"NQmgNNa41Kpncxp5i79fLSH6QqymrtLGvb+Mx+40rBV4ZAbqnkcQcB040njs/+aWWp1zb9E0jXmEfEaf",
-	"tasNHWr3pfji6g+t2WQ+FQaUoHFgpQAVvMGbObtOZ0lC1apUKIhQI/vTKJYLjvxTqT0WwEsy4rwM2ryW",
-	"bPVsujRuAzewhFeNfpM/J++8dfJYc5ZFEWhsgi9/jP9eUxaU1rBcL34E178FzcxSKv4d2ImB1WEPUZrk",
-	"V5veKL1xRSPpES6N+8gBLSVaLseXP4Lte2mC32UmTg2hN2ACzQ0EiM8SqSOZV8/teXWj0ekpw7a0U91z",
-	"bdMkllaAugELtIP8PIvj1YD7l4X7GRiEfFACvcJ+Wrtb80K/+gjfE+q3v/I/FfA5mYAyNiD+RSP+FWNB",
-	"gewtrI8ey0uHtQNRDAa2kX+N/18Hf1cAOooDBF8yBB16KhTaRlbRBAwojVcoto/D5rb4fDqpLsPIZv4L",
-	"a0Lv+yx9G5I082Ty2hROT6ncM+dzbC7Pb8CHUHrBoWRh1Z7Oqy9Me+oXt7DvIqYxSncs+nHmZqhnhnqm",
-	"qmccJlrCoFsr6/ty1F9Lu+s71dDaDoFxdGvbCIwdPa6LkMfmJ8juHUB1fByWvYdmYIBpsxkoUvgBLUH1",
-	"wbzXvqDPEqntucHz1EhDnzBEWb1PqJdJspw99lZEVzhu5SYq+4H+5uj3UyGPRIJ8POxlQ/2EYOfwEwj4",
-	"5oqPGuhGxYC3H3mNNyc9Yc/7ruU4ALq5tCHlnhgOracdAgOqA0ReA4mmmmBvbQ3rM4m9dYSeScsBkP9D",
-	"QNrmTNYcVIfjI+/SelWHcjcgDL3WUAXmvZYsXsi0DSHtwtaz6JG/0RnGjwZgluNHLh3erYLpdbf+nx/Z",
-	"85cZV+8ayHPjy8Qv0NcM1KqSSM7nGgypS8FgTrPY4At7z2v72vMzP8mYJ7yF4oUlSR/yt7rjfQxu+45n",
-	"PcwVDoHtCeyULrjA6jOPNqx10vLpZFvk5Y8re4RtzmGA6wDXOlxzUJQgtQ3i28bL3NYmsbastx5x+2nj",
-	"U1tERykw8DBMk5xih7is/OPIaFzvCpAmSfdqyL03Gt1fkPXt+r8AAAD//zm9sMT4SgAA",
+	"H4sIAAAAAAAC/+x9W3PbttboX8HwnJczw0RO2/TM9lvqpK33TprUdueb2Z1MBiaXJNQkwAKgbdXj//4N",
+	"LryDFGiJthLzJbEkXBfWDeuGuyBiacYoUCmC47tARGtIsf7zTRy/oZTlNIIUqDyDv3MQUv2ScZYBlwR0",
+	"O6Cx/n/JeIplcBzEWMILSVIIwkBuMgiOAyE5oavgPgxI3Gib5yR2NUuB5qe6aeenjBPGidyoH2MQESeZ",
+	"JIwGx8GvZLUGjooGCNeWLxDmgMSa3VC0JFzIalZCJayAq7EFXEMx9v/lsAyOg/+zqCC0sOBZ1AFzXvRR",
+	"/SXmcgQ0JOYrkGNmuzA9VF+41T1TfPse6Equg+NXR0dHYZASWn7RmfM+DDj8nRMOcXD8Z2Cgr0aqbb5c",
+	"1+eyO7v8CyI97Zs4fktExHIqz/IEetECR5JcQ+0ELxlLAFM1xjiUWXKWXqjfOgf+nkU4QaofYksU4w2S",
+	"a0A8TwCZk0CSIZxlySZEdtfqqwszEdziNEvUXK9eHx8duaZecZZnp3F35k+cxXkkkW5QzarmInqOUH95",
+	"s2YJIMZj4IgsEUuJlKBAvpUAPOnkitB4G/oUx/Uf1fYhSEpk4gD+uaYlyVCUC8lS4AJhoXcd2wlRQqga",
+	"sYah371+vQVBw8Ae0PFd/YT+f88JXeMkdyzuE/AIqMQrQEvGUWY+lisTIbpiGURXQv+8JLcQI0ZB1I+G",
+	"UPnjD4FeLknztL7YkmU4yUnDy55NscKwIIgemjqlKw4xGWK0niiRsJtzyaIr1bhc+pGT2/k1K8+/M1VO",
+	"iXT8MAQU3aWYugcWlrh+UbS1KzQGBEnfvlzLt8MUnYYX3s8TrzFJ8GXSwxYbKHw3yI32xT9SfPt7jqm0",
+	"Mm8I1ZXkhewDobkE4dOYRLCNNX1gFDZjz6IAQ4VUdcAVM4c1aPcfV8pOWPwwIRax2MF4TrAARKgAKojq",
+	"GyIhGYcYEYryLAOOIixaTPHHH7byxEmUrIcIjxTf/iF8zt82/AT8xMoHjz6EfuQx8AsmceKNO2Pl2QGK",
+	"DI1LoyRGTSXsom3EAUuI/UEy6/Bfkw4fBnkWjzngAaW/PAmX/h+WmFRNuQ0bz2vgB6po4M+A0CULwuAG",
+	"c6rWo4YlkkQ4CT531hoGDlDVxsryy4REijrilFD1f7J9GHEGImNUQJdWYiyx+p9ISMWYUwzuy0kx53jT",
+	"AbMe2QWuE0wjSBKsUP4MMsbljsvqDnjGbva5PjVcl8uYZlAn8Rod6luPcP+WASfMca06V7Sn7nLqGmEa",
+	"KcGpPkWMLskqV6JUIfk/TF8t/EiUY+kU1Xb9SKwVY1H3THSkbjSvGiOz/DKpDUvz9NLBx+2Wyn2HNfjY",
+	"FThhzdJLdrJmVllqQjgz+qSnyicS5te0tXLbL6xN17vS84Q9XBdnGvLCH62LGT/qjl2EHqc3FtpisYzB",
+	"TX4s1fAm0rwjUsknjAShqwSQhRliHGG6qT4uETbGgSBsActbiw+D2xcMZ+SF0g5WQF/AreT4hcQrYS7H",
+	"a5wLyfNIjWA2hRO99RF48/BJRM6jtWLPnopaG+vK7u5zoBSi4ghafGeNKYWkiUddsdzClMiMOEYr8kRr",
+	"kjkXkGAhP6m/veeTHFNRiINC3t2IIAyEAKeMy4VRq50/vFlZ5dCHNsqpwwq+emv1sepQrG1w+AD3JXur",
+	"EXeQbFqd0XeM/lWBxLVbbld4+aIFjeHWPQIHHG/eyF3UNzN6WF9sNa5z67Vb2I63BU8AUJw6WPMOXG3N",
+	"qC+vN20r/XUIIu/ZitBeE0Bxza+r6D9uVdDLtda6ff/dONt8uQe1Ao8N9OFzVDv5QQIr2mkb7BXQ7bA2",
+	"zcJqiqFlfpT91rz9wcu1goYRo36XMHd8pe2p67yTxZbOjn0o6s3BnEp6GGSMULl1qE+6lV2VPjF/m4mL",
+	"XRYjlPMPAXLgVoBT1cDbeDN4Syjsc54KsHLBeDb11BtLlbHQ6e3uBmGTJzDSkjituWb2n83+s17/2UhL",
+	"0lfocPM1YtXpd186a33Mh2ut72IiJwjFmO2zjxNjMSq8Qp31HF8xvXyYAycOio+Pj5lQhOIRNHFw0RDe",
+	"gRBqg16REKPc8qMU3y3LmirO4SsLStglCsFC89sMQ5jjCw6As44OLXh3mzEuf7ZTql0scZ6o2SNxHYSl",
+	"8cR8uk2EMkPS+C/BqNOAYsY7YzeiOZqW2i2yOQ4+UkCc3SjwWLnOOGK1L5VoRERCWltKMZT+2rWGX4AC",
+	"x0mXstzU8wFHazUNBxwr0lV/CEZDJEDq0wHOGTcyO0qIOsYIU7TGNE4gRPBy9dIs/otk7EuK6eaLWrYI",
+	"7bfqw5e/LYv7ArcRQAxx8av13vQ30IYaPXTCbrpfrslqHSJtPPlCmfyyZDmN619ohIbyK7jNCK8+poSa",
+	"warftUm2alHoLV8SkhIZokg5zb5E2on5JSVCucZa3xJ6jRPS2WJteSyXX9jyi5GH+/NS6ZNyc85UrJzi",
+	"R0gsc3FiMaNFXnolqUK0TEknyXNoU5yZ0ozfGM1Fbb8ClinOTiBxYOea5Xy8c53DNdDcX8zdAFzF2HHX",
+	"Oz3/iOyPIXqFiEAfGFUttzKdYsjQbKFmQCsWNwCLvVha63B98MW70i8fz2tSV1gHlNSHKKbN09VcahWi",
+	"NFEsNotEMFGgkUvh9TXKVCewL5NMNeLD8eLf7LK7iiUmSc77nIbKWfo25zqm5kNTmSiEurvTuxYDazqY",
+	"fyaUiPUYNFS9TqmQmEbQO+y5kRD+o7p9feoHuJVnOfUfieeUWq95l2XznPbAVx1vnPuo63qptQ7VGqvJ",
+	"7Uxhdag9aLAvrFQY9WB0/A+R0Rro7znksKf12CEvSHQFcueVnUvsDuUwPHHcHSTj6tj5mzjmIESXr62Z",
+	"kH8eKxHyWVmt3p2fLD59PEcU5A3jV8h2D5Fqgaw6qg1Z/3rltpSMjzHaDgqx33MqALzrQdnzHnDqdSnP",
+	"KMAnLE3d4SZFi9/6WMRUklTrHKdj2vZHbIyMxDPHsYe8k2IPjRXWJ6gvrZK19rwaoB8OhhgOgsiwEDeM",
+	"x72xRj38v7WlsmVYjTiwmD4KGRWc4B5/gxO5eRNFBVo3J7jESUs81lABqOQEHIznPZYgJLK/h4jCjfpc",
+	"eEK86Nmu7B2VfLOVmotlVmsa2mz8Vy5kT75ARbzbmG8Zm9AybujvtTsgjtXWV1gZGNQXEl8Bwjc+1wY7",
+	"elguaGA/BkRDW9nXFXK8e15fqH0tRg9fGIk99UgfQ1wdrIUxzpuDPnwP/QhlDJcFOSm8QqZxDb0YBWEQ",
+	"TK4h9cQyvX5rD6swbpA3tkBTCyECzLXiCDEYk5Q+e52eoOjNaY2y431iCYkc125lAtPrUjfuG8bl2hjA",
+	"bBh6E9/V/Nay6PI0YQ5FOL2xpmnLDoo4xERCrBxPBgToZg201o4IFCVMKKPPEZI5pwKBSd9AbLk0hmNr",
+	"grT+0AGfiQHKW7xxHPQ7zCkUR4tMS4SXUq11TQRS1jPlelTGs5RIxVGuADJz4Eum7Al82CL6cORM8e2Z",
+	"PtheCH/At0j0QdnsojTwIrnGxlB4CSjDJEY3RK5RiYL+EG0hdB0HHIt2ofQHzK+0KfwcgO6WY9ulLueE",
+	"QPPuBNq9658QUHeHuSLnyO4KV6FB2ZX1baVfOUmB5v470mDZJufNkM6laBteByvfaPVP+XqsSyFEr46O",
+	"ig8o1RK5ZCj93oUjJy2t2Av7baqmf/kfM2z9txckLW14WOkSgVhjKjHH6SK7Wi3SMg7xN7jReHgqIR26",
+	"dww7doyV2cFdTtZMAC3SMoxrRXGMDRIJs2ka2lBdNPFV1Op5O+3z25tE9yW4wbjEAsIDEc79VzffWPcC",
+	"ZF6wa5y5g4x7jUkZia7y7I3ckjgW2UPXrfVBh0rQCcaoEXhCEOXVcUeMDKp2feqKFemFGJMMZXij+XuI",
+	"IpxlEKNLEz1jlXaEaaw/J2VPrQ2EWz3Y1lnsyc/sdcuciws9PmagpPq72wiynnwbpQe4k2ys26ABO3fm",
+	"F1AXdaqRY4STRAcXNc7joVxcLWlgn7+ynA8YX6AAgz82dwDoTFOzGYsuICpnSbLxnu9/dHO9j62SQ1aZ",
+	"knaWsL5FJ5wUZbqQ4LGtPIWX0dPmUqpZ/gendlrEH7hObfpEoHF8c5Bp1nmjH4BHZiGFgQCgbru8cXJ6",
+	"rf/cNFU7UK7107dule3h2QyN9KjKy2SX2DKJ6S0V51BHomIJvSRSIs6uGRCeIkVdGEwAAjP3NPu9jodg",
+	"NHFcfHdKIO2TMnsYdFqLgm8WyMNnGBkXNqCbeam+DnW3UbPPGTkrarqtiZXVAWkIJ5rsEaFRksegY1gI",
+	"R2U2rvBVgMulnxS/PbYePGWNoeLsyig+jzOsAPHQBHnvDP7x2dfe2y+rATTgMJytrUFQmbNaF2J8+55Q",
+	"qEd0dm0oRayTwVqbW69YW1jd3VK80fwPo4Td6EiwlMitCrOdXrjnNcUc1KwxEZLQyMReC3V3xhQVgWVb",
+	"pxgXUZgSevFwAVfuKOzAtvdwzu1tvAq/M96JWhBd+QVLYvOHloBvQUTF329E5DRo1gV7zTbKMqBFVrIW",
+	"vExA3CiM0TvYvvyjejDnHRNu5UnOBeMOxDAhbIWgVQERKMOrfZruNThPCg7fnP63EidN1BRKsVTxiCu9",
+	"miVJJHBlDaWJoQdtucXiCmJt3tBGRfXPhc3n3JNW4OtK/qSsPKLnGhmf6UhKR15RnmLaDrhEopsqcbMm",
+	"CaASlxzXEPXLH1SSxF+zXQNWKh3gWFH/2H4XNkuo8zMR6nLoVpkVAtqrowMF4FbavBuFAhoZ1RU6RPhS",
+	"AJUWCuo7FbylMYAy/Vm1JQIV4S3+po1ipk84FxC7F62Ewwd8e9qqulGPwzEtPg7EJ+om/feqFp61ltXs",
+	"XoLYiYj1DOmul8XQlrbDE4oyZT/SpJM09O7Q/q9T2yhT0qfM0lEmnOLrzNqfFFnoH8ps6smyo8t7gw8E",
+	"Rd3ZNaDNWPVxdE7H6Ov9tiSQ3S/X9UjKln08Tgk1R4oT4BLiyv2l4xNRzFmmzXjaB5XANSRBj+j3V2yM",
+	"Fm4S2wjVTr1Cwdia17JrLouiGocOdG4D2hs2cC1caksVhUW1aT0fZSrXhaP2d0EQ7oP9xLj6S53YUoaa",
+	"MEsJaQ6WCP2t5Di6qgsQv0Da3aNhC8NEq6Ds9uwh34jZho/s0aKWPbDVoNZYX59Ld3tYeNVejq9zbOW+",
+	"RpxPZVo50DSvw6gf+kzTx0ZXZMiF63Zr9RtTOjEGdIMFUhoUIjRE5Q2suGLArTYHuRni11Yw14JkNEXu",
+	"69JZDvjweNzfcyZdy6gZnb2QbwKvyD59Floh/amKtnQGFVrNKWGrleFsxWUQXcKScTA6Wo8mtUt9w0s5",
+	"itB38Vk4/A/l/NXXg14JjTL9oTx79dB/VW7wXtf3GUQkg11hZUbphdSIwPHWwquu4dZNuH0IpMx18rQq",
+	"/917l6nSplBOifJHAdcpFEZfHcO/64uqTdm/uX3xZXveD2bKZ8AeFkizg8/saSNedrAVOqDXl1euU8cr",
+	"Q7T9qPLLXfZhM84vHNM8wVWRomIwk3ZajGXTT82XKgTCOeQ51iWf9pB2WhtppxLcrXEc1hjgeAUna4iu",
+	"tq3Jz5r0yBW5R+Uoby21XQwXNgHjhCzIE5zhiMhNLyGnNRPn1mtEZeocbtoye5bRvt/9UI/2fb2Vf9bH",
+	"6dtgZQPv3SMvDfGOfGFrPHfcKKx52VjZkW5p9C910lWINgfr/PG8aDt3UdiNRO8mSqvWjoYoF4x7oftr",
+	"afTvXVc82pMg3T6EHtCosOHiOPqxeKi+W2UkOo2bENxeO3NbrHI9La2cogeYNdfhrk89PSAIyXW1tMMM",
+	"rbd0RwwkzPU5Udq8zDTsma1uzNt63k2Ie5rtrIq5TxRoraMxi8c+bS5rP80PWP/uB4c3+t+erkhDar/3",
+	"DaRam/ZUPFYVsx4w1SJL+znbGG1oTKhqvyrkfHJiizsK6Bgbd787c0hl0oa9BxqTTV+zzpoeU9gBhouG",
+	"WZm4lzuRWxyO8AZ7O3KbftuOW7f//DU/Lmpn7EdLbw65k6LeGarLl18fNbGk50GZMMj+5d/ytWfLh4Xm",
+	"cqw9Cox6nazp1+wW6n2bPZn1usB3wTJT3NAFuDHvHdYtF7uXRPKMleu+RFguY7jSUbHt/Vw660B8MCJf",
+	"sKwQk04kHlUA4UlOw1UIwf887Ob3diI1YD74TP7QXovCQdUrk4t8qVHlYl1VcVxrqMvwgdSgquLsd9/3",
+	"VJwtk4Cqtq+Oetr21iQ7UrfP89yWISsv0T+OSpgtRi/WFBYbcQJANF6SO1ljuoL4AwiBVw7pq7Cs8YBP",
+	"o0L2l8h0d5qhXOmre4rWM6sK+7Jz/0fYAjCjd3dl+j3avsJt+n/Pruv93BBQd+rR21fX3YM909o2lAeJ",
+	"k5RQLE3Ea4qzzIYeuhG0T6UfpIawgw+9w7gRLmxCtLe347AKNXp7Z2Oxa3W/L0P1NiaHzcJXMS4KH5fB",
+	"8Z9bLjt9427r5tjL9k5u8G3vN3R695/DPnw/KLx2wnk7rbbQ47Co9b99esf4wEqX2PwpF4SCEEjX8iQS",
+	"AY0FwrJtyI9yyZbLwt6vYpRRhBOgMebISMytub7jYoHGRseIPE0x3/p4hAXnuW3tShIu57ZLrsYergZT",
+	"DG1sli5deXSUxoozIfYcFOwbm7SjybS8+5U2DLOZRtDCwItSrYPqIK6OtSrjkzqBS90A64c4xr6eAxt7",
+	"dTLHA/5mzQ52u7LZWXY6ylY67jpUrtkLqXqdf7V1DiDevuxo/629xPaAu969DmVfMhNGQCU2IfjmZhec",
+	"F8VTgjDIeRIcB2spM3G8WJRlVV6I7PIlz2vX5aoXevPpVEXJAReGqF69PHp5VFzJcEaC4+D7l0cvVSCe",
+	"KteiN7toqIXqG/uKjgJRqYEHv0DjaSJNkGpOkBrl/3SG3rdeDbLwQTiXa8bJP3p0HSsdHAd/56BZsgVF",
+	"+TK5AXzT91++Dd7zWPhnxy24G+hiOE1ziSJiGcRlQoBSUnsWWDrAqgW25/wcBtxinQbsd0dHxblblUW/",
+	"cxNpOCz+sm7aajzfd4wq3Nb41XLs51EEQlv7ftjj/EWlfceMP+EYFTYMPeurx5j1D1qgFegI4dePs9lT",
+	"KoFTnKBz4NfAkSnjfF/XYBTtoCjnHKhMNuiamBidJt1pESEchPcmjutnHRg2A0L+xOLN/tCpOUt5fE22",
+	"pqvgu5G6Rf21sVRFP4iRMHi4zJNkMyPj0yHjmzhuoJ7+uSkEFtg8UtArCJKkLQtmPjejluZzqsZTU6Sa",
+	"ChQqnqjMVdXRwPY5Ehf+3dU/nsb3hsEkIKGLj2/19x0WOYpDmbGfOY/64eiHx5j2NybRzyynh4a8Bo9a",
+	"rLGj5GpNUOnOlSLYxNWgLS/rCuK2uHBll8sdPLf9MOhESkDf+6N70QJsZs5MY8+YxhSCOZSPiFEKURmF",
+	"0Kd2nNSaTahx1KaZ9Y2D1zc44EQX0KgjURurFndkWIn4D4muqnP3UiCq5uhKvaYxc7bnzNl+ZjwCFzIq",
+	"U5I2Zuov/DQKsqMWobHfBpMsErYiGg5u80IRdKKf4phIr2jMMV6pmGINh8zXv/vXY8x6wRj6oGru26nF",
+	"gVHUe7ZSCUi6VI8uuE+Ns1BnuZtKh9i866LzVIwjT5Xy0u+wIIP29y1S0LmngzpGiSam6YQY2XqcZhsq",
+	"Pndhb5PE6/nEiXmIJ4F4Bbx8QKQ3jbyFDSn4IELwCDxpPnsPRa/vTHuuzM2QyokEmztu84kk23xJOUjc",
+	"NTjix5KqAIJtbOljGRXh0CZbnsrIlL8c8lSG7p5F5VWHD/bVUe9DNq7SAFO6QluFRGcyOEQyMIfUL5xb",
+	"z+i56ELf4hfcFGcwyZdT36T6nLK2QsREYqVVf+LeCpSp5IdWne3TMTMNzYaNptqdYKVtK+q0b56VNzId",
+	"L2UKeWdYSF1LEpeRDqYOvWhTssz6bRH2sEsZJ7OJDRIfZbarj0PVJkLCOotmg8ETYuo5qDK9lZ1AWwBM",
+	"TTwdzgUoWzMKTYS0QmW8ZeCnjfX1PWPrwHNnjSMsErh5Y31UE3ATxxf2wdIn1Z/MI8UuY9v+mX33XeRH",
+	"vqCPJOVZt3ouDESF4jFePZxdlFNs8AtVSHGNqY2TKuLiz/IEBg0FbxsNJ8TuxkTeF4jZomlD5XLJUixJ",
+	"VL02wPWJDcUD10E+XTxwfZZdtWQ1xhwHfHhxwG7sc7CaxZ15bs0jFLODnV7I0R+COUumpwuG7MMPHxXW",
+	"IMxk8ZCPwATb0+yFC85xkDN5mThIH+YrFrzMF9+q6pmWXj6hJWepH2kO1jpzDy7Z7kN/fgyV1aZyzhey",
+	"A9RPikMSaMUxlcZbhG1p4hDpklymzLyuEW8MfcoGUxHRGnAi1//00s6v+vcii3g7/1arJRGoeCMz9ObA",
+	"YGY2hCK9IwOCop5TH/OoP5c+5TXR+Sz7IVLegV0Si6f+9EHavKnyXffqiBfNB+3dV8dzfA2dV+ynUZ06",
+	"0zxUZypHQAJfz9fHg8FN4zVW5iuj0LYQFWoH78DRxV2MNx53SSe2+qPMnNd3kNfKXlTxuVaakj6e2mWf",
+	"a8Tg440ueTjALxulkifilO56zA9llx8boJ3vmoeF/2eQ6XAKg3hNOjCMsno0Z1BrO601m1Bpq00zpLPV",
+	"VzOfs1XcSBMovcb8CnjTmfKrOXblL9VIszn/4Mz5Fcp1mMnirv4cl4fm1ULLEXgxW/IPUOWqo4ZX/Enz",
+	"8bZpTPiTs77mJHvkfbNiNVOWNuK3We5f7HJQcfu3+n1CjU2NP0dxH7xyeImjK2XBpjHSGFPizuJOMeD7",
+	"heRktfLNctD/DTFp77i8CzPtv9nlxEjqAt6/2SVaEkrEGuLQGLFRgoXUQNRvS6sgXg4iT+TMbB+B2f5w",
+	"9CiB5SeMLhMSHZr1/SynLTpFlN0YUr2KxcK+MzDI7m319vOi6YRE1ZpqFgIHLwTsEwqoRKT7cMBx0zzf",
+	"iRTm1iQP1ZRt/9llc9gumxYCdlnb4q58TMXDZODAUF9EmR01833KWio6SOljrijRdJwaPITvi79zyMFD",
+	"uP+u200v2fU8s1if6cahSmRAdYVdqSrRmQySp6AjO/3izvyhqOgyTzO/W2zRZ5qMs5/yNLOEdKEn8pJP",
+	"pilSm5il07Omsg+YX5U0ZVAVYYHiMqN4S529KevrPWVdva+gnt6zV/zfV7XwbBLwJ5aQaDDd/X2j4fTZ",
+	"sXaiYQQ6wJJkOluvKmuj94IyzlYcpwbk+g2ZAUirZwGnBLAafybQWaA5EFgQCeaNoxJTF8WD7YNhcQqn",
+	"PhYNJ4uLq8+yq/NWjWUK2MyK3LPG+3OQKC2RoXhN2OC+fVm8H/XfxLF94G66YC07wa4Ib4eZw7TmMhNx",
+	"jArM7uD64q58Tt/DxFtHfl8EnG27Mwpa226JhT62qBIvJ4s/m5aV12bYFy+fw85mUtJhZ17sfMEhIhn4",
+	"WV+npbZzqEhBr2myO0Njmp0LNuhRZrKbya5M3anF1KOIUZGnJgmdUUAZ42rEwhrkR6QiYVIcCI2esPSS",
+	"nesFTUag1Ry7l15NLxnS8JtJdCbRkkSjGl40STFEmCJIM7lBCRESyZxTgXQYt2QIIw6rPMHcl3Ili64e",
+	"m3L7LHJW7p3rNU0tXfUsu5KvHmQm3JlwtUlOE1OHXFWtcZYXv9Z+UjVYKJNIcqze3utS6i+c5ZmPIc80",
+	"nNqap2fZ1zVQ176ZDXuzYa8y7Bmc6CEDP59OHVOn9+24Zpt9PDNh7E2gNAhjwNljKOSu/mmMKbwSH+O4",
+	"92wVn9G0aRUvWPgI27jF1qkN5FOqSO1p9qsjzbeLmcrqBvNhNaktBIq8gFF3/H3RpI+aNm0ymHuy3a/9",
+	"ehiEhSArOtPm877BaCRo6WqSuTPTqnf/3C+ZV+/YTUQQv8HNx9bDfI/60KvfQ326Qfkg9px8eVDJl+Uz",
+	"ejWUXvydMwn9iP27+nlKvNYTPBFS67nnQsBbXhrjRD8wVn+BUb8eYxZ0qd7PKMtlFzZT5QxROWFE1nFN",
+	"AAxkqagsF41p56rZNNjWmGPnepcaIEpM5LMT8CBzpgzKYoE05jUwUZ7rcxu0ShpMMe0mU3Nrk8wI+Q0j",
+	"pLILstoB1dFRvZHoUZC6FMN+iDCb+eZLTlH12qh9Yf9TDAO4tb+H8ucUsBkx6+9LaDZ1uUGnbx/vZdqK",
+	"4y4yTugTP0b7SS1hLGPX1UFiXZVOb0HPMZPPM4s3UydvSahZG8A81cIhApLV71/bE68/1ppNLQu+xqTr",
+	"hKRECgTXwDcW9GkuJBJYErHc1GAttoJZ+D2WFuVcMB4MlUDpeQlNL7bRMYYlzhMZHL86CoMU35I0T9UH",
+	"9YlQ+6lkXoRKWAHvn0Aw3hx/65mfmyfPwrsAbrOExVBwUufwxcWrmoBISIXfTKbzfbkbzDnW2q+Qm0R9",
+	"oVh20Ls1Y4LowPySsQQw7YeJfdtuj2/Z7WkoZag5jRvjObq2CfHS/FjExkWJrkCtxkSMq2DX1BTQdk35",
+	"d2OyFN++B7qS6+D4u9evw+2TF87UIqYmJhwiqV6u0Dd6bENtSwToWUU9+tVfXvdAMSX0gkmceGP9B0Zh",
+	"MzAevt3reMW73eN3245uz6m0bC7FMlprHND8jlAk1ZJ1k9BghW7NAcdCWwZ1DzBSiAh0CUIiLK6sxqIN",
+	"iKrbknAhUYZXgBhNNj3np5p3YVRysiVOBIRd8vw8tfiai5zOSlnXxIN5tDaEIkKN2gJhDmgJiiJig/sU",
+	"buWJlupVyDFcE5YL3aOuRCzgdvAB1nf65zHqxMG/vdonV01fXz5pAPOz6dQ7KGc3YuSQZ6rLN63BzJrC",
+	"N6UpjBOE1zR+yTKgt2liNi5esOWSRBCzKFcn+FJkWtCvAWSavNT/N/lrCbBLQjHfuEDWmPL2BY27XNpn",
+	"FAm3chGJ67E9O7zdkDZakgSencg+JPEpOeDUis8yiIRQ9KeSWiGS7P9V2qjRIBOpJS1LYqVkao3SyE8t",
+	"Cgcv4Z9Miwn1RDvDrB/O+mGtWm6BFCWSLgTIE5zhiMjh90DLRtPlR9sZds6OtuPMTugDdEIXxuKoOKQM",
+	"OMpIdJVnOnG5g5sJExD/QSVJhtGz1m46DK0m2RlJ9VDaGz+X2Ti4kEV1OMouRCiUCVQoV+euRf+KXGt/",
+	"RwptdP0VcAz8Am4bpfRiyDhEWBYY0pHIa3Yj9MhKqzTXhiy/TEiEMKUspxGk+vG9cgUx4FitrpbXr7ty",
+	"SNk1CETkS/SHgEZ3gQgVErC6SHTop7bwycinmmNX6jEjmS3PxHN4XH5dnU+bQt636zD3svRuIeYpCoh3",
+	"ajA/JPVKd58x8QAx0a9SdYWeH5u+6uHozElRs+OnnhHzW0JML29+Cy8JXX3CuYB4O2pWTaeNHS7n2Uv4",
+	"sFKzZpX4EFFWH7KyaXMQedpRji26cpayExbDsO2rajWl/aucxdtXOj/NKLULXR8jivQJ9b7MaCr8GBBP",
+	"WkTITLGH7Hi7p7l80HN/39ZWD7L40GZdi7vyb99yKDUqGIGH/RkSs3n8KUuSlHjhWY+kwJUpi5FMymcb",
+	"c+yR0c463Px8+ROWPWnwd1MRfFAvPbNNJlRK7RRDGmmxilkjtRqpPTllv7HKqQqUEcWxZoxLsYgwjSBJ",
+	"tr9Lf1JreKY7f9uhayuOqarsazy3vliq4PJLree+IuPOoBEZN2WcrOOg+8N2tgbpFCPMBogns5mtMQfF",
+	"BCypQ1zE6lxqJzJhcZMlrAHLFGdDzOBX0+Q58IHDJdTmIcw0+hXTqIkFtwmA10BzUMR5A3AV443+es1y",
+	"3iRTgZNhtexcNZhF9TcsqusnPNP/V0z/Z4boQ+vPMqlZiuzxNXCVahWtIbqqBHbYFeZKyMNtlOQxtOS5",
+	"yWd4m/PtSv55o+ks3p+UuF1nMVP5V0zlFySFglxFBlRHyRs/tqHRUH0hIGI0bl3TJct0RdVB6r0oGj0H",
+	"wp08T/5wOUP7nGem8BUzhZ9ASCQgSVQIR6Oqrb6gl9cBWDJeq9nYYQ82nW4bgyiazSziW2cRrZOemcQ3",
+	"xiQ82EP5UGSvvq8bjGECT1ciZVJd2zwuecClIQ4tH65KPBIIX2OS4EuSWDNKsPjHsIxB3Ptv0cYL/QZ4",
+	"+fd1Xv79jz9u4eVT4lGxp2E/pdn2zNiC4+A9loq1AY1fsOULZezkBXjqeLS4i/Hm3gObPHnZwcrt/+5X",
+	"Ws/xUE8QRdHGZe2AR5e5IBSEQLEpB7I9Qko19FaNnXUz1br0Qs0czb28+XQahEHOk+A4WFy/Cu4/3//v",
+	"AM9rwbjzfQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -15,3 +15,11 @@ func (m *WsMenuChangedMessage) GetId() string {
 func (m *WsMessage) GetId() string {
 	return m.Id
 }
+
+func (m *WsKitchenChangedMessage) GetId() string {
+	return m.Id
+}
+
+func (m *WsAnnouncementsChangedMessage) GetId() string {
+	return m.Id
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"shantaram/app/service/auth"
+	"strings"
+
+	"github.com/samber/do"
+	"github.com/spf13/cobra"
+)
+
+func newAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Manage admin accounts",
+	}
+
+	var password string
+
+	create := &cobra.Command{
+		Use:   "create <username>",
+		Short: "Create an admin account, the password is read from stdin unless --password is set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pass, err := readPassword(cmd, password)
+			if err != nil {
+				return err
+			}
+
+			e, err := bootstrap()
+			if err != nil {
+				return err
+			}
+			defer e.Close()
+
+			if err = do.MustInvoke[*auth.Service](e.di).CreateAdmin(e.ctx, args[0], pass); err != nil {
+				return err //nolint:wrapcheck
+			}
+
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Admin %s created\n", args[0])
+
+			return nil
+		},
+	}
+	create.Flags().StringVar(&password, "password", "", "password, prefer stdin to keep it out of shell history")
+
+	reset := &cobra.Command{
+		Use:   "reset-password <username>",
+		Short: "Set a new password for an existing admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pass, err := readPassword(cmd, password)
+			if err != nil {
+				return err
+			}
+
+			e, err := bootstrap()
+			if err != nil {
+				return err
+			}
+			defer e.Close()
+
+			if err = do.MustInvoke[*auth.Service](e.di).ResetPassword(e.ctx, args[0], pass); err != nil {
+				return err //nolint:wrapcheck
+			}
+
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Password for %s updated\n", args[0])
+
+			return nil
+		},
+	}
+	reset.Flags().StringVar(&password, "password", "", "password, prefer stdin to keep it out of shell history")
+
+	cmd.AddCommand(create, reset)
+
+	return cmd
+}
+
+func readPassword(cmd *cobra.Command, flag string) (string, error) {
+	if flag != "" {
+		return flag, nil
+	}
+
+	_, _ = fmt.Fprint(cmd.ErrOrStderr(), "Password: ")
+
+	line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"shantaram/app/service/announcement"
+	"shantaram/app/service/auth"
+	"shantaram/app/service/capacity"
+	"shantaram/app/service/connection"
+	"shantaram/app/service/customer"
+	"shantaram/app/service/discount"
+	"shantaram/app/service/email"
+	"shantaram/app/service/eta"
+	"shantaram/app/service/export"
+	"shantaram/app/service/hours"
+	"shantaram/app/service/inventory"
+	"shantaram/app/service/kitchen"
+	"shantaram/app/service/limits"
+	"shantaram/app/service/loyalty"
+	"shantaram/app/service/menu"
+	"shantaram/app/service/order"
+	"shantaram/app/service/params"
+	"shantaram/app/service/printing"
+	"shantaram/app/service/pubsub"
+	"shantaram/app/service/report"
+	"shantaram/app/service/scheduler"
+	"shantaram/app/service/sms"
+	"shantaram/app/service/telegram"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/telemetry"
+	"shantaram/pkg/tlog"
+	"time"
+
+	"github.com/exaring/otelpgx"
+	"github.com/getsentry/sentry-go"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/do"
+)
+
+// env is the shared wiring of every command that needs the database
+type env struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	di     *do.Injector
+
+	closers []func()
+}
+
+func (e *env) Close() {
+	for i := len(e.closers) - 1; i >= 0; i-- {
+		e.closers[i]()
+	}
+
+	e.cancel()
+}
+
+func bootstrap() (*env, error) {
+	appCtx, cancel := context.WithCancel(context.Background())
+
+	e := &env{
+		ctx:     appCtx,
+		cancel:  cancel,
+		di:      do.New(),
+		closers: nil,
+	}
+
+	if err := e.init(); err != nil {
+		e.Close()
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (e *env) init() error {
+	do.ProvideValue(e.di, e.ctx)
+
+	cfg, err := config.Load(configPaths...)
+	if err != nil {
+		return fmt.Errorf("config load failed: %w", err)
+	}
+	do.ProvideValue(e.di, cfg)
+	do.ProvideValue(e.di, config.NewReloader(cfg, configPaths))
+
+	if err = telemetry.InitSentry(cfg); err != nil {
+		return fmt.Errorf("sentry init failed: %w", err)
+	}
+	e.closers = append(e.closers, func() {
+		sentry.Flush(3 * time.Second)
+	})
+
+	tel, err := telemetry.Init(cfg)
+	if err != nil {
+		return fmt.Errorf("telemetry init failed: %w", err)
+	}
+	e.closers = append(e.closers, func() {
+		_ = tel.Shutdown(context.Background())
+	})
+	do.ProvideValue(e.di, tel)
+
+	if err = tlog.Init(cfg, tel); err != nil {
+		return fmt.Errorf("logging init failed: %w", err)
+	}
+
+	metrics, err := telemetry.NewMetrics(cfg, tel.Meter)
+	if err != nil {
+		return fmt.Errorf("metrics init failed: %w", err)
+	}
+	do.ProvideValue(e.di, metrics)
+
+	tracing := telemetry.NewTracing(cfg, tel.Tracer)
+	do.ProvideValue(e.di, tracing)
+
+	dbConnStr := "postgres://" + cfg.DB.User + ":" + cfg.DB.Pass + "@" + cfg.DB.Host + "/" + cfg.DB.Database + "?sslmode=disable&pool_max_conns=30&pool_min_conns=5&pool_max_conn_lifetime=1h&pool_max_conn_idle_time=30m&pool_health_check_period=1m&connect_timeout=10"
+
+	dbConf, err := pgxpool.ParseConfig(dbConnStr)
+	if err != nil {
+		return fmt.Errorf("pgxpool.ParseConfig() failed: %w", err)
+	}
+
+	dbConf.ConnConfig.RuntimeParams = map[string]string{
+		"statement_timeout":                   "30000",
+		"idle_in_transaction_session_timeout": "60000",
+	}
+	dbConf.ConnConfig.Tracer = otelpgx.NewTracer(
+		otelpgx.WithMeterProvider(tel.MeterProvider),
+		otelpgx.WithTracerProvider(tel.TracerProvider),
+	)
+
+	dbConn, err := pgxpool.NewWithConfig(e.ctx, dbConf)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	e.closers = append(e.closers, dbConn.Close)
+
+	if err = otelpgx.RecordStats(dbConn); err != nil {
+		return fmt.Errorf("unable to record database stats: %w", err)
+	}
+
+	do.ProvideValue(e.di, dbConn)
+
+	queries := database.New(dbConn)
+	do.ProvideValue(e.di, queries)
+
+	provideServices(e.di)
+
+	return nil
+}
+
+// provideServices registers lazy constructors, nothing is started here
+func provideServices(di *do.Injector) {
+	do.Provide(di, scheduler.New)
+	do.Provide(di, pubsub.New)
+	do.Provide(di, auth.New)
+	do.Provide(di, limits.New)
+	do.Provide(di, telegram.New)
+	do.Provide(di, email.New)
+	do.Provide(di, sms.New)
+	do.Provide(di, menu.New)
+	do.Provide(di, eta.New)
+	do.Provide(di, hours.New)
+	do.Provide(di, capacity.New)
+	do.Provide(di, kitchen.New)
+	do.Provide(di, printing.New)
+	do.Provide(di, discount.New)
+	do.Provide(di, inventory.New)
+	do.Provide(di, loyalty.New)
+	do.Provide(di, report.New)
+	do.Provide(di, order.New)
+	do.Provide(di, export.New)
+	do.Provide(di, customer.New)
+	do.Provide(di, params.New)
+	do.Provide(di, connection.New)
+	do.Provide(di, announcement.New)
+}
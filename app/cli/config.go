@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"fmt"
+	"shantaram/pkg/config"
+
+	"github.com/spf13/cobra"
+)
+
+func newConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect configuration",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "validate",
+		Short: "Load and validate the configuration without connecting anywhere",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if _, err := config.Load(configPaths...); err != nil {
+				return err //nolint:wrapcheck
+			}
+
+			_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Config is valid")
+
+			return nil
+		},
+	})
+
+	return cmd
+}
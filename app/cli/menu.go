@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"shantaram/app/api"
+	"shantaram/app/service/menu"
+
+	"github.com/samber/do"
+	"github.com/spf13/cobra"
+)
+
+func newMenuCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "menu",
+		Short: "Export and import the menu as JSON",
+	}
+
+	var output string
+
+	export := &cobra.Command{
+		Use:   "export",
+		Short: "Write the whole menu as JSON",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			e, err := bootstrap()
+			if err != nil {
+				return err
+			}
+			defer e.Close()
+
+			menus, err := do.MustInvoke[*menu.Service](e.di).GetMenu(e.ctx)
+			if err != nil {
+				return err //nolint:wrapcheck
+			}
+
+			return writeOutput(cmd, output, func(w io.Writer) error {
+				encoder := json.NewEncoder(w)
+				encoder.SetIndent("", "  ")
+
+				return encoder.Encode(api.MenuResponse{Menus: menus}) //nolint:wrapcheck
+			})
+		},
+	}
+	export.Flags().StringVarP(&output, "output", "o", "", "output file, stdout by default")
+
+	var prune bool
+
+	importCmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Create or update menus from JSON produced by export, stdin is used without a file",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var req api.MenuResponse
+
+			if err := readInput(cmd, args, func(r io.Reader) error {
+				return json.NewDecoder(r).Decode(&req) //nolint:wrapcheck
+			}); err != nil {
+				return fmt.Errorf("failed to parse menu: %w", err)
+			}
+
+			e, err := bootstrap()
+			if err != nil {
+				return err
+			}
+			defer e.Close()
+
+			if err = do.MustInvoke[*menu.Service](e.di).ImportMenu(e.ctx, req.Menus, prune); err != nil {
+				return err //nolint:wrapcheck
+			}
+
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Imported %d menus\n", len(req.Menus))
+
+			return nil
+		},
+	}
+	importCmd.Flags().BoolVar(&prune, "prune", false, "delete groups and products missing from the imported menus")
+
+	cmd.AddCommand(export, importCmd)
+
+	return cmd
+}
+
+// writeOutput writes to the file when it's set and to stdout otherwise
+func writeOutput(cmd *cobra.Command, path string, write func(w io.Writer) error) error {
+	if path == "" {
+		return write(cmd.OutOrStdout())
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+
+	if err = write(file); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close() //nolint:wrapcheck
+}
+
+// readInput reads the file from the first argument, or stdin without one
+func readInput(cmd *cobra.Command, args []string, read func(r io.Reader) error) error {
+	if len(args) == 0 || args[0] == "-" {
+		return read(cmd.InOrStdin())
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", args[0], err)
+	}
+	defer file.Close()
+
+	return read(file)
+}
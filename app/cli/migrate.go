@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"shantaram/pkg/migration"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+func newMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage database migrations",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "up",
+		Short: "Apply all pending migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			e, err := bootstrap()
+			if err != nil {
+				return err
+			}
+			defer e.Close()
+
+			return migration.Migrate(e.ctx, e.di) //nolint:wrapcheck
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "down [steps]",
+		Short: "Roll back the last applied migrations, one by default",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			steps := 1
+
+			if len(args) > 0 {
+				parsed, err := strconv.Atoi(args[0])
+				if err != nil || parsed < 1 {
+					return fmt.Errorf("steps must be a positive number")
+				}
+
+				steps = parsed
+			}
+
+			e, err := bootstrap()
+			if err != nil {
+				return err
+			}
+			defer e.Close()
+
+			return migration.Rollback(e.ctx, e.di, steps) //nolint:wrapcheck
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "status",
+		Short: "Show applied and pending migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			e, err := bootstrap()
+			if err != nil {
+				return err
+			}
+			defer e.Close()
+
+			statuses, err := migration.GetStatus(e.ctx, e.di)
+			if err != nil {
+				return err //nolint:wrapcheck
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintln(w, "ID\tKIND\tAPPLIED\tDOWN\tNOTE")
+
+			for _, status := range statuses {
+				applied := "pending"
+				if status.Applied != nil {
+					applied = status.Applied.Format("2006-01-02 15:04:05")
+				}
+
+				down := "no"
+				if status.Reversible {
+					down = "yes"
+				}
+
+				var note string
+
+				switch {
+				case status.Unknown:
+					note = "unknown to this build"
+				case status.Modified:
+					note = "modified after apply"
+				}
+
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", status.ID, status.Kind, applied, down, note)
+			}
+
+			return w.Flush() //nolint:wrapcheck
+		},
+	})
+
+	return cmd
+}
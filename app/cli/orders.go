@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"shantaram/app/api"
+	"shantaram/app/service/export"
+	"shantaram/pkg/config"
+	"time"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"github.com/spf13/cobra"
+)
+
+const dateLayout = "2006-01-02"
+
+func newOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders",
+		Short: "Work with orders",
+	}
+
+	var (
+		from, to, output, format, rows, table, search, product string
+		statuses                                               []string
+	)
+
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export orders created in [from, to) as CSV, XLSX or NDJSON, dates are YYYY-MM-DD in the restaurant timezone",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			opts := export.Options{
+				Format: api.ExportFormat(format),
+				Rows:   api.ExportRows(rows),
+				Filters: api.GetOrdersParams{
+					Cursor:     nil,
+					Limit:      nil,
+					Sort:       nil,
+					Status:     nil,
+					Seen:       nil,
+					From:       nil,
+					To:         nil,
+					TableId:    nil,
+					Q:          nil,
+					ProductId:  nil,
+					MinTotal:   nil,
+					MaxTotal:   nil,
+					CustomerId: nil,
+					WithTotal:  nil,
+				},
+			}
+
+			if !pie.Contains([]api.ExportFormat{api.ExportFormatCsv, api.ExportFormatXlsx, api.ExportFormatNdjson}, opts.Format) {
+				return fmt.Errorf("invalid --format: %s", format)
+			}
+			if !pie.Contains([]api.ExportRows{api.ExportRowsOrder, api.ExportRowsItem}, opts.Rows) {
+				return fmt.Errorf("invalid --rows: %s", rows)
+			}
+
+			if len(statuses) > 0 {
+				orderStatuses := pie.Map(statuses, func(status string) api.OrderStatus {
+					return api.OrderStatus(status)
+				})
+				opts.Filters.Status = &orderStatuses
+			}
+			if table != "" {
+				opts.Filters.TableId = &table
+			}
+			if search != "" {
+				opts.Filters.Q = &search
+			}
+			if product != "" {
+				productID, err := uuid.Parse(product)
+				if err != nil {
+					return fmt.Errorf("invalid --product: %w", err)
+				}
+
+				opts.Filters.ProductId = &productID
+			}
+
+			e, err := bootstrap()
+			if err != nil {
+				return err
+			}
+			defer e.Close()
+
+			since, until, err := parseDays(from, to, do.MustInvoke[*config.Config](e.di).Location)
+			if err != nil {
+				return err
+			}
+			opts.Filters.From = &since
+			opts.Filters.To = &until
+
+			exportService := do.MustInvoke[*export.Service](e.di)
+
+			return writeOutput(cmd, output, func(w io.Writer) error {
+				return exportService.Write(e.ctx, w, opts)
+			})
+		},
+	}
+	exportCmd.Flags().StringVar(&from, "from", "", "first day, inclusive")
+	exportCmd.Flags().StringVar(&to, "to", "", "last day, exclusive, defaults to the day after --from")
+	exportCmd.Flags().StringVarP(&format, "format", "f", string(api.ExportFormatCsv), "csv, xlsx or ndjson")
+	exportCmd.Flags().StringVar(&rows, "rows", string(api.ExportRowsOrder), "order for a row per order, item for a row per line item")
+	exportCmd.Flags().StringSliceVar(&statuses, "status", nil, "only orders in these statuses")
+	exportCmd.Flags().StringVar(&table, "table", "", "only orders for this table")
+	exportCmd.Flags().StringVar(&search, "search", "", "substring of the client name or comment")
+	exportCmd.Flags().StringVar(&product, "product", "", "only orders containing this product id")
+	exportCmd.Flags().StringVarP(&output, "output", "o", "", "output file, stdout by default")
+	_ = exportCmd.MarkFlagRequired("from")
+
+	cmd.AddCommand(exportCmd)
+
+	return cmd
+}
+
+// parseDays turns the --from and --to days into the bounds of [from, to) at
+// the local midnights of loc, an empty to means the day after from
+func parseDays(from, to string, loc *time.Location) (time.Time, time.Time, error) {
+	since, err := time.ParseInLocation(dateLayout, from, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid --from: %w", err)
+	}
+
+	until := since.AddDate(0, 0, 1)
+	if to != "" {
+		if until, err = time.ParseInLocation(dateLayout, to, loc); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --to: %w", err)
+		}
+	}
+
+	if !until.After(since) {
+		return time.Time{}, time.Time{}, fmt.Errorf("--to must be after --from")
+	}
+
+	return since, until, nil
+}
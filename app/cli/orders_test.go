@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDays(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		from, to string
+		loc      *time.Location
+		since    string
+		until    string
+		wantErr  bool
+	}{
+		{
+			name:  "single day starts at the local midnight",
+			from:  "2026-03-10",
+			loc:   moscow,
+			since: "2026-03-09T21:00:00Z",
+			until: "2026-03-10T21:00:00Z",
+		},
+		{
+			name:  "explicit range",
+			from:  "2026-03-10",
+			to:    "2026-03-13",
+			loc:   moscow,
+			since: "2026-03-09T21:00:00Z",
+			until: "2026-03-12T21:00:00Z",
+		},
+		{
+			name:  "day with a DST switch is 23 hours",
+			from:  "2026-03-29",
+			loc:   berlin,
+			since: "2026-03-28T23:00:00Z",
+			until: "2026-03-29T22:00:00Z",
+		},
+		{
+			name:  "UTC is unchanged",
+			from:  "2026-03-10",
+			loc:   time.UTC,
+			since: "2026-03-10T00:00:00Z",
+			until: "2026-03-11T00:00:00Z",
+		},
+		{name: "invalid from", from: "10.03.2026", loc: moscow, wantErr: true},
+		{name: "invalid to", from: "2026-03-10", to: "tomorrow", loc: moscow, wantErr: true},
+		{name: "to equal to from", from: "2026-03-10", to: "2026-03-10", loc: moscow, wantErr: true},
+		{name: "to before from", from: "2026-03-10", to: "2026-03-09", loc: moscow, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			since, until, err := parseDays(tt.from, tt.to, tt.loc)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got [%s, %s)", since, until)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := since.UTC().Format(time.RFC3339); got != tt.since {
+				t.Errorf("since = %s, want %s", got, tt.since)
+			}
+			if got := until.UTC().Format(time.RFC3339); got != tt.until {
+				t.Errorf("until = %s, want %s", got, tt.until)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"os"
+	"shantaram/pkg/config"
+
+	"github.com/spf13/cobra"
+)
+
+// configPaths are set by the persistent --config flag
+var configPaths []string
+
+func Execute() {
+	if err := newRootCmd().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:          "shantaram",
+		Short:        "Shantaram API server and maintenance tools",
+		SilenceUsage: true,
+		// running without a subcommand starts the server as before
+		RunE: runServe,
+	}
+
+	root.PersistentFlags().StringSliceVarP(&configPaths, "config", "c", nil,
+		"config file, repeat to layer several files, defaults to "+config.DefaultPath)
+
+	root.AddCommand(
+		newServeCmd(),
+		newMigrateCmd(),
+		newConfigCmd(),
+		newAdminCmd(),
+		newMenuCmd(),
+		newOrdersCmd(),
+		newSeedCmd(),
+	)
+
+	return root
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"shantaram/app/api"
+	"shantaram/app/service/hours"
+	"shantaram/app/service/menu"
+
+	"github.com/samber/do"
+	"github.com/spf13/cobra"
+)
+
+//go:embed seed/menu.json
+var seedMenu []byte
+
+// seedHours are used only when no weekly hours are configured yet
+var seedHours = hours.WeeklyHours{
+	Weekday: 0,
+	Opens:   "10:00",
+	Closes:  "22:00",
+}
+
+func newSeedCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "seed",
+		Short: "Fill an empty database with a demo menu and opening hours",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			var req api.MenuResponse
+			if err := json.NewDecoder(bytes.NewReader(seedMenu)).Decode(&req); err != nil {
+				return fmt.Errorf("failed to parse seed menu: %w", err)
+			}
+
+			e, err := bootstrap()
+			if err != nil {
+				return err
+			}
+			defer e.Close()
+
+			menuService := do.MustInvoke[*menu.Service](e.di)
+			hoursService := do.MustInvoke[*hours.Service](e.di)
+
+			existing, err := menuService.GetMenu(e.ctx)
+			if err != nil {
+				return err //nolint:wrapcheck
+			}
+
+			if len(existing) == 0 {
+				if err = menuService.ImportMenu(e.ctx, req.Menus, false); err != nil {
+					return err //nolint:wrapcheck
+				}
+
+				_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Demo menu created")
+			} else {
+				_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Menu already exists, skipping")
+			}
+
+			weekly, _, err := hoursService.GetWeeklyHours(e.ctx)
+			if err != nil {
+				return err //nolint:wrapcheck
+			}
+
+			if len(weekly) == 0 {
+				days := make([]hours.WeeklyHours, 0, 7)
+				for weekday := range 7 {
+					day := seedHours
+					day.Weekday = weekday
+					days = append(days, day)
+				}
+
+				if err = hoursService.SetWeeklyHours(e.ctx, days); err != nil {
+					return err //nolint:wrapcheck
+				}
+
+				_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Opening hours set to 10:00-22:00 daily")
+			} else {
+				_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Opening hours already set, skipping")
+			}
+
+			return nil
+		},
+	}
+}
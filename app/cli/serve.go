@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"shantaram/app/api"
+	"shantaram/app/controller"
+	"shantaram/app/service/announcement"
+	"shantaram/app/service/connection"
+	"shantaram/app/service/loyalty"
+	"shantaram/app/service/printing"
+	"shantaram/app/service/report"
+	"shantaram/app/service/scheduler"
+	"shantaram/pkg/config"
+	"shantaram/pkg/middleware"
+	"shantaram/pkg/migration"
+	"shantaram/pkg/routes"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/samber/do"
+	"github.com/spf13/cobra"
+)
+
+func newServeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "serve",
+		Short: "Run migrations and start the API server",
+		Args:  cobra.NoArgs,
+		RunE:  runServe,
+	}
+}
+
+func runServe(_ *cobra.Command, _ []string) error {
+	e, err := bootstrap()
+	if err != nil {
+		return err
+	}
+	defer e.Close()
+
+	appCtx, di := e.ctx, e.di
+
+	slog.ErrorContext(appCtx, "Service restarted")
+
+	if err = migration.Migrate(appCtx, di); err != nil {
+		return fmt.Errorf("failed to migrate: %w", err)
+	}
+
+	jobScheduler := do.MustInvoke[*scheduler.Service](di)
+	if err = jobScheduler.Register(scheduler.Job{
+		Name:     "header_deadline",
+		Interval: time.Minute,
+		Run:      do.MustInvoke[*announcement.Service](di).ExpireHeader,
+	}); err != nil {
+		return fmt.Errorf("failed to register job: %w", err)
+	}
+
+	reportService := do.MustInvoke[*report.Service](di)
+	if err = jobScheduler.Register(scheduler.Job{
+		Name: "z_report",
+		Cron: reportService.ZReportCron(),
+		Run:  reportService.CloseDays,
+	}); err != nil {
+		return fmt.Errorf("failed to register job: %w", err)
+	}
+
+	if err = jobScheduler.Register(scheduler.Job{
+		Name:     "z_report_delivery",
+		Interval: time.Hour,
+		Run:      reportService.DeliverZReports,
+	}); err != nil {
+		return fmt.Errorf("failed to register job: %w", err)
+	}
+
+	if err = jobScheduler.Register(scheduler.Job{
+		Name:     "loyalty_expire",
+		Interval: time.Hour,
+		Run:      do.MustInvoke[*loyalty.Service](di).ExpirePoints,
+	}); err != nil {
+		return fmt.Errorf("failed to register job: %w", err)
+	}
+
+	go do.MustInvoke[*config.Reloader](di).Run(appCtx)
+	go jobScheduler.Run(appCtx)
+	go do.MustInvoke[*connection.Service](di).RunAdminPresenceAlert(appCtx)
+	go do.MustInvoke[*printing.Service](di).Run(appCtx)
+	go do.MustInvoke[*announcement.Service](di).RunTicker(appCtx)
+
+	wsController := controller.NewWS(di)
+	sseController := controller.NewSSE(di)
+
+	server := controller.NewStrictServer(di)
+	handler := api.NewStrictHandler(server, nil)
+
+	app := fiber.New(fiber.Config{
+		AppName:          "Shantaram API",
+		ErrorHandler:     middleware.ErrorHandler,
+		ProxyHeader:      "X-Forwarded-For",
+		ReadTimeout:      time.Second * 60,
+		WriteTimeout:     time.Second * 60,
+		DisableKeepalive: false,
+	})
+
+	middleware.FiberMiddleware(app, di)
+	routes.StaticRoutes(app)
+	routes.WSRoutes(app, wsController)
+	routes.SSERoutes(app, sseController)
+
+	apiGroup := app.Group("/v1")
+	api.RegisterHandlersWithOptions(apiGroup, handler, api.FiberServerOptions{
+		BaseURL: "",
+		Middlewares: []api.MiddlewareFunc{
+			middleware.NewOpenAPIValidator(),
+		},
+	})
+
+	routes.NotFoundRoute(app)
+
+	go func() {
+		sigint := make(chan os.Signal, 1)
+		signal.Notify(sigint, os.Interrupt)
+		<-sigint
+
+		log.Info("Shutting down server...")
+
+		_ = app.Shutdown()
+		e.cancel()
+	}()
+
+	log.Info("Server started on port 8080")
+	if err := app.Listen(":8080"); err != nil {
+		log.Warnf("Server stopped! Reason: %v", err)
+	}
+
+	log.Info("Waiting for services to finish...")
+	_ = di.Shutdown()
+
+	return nil
+}
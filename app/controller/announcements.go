@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/samber/oops"
+)
+
+func (s *Server) GetAnnouncements(ctx context.Context, request api.GetAnnouncementsRequestObject) (api.GetAnnouncementsResponseObject, error) {
+	target := api.AnnouncementTargetPublic
+
+	if request.Params.Target != nil && *request.Params.Target == api.GetAnnouncementsParamsTargetAdmin {
+		if !s.authService.IsAdmin(ctx) {
+			return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+		}
+
+		target = api.AnnouncementTargetAdmin
+	}
+
+	announcements, err := s.announcementService.GetVisible(ctx, target, request.Params.MenuId)
+	if err != nil {
+		return nil, fmt.Errorf("GetVisible: %w", err)
+	}
+
+	return api.GetAnnouncements200JSONResponse{
+		Data: pie.Map(announcements, mapper.MapAnnouncement),
+	}, nil
+}
+
+func (s *Server) GetAllAnnouncements(ctx context.Context, _ api.GetAllAnnouncementsRequestObject) (api.GetAllAnnouncementsResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	announcements, err := s.announcementService.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetAll: %w", err)
+	}
+
+	return api.GetAllAnnouncements200JSONResponse{
+		Data: pie.Map(announcements, mapper.MapAnnouncement),
+	}, nil
+}
+
+func (s *Server) AddAnnouncement(ctx context.Context, request api.AddAnnouncementRequestObject) (api.AddAnnouncementResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.announcementService.Add(ctx, request.Body); err != nil {
+		return nil, fmt.Errorf("Add: %w", err)
+	}
+
+	return api.AddAnnouncement200Response{}, nil
+}
+
+func (s *Server) EditAnnouncement(ctx context.Context, request api.EditAnnouncementRequestObject) (api.EditAnnouncementResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.announcementService.Edit(ctx, request.AnnouncementId, request.Body); err != nil {
+		return nil, fmt.Errorf("Edit: %w", err)
+	}
+
+	return api.EditAnnouncement200Response{}, nil
+}
+
+func (s *Server) DeleteAnnouncement(ctx context.Context, request api.DeleteAnnouncementRequestObject) (api.DeleteAnnouncementResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.announcementService.Delete(ctx, request.AnnouncementId); err != nil {
+		return nil, fmt.Errorf("Delete: %w", err)
+	}
+
+	return api.DeleteAnnouncement200Response{}, nil
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func (s *Server) Login(ctx context.Context, request api.LoginRequestObject) (api.LoginResponseObject, error) {
-	if !s.limitsService.AllowIpRpm(ctx, "login", 5) {
+	if !s.limitsService.AllowIpRpm(ctx, "login", s.configReloader.Runtime().Limits.LoginPerMinute) {
 		return nil, oops.With("status_code", http.StatusTooManyRequests).New("Too many requests")
 	}
 
@@ -3,12 +3,24 @@ package controller
 import (
 	"context"
 	"shantaram/app/api"
+	"shantaram/app/service/announcement"
 	"shantaram/app/service/auth"
+	"shantaram/app/service/capacity"
+	"shantaram/app/service/connection"
+	"shantaram/app/service/customer"
+	"shantaram/app/service/discount"
+	"shantaram/app/service/export"
+	"shantaram/app/service/hours"
+	"shantaram/app/service/inventory"
+	"shantaram/app/service/kitchen"
 	"shantaram/app/service/limits"
+	"shantaram/app/service/loyalty"
 	"shantaram/app/service/menu"
 	"shantaram/app/service/order"
 	"shantaram/app/service/params"
 	"shantaram/app/service/pubsub"
+	"shantaram/app/service/report"
+	"shantaram/app/service/scheduler"
 	"shantaram/pkg/config"
 	"shantaram/pkg/database"
 
@@ -19,29 +31,55 @@ import (
 var _ api.StrictServerInterface = (*Server)(nil)
 
 type Server struct {
-	appCtx        context.Context
-	cfg           *config.Config
-	dbConn        *pgxpool.Pool
-	queries       *database.Queries
-	authService   *auth.Service
-	limitsService *limits.Service
-	pubsubService *pubsub.Service
-	menuService   *menu.Service
-	orderService  *order.Service
-	paramsService *params.Service
+	appCtx              context.Context
+	cfg                 *config.Config
+	configReloader      *config.Reloader
+	dbConn              *pgxpool.Pool
+	queries             *database.Queries
+	authService         *auth.Service
+	limitsService       *limits.Service
+	pubsubService       *pubsub.Service
+	menuService         *menu.Service
+	orderService        *order.Service
+	paramsService       *params.Service
+	connectionService   *connection.Service
+	kitchenService      *kitchen.Service
+	capacityService     *capacity.Service
+	hoursService        *hours.Service
+	announcementService *announcement.Service
+	schedulerService    *scheduler.Service
+	discountService     *discount.Service
+	inventoryService    *inventory.Service
+	loyaltyService      *loyalty.Service
+	reportService       *report.Service
+	customerService     *customer.Service
+	exportService       *export.Service
 }
 
 func NewStrictServer(di *do.Injector) *Server {
 	return &Server{
-		appCtx:        do.MustInvoke[context.Context](di),
-		cfg:           do.MustInvoke[*config.Config](di),
-		dbConn:        do.MustInvoke[*pgxpool.Pool](di),
-		queries:       do.MustInvoke[*database.Queries](di),
-		authService:   do.MustInvoke[*auth.Service](di),
-		limitsService: do.MustInvoke[*limits.Service](di),
-		pubsubService: do.MustInvoke[*pubsub.Service](di),
-		menuService:   do.MustInvoke[*menu.Service](di),
-		orderService:  do.MustInvoke[*order.Service](di),
-		paramsService: do.MustInvoke[*params.Service](di),
+		appCtx:              do.MustInvoke[context.Context](di),
+		cfg:                 do.MustInvoke[*config.Config](di),
+		configReloader:      do.MustInvoke[*config.Reloader](di),
+		dbConn:              do.MustInvoke[*pgxpool.Pool](di),
+		queries:             do.MustInvoke[*database.Queries](di),
+		authService:         do.MustInvoke[*auth.Service](di),
+		limitsService:       do.MustInvoke[*limits.Service](di),
+		pubsubService:       do.MustInvoke[*pubsub.Service](di),
+		menuService:         do.MustInvoke[*menu.Service](di),
+		orderService:        do.MustInvoke[*order.Service](di),
+		paramsService:       do.MustInvoke[*params.Service](di),
+		connectionService:   do.MustInvoke[*connection.Service](di),
+		kitchenService:      do.MustInvoke[*kitchen.Service](di),
+		capacityService:     do.MustInvoke[*capacity.Service](di),
+		hoursService:        do.MustInvoke[*hours.Service](di),
+		announcementService: do.MustInvoke[*announcement.Service](di),
+		schedulerService:    do.MustInvoke[*scheduler.Service](di),
+		discountService:     do.MustInvoke[*discount.Service](di),
+		inventoryService:    do.MustInvoke[*inventory.Service](di),
+		loyaltyService:      do.MustInvoke[*loyalty.Service](di),
+		reportService:       do.MustInvoke[*report.Service](di),
+		customerService:     do.MustInvoke[*customer.Service](di),
+		exportService:       do.MustInvoke[*export.Service](di),
 	}
 }
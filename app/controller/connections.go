@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/samber/oops"
+)
+
+func (s *Server) GetConnections(ctx context.Context, _ api.GetConnectionsRequestObject) (api.GetConnectionsResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	connections := s.connectionService.List()
+
+	return api.GetConnections200JSONResponse{
+		Data: pie.Map(connections, mapper.MapConnection),
+	}, nil
+}
+
+func (s *Server) KickConnection(ctx context.Context, req api.KickConnectionRequestObject) (api.KickConnectionResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.connectionService.Kick(req.Id); err != nil {
+		return nil, fmt.Errorf("Kick: %w", err)
+	}
+
+	return api.KickConnection200Response{}, nil
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/google/uuid"
+	"github.com/rofleksey/meg"
+	"github.com/samber/oops"
+)
+
+func (s *Server) customerID(ctx context.Context) (uuid.UUID, error) {
+	customerID := s.authService.CustomerID(ctx)
+	if customerID == nil {
+		return uuid.Nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	return *customerID, nil
+}
+
+func (s *Server) RequestCustomerOtp(ctx context.Context, request api.RequestCustomerOtpRequestObject) (api.RequestCustomerOtpResponseObject, error) {
+	if !s.limitsService.AllowIpRpm(ctx, "customer_otp", s.configReloader.Runtime().Limits.OTPPerMinute) {
+		return nil, oops.With("status_code", http.StatusTooManyRequests).New("Too many requests")
+	}
+
+	if err := s.customerService.RequestCode(ctx, request.Body.Phone); err != nil {
+		return nil, fmt.Errorf("RequestCode: %w", err)
+	}
+
+	return api.RequestCustomerOtp200Response{}, nil
+}
+
+func (s *Server) CustomerLogin(ctx context.Context, request api.CustomerLoginRequestObject) (api.CustomerLoginResponseObject, error) {
+	if !s.limitsService.AllowIpRpm(ctx, "customer_login", s.configReloader.Runtime().Limits.LoginPerMinute) {
+		return nil, oops.With("status_code", http.StatusTooManyRequests).New("Too many requests")
+	}
+
+	token, customer, err := s.customerService.Login(ctx, request.Body.Phone, request.Body.Code)
+	if err != nil {
+		return nil, fmt.Errorf("Login: %w", err)
+	}
+
+	return api.CustomerLogin200JSONResponse{
+		Token:    token,
+		Customer: mapper.MapCustomer(customer),
+	}, nil
+}
+
+func (s *Server) GetCustomer(ctx context.Context, _ api.GetCustomerRequestObject) (api.GetCustomerResponseObject, error) {
+	customerID, err := s.customerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := s.customerService.Get(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("Get: %w", err)
+	}
+
+	return api.GetCustomer200JSONResponse(mapper.MapCustomer(customer)), nil
+}
+
+func (s *Server) UpdateCustomer(ctx context.Context, request api.UpdateCustomerRequestObject) (api.UpdateCustomerResponseObject, error) {
+	customerID, err := s.customerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := s.customerService.UpdateName(ctx, customerID, request.Body.Name)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateName: %w", err)
+	}
+
+	return api.UpdateCustomer200JSONResponse(mapper.MapCustomer(customer)), nil
+}
+
+func (s *Server) GetCustomerOrders(ctx context.Context, request api.GetCustomerOrdersRequestObject) (api.GetCustomerOrdersResponseObject, error) {
+	customerID, err := s.customerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, totalCount, nextCursor, err := s.customerService.Orders(ctx, customerID, request.Params)
+	if err != nil {
+		return nil, fmt.Errorf("Orders: %w", err)
+	}
+
+	response := api.GetCustomerOrders200JSONResponse{
+		Data: pie.Map(orders, mapper.MapOrder),
+	}
+	if totalCount != nil {
+		response.TotalCount = meg.ToPtr(int(*totalCount))
+	}
+	if nextCursor != "" {
+		response.NextCursor = &nextCursor
+	}
+
+	return response, nil
+}
+
+func (s *Server) Reorder(ctx context.Context, request api.ReorderRequestObject) (api.ReorderResponseObject, error) {
+	customerID, err := s.customerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.limitsService.AllowIpRpm(ctx, "create_order", s.configReloader.Runtime().Limits.CreateOrderPerMinute) {
+		return nil, oops.With("status_code", http.StatusTooManyRequests).New("Too many requests")
+	}
+
+	order, err := s.customerService.Reorder(ctx, customerID, request.Id, request.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Reorder: %w", err)
+	}
+
+	return api.Reorder200JSONResponse(mapper.MapCreateOrderResponse(order)), nil
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"shantaram/app/api"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+)
+
+var dedupTTL = time.Minute
+
+// messageDeduper drops messages that were already delivered to a single realtime connection
+type messageDeduper struct {
+	idCache *ttlcache.Cache[string, struct{}]
+}
+
+func newMessageDeduper() *messageDeduper {
+	idCache := ttlcache.New[string, struct{}]()
+
+	go idCache.Start()
+
+	return &messageDeduper{
+		idCache: idCache,
+	}
+}
+
+func (d *messageDeduper) Allow(msg api.IdMessage) bool {
+	id := msg.GetId()
+
+	if id != "" && d.idCache.Has(id) {
+		return false
+	}
+
+	d.idCache.Set(id, struct{}{}, dedupTTL)
+
+	return true
+}
+
+func (d *messageDeduper) Stop() {
+	d.idCache.Stop()
+}
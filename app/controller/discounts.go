@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+	"shantaram/pkg/money"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/rofleksey/meg"
+	"github.com/samber/oops"
+)
+
+func (s *Server) QuoteOrder(ctx context.Context, request api.QuoteOrderRequestObject) (api.QuoteOrderResponseObject, error) {
+	items, result, err := s.orderService.Quote(ctx, request.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Quote: %w", err)
+	}
+
+	quote := mapper.MapQuote(items, result)
+
+	if customerID := s.authService.CustomerID(ctx); customerID != nil {
+		balance, err := s.loyaltyService.Balance(ctx, *customerID)
+		if err != nil {
+			return nil, fmt.Errorf("Balance: %w", err)
+		}
+
+		quote.PointsBalance = meg.ToPtr(int(balance))
+	}
+
+	return api.QuoteOrder200JSONResponse(quote), nil
+}
+
+func (s *Server) GetPromoCodes(ctx context.Context, _ api.GetPromoCodesRequestObject) (api.GetPromoCodesResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	codes, err := s.discountService.GetPromoCodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetPromoCodes: %w", err)
+	}
+
+	return api.GetPromoCodes200JSONResponse{
+		Data: pie.Map(codes, mapper.MapPromoCode),
+	}, nil
+}
+
+func (s *Server) AddPromoCode(ctx context.Context, request api.AddPromoCodeRequestObject) (api.AddPromoCodeResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.discountService.AddPromoCode(ctx, request.Body); err != nil {
+		return nil, fmt.Errorf("AddPromoCode: %w", err)
+	}
+
+	return api.AddPromoCode200Response{}, nil
+}
+
+func (s *Server) EditPromoCode(ctx context.Context, request api.EditPromoCodeRequestObject) (api.EditPromoCodeResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.discountService.EditPromoCode(ctx, request.PromoCodeId, request.Body); err != nil {
+		return nil, fmt.Errorf("EditPromoCode: %w", err)
+	}
+
+	return api.EditPromoCode200Response{}, nil
+}
+
+func (s *Server) DeletePromoCode(ctx context.Context, request api.DeletePromoCodeRequestObject) (api.DeletePromoCodeResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.discountService.DeletePromoCode(ctx, request.PromoCodeId); err != nil {
+		return nil, fmt.Errorf("DeletePromoCode: %w", err)
+	}
+
+	return api.DeletePromoCode200Response{}, nil
+}
+
+func (s *Server) GetDiscountRules(ctx context.Context, _ api.GetDiscountRulesRequestObject) (api.GetDiscountRulesResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	rules, err := s.discountService.GetRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetRules: %w", err)
+	}
+
+	return api.GetDiscountRules200JSONResponse{
+		Data: pie.Map(rules, mapper.MapDiscountRule),
+	}, nil
+}
+
+func (s *Server) AddDiscountRule(ctx context.Context, request api.AddDiscountRuleRequestObject) (api.AddDiscountRuleResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.discountService.AddRule(ctx, request.Body); err != nil {
+		return nil, fmt.Errorf("AddRule: %w", err)
+	}
+
+	return api.AddDiscountRule200Response{}, nil
+}
+
+func (s *Server) EditDiscountRule(ctx context.Context, request api.EditDiscountRuleRequestObject) (api.EditDiscountRuleResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.discountService.EditRule(ctx, request.RuleId, request.Body); err != nil {
+		return nil, fmt.Errorf("EditRule: %w", err)
+	}
+
+	return api.EditDiscountRule200Response{}, nil
+}
+
+func (s *Server) DeleteDiscountRule(ctx context.Context, request api.DeleteDiscountRuleRequestObject) (api.DeleteDiscountRuleResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.discountService.DeleteRule(ctx, request.RuleId); err != nil {
+		return nil, fmt.Errorf("DeleteRule: %w", err)
+	}
+
+	return api.DeleteDiscountRule200Response{}, nil
+}
+
+func (s *Server) GetDiscountReport(ctx context.Context, request api.GetDiscountReportRequestObject) (api.GetDiscountReportResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	rows, points, err := s.discountService.GetReport(ctx, request.Params.From, request.Params.To)
+	if err != nil {
+		return nil, fmt.Errorf("GetReport: %w", err)
+	}
+
+	var total money.Kopecks
+	for _, row := range rows {
+		total += money.Kopecks(row.Amount)
+	}
+
+	return api.GetDiscountReport200JSONResponse{
+		Data:   pie.Map(rows, mapper.MapDiscountReportRow),
+		Total:  total,
+		Points: mapper.MapPointsReport(points),
+	}, nil
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/service/export"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/samber/oops"
+)
+
+// exportResponse hands the body to fasthttp as a stream,
+// the generated responses copy the whole body into memory first
+type exportResponse struct {
+	contentType string
+	filename    string
+	body        io.ReadCloser
+}
+
+func (r exportResponse) VisitExportOrdersResponse(ctx *fiber.Ctx) error {
+	ctx.Set(fiber.HeaderContentType, r.contentType)
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, r.filename))
+	ctx.Status(http.StatusOK)
+	ctx.Response().SetBodyStream(r.body, -1)
+
+	return nil
+}
+
+func (s *Server) ExportOrders(ctx context.Context, request api.ExportOrdersRequestObject) (api.ExportOrdersResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	params := request.Params
+	opts := export.Options{
+		Format: api.ExportFormatCsv,
+		Rows:   api.ExportRowsOrder,
+		Filters: api.GetOrdersParams{
+			Cursor:     nil,
+			Limit:      nil,
+			Sort:       nil,
+			Status:     params.Status,
+			Seen:       params.Seen,
+			From:       &params.From,
+			To:         &params.To,
+			TableId:    params.TableId,
+			Q:          params.Q,
+			ProductId:  params.ProductId,
+			MinTotal:   params.MinTotal,
+			MaxTotal:   params.MaxTotal,
+			CustomerId: nil,
+			WithTotal:  nil,
+		},
+	}
+	if params.Format != nil {
+		opts.Format = *params.Format
+	}
+	if params.Rows != nil {
+		opts.Rows = *params.Rows
+	}
+
+	body, err := s.exportService.Stream(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Stream: %w", err)
+	}
+
+	return exportResponse{
+		contentType: export.ContentType(opts.Format),
+		filename: fmt.Sprintf("orders-%s-%s.%s",
+			params.From.In(s.cfg.Location).Format(time.DateOnly),
+			params.To.In(s.cfg.Location).Format(time.DateOnly),
+			opts.Format,
+		),
+		body: body,
+	}, nil
+}
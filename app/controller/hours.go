@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+	"shantaram/app/service/hours"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/samber/oops"
+)
+
+func (s *Server) GetOpeningHours(ctx context.Context, _ api.GetOpeningHoursRequestObject) (api.GetOpeningHoursResponseObject, error) {
+	weekly, exceptions, err := s.hoursService.GetWeeklyHours(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetWeeklyHours: %w", err)
+	}
+
+	return api.GetOpeningHours200JSONResponse{
+		Timezone:   s.cfg.Timezone,
+		Weekly:     pie.Map(weekly, mapper.MapWeeklyHours),
+		Exceptions: pie.Map(exceptions, mapper.MapOpeningException),
+	}, nil
+}
+
+func (s *Server) SetWeeklyHours(ctx context.Context, request api.SetWeeklyHoursRequestObject) (api.SetWeeklyHoursResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	weekly := pie.Map(request.Body.Data, func(h api.WeeklyHours) hours.WeeklyHours {
+		return hours.WeeklyHours{
+			Weekday: h.Weekday,
+			Opens:   h.Opens,
+			Closes:  h.Closes,
+		}
+	})
+
+	if err := s.hoursService.SetWeeklyHours(ctx, weekly); err != nil {
+		return nil, fmt.Errorf("SetWeeklyHours: %w", err)
+	}
+
+	return api.SetWeeklyHours200Response{}, nil
+}
+
+func (s *Server) SaveOpeningException(ctx context.Context, request api.SaveOpeningExceptionRequestObject) (api.SaveOpeningExceptionResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.hoursService.SaveException(ctx, hours.Exception{
+		Day:    request.Body.Day.Time,
+		Opens:  request.Body.Opens,
+		Closes: request.Body.Closes,
+		Title:  request.Body.Title,
+	}); err != nil {
+		return nil, fmt.Errorf("SaveException: %w", err)
+	}
+
+	return api.SaveOpeningException200Response{}, nil
+}
+
+func (s *Server) DeleteOpeningException(ctx context.Context, request api.DeleteOpeningExceptionRequestObject) (api.DeleteOpeningExceptionResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.hoursService.DeleteException(ctx, request.Day.Time); err != nil {
+		return nil, fmt.Errorf("DeleteException: %w", err)
+	}
+
+	return api.DeleteOpeningException200Response{}, nil
+}
@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/samber/oops"
+)
+
+func (s *Server) SetProductStock(ctx context.Context, request api.SetProductStockRequestObject) (api.SetProductStockResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.inventoryService.SetProductStock(ctx, request.ProductId, request.Body); err != nil {
+		return nil, fmt.Errorf("SetProductStock: %w", err)
+	}
+
+	return api.SetProductStock200Response{}, nil
+}
+
+func (s *Server) SetProductRecipe(ctx context.Context, request api.SetProductRecipeRequestObject) (api.SetProductRecipeResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.inventoryService.SetProductRecipe(ctx, request.ProductId, request.Body); err != nil {
+		return nil, fmt.Errorf("SetProductRecipe: %w", err)
+	}
+
+	return api.SetProductRecipe200Response{}, nil
+}
+
+func (s *Server) GetRecipes(ctx context.Context, _ api.GetRecipesRequestObject) (api.GetRecipesResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	recipes, err := s.inventoryService.GetRecipes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetRecipes: %w", err)
+	}
+
+	return api.GetRecipes200JSONResponse{
+		Data: mapper.MapRecipes(recipes),
+	}, nil
+}
+
+func (s *Server) GetIngredients(ctx context.Context, _ api.GetIngredientsRequestObject) (api.GetIngredientsResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	ingredients, err := s.inventoryService.GetIngredients(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetIngredients: %w", err)
+	}
+
+	return api.GetIngredients200JSONResponse{
+		Data: pie.Map(ingredients, mapper.MapIngredient),
+	}, nil
+}
+
+func (s *Server) AddIngredient(ctx context.Context, request api.AddIngredientRequestObject) (api.AddIngredientResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.inventoryService.AddIngredient(ctx, request.Body); err != nil {
+		return nil, fmt.Errorf("AddIngredient: %w", err)
+	}
+
+	return api.AddIngredient200Response{}, nil
+}
+
+func (s *Server) EditIngredient(ctx context.Context, request api.EditIngredientRequestObject) (api.EditIngredientResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.inventoryService.EditIngredient(ctx, request.IngredientId, request.Body); err != nil {
+		return nil, fmt.Errorf("EditIngredient: %w", err)
+	}
+
+	return api.EditIngredient200Response{}, nil
+}
+
+func (s *Server) DeleteIngredient(ctx context.Context, request api.DeleteIngredientRequestObject) (api.DeleteIngredientResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.inventoryService.DeleteIngredient(ctx, request.IngredientId); err != nil {
+		return nil, fmt.Errorf("DeleteIngredient: %w", err)
+	}
+
+	return api.DeleteIngredient200Response{}, nil
+}
@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/samber/oops"
+)
+
+func (s *Server) GetJobs(ctx context.Context, _ api.GetJobsRequestObject) (api.GetJobsResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	jobs, err := s.schedulerService.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("List: %w", err)
+	}
+
+	return api.GetJobs200JSONResponse{
+		Data: pie.Map(jobs, mapper.MapJob),
+	}, nil
+}
+
+func (s *Server) TriggerJob(ctx context.Context, request api.TriggerJobRequestObject) (api.TriggerJobResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	status, err := s.schedulerService.Trigger(ctx, request.Name)
+	if err != nil {
+		return nil, fmt.Errorf("Trigger: %w", err)
+	}
+
+	return api.TriggerJob200JSONResponse(mapper.MapJob(status)), nil
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/samber/oops"
+)
+
+func (s *Server) GetKitchenStations(ctx context.Context, _ api.GetKitchenStationsRequestObject) (api.GetKitchenStationsResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	stations, err := s.kitchenService.GetStations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetStations: %w", err)
+	}
+
+	return api.GetKitchenStations200JSONResponse{
+		Data: pie.Map(stations, mapper.MapKitchenStation),
+	}, nil
+}
+
+func (s *Server) SaveKitchenStation(ctx context.Context, req api.SaveKitchenStationRequestObject) (api.SaveKitchenStationResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.kitchenService.SaveStation(ctx, req.Body); err != nil {
+		return nil, fmt.Errorf("SaveStation: %w", err)
+	}
+
+	return api.SaveKitchenStation200Response{}, nil
+}
+
+func (s *Server) DeleteKitchenStation(ctx context.Context, req api.DeleteKitchenStationRequestObject) (api.DeleteKitchenStationResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.kitchenService.DeleteStation(ctx, req.StationId); err != nil {
+		return nil, fmt.Errorf("DeleteStation: %w", err)
+	}
+
+	return api.DeleteKitchenStation200Response{}, nil
+}
+
+func (s *Server) SetProductGroupStation(ctx context.Context, req api.SetProductGroupStationRequestObject) (api.SetProductGroupStationResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.kitchenService.SetProductGroupStation(ctx, req.ProductGroupId, req.Body.StationId); err != nil {
+		return nil, fmt.Errorf("SetProductGroupStation: %w", err)
+	}
+
+	return api.SetProductGroupStation200Response{}, nil
+}
+
+func (s *Server) GetKitchenQueue(ctx context.Context, req api.GetKitchenQueueRequestObject) (api.GetKitchenQueueResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	tickets, err := s.kitchenService.GetQueue(ctx, req.StationId)
+	if err != nil {
+		return nil, fmt.Errorf("GetQueue: %w", err)
+	}
+
+	return api.GetKitchenQueue200JSONResponse{
+		Data: pie.Map(tickets, mapper.MapKitchenTicket),
+	}, nil
+}
+
+func (s *Server) BumpKitchenTicket(ctx context.Context, req api.BumpKitchenTicketRequestObject) (api.BumpKitchenTicketResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.kitchenService.Bump(ctx, req.TicketId); err != nil {
+		return nil, fmt.Errorf("Bump: %w", err)
+	}
+
+	return api.BumpKitchenTicket200Response{}, nil
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+
+	"github.com/samber/oops"
+)
+
+func (s *Server) GetCustomerLoyalty(ctx context.Context, _ api.GetCustomerLoyaltyRequestObject) (api.GetCustomerLoyaltyResponseObject, error) {
+	customerID, err := s.customerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.loyaltyService.Account(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("Account: %w", err)
+	}
+
+	return api.GetCustomerLoyalty200JSONResponse(mapper.MapLoyaltyAccount(account)), nil
+}
+
+func (s *Server) GetCustomerLoyaltyById(ctx context.Context, request api.GetCustomerLoyaltyByIdRequestObject) (api.GetCustomerLoyaltyByIdResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	account, err := s.loyaltyService.Account(ctx, request.Id)
+	if err != nil {
+		return nil, fmt.Errorf("Account: %w", err)
+	}
+
+	return api.GetCustomerLoyaltyById200JSONResponse(mapper.MapLoyaltyAccount(account)), nil
+}
+
+func (s *Server) AdjustCustomerLoyalty(ctx context.Context, request api.AdjustCustomerLoyaltyRequestObject) (api.AdjustCustomerLoyaltyResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	account, err := s.loyaltyService.Adjust(ctx, request.Id, request.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Adjust: %w", err)
+	}
+
+	return api.AdjustCustomerLoyalty200JSONResponse(mapper.MapLoyaltyAccount(account)), nil
+}
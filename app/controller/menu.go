@@ -67,6 +67,18 @@ func (s *Server) EditProduct(ctx context.Context, req api.EditProductRequestObje
 	return api.EditProduct200Response{}, nil
 }
 
+func (s *Server) SetComboSlots(ctx context.Context, req api.SetComboSlotsRequestObject) (api.SetComboSlotsResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.menuService.SetComboSlots(ctx, req.ProductId, req.Body); err != nil {
+		return nil, err
+	}
+
+	return api.SetComboSlots200Response{}, nil
+}
+
 func (s *Server) DeleteProductGroup(ctx context.Context, req api.DeleteProductGroupRequestObject) (api.DeleteProductGroupResponseObject, error) {
 	if !s.authService.IsAdmin(ctx) {
 		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
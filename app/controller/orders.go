@@ -8,19 +8,21 @@ import (
 	"shantaram/app/mapper"
 
 	"github.com/elliotchance/pie/v2"
+	"github.com/rofleksey/meg"
 	"github.com/samber/oops"
 )
 
 func (s *Server) CreateOrder(ctx context.Context, req api.CreateOrderRequestObject) (api.CreateOrderResponseObject, error) {
-	if !s.limitsService.AllowIpRpm(ctx, "create_order", 5) {
+	if !s.limitsService.AllowIpRpm(ctx, "create_order", s.configReloader.Runtime().Limits.CreateOrderPerMinute) {
 		return nil, oops.With("status_code", http.StatusTooManyRequests).New("Too many requests")
 	}
 
-	if err := s.orderService.CreateOrder(ctx, req.Body); err != nil {
+	order, err := s.orderService.CreateOrder(ctx, req.Body)
+	if err != nil {
 		return nil, fmt.Errorf("CreateOrder: %w", err)
 	}
 
-	return api.CreateOrder200Response{}, nil
+	return api.CreateOrder200JSONResponse(mapper.MapCreateOrderResponse(order)), nil
 }
 
 func (s *Server) SetOrderStatus(ctx context.Context, request api.SetOrderStatusRequestObject) (api.SetOrderStatusResponseObject, error) {
@@ -61,25 +63,22 @@ func (s *Server) GetOrders(ctx context.Context, req api.GetOrdersRequestObject)
 		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
 	}
 
-	offset := 0
-	limit := 10
+	orders, totalCount, nextCursor, err := s.orderService.SearchOrders(ctx, req.Params)
+	if err != nil {
+		return nil, fmt.Errorf("SearchOrders: %w", err)
+	}
 
-	if req.Params.Offset != nil {
-		offset = max(*req.Params.Offset, 0)
+	response := api.GetOrders200JSONResponse{
+		Data: pie.Map(orders, mapper.MapOrder),
 	}
-	if req.Params.Limit != nil {
-		limit = min(max(*req.Params.Limit, 0), 100)
+	if totalCount != nil {
+		response.TotalCount = meg.ToPtr(int(*totalCount))
 	}
-
-	orders, totalCount, err := s.orderService.GetOrdersPaginated(ctx, offset, limit)
-	if err != nil {
-		return nil, fmt.Errorf("GetOrdersPaginated: %w", err)
+	if nextCursor != "" {
+		response.NextCursor = &nextCursor
 	}
 
-	return api.GetOrders200JSONResponse{
-		Data:       pie.Map(orders, mapper.MapOrder),
-		TotalCount: int(totalCount),
-	}, nil
+	return response, nil
 }
 
 func (s *Server) MarkOrderSeen(ctx context.Context, req api.MarkOrderSeenRequestObject) (api.MarkOrderSeenResponseObject, error) {
@@ -93,3 +92,15 @@ func (s *Server) MarkOrderSeen(ctx context.Context, req api.MarkOrderSeenRequest
 
 	return api.MarkOrderSeen200Response{}, nil
 }
+
+func (s *Server) PrintOrder(ctx context.Context, req api.PrintOrderRequestObject) (api.PrintOrderResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.orderService.PrintOrder(ctx, req.Id); err != nil {
+		return nil, fmt.Errorf("PrintOrder: %w", err)
+	}
+
+	return api.PrintOrder200Response{}, nil
+}
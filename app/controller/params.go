@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"shantaram/app/api"
 	"shantaram/app/mapper"
+	"time"
 
 	"github.com/samber/oops"
 )
@@ -15,7 +16,7 @@ func (s *Server) SetHeaderText(ctx context.Context, request api.SetHeaderTextReq
 		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
 	}
 
-	if err := s.paramsService.SetHeaderText(ctx, request.Body.Text, request.Body.Deadline); err != nil {
+	if err := s.announcementService.SetHeader(ctx, request.Body.Text, request.Body.Deadline); err != nil {
 		return nil, fmt.Errorf("SetHeaderText: %w", err)
 	}
 
@@ -28,5 +29,93 @@ func (s *Server) GetParams(ctx context.Context, _ api.GetParamsRequestObject) (a
 		return nil, fmt.Errorf("GetParams: %w", err)
 	}
 
-	return api.GetParams200JSONResponse(mapper.MapParams(params)), nil
+	header, err := s.announcementService.GetHeader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetHeader: %w", err)
+	}
+
+	schedule, err := s.hoursService.GetSchedule(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetSchedule: %w", err)
+	}
+
+	return api.GetParams200JSONResponse(mapper.MapParams(params, header, schedule, time.Now())), nil
+}
+
+func (s *Server) SetOrderingPaused(ctx context.Context, request api.SetOrderingPausedRequestObject) (api.SetOrderingPausedResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.paramsService.SetOrderingPaused(ctx, request.Body.Paused); err != nil {
+		return nil, fmt.Errorf("SetOrderingPaused: %w", err)
+	}
+
+	return api.SetOrderingPaused200Response{}, nil
+}
+
+func (s *Server) SetCapacity(ctx context.Context, request api.SetCapacityRequestObject) (api.SetCapacityResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.paramsService.SetCapacity(ctx, request.Body.SlotMinutes, request.Body.MaxOrders, request.Body.MaxItems); err != nil {
+		return nil, fmt.Errorf("SetCapacity: %w", err)
+	}
+
+	return api.SetCapacity200Response{}, nil
+}
+
+func (s *Server) SetClosedUntil(ctx context.Context, request api.SetClosedUntilRequestObject) (api.SetClosedUntilResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.hoursService.SetClosedUntil(ctx, request.Body.Until, request.Body.Reason); err != nil {
+		return nil, fmt.Errorf("SetClosedUntil: %w", err)
+	}
+
+	return api.SetClosedUntil200Response{}, nil
+}
+
+func (s *Server) GetOrderPolicy(ctx context.Context, _ api.GetOrderPolicyRequestObject) (api.GetOrderPolicyResponseObject, error) {
+	params, err := s.paramsService.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetParams: %w", err)
+	}
+
+	return api.GetOrderPolicy200JSONResponse(mapper.MapOrderPolicy(params)), nil
+}
+
+func (s *Server) SetOrderPolicy(ctx context.Context, request api.SetOrderPolicyRequestObject) (api.SetOrderPolicyResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.paramsService.SetOrderPolicy(ctx, request.Body); err != nil {
+		return nil, fmt.Errorf("SetOrderPolicy: %w", err)
+	}
+
+	return api.SetOrderPolicy200Response{}, nil
+}
+
+func (s *Server) GetLoyaltyPolicy(ctx context.Context, _ api.GetLoyaltyPolicyRequestObject) (api.GetLoyaltyPolicyResponseObject, error) {
+	params, err := s.paramsService.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetParams: %w", err)
+	}
+
+	return api.GetLoyaltyPolicy200JSONResponse(mapper.MapLoyaltyPolicy(params)), nil
+}
+
+func (s *Server) SetLoyaltyPolicy(ctx context.Context, request api.SetLoyaltyPolicyRequestObject) (api.SetLoyaltyPolicyResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	if err := s.paramsService.SetLoyaltyPolicy(ctx, request.Body); err != nil {
+		return nil, fmt.Errorf("SetLoyaltyPolicy: %w", err)
+	}
+
+	return api.SetLoyaltyPolicy200Response{}, nil
 }
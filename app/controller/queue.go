@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"shantaram/app/api"
+	"sync"
+)
+
+var (
+	slowConsumerPolicyDrop       = "drop"
+	slowConsumerPolicyDisconnect = "disconnect"
+)
+
+// outboundQueue is a bounded per-connection message queue.
+// Push never blocks the publisher: when the queue is full the message is either dropped
+// or the queue is closed, depending on the slow consumer policy.
+// The message channel itself is never closed, so a late Push after Close is a no-op instead of a panic.
+type outboundQueue struct {
+	messages  chan api.IdMessage
+	done      chan struct{}
+	closeOnce sync.Once
+
+	policy     string
+	onDrop     func()
+	onOverflow func()
+}
+
+func newOutboundQueue(size int, policy string, onDrop, onOverflow func()) *outboundQueue {
+	return &outboundQueue{
+		messages:   make(chan api.IdMessage, size),
+		done:       make(chan struct{}),
+		closeOnce:  sync.Once{},
+		policy:     policy,
+		onDrop:     onDrop,
+		onOverflow: onOverflow,
+	}
+}
+
+// Push enqueues the message, returns false if it was not enqueued
+func (q *outboundQueue) Push(msg api.IdMessage) bool {
+	select {
+	case <-q.done:
+		return false
+	default:
+	}
+
+	select {
+	case q.messages <- msg:
+		return true
+	default:
+	}
+
+	if q.policy == slowConsumerPolicyDisconnect {
+		q.Close()
+		q.onOverflow()
+	} else {
+		q.onDrop()
+	}
+
+	return false
+}
+
+func (q *outboundQueue) Messages() <-chan api.IdMessage {
+	return q.messages
+}
+
+func (q *outboundQueue) Done() <-chan struct{} {
+	return q.done
+}
+
+func (q *outboundQueue) Close() {
+	q.closeOnce.Do(func() {
+		close(q.done)
+	})
+}
@@ -0,0 +1,299 @@
+package controller
+
+import (
+	"errors"
+	"shantaram/app/api"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testMessage(id int) api.IdMessage {
+	return &api.WsMessage{
+		Id:    strconv.Itoa(id),
+		Event: "test",
+	}
+}
+
+func isDone(q *outboundQueue) bool {
+	select {
+	case <-q.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+func TestOutboundQueueDropPolicy(t *testing.T) {
+	var dropped, overflows atomic.Int32
+
+	q := newOutboundQueue(2, slowConsumerPolicyDrop,
+		func() { dropped.Add(1) },
+		func() { overflows.Add(1) },
+	)
+
+	for i := range 5 {
+		ok := q.Push(testMessage(i))
+		if want := i < 2; ok != want {
+			t.Fatalf("Push %d = %v, want %v", i, ok, want)
+		}
+	}
+
+	if dropped.Load() != 3 || overflows.Load() != 0 {
+		t.Fatalf("dropped = %d, overflows = %d, want 3 and 0", dropped.Load(), overflows.Load())
+	}
+
+	if isDone(q) {
+		t.Fatal("queue closed under the drop policy")
+	}
+
+	// the consumer catches up and the queue accepts messages again
+	<-q.Messages()
+
+	if !q.Push(testMessage(5)) {
+		t.Fatal("Push after the consumer caught up was rejected")
+	}
+}
+
+func TestOutboundQueueDisconnectPolicy(t *testing.T) {
+	var dropped, overflows atomic.Int32
+
+	q := newOutboundQueue(2, slowConsumerPolicyDisconnect,
+		func() { dropped.Add(1) },
+		func() { overflows.Add(1) },
+	)
+
+	for i := range 2 {
+		if !q.Push(testMessage(i)) {
+			t.Fatalf("Push %d was rejected", i)
+		}
+	}
+
+	if q.Push(testMessage(2)) {
+		t.Fatal("Push into a full queue succeeded")
+	}
+
+	if !isDone(q) {
+		t.Fatal("queue is not closed after overflow")
+	}
+
+	// later pushes are ignored without reporting the overflow again
+	<-q.Messages()
+
+	if q.Push(testMessage(3)) {
+		t.Fatal("Push after disconnect succeeded")
+	}
+
+	if dropped.Load() != 0 || overflows.Load() != 1 {
+		t.Fatalf("dropped = %d, overflows = %d, want 0 and 1", dropped.Load(), overflows.Load())
+	}
+}
+
+func TestOutboundQueueSlowConsumer(t *testing.T) {
+	for _, policy := range []string{slowConsumerPolicyDrop, slowConsumerPolicyDisconnect} {
+		t.Run(policy, func(t *testing.T) {
+			const total = 500
+
+			var dropped, overflows, pushed atomic.Int32
+
+			q := newOutboundQueue(8, policy,
+				func() { dropped.Add(1) },
+				func() { overflows.Add(1) },
+			)
+
+			received := make(chan int)
+
+			go func() {
+				count := 0
+
+				defer func() { received <- count }()
+
+				for {
+					select {
+					case <-q.Done():
+						return
+					case <-q.Messages():
+						count++
+						time.Sleep(100 * time.Microsecond)
+					}
+				}
+			}()
+
+			// the publisher must never block on the slow consumer
+			start := time.Now()
+
+			for i := range total {
+				if q.Push(testMessage(i)) {
+					pushed.Add(1)
+				}
+			}
+
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Fatalf("publishing took %s", elapsed)
+			}
+
+			// wait until the consumer drains what was accepted
+			deadline := time.Now().Add(5 * time.Second)
+			for len(q.messages) > 0 && !isDone(q) && time.Now().Before(deadline) {
+				time.Sleep(time.Millisecond)
+			}
+
+			q.Close()
+			count := <-received
+
+			switch policy {
+			case slowConsumerPolicyDrop:
+				if int(pushed.Load())+int(dropped.Load()) != total {
+					t.Fatalf("pushed %d + dropped %d != %d", pushed.Load(), dropped.Load(), total)
+				}
+
+				if dropped.Load() == 0 {
+					t.Fatal("nothing was dropped for a slow consumer")
+				}
+
+				if count != int(pushed.Load()) {
+					t.Fatalf("received %d, want %d", count, pushed.Load())
+				}
+			case slowConsumerPolicyDisconnect:
+				if overflows.Load() != 1 {
+					t.Fatalf("overflows = %d, want 1", overflows.Load())
+				}
+
+				if count > int(pushed.Load()) {
+					t.Fatalf("received %d, more than pushed %d", count, pushed.Load())
+				}
+			}
+		})
+	}
+}
+
+func TestOutboundQueueCloseRacesPush(t *testing.T) {
+	for _, policy := range []string{slowConsumerPolicyDrop, slowConsumerPolicyDisconnect} {
+		t.Run(policy, func(t *testing.T) {
+			for range 50 {
+				q := newOutboundQueue(4, policy, func() {}, func() {})
+
+				var wg sync.WaitGroup
+
+				start := make(chan struct{})
+
+				for p := range 8 {
+					wg.Add(1)
+
+					go func() {
+						defer wg.Done()
+						<-start
+
+						for i := range 100 {
+							q.Push(testMessage(p*100 + i))
+						}
+					}()
+				}
+
+				for range 2 {
+					wg.Add(1)
+
+					go func() {
+						defer wg.Done()
+						<-start
+						q.Close()
+					}()
+				}
+
+				close(start)
+				wg.Wait()
+
+				if q.Push(testMessage(-1)) {
+					t.Fatal("Push after Close succeeded")
+				}
+			}
+		})
+	}
+}
+
+// fakeWSConn fails writes after a number of successful ones, like a client that went away
+type fakeWSConn struct {
+	mu        sync.Mutex
+	failAfter int
+	written   []api.IdMessage
+	closed    bool
+}
+
+func (f *fakeWSConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+func (f *fakeWSConn) WriteJSON(v any) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed || len(f.written) >= f.failAfter {
+		return errors.New("broken pipe")
+	}
+
+	msg, _ := v.(api.IdMessage)
+	f.written = append(f.written, msg)
+
+	return nil
+}
+
+func (f *fakeWSConn) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.closed = true
+
+	return nil
+}
+
+func TestWriteLoopClientGoneMidWrite(t *testing.T) {
+	conn := &fakeWSConn{failAfter: 2}
+	q := newOutboundQueue(16, slowConsumerPolicyDrop, func() {}, func() {})
+
+	done := make(chan struct{})
+
+	go func() {
+		(&WS{}).writeLoop(conn, q)
+		close(done)
+	}()
+
+	// publishers keep pushing while the writer fails
+	var wg sync.WaitGroup
+
+	for p := range 4 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range 50 {
+				q.Push(testMessage(p*50 + i))
+			}
+		}()
+	}
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("write loop did not stop after a failed write")
+	}
+
+	wg.Wait()
+
+	if !isDone(q) {
+		t.Fatal("queue is not closed after a failed write")
+	}
+
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+
+	if !conn.closed {
+		t.Fatal("connection is not closed after a failed write")
+	}
+
+	if len(conn.written) != 2 {
+		t.Fatalf("written %d messages, want 2", len(conn.written))
+	}
+}
@@ -0,0 +1,198 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/samber/oops"
+)
+
+func wantsCSV(format *api.ReportFormat) bool {
+	return format != nil && *format == api.ReportFormatCsv
+}
+
+func csvBody(records [][]string) (*bytes.Reader, error) {
+	data, err := mapper.EncodeCSV(records)
+	if err != nil {
+		return nil, fmt.Errorf("EncodeCSV: %w", err)
+	}
+
+	return bytes.NewReader(data), nil
+}
+
+func (s *Server) GetSalesReport(ctx context.Context, request api.GetSalesReportRequestObject) (api.GetSalesReportResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	rows, err := s.reportService.Sales(ctx, request.Params)
+	if err != nil {
+		return nil, fmt.Errorf("Sales: %w", err)
+	}
+
+	if wantsCSV(request.Params.Format) {
+		body, err := csvBody(mapper.SalesReportCSV(rows))
+		if err != nil {
+			return nil, err
+		}
+
+		return api.GetSalesReport200TextcsvResponse{Body: body, ContentLength: body.Size()}, nil
+	}
+
+	return api.GetSalesReport200JSONResponse{Data: rows}, nil
+}
+
+func (s *Server) GetTopProductsReport(ctx context.Context, request api.GetTopProductsReportRequestObject) (api.GetTopProductsReportResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	rows, err := s.reportService.TopProducts(ctx, request.Params)
+	if err != nil {
+		return nil, fmt.Errorf("TopProducts: %w", err)
+	}
+
+	if wantsCSV(request.Params.Format) {
+		body, err := csvBody(mapper.TopProductsReportCSV(rows))
+		if err != nil {
+			return nil, err
+		}
+
+		return api.GetTopProductsReport200TextcsvResponse{Body: body, ContentLength: body.Size()}, nil
+	}
+
+	return api.GetTopProductsReport200JSONResponse{Data: rows}, nil
+}
+
+func (s *Server) GetTopGroupsReport(ctx context.Context, request api.GetTopGroupsReportRequestObject) (api.GetTopGroupsReportResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	rows, err := s.reportService.TopGroups(ctx, request.Params)
+	if err != nil {
+		return nil, fmt.Errorf("TopGroups: %w", err)
+	}
+
+	if wantsCSV(request.Params.Format) {
+		body, err := csvBody(mapper.TopGroupsReportCSV(rows))
+		if err != nil {
+			return nil, err
+		}
+
+		return api.GetTopGroupsReport200TextcsvResponse{Body: body, ContentLength: body.Size()}, nil
+	}
+
+	return api.GetTopGroupsReport200JSONResponse{Data: rows}, nil
+}
+
+func (s *Server) GetCancellationReport(ctx context.Context, request api.GetCancellationReportRequestObject) (api.GetCancellationReportResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	rows, err := s.reportService.Cancellations(ctx, request.Params)
+	if err != nil {
+		return nil, fmt.Errorf("Cancellations: %w", err)
+	}
+
+	if wantsCSV(request.Params.Format) {
+		body, err := csvBody(mapper.CancellationReportCSV(rows))
+		if err != nil {
+			return nil, err
+		}
+
+		return api.GetCancellationReport200TextcsvResponse{Body: body, ContentLength: body.Size()}, nil
+	}
+
+	return api.GetCancellationReport200JSONResponse{Data: rows}, nil
+}
+
+func (s *Server) GetHeatmapReport(ctx context.Context, request api.GetHeatmapReportRequestObject) (api.GetHeatmapReportResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	rows, err := s.reportService.Heatmap(ctx, request.Params)
+	if err != nil {
+		return nil, fmt.Errorf("Heatmap: %w", err)
+	}
+
+	if wantsCSV(request.Params.Format) {
+		body, err := csvBody(mapper.HeatmapReportCSV(rows))
+		if err != nil {
+			return nil, err
+		}
+
+		return api.GetHeatmapReport200TextcsvResponse{Body: body, ContentLength: body.Size()}, nil
+	}
+
+	return api.GetHeatmapReport200JSONResponse{Data: rows}, nil
+}
+
+func (s *Server) GetStatusDurationReport(ctx context.Context, request api.GetStatusDurationReportRequestObject) (api.GetStatusDurationReportResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	rows, err := s.reportService.StatusDurations(ctx, request.Params)
+	if err != nil {
+		return nil, fmt.Errorf("StatusDurations: %w", err)
+	}
+
+	if wantsCSV(request.Params.Format) {
+		body, err := csvBody(mapper.StatusDurationReportCSV(rows))
+		if err != nil {
+			return nil, err
+		}
+
+		return api.GetStatusDurationReport200TextcsvResponse{Body: body, ContentLength: body.Size()}, nil
+	}
+
+	return api.GetStatusDurationReport200JSONResponse{Data: rows}, nil
+}
+
+func (s *Server) GetZReports(ctx context.Context, request api.GetZReportsRequestObject) (api.GetZReportsResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	limit := 30
+	if request.Params.Limit != nil {
+		limit = min(max(*request.Params.Limit, 1), 366)
+	}
+
+	reports, err := s.reportService.GetZReports(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("GetZReports: %w", err)
+	}
+
+	return api.GetZReports200JSONResponse{Data: pie.Map(reports, mapper.MapZReport)}, nil
+}
+
+func (s *Server) GetZReport(ctx context.Context, request api.GetZReportRequestObject) (api.GetZReportResponseObject, error) {
+	if !s.authService.IsAdmin(ctx) {
+		return nil, oops.With("status_code", http.StatusUnauthorized).Errorf("Unauthorized")
+	}
+
+	report, err := s.reportService.GetZReport(ctx, request.Day.Time)
+	if err != nil {
+		return nil, fmt.Errorf("GetZReport: %w", err)
+	}
+
+	if wantsCSV(request.Params.Format) {
+		body, err := csvBody(mapper.ZReportCSV(report, s.cfg.Location))
+		if err != nil {
+			return nil, err
+		}
+
+		return api.GetZReport200TextcsvResponse{Body: body, ContentLength: body.Size()}, nil
+	}
+
+	return api.GetZReport200JSONResponse(mapper.MapZReport(report)), nil
+}
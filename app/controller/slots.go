@@ -0,0 +1,23 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+
+	"github.com/elliotchance/pie/v2"
+)
+
+func (s *Server) GetSlots(ctx context.Context, request api.GetSlotsRequestObject) (api.GetSlotsResponseObject, error) {
+	params, slots, err := s.capacityService.GetSlots(ctx, request.Params.From, request.Params.To)
+	if err != nil {
+		return nil, fmt.Errorf("GetSlots: %w", err)
+	}
+
+	return api.GetSlots200JSONResponse{
+		SlotMinutes:    int(params.SlotMinutes),
+		OrderingPaused: params.OrderingPaused,
+		Data:           pie.Map(slots, mapper.MapSlot),
+	}, nil
+}
@@ -0,0 +1,192 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"shantaram/app/api"
+	"shantaram/app/service/auth"
+	"shantaram/app/service/connection"
+	"shantaram/app/service/pubsub"
+	"shantaram/pkg/config"
+	"shantaram/pkg/telemetry"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/samber/do"
+	simplepubsub "github.com/simonfxr/pubsub"
+)
+
+var sseHeartbeatInterval = 15 * time.Second
+
+// sseMaxDuration keeps a single stream below the server WriteTimeout,
+// clients reconnect and resume using Last-Event-ID
+var sseMaxDuration = 50 * time.Second
+
+var sseRetry = time.Second
+
+type SSE struct {
+	appCtx            context.Context
+	cfg               *config.Config
+	metrics           *telemetry.Metrics
+	authService       *auth.Service
+	pubSubService     *pubsub.Service
+	connectionService *connection.Service
+}
+
+func NewSSE(di *do.Injector) *SSE {
+	return &SSE{
+		appCtx:            do.MustInvoke[context.Context](di),
+		cfg:               do.MustInvoke[*config.Config](di),
+		metrics:           do.MustInvoke[*telemetry.Metrics](di),
+		authService:       do.MustInvoke[*auth.Service](di),
+		pubSubService:     do.MustInvoke[*pubsub.Service](di),
+		connectionService: do.MustInvoke[*connection.Service](di),
+	}
+}
+
+func (c *SSE) writeMessage(w *bufio.Writer, msg api.IdMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	if id := msg.GetId(); id != "" {
+		_, _ = fmt.Fprintf(w, "id: %s\n", id)
+	}
+
+	_, _ = fmt.Fprintf(w, "data: %s\n\n", data)
+
+	return w.Flush() //nolint:wrapcheck
+}
+
+func (c *SSE) handleInternal(w *bufio.Writer, info connection.Connection, lastEventID string) {
+	queue := newOutboundQueue(c.cfg.WS.QueueSize, c.cfg.WS.SlowConsumerPolicy,
+		func() {
+			c.metrics.RealtimeMessageDropped(c.appCtx, "sse")
+		},
+		func() {
+			c.metrics.RealtimeSlowConsumerDisconnected(c.appCtx, "sse")
+			slog.Warn("Disconnecting slow SSE consumer",
+				slog.String("ip", info.IP),
+			)
+		},
+	)
+
+	connID := c.connectionService.Register(info, queue.Close)
+	defer c.connectionService.Unregister(connID)
+
+	channels := info.Channels
+
+	subs := make([]*simplepubsub.Subscription, 0, len(channels))
+	for _, channel := range channels {
+		subs = append(subs, c.pubSubService.SubscribeMessages(channel, func(idMsg api.IdMessage) {
+			queue.Push(idMsg)
+		}))
+	}
+
+	// stop publishers before the queue is closed
+	defer func() {
+		for _, sub := range subs {
+			c.pubSubService.Unsubscribe(sub)
+		}
+
+		queue.Close()
+	}()
+
+	deduper := newMessageDeduper()
+	defer deduper.Stop()
+
+	_, _ = fmt.Fprintf(w, "retry: %d\n\n", sseRetry.Milliseconds())
+	if err := w.Flush(); err != nil {
+		return
+	}
+
+	if lastEventID != "" {
+		missed, ok := c.pubSubService.Since(channels, lastEventID)
+		if !ok {
+			slog.Debug("SSE resume point is no longer in history",
+				slog.String("last_event_id", lastEventID),
+			)
+		}
+
+		for _, msg := range missed {
+			if !deduper.Allow(msg) {
+				continue
+			}
+
+			if err := c.writeMessage(w, msg); err != nil {
+				return
+			}
+		}
+	}
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	deadline := time.NewTimer(sseMaxDuration)
+	defer deadline.Stop()
+
+	for {
+		select {
+		case <-c.appCtx.Done():
+			return
+		case <-deadline.C:
+			return
+		case <-queue.Done():
+			return
+		case <-heartbeat.C:
+			_, _ = fmt.Fprint(w, ": ping\n\n")
+			if err := w.Flush(); err != nil {
+				return
+			}
+
+			c.connectionService.Touch(connID)
+		case msg := <-queue.Messages():
+			if !deduper.Allow(msg) {
+				continue
+			}
+
+			if err := c.writeMessage(w, msg); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *SSE) Handle(ctx *fiber.Ctx) error {
+	channels := []string{"public"}
+	var user string
+
+	if c.authService.IsAdmin(ctx.UserContext()) {
+		channels = append(channels, "admin")
+		user = c.authService.Username(ctx.UserContext())
+	}
+
+	info := connection.Connection{
+		Transport: "sse",
+		Channels:  channels,
+		User:      user,
+		IP:        ctx.IP(),
+		UserAgent: ctx.Get(fiber.HeaderUserAgent),
+	}
+
+	lastEventID := ctx.Get("Last-Event-ID")
+	if lastEventID == "" {
+		// EventSource polyfills that can't set headers pass it as a query param
+		lastEventID = ctx.Query("lastEventId")
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		c.handleInternal(w, info, lastEventID)
+	})
+
+	return nil
+}
@@ -6,76 +6,76 @@ import (
 	"log/slog"
 	"shantaram/app/api"
 	"shantaram/app/service/auth"
+	"shantaram/app/service/connection"
 	"shantaram/app/service/pubsub"
 	"shantaram/pkg/config"
+	"shantaram/pkg/telemetry"
+	"sync"
 	"time"
 
 	"github.com/gofiber/contrib/websocket"
-	"github.com/jellydator/ttlcache/v3"
+	"github.com/google/uuid"
 	"github.com/samber/do"
+	simplepubsub "github.com/simonfxr/pubsub"
 )
 
 var pingMsg = []byte("ping")
 
+var wsWriteWait = 10 * time.Second
+
 type WS struct {
-	cfg           *config.Config
-	authService   *auth.Service
-	pubSubService *pubsub.Service
+	appCtx            context.Context
+	cfg               *config.Config
+	metrics           *telemetry.Metrics
+	authService       *auth.Service
+	pubSubService     *pubsub.Service
+	connectionService *connection.Service
 }
 
 func NewWS(di *do.Injector) *WS {
 	return &WS{
-		cfg:           do.MustInvoke[*config.Config](di),
-		authService:   do.MustInvoke[*auth.Service](di),
-		pubSubService: do.MustInvoke[*pubsub.Service](di),
+		appCtx:            do.MustInvoke[context.Context](di),
+		cfg:               do.MustInvoke[*config.Config](di),
+		metrics:           do.MustInvoke[*telemetry.Metrics](di),
+		authService:       do.MustInvoke[*auth.Service](di),
+		pubSubService:     do.MustInvoke[*pubsub.Service](di),
+		connectionService: do.MustInvoke[*connection.Service](di),
 	}
 }
 
-func (c *WS) handleInternal(conn *websocket.Conn, channels []string) {
-	writeChan := make(chan api.IdMessage, 16)
-	defer close(writeChan)
-
-	for _, channel := range channels {
-		sub := c.pubSubService.Subscribe(channel, func(data any) {
-			defer func() {
-				if err := recover(); err != nil {
-					slog.Warn("Panic in subscription handler", slog.Any("error", err))
-				}
-			}()
-
-			idMsg, ok := data.(api.IdMessage)
-			if !ok {
-				slog.LogAttrs(context.Background(), slog.LevelError, "Failed to cast pubsub message to IdMessage",
-					slog.Any("data", data),
-				)
-				return
-			}
-
-			writeChan <- idMsg
-		})
-		defer c.pubSubService.Unsubscribe(sub) // it's ok to defer there
-	}
-
-	go func() {
-		idCache := ttlcache.New[string, struct{}]()
-
-		go idCache.Start()
-		defer idCache.Stop()
+// wsWriter is the part of the connection the write loop needs
+type wsWriter interface {
+	SetWriteDeadline(t time.Time) error
+	WriteJSON(v any) error
+	Close() error
+}
 
-		for data := range writeChan {
-			id := data.GetId()
+func (c *WS) writeLoop(conn wsWriter, queue *outboundQueue) {
+	deduper := newMessageDeduper()
+	defer deduper.Stop()
 
-			if id != "" && idCache.Has(data.GetId()) {
+	for {
+		select {
+		case <-queue.Done():
+			return
+		case data := <-queue.Messages():
+			if !deduper.Allow(data) {
 				continue
 			}
 
-			idCache.Set(data.GetId(), struct{}{}, time.Minute)
+			_ = conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteJSON(data); err != nil {
+				// unblock the read loop, the client is gone or too slow
+				queue.Close()
+				_ = conn.Close()
 
-			_ = conn.SetWriteDeadline(time.Now().Add(1 * time.Minute))
-			_ = conn.WriteJSON(data)
+				return
+			}
 		}
-	}()
+	}
+}
 
+func (c *WS) readLoop(conn *websocket.Conn, connID uuid.UUID, queue *outboundQueue) {
 	for {
 		_ = conn.SetReadDeadline(time.Now().Add(1 * time.Minute))
 
@@ -85,19 +85,75 @@ func (c *WS) handleInternal(conn *websocket.Conn, channels []string) {
 		}
 
 		if bytes.Equal(msg, pingMsg) {
-			writeChan <- &api.WsMessage{
+			c.connectionService.Touch(connID)
+
+			queue.Push(&api.WsMessage{
 				Event: "pong",
-			}
+			})
 		}
 	}
 }
 
+func (c *WS) handleInternal(conn *websocket.Conn, connID uuid.UUID, channels []string) {
+	queue := newOutboundQueue(c.cfg.WS.QueueSize, c.cfg.WS.SlowConsumerPolicy,
+		func() {
+			c.metrics.RealtimeMessageDropped(c.appCtx, "ws")
+		},
+		func() {
+			c.metrics.RealtimeSlowConsumerDisconnected(c.appCtx, "ws")
+			slog.Warn("Disconnecting slow websocket consumer",
+				slog.String("ip", conn.IP()),
+			)
+
+			_ = conn.Close()
+		},
+	)
+
+	subs := make([]*simplepubsub.Subscription, 0, len(channels))
+	for _, channel := range channels {
+		subs = append(subs, c.pubSubService.SubscribeMessages(channel, func(idMsg api.IdMessage) {
+			queue.Push(idMsg)
+		}))
+	}
+
+	var writerWg sync.WaitGroup
+
+	writerWg.Add(1)
+	go func() {
+		defer writerWg.Done()
+		c.writeLoop(conn, queue)
+	}()
+
+	c.readLoop(conn, connID, queue)
+
+	// stop publishers first, then the writer, and only then release the connection
+	for _, sub := range subs {
+		c.pubSubService.Unsubscribe(sub)
+	}
+
+	queue.Close()
+	writerWg.Wait()
+}
+
 func (c *WS) Handle(conn *websocket.Conn) {
-	var channels []string
+	channels := []string{"public"}
+	var user string
 
 	if c.authService.IsAdminLocals(conn.Locals) {
 		channels = append(channels, "admin")
+		user = c.authService.UsernameLocals(conn.Locals)
 	}
 
-	c.handleInternal(conn, channels)
+	connID := c.connectionService.Register(connection.Connection{
+		Transport: "ws",
+		Channels:  channels,
+		User:      user,
+		IP:        conn.IP(),
+		UserAgent: conn.Headers("User-Agent"),
+	}, func() {
+		_ = conn.Close()
+	})
+	defer c.connectionService.Unregister(connID)
+
+	c.handleInternal(conn, connID, channels)
 }
@@ -0,0 +1,21 @@
+package mapper
+
+import (
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+)
+
+func MapAnnouncement(a database.Announcement) api.Announcement {
+	return api.Announcement{
+		Id:       a.ID,
+		Text:     a.Text,
+		Starts:   a.Starts,
+		Ends:     a.Ends,
+		Priority: int(a.Priority),
+		Severity: a.Severity,
+		Target:   a.Target,
+		MenuId:   a.MenuID,
+		Created:  a.Created,
+		Updated:  a.Updated,
+	}
+}
@@ -0,0 +1,24 @@
+package mapper
+
+import (
+	"shantaram/app/api"
+	"shantaram/app/service/connection"
+)
+
+func MapConnection(c connection.Connection) api.Connection {
+	var user *string
+	if c.User != "" {
+		user = &c.User
+	}
+
+	return api.Connection{
+		Channels:  c.Channels,
+		Connected: c.Connected,
+		Id:        c.ID,
+		Ip:        c.IP,
+		LastPing:  c.LastPing,
+		Transport: api.ConnectionTransport(c.Transport),
+		User:      user,
+		UserAgent: c.UserAgent,
+	}
+}
@@ -0,0 +1,15 @@
+package mapper
+
+import (
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+)
+
+func MapCustomer(c database.Customer) api.Customer {
+	return api.Customer{
+		Id:      c.ID,
+		Phone:   c.Phone,
+		Name:    c.Name,
+		Created: c.Created,
+	}
+}
@@ -0,0 +1,80 @@
+package mapper
+
+import (
+	"shantaram/app/api"
+	"shantaram/app/service/discount"
+	"shantaram/app/service/hours"
+	"shantaram/pkg/database"
+	"shantaram/pkg/money"
+
+	"github.com/rofleksey/meg"
+)
+
+func MapPromoCode(p database.GetPromoCodesRow) api.PromoCode {
+	return api.PromoCode{
+		Id:                 p.ID,
+		Code:               p.Code,
+		Kind:               p.Kind,
+		Value:              p.Value,
+		MinOrderTotal:      p.MinOrderTotal,
+		MaxUses:            meg.PtrInt32ToPtrInt(p.MaxUses),
+		MaxUsesPerCustomer: meg.PtrInt32ToPtrInt(p.MaxUsesPerCustomer),
+		Starts:             p.Starts,
+		Ends:               p.Ends,
+		Active:             p.Active,
+		Uses:               int(p.Uses),
+		Created:            p.Created,
+		Updated:            p.Updated,
+	}
+}
+
+func MapDiscountRule(r database.DiscountRule) api.DiscountRule {
+	var fromTime, toTime *string
+	if r.FromMinute != nil && r.ToMinute != nil {
+		fromTime = meg.ToPtr(hours.FormatMinute(*r.FromMinute))
+		toTime = meg.ToPtr(hours.FormatMinute(*r.ToMinute))
+	}
+
+	return api.DiscountRule{
+		Id:       r.ID,
+		Title:    r.Title,
+		Kind:     r.Kind,
+		Value:    r.Value,
+		GroupId:  r.GroupID,
+		FromTime: fromTime,
+		ToTime:   toTime,
+		Starts:   r.Starts,
+		Ends:     r.Ends,
+		Active:   r.Active,
+		Created:  r.Created,
+		Updated:  r.Updated,
+	}
+}
+
+func MapDiscountReportRow(r database.GetDiscountReportRow) api.DiscountReportRow {
+	return api.DiscountReportRow{
+		Title:       r.Title,
+		PromoCodeId: r.PromoCodeID,
+		RuleId:      r.RuleID,
+		Orders:      int(r.Orders),
+		Amount:      money.Kopecks(r.Amount),
+	}
+}
+
+func MapPointsReport(r database.GetPointsReportRow) api.PointsReport {
+	return api.PointsReport{
+		Orders: int(r.Orders),
+		Points: int(r.Points),
+		Amount: money.Kopecks(r.Amount),
+	}
+}
+
+func MapQuote(items []api.OrderItem, result discount.Result) api.Quote {
+	return api.Quote{
+		Items:     items,
+		Discounts: result.Discounts,
+		Subtotal:  result.Subtotal,
+		Discount:  result.Discount,
+		Total:     result.Total,
+	}
+}
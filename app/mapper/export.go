@@ -0,0 +1,96 @@
+package mapper
+
+import (
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+	"shantaram/pkg/money"
+	"strings"
+	"time"
+
+	"github.com/elliotchance/pie/v2"
+)
+
+// Export rows hold strings, ints, money.Kopecks, time.Time and nil for missing values,
+// each export format renders them on its own.
+
+var OrderExportColumns = []string{
+	"id", "index", "created", "status", "table_id", "client_name", "client_comment",
+	"items", "gross", "discount", "total", "discounts",
+}
+
+var OrderItemExportColumns = []string{
+	"order_id", "order_index", "created", "status", "table_id", "client_name",
+	"product_id", "title", "components", "amount", "price", "sum",
+}
+
+func OrderExportRow(o database.Order, loc *time.Location) []any {
+	var (
+		items    int
+		gross    money.Kopecks
+		discount money.Kopecks
+	)
+
+	for _, item := range o.Items {
+		items += item.Amount
+		gross += item.Price.Times(item.Amount)
+	}
+
+	for _, d := range o.Discounts {
+		discount += d.Amount
+	}
+
+	return []any{
+		o.ID.String(),
+		o.Index,
+		o.Created.In(loc),
+		string(o.Status),
+		exportOptional(o.TableID),
+		o.ClientName,
+		exportOptional(o.ClientComment),
+		items,
+		gross,
+		discount,
+		gross - discount,
+		strings.Join(pie.Map(o.Discounts, func(d api.OrderDiscount) string {
+			return d.Title
+		}), "; "),
+	}
+}
+
+func OrderItemExportRows(o database.Order, loc *time.Location) [][]any {
+	rows := make([][]any, 0, len(o.Items))
+
+	for _, item := range o.Items {
+		var components string
+		if item.Components != nil {
+			components = strings.Join(pie.Map(*item.Components, func(c api.OrderItemComponent) string {
+				return c.Title
+			}), "; ")
+		}
+
+		rows = append(rows, []any{
+			o.ID.String(),
+			o.Index,
+			o.Created.In(loc),
+			string(o.Status),
+			exportOptional(o.TableID),
+			o.ClientName,
+			item.Id.String(),
+			item.Title,
+			components,
+			item.Amount,
+			item.Price,
+			item.Price.Times(item.Amount),
+		})
+	}
+
+	return rows
+}
+
+func exportOptional(value *string) any {
+	if value == nil {
+		return nil
+	}
+
+	return *value
+}
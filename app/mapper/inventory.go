@@ -0,0 +1,42 @@
+package mapper
+
+import (
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+
+	"github.com/rofleksey/meg"
+)
+
+func MapIngredient(i database.Ingredient) api.Ingredient {
+	return api.Ingredient{
+		Id:       i.ID,
+		Title:    i.Title,
+		Unit:     i.Unit,
+		Stock:    int(i.Stock),
+		LowStock: meg.PtrInt32ToPtrInt(i.LowStock),
+		Created:  i.Created,
+		Updated:  i.Updated,
+	}
+}
+
+// MapRecipes groups recipe rows sorted by product into one recipe per product
+func MapRecipes(rows []database.ProductIngredient) []api.Recipe {
+	result := []api.Recipe{}
+
+	for _, row := range rows {
+		if len(result) == 0 || result[len(result)-1].ProductId != row.ProductID {
+			result = append(result, api.Recipe{
+				ProductId: row.ProductID,
+				Items:     []api.RecipeItem{},
+			})
+		}
+
+		last := &result[len(result)-1]
+		last.Items = append(last.Items, api.RecipeItem{
+			IngredientId: row.IngredientID,
+			Quantity:     int(row.Quantity),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,22 @@
+package mapper
+
+import (
+	"shantaram/app/api"
+	"shantaram/app/service/scheduler"
+)
+
+func MapJob(s scheduler.Status) api.Job {
+	return api.Job{
+		Name:           s.Name,
+		Schedule:       s.Schedule,
+		NextRun:        s.NextRun,
+		Running:        s.Running,
+		LastStarted:    s.State.LastStarted,
+		LastFinished:   s.State.LastFinished,
+		LastError:      s.State.LastError,
+		LastDurationMs: s.State.LastDurationMs,
+		LastInstance:   s.State.LastInstance,
+		Runs:           int(s.State.Runs),
+		Failures:       int(s.State.Failures),
+	}
+}
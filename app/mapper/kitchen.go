@@ -0,0 +1,29 @@
+package mapper
+
+import (
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+)
+
+func MapKitchenStation(s database.KitchenStation) api.KitchenStation {
+	return api.KitchenStation{
+		Id:             s.ID,
+		PrinterAddress: s.PrinterAddress,
+		Title:          s.Title,
+	}
+}
+
+func MapKitchenTicket(t database.GetKitchenQueueRow) api.KitchenTicket {
+	return api.KitchenTicket{
+		Amount:        int(t.KitchenTicket.Amount),
+		ClientComment: t.ClientComment,
+		ClientName:    t.ClientName,
+		Created:       t.KitchenTicket.Created,
+		Id:            t.KitchenTicket.ID,
+		OrderId:       t.KitchenTicket.OrderID,
+		OrderIndex:    int(t.OrderIndex),
+		ProductId:     t.KitchenTicket.ProductID,
+		StationId:     t.KitchenTicket.StationID,
+		Title:         t.KitchenTicket.Title,
+	}
+}
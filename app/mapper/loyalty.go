@@ -0,0 +1,28 @@
+package mapper
+
+import (
+	"shantaram/app/api"
+	"shantaram/app/service/loyalty"
+	"shantaram/pkg/database"
+
+	"github.com/elliotchance/pie/v2"
+)
+
+func MapLoyaltyEntry(e database.LoyaltyEntry) api.LoyaltyEntry {
+	return api.LoyaltyEntry{
+		Id:      e.ID,
+		Kind:    e.Kind,
+		Points:  int(e.Points),
+		OrderId: e.OrderID,
+		Expires: e.Expires,
+		Comment: e.Comment,
+		Created: e.Created,
+	}
+}
+
+func MapLoyaltyAccount(a loyalty.Account) api.LoyaltyAccount {
+	return api.LoyaltyAccount{
+		Balance: int(a.Balance),
+		Entries: pie.Map(a.Entries, MapLoyaltyEntry),
+	}
+}
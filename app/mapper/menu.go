@@ -3,6 +3,8 @@ package mapper
 import (
 	"shantaram/app/api"
 	"shantaram/pkg/database"
+
+	"github.com/rofleksey/meg"
 )
 
 func MapMenu(m database.Menu) api.Menu {
@@ -15,11 +17,13 @@ func MapMenu(m database.Menu) api.Menu {
 
 func MapProductGroup(g database.ProductGroup) api.ProductGroup {
 	return api.ProductGroup{
-		Created:  g.Created,
-		Id:       g.ID,
-		Products: []api.Product{},
-		Title:    g.Title,
-		Updated:  g.Updated,
+		Created:     g.Created,
+		Id:          g.ID,
+		Products:    []api.Product{},
+		PrepMinutes: meg.PtrInt32ToPtrInt(g.PrepMinutes),
+		StationId:   g.StationID,
+		Title:       g.Title,
+		Updated:     g.Updated,
 	}
 }
 
@@ -31,7 +35,36 @@ func MapProduct(p database.Product) api.Product {
 		Id:          p.ID,
 		Index:       int(p.Index),
 		Price:       p.Price,
+		PrepMinutes: meg.PtrInt32ToPtrInt(p.PrepMinutes),
+		MaxQuantity: meg.PtrInt32ToPtrInt(p.MaxQuantity),
+		Stock:       meg.PtrInt32ToPtrInt(p.Stock),
+		LowStock:    meg.PtrInt32ToPtrInt(p.LowStock),
 		Title:       p.Title,
 		Updated:     p.Updated,
 	}
 }
+
+func MapComboSlotOption(o database.ComboSlotOption) api.ComboSlotOption {
+	return api.ComboSlotOption{
+		ProductId: o.ProductID,
+		GroupId:   o.GroupID,
+		Surcharge: o.Surcharge,
+	}
+}
+
+// MapComboSlot picks the slot options out of options of any slots
+func MapComboSlot(slot database.ComboSlot, options []database.ComboSlotOption) api.ComboSlot {
+	result := api.ComboSlot{
+		Id:      slot.ID,
+		Title:   slot.Title,
+		Options: []api.ComboSlotOption{},
+	}
+
+	for _, option := range options {
+		if option.SlotID == slot.ID {
+			result.Options = append(result.Options, MapComboSlotOption(option))
+		}
+	}
+
+	return result
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"shantaram/app/api"
 	"shantaram/pkg/database"
+	"shantaram/pkg/money"
 	"strings"
 
 	"github.com/rofleksey/meg"
@@ -20,6 +21,36 @@ func MapOrder(o database.Order) api.Order {
 		Seen:          o.Seen,
 		Status:        o.Status,
 		TableID:       o.TableID,
+		EtaMinutes:    meg.PtrInt32ToPtrInt(o.EtaMinutes),
+		ReadyAt:       o.ReadyAt,
+		PickupAt:      o.PickupAt,
+		Discounts:     o.Discounts,
+		Total:         OrderTotal(o),
+		CustomerId:    o.CustomerID,
+	}
+}
+
+// OrderTotal is the amount to pay, discounts are never larger than the items sum
+func OrderTotal(o database.Order) money.Kopecks {
+	var total money.Kopecks
+
+	for _, item := range o.Items {
+		total += item.Price.Times(item.Amount)
+	}
+
+	for _, discount := range o.Discounts {
+		total -= discount.Amount
+	}
+
+	return total
+}
+
+func MapCreateOrderResponse(o database.Order) api.CreateOrderResponse {
+	return api.CreateOrderResponse{
+		EtaMinutes: int(meg.GetPtrOrZero(o.EtaMinutes)),
+		Id:         o.ID,
+		Index:      int(o.Index),
+		ReadyAt:    meg.GetPtrOrZero(o.ReadyAt),
 	}
 }
 
@@ -42,8 +73,6 @@ func OrderToNotificationText(o database.Order) string {
 
 	builder.WriteString("\nТовары: \n")
 
-	var totalPrice float64
-
 	for i, item := range o.Items {
 		builder.WriteString(fmt.Sprint(i + 1))
 		builder.WriteString(". ")
@@ -51,17 +80,28 @@ func OrderToNotificationText(o database.Order) string {
 		builder.WriteString(" x ")
 		builder.WriteString(fmt.Sprint(item.Amount))
 		builder.WriteString(" - ")
-		builder.WriteString(fmt.Sprintf("%.2f", meg.FixPrice(item.Price*float64(item.Amount))))
+		builder.WriteString(item.Price.Times(item.Amount).String())
 		builder.WriteString(" ₽\n")
 
-		totalPrice += meg.FixPrice(float64(item.Amount) * item.Price)
+		if item.Components != nil {
+			for _, component := range *item.Components {
+				builder.WriteString("    • ")
+				builder.WriteString(component.Title)
+				builder.WriteString("\n")
+			}
+		}
 	}
 
-	totalPrice = meg.FixPrice(totalPrice)
+	for _, discount := range o.Discounts {
+		builder.WriteString(discount.Title)
+		builder.WriteString(" - −")
+		builder.WriteString(discount.Amount.String())
+		builder.WriteString(" ₽\n")
+	}
 
 	builder.WriteString("\n")
 	builder.WriteString("Сумма: ")
-	builder.WriteString(fmt.Sprintf("%.2f", totalPrice))
+	builder.WriteString(OrderTotal(o).String())
 	builder.WriteString(" ₽\n\n")
 	builder.WriteString("https://admin.shantaram-spb.ru/#/order/")
 	builder.WriteString(o.ID.String())
@@ -2,12 +2,84 @@ package mapper
 
 import (
 	"shantaram/app/api"
+	"shantaram/app/service/capacity"
+	"shantaram/app/service/hours"
 	"shantaram/pkg/database"
+
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/rofleksey/meg"
 )
 
-func MapParams(p database.Param) api.Params {
-	return api.Params{
-		HeaderText:     p.HeaderText,
-		HeaderDeadline: p.HeaderDeadline,
+// MapParams fills the deprecated headerText and headerDeadline from the header announcement
+func MapParams(p database.Param, header *database.Announcement, schedule *hours.Schedule, now time.Time) api.Params {
+	result := api.Params{
+		HeaderText:     nil,
+		HeaderDeadline: nil,
+		OrderingPaused: p.OrderingPaused,
+		SlotMinutes:    int(p.SlotMinutes),
+		SlotMaxOrders:  meg.PtrInt32ToPtrInt(p.SlotMaxOrders),
+		SlotMaxItems:   meg.PtrInt32ToPtrInt(p.SlotMaxItems),
+		IsOpen:         schedule.OpenAt(now),
+		NextOpening:    nil,
+		ClosedReason:   nil,
+		ClosedUntil:    p.ClosedUntil,
+	}
+
+	if header != nil {
+		result.HeaderText = &header.Text
+		result.HeaderDeadline = header.Ends
+	}
+
+	if !result.IsOpen {
+		result.NextOpening = schedule.NextOpening(now)
+		result.ClosedReason = meg.ToPtr(schedule.ClosedReason(now))
+	}
+
+	return result
+}
+
+func MapOrderPolicy(p database.Param) api.OrderPolicy {
+	return api.OrderPolicy{
+		MaxLines:        int(p.MaxLines),
+		MaxLineQuantity: int(p.MaxLineQuantity),
+		MinTotal:        p.MinOrderTotal,
+		MaxTotal:        p.MaxOrderTotal,
+	}
+}
+
+func MapLoyaltyPolicy(p database.Param) api.LoyaltyPolicy {
+	return api.LoyaltyPolicy{
+		EarnPercent:      int(p.LoyaltyEarnPercent),
+		MaxRedeemPercent: int(p.LoyaltyMaxRedeemPercent),
+		ExpireDays:       meg.PtrInt32ToPtrInt(p.LoyaltyExpireDays),
+	}
+}
+
+func MapWeeklyHours(h hours.WeeklyHours) api.WeeklyHours {
+	return api.WeeklyHours{
+		Weekday: h.Weekday,
+		Opens:   h.Opens,
+		Closes:  h.Closes,
+	}
+}
+
+func MapOpeningException(e hours.Exception) api.OpeningException {
+	return api.OpeningException{
+		Day:    types.Date{Time: e.Day},
+		Opens:  e.Opens,
+		Closes: e.Closes,
+		Title:  e.Title,
+	}
+}
+
+func MapSlot(s capacity.Slot) api.Slot {
+	return api.Slot{
+		Start:     s.Start,
+		End:       s.End,
+		Orders:    s.Orders,
+		Items:     s.Items,
+		Available: !s.Full,
 	}
 }
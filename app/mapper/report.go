@@ -0,0 +1,190 @@
+package mapper
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+	"strconv"
+	"strings"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func EncodeCSV(records [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	writer := csv.NewWriter(&buf)
+	if err := writer.WriteAll(records); err != nil {
+		return nil, fmt.Errorf("WriteAll: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// the *CSV functions return records with a header row for report exports
+
+func SalesReportCSV(rows []api.SalesReportRow) [][]string {
+	records := [][]string{{"period", "orders", "revenue", "average_check"}}
+	for _, row := range rows {
+		records = append(records, []string{
+			row.Period.Format(time.RFC3339),
+			strconv.Itoa(row.Orders),
+			row.Revenue.String(),
+			row.AverageCheck.String(),
+		})
+	}
+
+	return records
+}
+
+func TopProductsReportCSV(rows []api.TopProductRow) [][]string {
+	records := [][]string{{"product_id", "title", "quantity", "revenue"}}
+	for _, row := range rows {
+		records = append(records, []string{
+			row.ProductId.String(),
+			row.Title,
+			strconv.Itoa(row.Quantity),
+			row.Revenue.String(),
+		})
+	}
+
+	return records
+}
+
+func TopGroupsReportCSV(rows []api.TopGroupRow) [][]string {
+	records := [][]string{{"group_id", "title", "quantity", "revenue"}}
+	for _, row := range rows {
+		records = append(records, []string{
+			row.GroupId.String(),
+			row.Title,
+			strconv.Itoa(row.Quantity),
+			row.Revenue.String(),
+		})
+	}
+
+	return records
+}
+
+func CancellationReportCSV(rows []api.CancellationReportRow) [][]string {
+	records := [][]string{{"period", "orders", "cancelled", "rate"}}
+	for _, row := range rows {
+		records = append(records, []string{
+			row.Period.Format(time.RFC3339),
+			strconv.Itoa(row.Orders),
+			strconv.Itoa(row.Cancelled),
+			strconv.FormatFloat(row.Rate, 'f', 4, 64),
+		})
+	}
+
+	return records
+}
+
+func HeatmapReportCSV(rows []api.HeatmapCell) [][]string {
+	records := [][]string{{"weekday", "hour", "orders", "revenue"}}
+	for _, row := range rows {
+		records = append(records, []string{
+			strconv.Itoa(row.Weekday),
+			strconv.Itoa(row.Hour),
+			strconv.Itoa(row.Orders),
+			row.Revenue.String(),
+		})
+	}
+
+	return records
+}
+
+func StatusDurationReportCSV(rows []api.StatusDurationRow) [][]string {
+	records := [][]string{{"status", "transitions", "p50_seconds", "p90_seconds", "p95_seconds"}}
+	for _, row := range rows {
+		records = append(records, []string{
+			string(row.Status),
+			strconv.Itoa(row.Transitions),
+			strconv.FormatFloat(row.P50, 'f', 1, 64),
+			strconv.FormatFloat(row.P90, 'f', 1, 64),
+			strconv.FormatFloat(row.P95, 'f', 1, 64),
+		})
+	}
+
+	return records
+}
+
+func MapZReport(r database.ZReport) api.ZReport {
+	return api.ZReport{
+		Day:     openapi_types.Date{Time: r.Day},
+		Starts:  r.Starts,
+		Ends:    r.Ends,
+		Summary: r.Summary,
+		Created: r.Created,
+	}
+}
+
+// ZReportCSV has one section per row kind: status, total and top_item
+func ZReportCSV(r database.ZReport, loc *time.Location) [][]string {
+	records := [][]string{
+		{"section", "name", "count", "gross", "discount", "points", "revenue"},
+		{"period", r.Starts.In(loc).Format(time.RFC3339), "", "", "", "", r.Ends.In(loc).Format(time.RFC3339)},
+	}
+
+	for _, row := range r.Summary.Statuses {
+		records = append(records, []string{
+			"status",
+			string(row.Status),
+			strconv.Itoa(row.Orders),
+			row.Gross.String(),
+			row.Discount.String(),
+			row.Points.String(),
+			(row.Gross - row.Discount - row.Points).String(),
+		})
+	}
+
+	records = append(records,
+		[]string{"total", "orders", strconv.Itoa(r.Summary.Orders), r.Summary.Gross.String(), r.Summary.Discount.String(), r.Summary.Points.String(), r.Summary.Revenue.String()},
+		[]string{"total", "average_check", "", "", "", "", r.Summary.AverageCheck.String()},
+	)
+
+	for _, item := range r.Summary.TopItems {
+		records = append(records, []string{"top_item", item.Title, strconv.Itoa(item.Quantity), item.Revenue.String(), "", "", ""})
+	}
+
+	return records
+}
+
+func ZReportToText(r database.ZReport, loc *time.Location) string {
+	var builder strings.Builder
+
+	builder.WriteString("Z-отчёт за ")
+	builder.WriteString(r.Day.Format("02.01.2006"))
+	builder.WriteString("\n")
+	builder.WriteString(r.Starts.In(loc).Format("02.01.2006 15:04"))
+	builder.WriteString(" — ")
+	builder.WriteString(r.Ends.In(loc).Format("02.01.2006 15:04"))
+	builder.WriteString("\n\nСтатусы:\n")
+
+	for _, row := range r.Summary.Statuses {
+		builder.WriteString(fmt.Sprintf("%s: %d — %s ₽\n", row.Status, row.Orders, (row.Gross - row.Discount - row.Points).String()))
+	}
+
+	builder.WriteString(fmt.Sprintf("\nЗаказов: %d\n", r.Summary.Orders))
+	builder.WriteString("Сумма без скидок: " + r.Summary.Gross.String() + " ₽\n")
+	builder.WriteString("Скидки: " + r.Summary.Discount.String() + " ₽\n")
+
+	if r.Summary.Points > 0 {
+		builder.WriteString("Оплачено баллами: " + r.Summary.Points.String() + " ₽\n")
+	}
+
+	builder.WriteString("Выручка: " + r.Summary.Revenue.String() + " ₽\n")
+	builder.WriteString("Средний чек: " + r.Summary.AverageCheck.String() + " ₽\n")
+
+	if len(r.Summary.TopItems) > 0 {
+		builder.WriteString("\nТоп позиций:\n")
+
+		for i, item := range r.Summary.TopItems {
+			builder.WriteString(fmt.Sprintf("%d. %s x %d — %s ₽\n", i+1, item.Title, item.Quantity, item.Revenue.String()))
+		}
+	}
+
+	return builder.String()
+}
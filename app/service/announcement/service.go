@@ -0,0 +1,338 @@
+package announcement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/service/pubsub"
+	"shantaram/pkg/database"
+	"shantaram/pkg/telemetry"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rofleksey/meg"
+	"github.com/samber/do"
+	"github.com/samber/oops"
+)
+
+var serviceName = "announcement"
+
+var tickerInterval = 15 * time.Second
+
+// headerID is the announcement that shows the header banner of the deprecated setHeaderText
+var headerID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+type Service struct {
+	queries       *database.Queries
+	pubsubService *pubsub.Service
+	tracing       *telemetry.Tracing
+
+	// signatures of the visible sets per pubsub channel, used to detect changes
+	visibleMu sync.Mutex
+	visible   map[string]string
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		queries:       do.MustInvoke[*database.Queries](di),
+		pubsubService: do.MustInvoke[*pubsub.Service](di),
+		tracing:       do.MustInvoke[*telemetry.Tracing](di),
+		visible:       nil,
+	}, nil
+}
+
+func visibleFor(a database.Announcement, target api.AnnouncementTarget) bool {
+	return a.Target == api.AnnouncementTargetAll || a.Target == target
+}
+
+func validate(text string, starts, ends *time.Time, severity api.AnnouncementSeverity, target api.AnnouncementTarget) error {
+	if strings.TrimSpace(text) == "" {
+		return oops.With("status_code", http.StatusBadRequest).New("text is empty")
+	}
+
+	if starts != nil && ends != nil && !ends.After(*starts) {
+		return oops.With("status_code", http.StatusBadRequest).New("ends must be after starts")
+	}
+
+	switch severity {
+	case api.AnnouncementSeverityInfo, api.AnnouncementSeverityWarning, api.AnnouncementSeverityCritical:
+	default:
+		return oops.With("status_code", http.StatusBadRequest).Errorf("invalid severity %q", severity)
+	}
+
+	switch target {
+	case api.AnnouncementTargetPublic, api.AnnouncementTargetAdmin, api.AnnouncementTargetAll:
+	default:
+		return oops.With("status_code", http.StatusBadRequest).Errorf("invalid target %q", target)
+	}
+
+	return nil
+}
+
+func toUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	return meg.ToPtr(t.UTC())
+}
+
+// GetVisible returns announcements active right now for the target,
+// menu scoped ones are included only for the matching menu
+func (s *Service) GetVisible(ctx context.Context, target api.AnnouncementTarget, menuID *string) ([]database.Announcement, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_visible")
+	defer span.End()
+
+	active, err := s.queries.GetActiveAnnouncements(ctx, time.Now().UTC())
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetActiveAnnouncements: %w", err))
+	}
+
+	result := make([]database.Announcement, 0, len(active))
+
+	for _, a := range active {
+		if !visibleFor(a, target) {
+			continue
+		}
+
+		if a.MenuID != nil && (menuID == nil || *a.MenuID != *menuID) {
+			continue
+		}
+
+		result = append(result, a)
+	}
+
+	s.tracing.Success(span)
+
+	return result, nil
+}
+
+func (s *Service) GetAll(ctx context.Context) ([]database.Announcement, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_all")
+	defer span.End()
+
+	announcements, err := s.queries.GetAnnouncements(ctx)
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetAnnouncements: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return announcements, nil
+}
+
+func (s *Service) Add(ctx context.Context, req *api.AddAnnouncementRequest) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "add")
+	defer span.End()
+
+	if err := validate(req.Text, req.Starts, req.Ends, req.Severity, req.Target); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	if err := s.queries.CreateAnnouncement(ctx, database.CreateAnnouncementParams{
+		ID:       req.Id,
+		Text:     req.Text,
+		Starts:   toUTC(req.Starts),
+		Ends:     toUTC(req.Ends),
+		Priority: int32(meg.GetPtrOrZero(req.Priority)), //nolint:gosec
+		Severity: req.Severity,
+		Target:   req.Target,
+		MenuID:   req.MenuId,
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("CreateAnnouncement: %w", err))
+	}
+
+	s.refresh(ctx)
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) Edit(ctx context.Context, id uuid.UUID, req *api.EditAnnouncementRequest) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "edit")
+	defer span.End()
+
+	if err := validate(req.Text, req.Starts, req.Ends, req.Severity, req.Target); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	affected, err := s.queries.UpdateAnnouncement(ctx, database.UpdateAnnouncementParams{
+		ID:       id,
+		Text:     req.Text,
+		Starts:   toUTC(req.Starts),
+		Ends:     toUTC(req.Ends),
+		Priority: int32(meg.GetPtrOrZero(req.Priority)), //nolint:gosec
+		Severity: req.Severity,
+		Target:   req.Target,
+		MenuID:   req.MenuId,
+	})
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("UpdateAnnouncement: %w", err))
+	}
+
+	if affected == 0 {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).New("announcement not found"))
+	}
+
+	s.refresh(ctx)
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "delete")
+	defer span.End()
+
+	affected, err := s.queries.DeleteAnnouncement(ctx, id)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("DeleteAnnouncement: %w", err))
+	}
+
+	if affected == 0 {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).New("announcement not found"))
+	}
+
+	s.refresh(ctx)
+	s.tracing.Success(span)
+
+	return nil
+}
+
+// SetHeader keeps the deprecated setHeaderText working: the text is shown as a public
+// announcement until the deadline, an empty text removes it
+func (s *Service) SetHeader(ctx context.Context, text *string, deadline *time.Time) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "set_header")
+	defer span.End()
+
+	if text == nil || strings.TrimSpace(*text) == "" {
+		if _, err := s.queries.DeleteAnnouncement(ctx, headerID); err != nil {
+			return s.tracing.Error(span, fmt.Errorf("DeleteAnnouncement: %w", err))
+		}
+	} else if err := s.queries.SetHeaderAnnouncement(ctx, database.SetHeaderAnnouncementParams{
+		ID:   headerID,
+		Text: *text,
+		Ends: toUTC(deadline),
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("SetHeaderAnnouncement: %w", err))
+	}
+
+	s.refresh(ctx)
+	s.tracing.Success(span)
+
+	return nil
+}
+
+// GetHeader returns the header banner while it is shown, nil otherwise
+func (s *Service) GetHeader(ctx context.Context) (*database.Announcement, error) {
+	header, err := s.queries.GetAnnouncementByID(ctx, headerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, fmt.Errorf("GetAnnouncementByID: %w", err)
+	}
+
+	if header.Ends != nil && !header.Ends.After(time.Now().UTC()) {
+		return nil, nil //nolint:nilnil
+	}
+
+	return &header, nil
+}
+
+// ExpireHeader removes the header banner once its deadline passes
+func (s *Service) ExpireHeader(ctx context.Context) error {
+	affected, err := s.queries.DeleteExpiredAnnouncement(ctx, database.DeleteExpiredAnnouncementParams{
+		ID:  headerID,
+		Now: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("DeleteExpiredAnnouncement: %w", err)
+	}
+
+	if affected > 0 {
+		slog.Info("Header was reset on deadline")
+		s.refresh(ctx)
+	}
+
+	return nil
+}
+
+// RunTicker activates and expires scheduled announcements
+func (s *Service) RunTicker(ctx context.Context) {
+	meg.RunTicker(ctx, tickerInterval, func() {
+		s.refresh(ctx)
+	})
+}
+
+func (s *Service) refresh(ctx context.Context) {
+	if err := s.checkVisible(ctx); err != nil {
+		slog.Error("checkVisible error",
+			slog.Any("error", err),
+		)
+	}
+}
+
+func (s *Service) checkVisible(ctx context.Context) error {
+	active, err := s.queries.GetActiveAnnouncements(ctx, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("GetActiveAnnouncements: %w", err)
+	}
+
+	targets := map[string]api.AnnouncementTarget{
+		"public": api.AnnouncementTargetPublic,
+		"admin":  api.AnnouncementTargetAdmin,
+	}
+
+	signatures := make(map[string]string, len(targets))
+
+	for channel, target := range targets {
+		var builder strings.Builder
+
+		for _, a := range active {
+			if visibleFor(a, target) {
+				builder.WriteString(a.ID.String())
+				builder.WriteString(a.Updated.Format(time.RFC3339Nano))
+				builder.WriteString(";")
+			}
+		}
+
+		signatures[channel] = builder.String()
+	}
+
+	s.visibleMu.Lock()
+	defer s.visibleMu.Unlock()
+
+	// the first check only remembers the state
+	if s.visible == nil {
+		s.visible = signatures
+		return nil
+	}
+
+	var changed []string
+
+	for channel, signature := range signatures {
+		if s.visible[channel] != signature {
+			changed = append(changed, channel)
+		}
+	}
+
+	s.visible = signatures
+
+	if len(changed) > 0 {
+		slog.Info("Visible announcements changed",
+			slog.Any("channels", changed),
+		)
+
+		s.pubsubService.NotifyAnnouncementsChanged(changed...)
+	}
+
+	return nil
+}
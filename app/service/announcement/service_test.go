@@ -0,0 +1,135 @@
+package announcement
+
+import (
+	"context"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/database/dbtest"
+	"shantaram/pkg/telemetry"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rofleksey/meg"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// newHeaderService keeps announcements in memory, keyed by id
+func newHeaderService(t *testing.T) (*Service, map[uuid.UUID]database.Announcement) {
+	t.Helper()
+
+	announcements := make(map[uuid.UUID]database.Announcement)
+
+	db := dbtest.New()
+	db.Handle("SetHeaderAnnouncement", func(args []any) ([][]any, error) {
+		id := args[0].(uuid.UUID)
+		announcements[id] = database.Announcement{ //nolint:exhaustruct
+			ID:     id,
+			Text:   args[1].(string),
+			Ends:   args[2].(*time.Time),
+			Target: "public",
+		}
+
+		return [][]any{{}}, nil
+	})
+	db.Handle("DeleteAnnouncement", func(args []any) ([][]any, error) {
+		delete(announcements, args[0].(uuid.UUID))
+
+		return [][]any{{}}, nil
+	})
+	db.Handle("DeleteExpiredAnnouncement", func(args []any) ([][]any, error) {
+		id, now := args[0].(uuid.UUID), args[1].(time.Time)
+
+		a, ok := announcements[id]
+		if !ok || a.Ends == nil || a.Ends.After(now) {
+			return nil, nil
+		}
+
+		delete(announcements, id)
+
+		return [][]any{{}}, nil
+	})
+	db.Handle("GetAnnouncementByID", func(args []any) ([][]any, error) {
+		a, ok := announcements[args[0].(uuid.UUID)]
+		if !ok {
+			return nil, nil
+		}
+
+		return [][]any{dbtest.Fields(a)}, nil
+	})
+	db.Handle("GetActiveAnnouncements", func([]any) ([][]any, error) {
+		return nil, nil
+	})
+
+	cfg := &config.Config{} //nolint:exhaustruct
+
+	return &Service{
+		queries:       database.New(db),
+		pubsubService: nil,
+		tracing:       telemetry.NewTracing(cfg, noop.NewTracerProvider().Tracer("test")),
+		visible:       nil,
+	}, announcements
+}
+
+func TestSetHeader(t *testing.T) {
+	s, announcements := newHeaderService(t)
+	ctx := context.Background()
+
+	deadline := time.Now().Add(time.Hour).In(time.FixedZone("UTC+3", 3*60*60))
+
+	if err := s.SetHeader(ctx, meg.ToPtr("Сегодня работаем до 18:00"), &deadline); err != nil {
+		t.Fatalf("SetHeader: %v", err)
+	}
+
+	header, err := s.GetHeader(ctx)
+	if err != nil {
+		t.Fatalf("GetHeader: %v", err)
+	}
+
+	if header == nil || header.Text != "Сегодня работаем до 18:00" || header.Ends.Location() != time.UTC || !header.Ends.Equal(deadline) {
+		t.Fatalf("header = %+v, want the text until the deadline in UTC", header)
+	}
+
+	// setting it again replaces the same announcement
+	if err = s.SetHeader(ctx, meg.ToPtr("Закрыто на санитарный день"), nil); err != nil {
+		t.Fatalf("SetHeader: %v", err)
+	}
+
+	if len(announcements) != 1 || announcements[headerID].Text != "Закрыто на санитарный день" {
+		t.Fatalf("announcements = %v, want the header replaced", announcements)
+	}
+
+	if err = s.SetHeader(ctx, meg.ToPtr("  "), nil); err != nil {
+		t.Fatalf("SetHeader: %v", err)
+	}
+
+	if len(announcements) != 0 {
+		t.Fatalf("announcements = %v, want an empty text to remove the header", announcements)
+	}
+}
+
+func TestExpireHeader(t *testing.T) {
+	s, announcements := newHeaderService(t)
+	ctx := context.Background()
+
+	if err := s.SetHeader(ctx, meg.ToPtr("Доставка задерживается"), meg.ToPtr(time.Now().Add(-time.Minute))); err != nil {
+		t.Fatalf("SetHeader: %v", err)
+	}
+
+	header, err := s.GetHeader(ctx)
+	if err != nil {
+		t.Fatalf("GetHeader: %v", err)
+	}
+
+	if header != nil {
+		t.Fatalf("header = %+v, want none after the deadline", header)
+	}
+
+	if err = s.ExpireHeader(ctx); err != nil {
+		t.Fatalf("ExpireHeader: %v", err)
+	}
+
+	if len(announcements) != 0 {
+		t.Fatalf("announcements = %v, want the expired header removed", announcements)
+	}
+}
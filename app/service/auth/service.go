@@ -2,24 +2,31 @@ package auth
 
 import (
 	"context"
+	"crypto/subtle"
 	_ "embed"
 	"errors"
 	"fmt"
 	"shantaram/pkg/config"
 	"shantaram/pkg/database"
 	"shantaram/pkg/telemetry"
+	"shantaram/pkg/util"
 	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/samber/do"
 	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/crypto/bcrypt"
 )
 
 var serviceName = "auth"
 
 var ErrInvalidCredentials = errors.New("invalid username or password")
 
+var minPasswordLength = 8
+
 type Service struct {
 	cfg     *config.Config
 	queries *database.Queries
@@ -40,12 +47,32 @@ func (s *Service) IsAdmin(ctx context.Context) bool {
 	return isAdmin
 }
 
+// IsAdminLocals reads the flag set by the auth middleware, passing a value to the getter would overwrite it
 func (s *Service) IsAdminLocals(getter func(key string, value ...interface{}) interface{}) bool {
-	isAdmin, _ := getter("admin", false).(bool)
+	isAdmin, _ := getter("admin").(bool)
 
 	return isAdmin
 }
 
+// Username returns the admin from the token subject, empty for guests and customers
+func (s *Service) Username(ctx context.Context) string {
+	username, _ := ctx.Value(util.UsernameContextKey).(string)
+
+	return username
+}
+
+// UsernameLocals is Username for websocket connections, which only have locals
+func (s *Service) UsernameLocals(getter func(key string, value ...interface{}) interface{}) string {
+	username, _ := getter(string(util.UsernameContextKey)).(string)
+
+	return username
+}
+
+// CustomerID returns the logged in customer, admin tokens never carry one
+func (s *Service) CustomerID(ctx context.Context) *uuid.UUID {
+	return util.CustomerID(ctx)
+}
+
 func (s *Service) Login(ctx context.Context, user, pass string) (string, error) {
 	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "login")
 	defer span.End()
@@ -53,14 +80,18 @@ func (s *Service) Login(ctx context.Context, user, pass string) (string, error)
 	user = strings.TrimSpace(user)
 	span.SetAttributes(attribute.String("username", user))
 
-	success := user == "admin" && pass == s.cfg.Admin.Password
+	success, err := s.checkCredentials(ctx, user, pass)
+	if err != nil {
+		return "", s.tracing.Error(span, fmt.Errorf("checkCredentials: %w", err))
+	}
+
 	if !success {
 		return "", s.tracing.Error(span, ErrInvalidCredentials)
 	}
 
 	claims := jwt.MapClaims{
 		"exp": time.Now().Add(time.Hour * 24 * 365 * 10).Unix(),
-		"sub": "admin",
+		"sub": user,
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -74,3 +105,84 @@ func (s *Service) Login(ctx context.Context, user, pass string) (string, error)
 
 	return tokenStr, nil
 }
+
+// checkCredentials prefers admins stored in the database,
+// the password from config still works for the built-in admin user
+func (s *Service) checkCredentials(ctx context.Context, user, pass string) (bool, error) {
+	admin, err := s.queries.GetAdminByUsername(ctx, user)
+	if err == nil {
+		return bcrypt.CompareHashAndPassword([]byte(admin.PasswordHash), []byte(pass)) == nil, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return false, fmt.Errorf("GetAdminByUsername: %w", err)
+	}
+
+	return user == "admin" && s.cfg.Admin.Password != "" &&
+		subtle.ConstantTimeCompare([]byte(pass), []byte(s.cfg.Admin.Password)) == 1, nil
+}
+
+func hashPassword(pass string) (string, error) {
+	if len(pass) < minPasswordLength {
+		return "", fmt.Errorf("password must be at least %d characters long", minPasswordLength)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(pass), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("GenerateFromPassword: %w", err)
+	}
+
+	return string(hash), nil
+}
+
+func (s *Service) CreateAdmin(ctx context.Context, user, pass string) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "create_admin")
+	defer span.End()
+
+	user = strings.TrimSpace(user)
+	if user == "" {
+		return s.tracing.Error(span, errors.New("username is empty"))
+	}
+
+	hash, err := hashPassword(pass)
+	if err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	if err = s.queries.CreateAdmin(ctx, database.CreateAdminParams{
+		Username:     user,
+		PasswordHash: hash,
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("CreateAdmin: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) ResetPassword(ctx context.Context, user, pass string) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "reset_password")
+	defer span.End()
+
+	hash, err := hashPassword(pass)
+	if err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	affected, err := s.queries.UpdateAdminPassword(ctx, database.UpdateAdminPasswordParams{
+		Username:     strings.TrimSpace(user),
+		PasswordHash: hash,
+	})
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("UpdateAdminPassword: %w", err))
+	}
+
+	if affected == 0 {
+		return s.tracing.Error(span, fmt.Errorf("admin %s not found", user))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package capacity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"shantaram/app/service/hours"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/telemetry"
+	"time"
+
+	"github.com/samber/do"
+	"github.com/samber/oops"
+)
+
+var serviceName = "capacity"
+
+// maxPickupAhead limits how far in advance a pickup slot can be booked
+var maxPickupAhead = 48 * time.Hour
+
+// defaultSlotsWindow is used by GetSlots when no range is requested
+var defaultSlotsWindow = 4 * time.Hour
+
+type Slot struct {
+	Start  time.Time
+	End    time.Time
+	Orders int
+	Items  int
+	Full   bool
+}
+
+type Service struct {
+	cfg          *config.Config
+	queries      *database.Queries
+	hoursService *hours.Service
+	tracing      *telemetry.Tracing
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		cfg:          do.MustInvoke[*config.Config](di),
+		queries:      do.MustInvoke[*database.Queries](di),
+		hoursService: do.MustInvoke[*hours.Service](di),
+		tracing:      do.MustInvoke[*telemetry.Tracing](di),
+	}, nil
+}
+
+func slotDuration(params database.Param) time.Duration {
+	if params.SlotMinutes <= 0 {
+		return 15 * time.Minute
+	}
+
+	return time.Duration(params.SlotMinutes) * time.Minute
+}
+
+// localMidnight returns the start of the local day t belongs to
+func (s *Service) localMidnight(t time.Time) time.Time {
+	local := t.In(s.cfg.Location)
+
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, s.cfg.Location)
+}
+
+// slotStart returns the start of the slot t belongs to in UTC, slots are counted from the local midnight
+// so that they start at the same wall clock times in any timezone
+func (s *Service) slotStart(t time.Time, slot time.Duration) time.Time {
+	midnight := s.localMidnight(t)
+
+	return midnight.Add(t.Sub(midnight).Truncate(slot)).UTC()
+}
+
+// slotEnd returns the end of the slot starting at start, the last slot of a day ends at midnight
+// in case a daylight saving change makes the day shorter or longer than a whole number of slots
+func (s *Service) slotEnd(start time.Time, slot time.Duration) time.Time {
+	midnight := s.localMidnight(start)
+	nextMidnight := time.Date(midnight.Year(), midnight.Month(), midnight.Day()+1, 0, 0, 0, 0, s.cfg.Location)
+
+	end := start.Add(slot)
+	if end.After(nextMidnight) {
+		end = nextMidnight
+	}
+
+	return end.UTC()
+}
+
+// ResolveSlot returns the start of the slot the order belongs to.
+// Orders without a requested pickup time go to the current slot.
+func (s *Service) ResolveSlot(params database.Param, pickupAt *time.Time) (time.Time, error) {
+	slot := slotDuration(params)
+	now := time.Now().UTC()
+	current := s.slotStart(now, slot)
+
+	if pickupAt == nil {
+		return current, nil
+	}
+
+	requested := pickupAt.UTC()
+
+	if !s.slotStart(requested, slot).Equal(requested) {
+		return time.Time{}, oops.With("status_code", http.StatusBadRequest).Errorf("pickup time must be aligned to %d minute slots", params.SlotMinutes)
+	}
+
+	if requested.Before(current) {
+		return time.Time{}, oops.With("status_code", http.StatusBadRequest).New("pickup time is in the past")
+	}
+
+	if requested.Sub(now) > maxPickupAhead {
+		return time.Time{}, oops.With("status_code", http.StatusBadRequest).New("pickup time is too far ahead")
+	}
+
+	return requested, nil
+}
+
+// CheckSlot must be called inside the order creation transaction,
+// it serializes concurrent checkouts so that a slot can't be overbooked.
+func (s *Service) CheckSlot(ctx context.Context, qtx *database.Queries, pickupAt *time.Time, items int) (time.Time, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "check_slot")
+	defer span.End()
+
+	params, err := qtx.GetParams(ctx)
+	if err != nil {
+		return time.Time{}, s.tracing.Error(span, fmt.Errorf("GetParams: %w", err))
+	}
+
+	if params.OrderingPaused {
+		return time.Time{}, s.tracing.Error(span, oops.With("status_code", http.StatusForbidden).New("online ordering is paused"))
+	}
+
+	start, err := s.ResolveSlot(params, pickupAt)
+	if err != nil {
+		return time.Time{}, s.tracing.Error(span, err)
+	}
+
+	if params.SlotMaxOrders == nil && params.SlotMaxItems == nil {
+		s.tracing.Success(span)
+		return start, nil
+	}
+
+	if err = qtx.LockSlots(ctx); err != nil {
+		return time.Time{}, s.tracing.Error(span, fmt.Errorf("LockSlots: %w", err))
+	}
+
+	load, err := qtx.GetSlotLoad(ctx, database.GetSlotLoadParams{
+		Since: start,
+		Until: s.slotEnd(start, slotDuration(params)),
+	})
+	if err != nil {
+		return time.Time{}, s.tracing.Error(span, fmt.Errorf("GetSlotLoad: %w", err))
+	}
+
+	var orders, slotItems int
+	for _, row := range load {
+		orders += int(row.Orders)
+		slotItems += int(row.Items)
+	}
+
+	if params.SlotMaxOrders != nil && orders+1 > int(*params.SlotMaxOrders) {
+		return time.Time{}, s.tracing.Error(span, oops.With("status_code", http.StatusConflict).New("slot is full"))
+	}
+
+	if params.SlotMaxItems != nil && slotItems+items > int(*params.SlotMaxItems) {
+		return time.Time{}, s.tracing.Error(span, oops.With("status_code", http.StatusConflict).New("slot is full"))
+	}
+
+	s.tracing.Success(span)
+
+	return start, nil
+}
+
+// GetSlots returns slots in [from, to) along with their current load
+func (s *Service) GetSlots(ctx context.Context, from, to *time.Time) (database.Param, []Slot, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_slots")
+	defer span.End()
+
+	params, err := s.queries.GetParams(ctx)
+	if err != nil {
+		return database.Param{}, nil, s.tracing.Error(span, fmt.Errorf("GetParams: %w", err))
+	}
+
+	slot := slotDuration(params)
+	now := time.Now().UTC()
+
+	start := s.slotStart(now, slot)
+	if from != nil && from.UTC().After(start) {
+		start = s.slotStart(*from, slot)
+	}
+
+	end := start.Add(defaultSlotsWindow)
+	if to != nil {
+		end = to.UTC()
+	}
+
+	if limit := now.Add(maxPickupAhead); end.After(limit) {
+		end = limit
+	}
+
+	if !end.After(start) {
+		return params, []Slot{}, nil
+	}
+
+	load, err := s.queries.GetSlotLoad(ctx, database.GetSlotLoadParams{
+		Since: start,
+		Until: end,
+	})
+	if err != nil {
+		return database.Param{}, nil, s.tracing.Error(span, fmt.Errorf("GetSlotLoad: %w", err))
+	}
+
+	schedule, err := s.hoursService.GetSchedule(ctx)
+	if err != nil {
+		return database.Param{}, nil, s.tracing.Error(span, fmt.Errorf("GetSchedule: %w", err))
+	}
+
+	loadMap := make(map[time.Time]database.GetSlotLoadRow, len(load))
+	for _, row := range load {
+		key := s.slotStart(row.PickupAt.UTC(), slot)
+		prev := loadMap[key]
+		prev.Orders += row.Orders
+		prev.Items += row.Items
+		loadMap[key] = prev
+	}
+
+	var result []Slot
+
+	for cur := start; cur.Before(end); cur = s.slotEnd(cur, slot) {
+		row := loadMap[cur]
+
+		full := params.OrderingPaused || !schedule.OpenAt(cur) ||
+			(params.SlotMaxOrders != nil && row.Orders >= *params.SlotMaxOrders) ||
+			(params.SlotMaxItems != nil && row.Items >= *params.SlotMaxItems)
+
+		result = append(result, Slot{
+			Start:  cur,
+			End:    s.slotEnd(cur, slot),
+			Orders: int(row.Orders),
+			Items:  int(row.Items),
+			Full:   full,
+		})
+	}
+
+	s.tracing.Success(span)
+
+	return params, result, nil
+}
@@ -0,0 +1,130 @@
+package capacity
+
+import (
+	"net/http"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"testing"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+func newTestService(t *testing.T, timezone string) *Service {
+	t.Helper()
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+
+	cfg := &config.Config{} //nolint:exhaustruct
+	cfg.Location = location
+
+	return &Service{cfg: cfg, queries: nil, hoursService: nil, tracing: nil}
+}
+
+func TestSlotBounds(t *testing.T) {
+	tests := []struct {
+		name      string
+		timezone  string
+		slot      time.Duration
+		at        string
+		wantStart string
+		wantEnd   string
+	}{
+		{
+			name:     "two hour slots start at even local hours",
+			timezone: "Europe/Moscow", slot: 2 * time.Hour,
+			at: "2026-03-10T10:30:00+03:00", wantStart: "2026-03-10T10:00:00+03:00", wantEnd: "2026-03-10T12:00:00+03:00",
+		},
+		{
+			name:     "hour slots in a half hour timezone",
+			timezone: "Asia/Kolkata", slot: time.Hour,
+			at: "2026-03-10T12:10:00+05:30", wantStart: "2026-03-10T12:00:00+05:30", wantEnd: "2026-03-10T13:00:00+05:30",
+		},
+		{
+			name:     "quarter hour slots",
+			timezone: "Asia/Kolkata", slot: 15 * time.Minute,
+			at: "2026-03-10T12:44:59+05:30", wantStart: "2026-03-10T12:30:00+05:30", wantEnd: "2026-03-10T12:45:00+05:30",
+		},
+		{
+			name:     "slot start stays put",
+			timezone: "Europe/Moscow", slot: 3 * time.Hour,
+			at: "2026-03-10T21:00:00+03:00", wantStart: "2026-03-10T21:00:00+03:00", wantEnd: "2026-03-11T00:00:00+03:00",
+		},
+		{
+			name:     "just after midnight",
+			timezone: "Europe/Moscow", slot: 4 * time.Hour,
+			at: "2026-03-11T00:01:00+03:00", wantStart: "2026-03-11T00:00:00+03:00", wantEnd: "2026-03-11T04:00:00+03:00",
+		},
+		{
+			name:     "short day ends at midnight",
+			timezone: "Europe/Berlin", slot: 4 * time.Hour,
+			// clocks move forward at 02:00, the day has 23 hours
+			at: "2026-03-29T22:30:00+02:00", wantStart: "2026-03-29T21:00:00+02:00", wantEnd: "2026-03-30T00:00:00+02:00",
+		},
+		{
+			name:     "long day ends at midnight",
+			timezone: "Europe/Berlin", slot: 4 * time.Hour,
+			// clocks move back at 03:00, the day has 25 hours
+			at: "2026-10-25T23:30:00+01:00", wantStart: "2026-10-25T23:00:00+01:00", wantEnd: "2026-10-26T00:00:00+01:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(t, tt.timezone)
+
+			at, _ := time.Parse(time.RFC3339, tt.at)
+			wantStart, _ := time.Parse(time.RFC3339, tt.wantStart)
+			wantEnd, _ := time.Parse(time.RFC3339, tt.wantEnd)
+
+			start := s.slotStart(at, tt.slot)
+			if !start.Equal(wantStart) || start.Location() != time.UTC {
+				t.Fatalf("slotStart = %s, want %s in UTC", start, wantStart)
+			}
+
+			if end := s.slotEnd(start, tt.slot); !end.Equal(wantEnd) {
+				t.Fatalf("slotEnd = %s, want %s", end, wantEnd)
+			}
+		})
+	}
+}
+
+func TestResolveSlotAlignment(t *testing.T) {
+	s := newTestService(t, "Europe/Moscow")
+	params := database.Param{SlotMinutes: 120} //nolint:exhaustruct
+
+	// the next even local hour is a slot start, an odd one is not
+	midnight := s.localMidnight(time.Now()).AddDate(0, 0, 1)
+
+	tests := []struct {
+		name     string
+		pickupAt time.Time
+		aligned  bool
+	}{
+		{name: "even local hour", pickupAt: midnight.Add(10 * time.Hour), aligned: true},
+		{name: "odd local hour", pickupAt: midnight.Add(11 * time.Hour), aligned: false},
+		{name: "half past", pickupAt: midnight.Add(10*time.Hour + 30*time.Minute), aligned: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slot, err := s.ResolveSlot(params, &tt.pickupAt)
+
+			if tt.aligned {
+				if err != nil || !slot.Equal(tt.pickupAt) {
+					t.Fatalf("ResolveSlot = %s, %v, want %s", slot, err, tt.pickupAt)
+				}
+
+				return
+			}
+
+			oopsErr, ok := oops.AsOops(err)
+			if !ok || oopsErr.Context()["status_code"] != http.StatusBadRequest {
+				t.Fatalf("ResolveSlot = %s, %v, want a bad request", slot, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package connection
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"shantaram/app/service/hours"
+	"shantaram/app/service/telegram"
+	"shantaram/pkg/config"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rofleksey/meg"
+	"github.com/samber/do"
+	"github.com/samber/oops"
+)
+
+type Connection struct {
+	ID        uuid.UUID `exhaustruct:"optional"`
+	Transport string
+	Channels  []string
+	User      string
+	IP        string
+	UserAgent string
+	Connected time.Time `exhaustruct:"optional"`
+	LastPing  time.Time `exhaustruct:"optional"`
+
+	kick func() `exhaustruct:"optional"`
+}
+
+func (c *Connection) IsAdmin() bool {
+	return slices.Contains(c.Channels, "admin")
+}
+
+type Service struct {
+	configReloader  *config.Reloader
+	hoursService    *hours.Service
+	telegramService *telegram.Service
+
+	mu           sync.RWMutex
+	connections  map[uuid.UUID]*Connection
+	lastAdminAt  time.Time
+	adminAlerted bool
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		configReloader:  do.MustInvoke[*config.Reloader](di),
+		hoursService:    do.MustInvoke[*hours.Service](di),
+		telegramService: do.MustInvoke[*telegram.Service](di),
+		connections:     make(map[uuid.UUID]*Connection),
+		lastAdminAt:     time.Now(),
+	}, nil
+}
+
+// Register adds a connection to the registry, kick is called to force it to disconnect
+func (s *Service) Register(conn Connection, kick func()) uuid.UUID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	conn.ID = uuid.New()
+	conn.Connected = time.Now()
+	conn.LastPing = conn.Connected
+	conn.kick = kick
+
+	s.connections[conn.ID] = &conn
+
+	if conn.IsAdmin() {
+		s.lastAdminAt = conn.Connected
+		s.adminAlerted = false
+	}
+
+	return conn.ID
+}
+
+func (s *Service) Touch(id uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if conn, ok := s.connections[id]; ok {
+		conn.LastPing = time.Now()
+	}
+}
+
+func (s *Service) Unregister(id uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	conn, ok := s.connections[id]
+	if !ok {
+		return
+	}
+
+	if conn.IsAdmin() {
+		s.lastAdminAt = time.Now()
+	}
+
+	delete(s.connections, id)
+}
+
+func (s *Service) List() []Connection {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]Connection, 0, len(s.connections))
+	for _, conn := range s.connections {
+		result = append(result, *conn)
+	}
+
+	slices.SortFunc(result, func(a, b Connection) int {
+		return a.Connected.Compare(b.Connected)
+	})
+
+	return result
+}
+
+func (s *Service) Kick(id uuid.UUID) error {
+	s.mu.RLock()
+	conn, ok := s.connections[id]
+	s.mu.RUnlock()
+
+	if !ok {
+		return oops.With("status_code", http.StatusNotFound).Errorf("connection not found")
+	}
+
+	conn.kick()
+
+	slog.Info("Connection kicked",
+		slog.String("id", id.String()),
+		slog.String("ip", conn.IP),
+	)
+
+	return nil
+}
+
+// RunAdminPresenceAlert keeps running when the alert is disabled, so that it can be enabled by a config reload
+func (s *Service) RunAdminPresenceAlert(ctx context.Context) {
+	meg.RunTicker(ctx, time.Minute, func() {
+		if err := s.checkAdminPresence(ctx); err != nil {
+			slog.Error("checkAdminPresence error",
+				slog.Any("error", err),
+			)
+		}
+	})
+}
+
+// checkAdminPresence alerts only while ordering is open by the opening hours, closures included
+func (s *Service) checkAdminPresence(ctx context.Context) error {
+	alert := s.configReloader.Runtime().AdminAlert
+	if alert.After <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	schedule, err := s.hoursService.GetSchedule(ctx)
+	if err != nil {
+		return fmt.Errorf("GetSchedule: %w", err)
+	}
+
+	open := schedule.OpenAt(now)
+
+	s.mu.Lock()
+
+	hasAdmin := false
+	for _, conn := range s.connections {
+		if conn.IsAdmin() {
+			hasAdmin = true
+			break
+		}
+	}
+
+	if hasAdmin || !open {
+		s.lastAdminAt = now
+		s.adminAlerted = false
+		s.mu.Unlock()
+
+		return nil
+	}
+
+	absent := now.Sub(s.lastAdminAt)
+	if s.adminAlerted || absent < alert.After {
+		s.mu.Unlock()
+
+		return nil
+	}
+
+	s.adminAlerted = true
+	s.mu.Unlock()
+
+	slog.Warn("No admin connections",
+		slog.Duration("absent", absent),
+	)
+
+	go s.telegramService.Notify(fmt.Sprintf("Внимание: админка не подключена уже %d мин.", int(absent.Minutes())))
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package customer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rofleksey/meg"
+	"github.com/samber/oops"
+)
+
+// Orders returns a page of the customer orders, newest first.
+// A customer has few orders, so they are always counted
+func (s *Service) Orders(ctx context.Context, id uuid.UUID, params api.GetCustomerOrdersParams) ([]database.Order, *int64, string, error) {
+	orders, totalCount, next, err := s.orderService.SearchOrders(ctx, api.GetOrdersParams{
+		Cursor:     params.Cursor,
+		Limit:      params.Limit,
+		Sort:       nil,
+		Status:     nil,
+		Seen:       nil,
+		From:       nil,
+		To:         nil,
+		TableId:    nil,
+		Q:          nil,
+		ProductId:  nil,
+		MinTotal:   nil,
+		MaxTotal:   nil,
+		CustomerId: &id,
+		WithTotal:  meg.ToPtr(true),
+	})
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("SearchOrders: %w", err)
+	}
+
+	return orders, totalCount, next, nil
+}
+
+// Reorder places a new order with the items and combo choices of a past one.
+// Prices, availability and opening hours are checked again as for any new order,
+// which is linked to the customer from the request token.
+func (s *Service) Reorder(ctx context.Context, id, orderID uuid.UUID, req *api.ReorderRequest) (database.Order, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "reorder")
+	defer span.End()
+
+	past, err := s.queries.GetOrderByID(ctx, orderID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("GetOrderByID: %w", err))
+	}
+	if err != nil || past.CustomerID == nil || *past.CustomerID != id {
+		return database.Order{}, s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).Errorf("order not found"))
+	}
+
+	customer, err := s.queries.GetCustomerByID(ctx, id)
+	if err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("GetCustomerByID: %w", err))
+	}
+
+	newOrder := api.NewOrderRequest{
+		Comment:   nil,
+		Id:        uuid.New(),
+		Items:     make([]api.NewOrderItem, 0, len(past.Items)),
+		Name:      past.ClientName,
+		PickupAt:  nil,
+		PromoCode: nil,
+		Points:    nil,
+	}
+	if customer.Name != nil {
+		newOrder.Name = *customer.Name
+	}
+	if req != nil {
+		newOrder.Comment = req.Comment
+		newOrder.PickupAt = req.PickupAt
+	}
+
+	for _, item := range past.Items {
+		newItem := api.NewOrderItem{
+			Amount: item.Amount,
+			Id:     item.Id,
+		}
+
+		if item.Components != nil {
+			choices := make([]api.ComboChoice, 0, len(*item.Components))
+			for _, component := range *item.Components {
+				choices = append(choices, api.ComboChoice{
+					ProductId: component.Id,
+					SlotId:    component.SlotId,
+				})
+			}
+
+			newItem.Choices = &choices
+		}
+
+		newOrder.Items = append(newOrder.Items, newItem)
+	}
+
+	created, err := s.orderService.CreateOrder(ctx, &newOrder)
+	if err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("CreateOrder: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return created, nil
+}
@@ -0,0 +1,299 @@
+package customer
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"shantaram/app/service/order"
+	"shantaram/app/service/sms"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/telemetry"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/do"
+	"github.com/samber/oops"
+)
+
+var serviceName = "customer"
+
+// Audience marks customer tokens, they are signed with their own secret as well
+const Audience = "customer"
+
+// error codes returned to clients during login
+const (
+	CodeInvalidPhone = "invalid_phone"
+	CodeOTPTooSoon   = "otp_too_soon"
+	CodeOTPInvalid   = "otp_invalid"
+)
+
+const (
+	otpTTL         = 5 * time.Minute
+	otpResendAfter = time.Minute
+	otpMaxAttempts = 5
+	tokenTTL       = 180 * 24 * time.Hour
+)
+
+// txBeginner is the connection pool, tests pass a stand-in
+type txBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+type Service struct {
+	cfg          *config.Config
+	dbConn       txBeginner
+	queries      *database.Queries
+	smsService   *sms.Service
+	orderService *order.Service
+	tracing      *telemetry.Tracing
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		cfg:          do.MustInvoke[*config.Config](di),
+		dbConn:       do.MustInvoke[*pgxpool.Pool](di),
+		queries:      do.MustInvoke[*database.Queries](di),
+		smsService:   do.MustInvoke[*sms.Service](di),
+		orderService: do.MustInvoke[*order.Service](di),
+		tracing:      do.MustInvoke[*telemetry.Tracing](di),
+	}, nil
+}
+
+func loginError(code string, status int, public string) error {
+	return oops.Code(code).
+		With("status_code", status).
+		Public(public).
+		New(code)
+}
+
+// NormalizePhone turns Russian numbers written as 8XXXXXXXXXX or XXXXXXXXXX into +7XXXXXXXXXX,
+// other numbers must be given with the country code
+func NormalizePhone(phone string) (string, error) {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+
+		return -1
+	}, phone)
+
+	switch {
+	case len(digits) == 10:
+		digits = "7" + digits
+	case len(digits) == 11 && digits[0] == '8':
+		digits = "7" + digits[1:]
+	}
+
+	if len(digits) < 11 || len(digits) > 15 {
+		return "", loginError(CodeInvalidPhone, http.StatusBadRequest, "Неверный номер телефона.")
+	}
+
+	return "+" + digits, nil
+}
+
+func (s *Service) hashCode(phone, code string) string {
+	mac := hmac.New(sha256.New, []byte(s.cfg.JWT.CustomerSecret))
+	mac.Write([]byte(phone + ":" + code))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", fmt.Errorf("rand.Int: %w", err)
+	}
+
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// RequestCode sends a new one-time code, the previous one stops working
+func (s *Service) RequestCode(ctx context.Context, phone string) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "request_code")
+	defer span.End()
+
+	phone, err := NormalizePhone(phone)
+	if err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	now := time.Now().UTC()
+
+	otp, err := s.queries.GetCustomerOTP(ctx, phone)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return s.tracing.Error(span, fmt.Errorf("GetCustomerOTP: %w", err))
+	}
+	if err == nil && now.Sub(otp.Created) < otpResendAfter {
+		return s.tracing.Error(span, loginError(CodeOTPTooSoon, http.StatusTooManyRequests, "Код уже отправлен, повторить можно через минуту."))
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	if err = s.queries.UpsertCustomerOTP(ctx, database.UpsertCustomerOTPParams{
+		Phone:    phone,
+		CodeHash: s.hashCode(phone, code),
+		Expires:  now.Add(otpTTL),
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("UpsertCustomerOTP: %w", err))
+	}
+
+	if err = s.smsService.Send(ctx, phone, "Код для входа: "+code); err != nil {
+		// let the customer retry right away
+		_ = s.queries.DeleteCustomerOTP(ctx, phone)
+
+		return s.tracing.Error(span, fmt.Errorf("Send: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+// Login checks the one-time code and returns a customer token, the account is created on first login
+func (s *Service) Login(ctx context.Context, phone, code string) (string, database.Customer, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "login")
+	defer span.End()
+
+	phone, err := NormalizePhone(phone)
+	if err != nil {
+		return "", database.Customer{}, s.tracing.Error(span, err)
+	}
+
+	if err = s.checkCode(ctx, phone, strings.TrimSpace(code)); err != nil {
+		return "", database.Customer{}, s.tracing.Error(span, err)
+	}
+
+	customer, err := s.queries.UpsertCustomerByPhone(ctx, database.UpsertCustomerByPhoneParams{
+		ID:    uuid.New(),
+		Phone: phone,
+	})
+	if err != nil {
+		return "", database.Customer{}, s.tracing.Error(span, fmt.Errorf("UpsertCustomerByPhone: %w", err))
+	}
+
+	claims := jwt.MapClaims{
+		"exp": time.Now().Add(tokenTTL).Unix(),
+		"sub": customer.ID.String(),
+		"aud": Audience,
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.cfg.JWT.CustomerSecret))
+	if err != nil {
+		return "", database.Customer{}, s.tracing.Error(span, fmt.Errorf("failed to sign token: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return token, customer, nil
+}
+
+// checkCode consumes the code on success, a code is dropped after too many wrong attempts.
+// The attempt is counted and the code deleted within one transaction, the row lock
+// keeps parallel requests from guessing past the limit or using the code twice.
+func (s *Service) checkCode(ctx context.Context, phone, code string) error {
+	tx, err := s.dbConn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("Begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	qtx := s.queries.WithTx(tx)
+
+	codeHash, err := qtx.UseCustomerOTPAttempt(ctx, database.UseCustomerOTPAttemptParams{
+		Phone:       phone,
+		MaxAttempts: otpMaxAttempts,
+		Now:         time.Now().UTC(),
+	})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("UseCustomerOTPAttempt: %w", err)
+		}
+
+		// expired or out of attempts
+		if err = qtx.DeleteCustomerOTP(ctx, phone); err != nil {
+			return fmt.Errorf("DeleteCustomerOTP: %w", err)
+		}
+
+		if err = tx.Commit(ctx); err != nil {
+			return fmt.Errorf("Commit: %w", err)
+		}
+
+		return loginError(CodeOTPInvalid, http.StatusBadRequest, "Код устарел, запросите новый.")
+	}
+
+	matches := subtle.ConstantTimeCompare([]byte(codeHash), []byte(s.hashCode(phone, code))) == 1
+	if matches {
+		if err = qtx.DeleteCustomerOTP(ctx, phone); err != nil {
+			return fmt.Errorf("DeleteCustomerOTP: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("Commit: %w", err)
+	}
+
+	if !matches {
+		return loginError(CodeOTPInvalid, http.StatusBadRequest, "Неверный код.")
+	}
+
+	return nil
+}
+
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (database.Customer, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get")
+	defer span.End()
+
+	customer, err := s.queries.GetCustomerByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return database.Customer{}, s.tracing.Error(span, oops.With("status_code", http.StatusUnauthorized).Errorf("customer not found"))
+		}
+
+		return database.Customer{}, s.tracing.Error(span, fmt.Errorf("GetCustomerByID: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return customer, nil
+}
+
+func (s *Service) UpdateName(ctx context.Context, id uuid.UUID, name string) (database.Customer, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "update_name")
+	defer span.End()
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return database.Customer{}, s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("name is empty"))
+	}
+
+	customer, err := s.queries.UpdateCustomerName(ctx, database.UpdateCustomerNameParams{
+		ID:   id,
+		Name: &name,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return database.Customer{}, s.tracing.Error(span, oops.With("status_code", http.StatusUnauthorized).Errorf("customer not found"))
+		}
+
+		return database.Customer{}, s.tracing.Error(span, fmt.Errorf("UpdateCustomerName: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return customer, nil
+}
@@ -0,0 +1,397 @@
+package customer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"shantaram/app/service/sms"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/telemetry"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/samber/do"
+	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// fakeDB keeps customers and codes in memory and answers the queries of the login flow.
+// A transaction holds txMu until it ends, like the row lock taken by the attempt UPDATE.
+type fakeDB struct {
+	txMu      sync.Mutex
+	mu        sync.Mutex
+	otps      map[string]database.CustomerOtp
+	customers map[string]database.Customer
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{
+		otps:      make(map[string]database.CustomerOtp),
+		customers: make(map[string]database.Customer),
+	}
+}
+
+type fakeRow struct {
+	values []any
+	err    error
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(reflect.ValueOf(r.values[i]))
+	}
+
+	return nil
+}
+
+func queryName(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) < 3 {
+		return ""
+	}
+
+	return fields[2]
+}
+
+func (db *fakeDB) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	switch queryName(sql) {
+	case "UpsertCustomerOTP":
+		phone := args[0].(string)
+		db.otps[phone] = database.CustomerOtp{
+			Phone:    phone,
+			CodeHash: args[1].(string),
+			Attempts: 0,
+			Expires:  args[2].(time.Time),
+			Created:  time.Now().UTC(),
+		}
+	case "DeleteCustomerOTP":
+		delete(db.otps, args[0].(string))
+	default:
+		return pgconn.CommandTag{}, errors.New("unexpected exec " + queryName(sql))
+	}
+
+	return pgconn.CommandTag{}, nil
+}
+
+func (db *fakeDB) Query(_ context.Context, sql string, _ ...any) (pgx.Rows, error) {
+	return nil, errors.New("unexpected query " + queryName(sql))
+}
+
+func (db *fakeDB) QueryRow(_ context.Context, sql string, args ...any) pgx.Row {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	switch queryName(sql) {
+	case "GetCustomerOTP":
+		otp, ok := db.otps[args[0].(string)]
+		if !ok {
+			return fakeRow{err: pgx.ErrNoRows}
+		}
+
+		return fakeRow{values: []any{otp.Phone, otp.CodeHash, otp.Attempts, otp.Expires, otp.Created}}
+	case "UseCustomerOTPAttempt":
+		phone := args[0].(string)
+
+		otp, ok := db.otps[phone]
+		if !ok || otp.Attempts >= args[1].(int32) || !otp.Expires.After(args[2].(time.Time)) {
+			return fakeRow{err: pgx.ErrNoRows}
+		}
+
+		otp.Attempts++
+		db.otps[phone] = otp
+
+		return fakeRow{values: []any{otp.CodeHash}}
+	case "UpsertCustomerByPhone":
+		phone := args[1].(string)
+
+		customer, ok := db.customers[phone]
+		if !ok {
+			customer = database.Customer{
+				ID:      args[0].(uuid.UUID),
+				Phone:   phone,
+				Name:    nil,
+				Created: time.Now().UTC(),
+				Updated: time.Now().UTC(),
+			}
+			db.customers[phone] = customer
+		}
+
+		return fakeRow{values: []any{customer.ID, customer.Phone, customer.Name, customer.Created, customer.Updated}}
+	}
+
+	return fakeRow{err: errors.New("unexpected query " + queryName(sql))}
+}
+
+func (db *fakeDB) Begin(context.Context) (pgx.Tx, error) {
+	db.txMu.Lock()
+
+	return &fakeTx{db: db}, nil
+}
+
+// fakeTx applies writes right away, the tests only need the lock
+type fakeTx struct {
+	pgx.Tx
+
+	db   *fakeDB
+	once sync.Once
+}
+
+func (tx *fakeTx) end() {
+	tx.once.Do(tx.db.txMu.Unlock)
+}
+
+func (tx *fakeTx) Commit(context.Context) error {
+	tx.end()
+
+	return nil
+}
+
+func (tx *fakeTx) Rollback(context.Context) error {
+	tx.end()
+
+	return nil
+}
+
+func (tx *fakeTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return tx.db.Exec(ctx, sql, args...)
+}
+
+func (tx *fakeTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return tx.db.QueryRow(ctx, sql, args...)
+}
+
+func newTestService(t *testing.T) (*Service, *fakeDB, *sms.LogProvider) {
+	t.Helper()
+
+	cfg := &config.Config{} //nolint:exhaustruct
+	cfg.JWT.CustomerSecret = "customer-secret"
+	cfg.SMS.Provider = "log"
+
+	di := do.New()
+	do.ProvideValue(di, cfg)
+	do.ProvideValue(di, telemetry.NewTracing(cfg, noop.NewTracerProvider().Tracer("test")))
+
+	smsService, err := sms.New(di)
+	if err != nil {
+		t.Fatalf("sms.New: %v", err)
+	}
+
+	provider, ok := smsService.Provider().(*sms.LogProvider)
+	if !ok {
+		t.Fatal("the log provider is not used")
+	}
+
+	db := newFakeDB()
+
+	return &Service{
+		cfg:          cfg,
+		dbConn:       db,
+		queries:      database.New(db),
+		smsService:   smsService,
+		orderService: nil,
+		tracing:      do.MustInvoke[*telemetry.Tracing](di),
+	}, db, provider
+}
+
+func requestCode(t *testing.T, s *Service, provider *sms.LogProvider, phone string) string {
+	t.Helper()
+
+	if err := s.RequestCode(context.Background(), phone); err != nil {
+		t.Fatalf("RequestCode: %v", err)
+	}
+
+	normalized, err := NormalizePhone(phone)
+	if err != nil {
+		t.Fatalf("NormalizePhone: %v", err)
+	}
+
+	text, ok := provider.LastMessage(normalized)
+	if !ok {
+		t.Fatalf("no message was sent to %s", normalized)
+	}
+
+	code := strings.TrimPrefix(text, "Код для входа: ")
+	if len(code) != 6 {
+		t.Fatalf("unexpected message %q", text)
+	}
+
+	return code
+}
+
+func wrongCode(code string) string {
+	if code == "000000" {
+		return "111111"
+	}
+
+	return "000000"
+}
+
+func errorCode(err error) string {
+	if oopsErr, ok := oops.AsOops(err); ok {
+		return oopsErr.Code()
+	}
+
+	return ""
+}
+
+func TestLoginFlow(t *testing.T) {
+	s, _, provider := newTestService(t)
+	ctx := context.Background()
+
+	code := requestCode(t, s, provider, "8 (912) 345-67-89")
+
+	if err := s.RequestCode(ctx, "+7 912 345 67 89"); errorCode(err) != CodeOTPTooSoon {
+		t.Fatalf("second RequestCode = %v, want %s", err, CodeOTPTooSoon)
+	}
+
+	if _, _, err := s.Login(ctx, "89123456789", wrongCode(code)); errorCode(err) != CodeOTPInvalid {
+		t.Fatalf("Login with a wrong code = %v, want %s", err, CodeOTPInvalid)
+	}
+
+	token, customer, err := s.Login(ctx, "89123456789", " "+code+" ")
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	if customer.Phone != "+79123456789" {
+		t.Fatalf("phone = %s, want +79123456789", customer.Phone)
+	}
+
+	parsed, err := jwt.Parse(token, func(*jwt.Token) (any, error) {
+		return []byte(s.cfg.JWT.CustomerSecret), nil
+	}, jwt.WithAudience(Audience), jwt.WithValidMethods([]string{"HS256"}))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	if sub, _ := parsed.Claims.GetSubject(); sub != customer.ID.String() {
+		t.Fatalf("sub = %s, want %s", sub, customer.ID)
+	}
+
+	// the code works only once
+	if _, _, err = s.Login(ctx, "89123456789", code); errorCode(err) != CodeOTPInvalid {
+		t.Fatalf("second Login = %v, want %s", err, CodeOTPInvalid)
+	}
+}
+
+func TestLoginAttemptLimit(t *testing.T) {
+	s, db, provider := newTestService(t)
+	ctx := context.Background()
+
+	code := requestCode(t, s, provider, "+79120000000")
+
+	// parallel guesses from many clients must not get more attempts than allowed
+	var wg sync.WaitGroup
+
+	for range 3 * otpMaxAttempts {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, _, err := s.Login(ctx, "+79120000000", wrongCode(code)); errorCode(err) != CodeOTPInvalid {
+				t.Errorf("Login with a wrong code = %v, want %s", err, CodeOTPInvalid)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if _, _, err := s.Login(ctx, "+79120000000", code); errorCode(err) != CodeOTPInvalid {
+		t.Fatalf("Login after running out of attempts = %v, want %s", err, CodeOTPInvalid)
+	}
+
+	db.mu.Lock()
+	_, exists := db.otps["+79120000000"]
+	db.mu.Unlock()
+
+	if exists {
+		t.Fatal("the code was not dropped after running out of attempts")
+	}
+}
+
+func TestLoginParallelSameCode(t *testing.T) {
+	s, _, provider := newTestService(t)
+	ctx := context.Background()
+
+	code := requestCode(t, s, provider, "+79121111111")
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		successes int
+	)
+
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, _, err := s.Login(ctx, "+79121111111", code); err == nil {
+				mu.Lock()
+				successes++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if successes != 1 {
+		t.Fatalf("the code was accepted %d times, want once", successes)
+	}
+}
+
+func TestLoginExpiredCode(t *testing.T) {
+	s, db, provider := newTestService(t)
+
+	code := requestCode(t, s, provider, "+79122222222")
+
+	db.mu.Lock()
+	otp := db.otps["+79122222222"]
+	otp.Expires = time.Now().UTC().Add(-time.Second)
+	db.otps["+79122222222"] = otp
+	db.mu.Unlock()
+
+	if _, _, err := s.Login(context.Background(), "+79122222222", code); errorCode(err) != CodeOTPInvalid {
+		t.Fatalf("Login with an expired code = %v, want %s", err, CodeOTPInvalid)
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{in: "+7 (912) 345-67-89", want: "+79123456789", ok: true},
+		{in: "8 912 345 67 89", want: "+79123456789", ok: true},
+		{in: "9123456789", want: "+79123456789", ok: true},
+		{in: "+44 20 7946 0958", want: "+442079460958", ok: true},
+		{in: "12345", ok: false},
+		{in: "+1234567890123456", ok: false},
+		{in: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		got, err := NormalizePhone(tt.in)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("NormalizePhone(%q) = %q, %v", tt.in, got, err)
+		}
+	}
+}
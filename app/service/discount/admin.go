@@ -0,0 +1,308 @@
+package discount
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/service/hours"
+	"shantaram/pkg/database"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rofleksey/meg"
+	"github.com/samber/oops"
+)
+
+const uniqueViolation = "23505"
+
+func validateValue(kind api.DiscountKind, value int64, starts, ends *time.Time) error {
+	switch kind {
+	case api.DiscountKindPercent:
+		if value < 1 || value > 100 {
+			return oops.With("status_code", http.StatusBadRequest).New("percent must be between 1 and 100")
+		}
+	case api.DiscountKindFixed:
+		if value < 1 {
+			return oops.With("status_code", http.StatusBadRequest).New("fixed discount must be positive")
+		}
+	default:
+		return oops.With("status_code", http.StatusBadRequest).Errorf("invalid discount kind %q", kind)
+	}
+
+	if starts != nil && ends != nil && !ends.After(*starts) {
+		return oops.With("status_code", http.StatusBadRequest).New("ends must be after starts")
+	}
+
+	return nil
+}
+
+func toUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	return meg.ToPtr(t.UTC())
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
+
+func (s *Service) GetPromoCodes(ctx context.Context) ([]database.GetPromoCodesRow, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_promo_codes")
+	defer span.End()
+
+	codes, err := s.queries.GetPromoCodes(ctx)
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetPromoCodes: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return codes, nil
+}
+
+func (s *Service) AddPromoCode(ctx context.Context, req *api.AddPromoCodeRequest) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "add_promo_code")
+	defer span.End()
+
+	code := NormalizeCode(req.Code)
+	if code == "" {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("code is empty"))
+	}
+
+	if err := validateValue(req.Kind, req.Value, req.Starts, req.Ends); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	if err := s.queries.CreatePromoCode(ctx, database.CreatePromoCodeParams{
+		ID:                 req.Id,
+		Code:               code,
+		Kind:               req.Kind,
+		Value:              req.Value,
+		MinOrderTotal:      req.MinOrderTotal,
+		MaxUses:            meg.PtrIntToPtrInt32(req.MaxUses),
+		MaxUsesPerCustomer: meg.PtrIntToPtrInt32(req.MaxUsesPerCustomer),
+		Starts:             toUTC(req.Starts),
+		Ends:               toUTC(req.Ends),
+		Active:             req.Active,
+	}); err != nil {
+		if isUniqueViolation(err) {
+			return s.tracing.Error(span, oops.With("status_code", http.StatusConflict).New("promo code already exists"))
+		}
+
+		return s.tracing.Error(span, fmt.Errorf("CreatePromoCode: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) EditPromoCode(ctx context.Context, id uuid.UUID, req *api.EditPromoCodeRequest) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "edit_promo_code")
+	defer span.End()
+
+	code := NormalizeCode(req.Code)
+	if code == "" {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("code is empty"))
+	}
+
+	if err := validateValue(req.Kind, req.Value, req.Starts, req.Ends); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	affected, err := s.queries.UpdatePromoCode(ctx, database.UpdatePromoCodeParams{
+		ID:                 id,
+		Code:               code,
+		Kind:               req.Kind,
+		Value:              req.Value,
+		MinOrderTotal:      req.MinOrderTotal,
+		MaxUses:            meg.PtrIntToPtrInt32(req.MaxUses),
+		MaxUsesPerCustomer: meg.PtrIntToPtrInt32(req.MaxUsesPerCustomer),
+		Starts:             toUTC(req.Starts),
+		Ends:               toUTC(req.Ends),
+		Active:             req.Active,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return s.tracing.Error(span, oops.With("status_code", http.StatusConflict).New("promo code already exists"))
+		}
+
+		return s.tracing.Error(span, fmt.Errorf("UpdatePromoCode: %w", err))
+	}
+
+	if affected == 0 {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).New("promo code not found"))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) DeletePromoCode(ctx context.Context, id uuid.UUID) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "delete_promo_code")
+	defer span.End()
+
+	affected, err := s.queries.DeletePromoCode(ctx, id)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("DeletePromoCode: %w", err))
+	}
+
+	if affected == 0 {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).New("promo code not found"))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+// parseTimeWindow returns nil bounds when the rule applies all day
+func parseTimeWindow(from, to *string) (*int32, *int32, error) {
+	if from == nil && to == nil {
+		return nil, nil, nil
+	}
+
+	if from == nil || to == nil {
+		return nil, nil, oops.With("status_code", http.StatusBadRequest).New("both fromTime and toTime must be set")
+	}
+
+	fromMinute, err := hours.ParseMinute(strings.TrimSpace(*from))
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
+	toMinute, err := hours.ParseMinute(strings.TrimSpace(*to))
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
+	if fromMinute == 24*60 {
+		return nil, nil, oops.With("status_code", http.StatusBadRequest).New("fromTime must be before 24:00")
+	}
+
+	if fromMinute == toMinute {
+		return nil, nil, oops.With("status_code", http.StatusBadRequest).New("fromTime and toTime must differ")
+	}
+
+	return &fromMinute, &toMinute, nil
+}
+
+func (s *Service) GetRules(ctx context.Context) ([]database.DiscountRule, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_rules")
+	defer span.End()
+
+	rules, err := s.queries.GetDiscountRules(ctx)
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetDiscountRules: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return rules, nil
+}
+
+func (s *Service) AddRule(ctx context.Context, req *api.AddDiscountRuleRequest) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "add_rule")
+	defer span.End()
+
+	if strings.TrimSpace(req.Title) == "" {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("title is empty"))
+	}
+
+	if err := validateValue(req.Kind, req.Value, req.Starts, req.Ends); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	fromMinute, toMinute, err := parseTimeWindow(req.FromTime, req.ToTime)
+	if err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	if err = s.queries.CreateDiscountRule(ctx, database.CreateDiscountRuleParams{
+		ID:         req.Id,
+		Title:      strings.TrimSpace(req.Title),
+		Kind:       req.Kind,
+		Value:      req.Value,
+		GroupID:    req.GroupId,
+		FromMinute: fromMinute,
+		ToMinute:   toMinute,
+		Starts:     toUTC(req.Starts),
+		Ends:       toUTC(req.Ends),
+		Active:     req.Active,
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("CreateDiscountRule: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) EditRule(ctx context.Context, id uuid.UUID, req *api.EditDiscountRuleRequest) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "edit_rule")
+	defer span.End()
+
+	if strings.TrimSpace(req.Title) == "" {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("title is empty"))
+	}
+
+	if err := validateValue(req.Kind, req.Value, req.Starts, req.Ends); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	fromMinute, toMinute, err := parseTimeWindow(req.FromTime, req.ToTime)
+	if err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	affected, err := s.queries.UpdateDiscountRule(ctx, database.UpdateDiscountRuleParams{
+		ID:         id,
+		Title:      strings.TrimSpace(req.Title),
+		Kind:       req.Kind,
+		Value:      req.Value,
+		GroupID:    req.GroupId,
+		FromMinute: fromMinute,
+		ToMinute:   toMinute,
+		Starts:     toUTC(req.Starts),
+		Ends:       toUTC(req.Ends),
+		Active:     req.Active,
+	})
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("UpdateDiscountRule: %w", err))
+	}
+
+	if affected == 0 {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).New("discount rule not found"))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "delete_rule")
+	defer span.End()
+
+	affected, err := s.queries.DeleteDiscountRule(ctx, id)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("DeleteDiscountRule: %w", err))
+	}
+
+	if affected == 0 {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).New("discount rule not found"))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
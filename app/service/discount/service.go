@@ -0,0 +1,374 @@
+package discount
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/money"
+	"shantaram/pkg/telemetry"
+	"shantaram/pkg/util"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/do"
+	"github.com/samber/oops"
+)
+
+var serviceName = "discount"
+
+// error codes returned to clients when a promo code can't be applied
+const (
+	CodePromoNotFound      = "promo_not_found"
+	CodePromoNotStarted    = "promo_not_started"
+	CodePromoExpired       = "promo_expired"
+	CodePromoMinTotal      = "promo_min_total"
+	CodePromoExhausted     = "promo_exhausted"
+	CodePromoCustomerLimit = "promo_customer_limit"
+)
+
+// Result is an order priced with all applicable discounts
+type Result struct {
+	Subtotal  money.Kopecks
+	Discount  money.Kopecks
+	Total     money.Kopecks
+	Discounts []api.OrderDiscount
+}
+
+type Service struct {
+	cfg     *config.Config
+	queries *database.Queries
+	tracing *telemetry.Tracing
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		cfg:     do.MustInvoke[*config.Config](di),
+		queries: do.MustInvoke[*database.Queries](di),
+		tracing: do.MustInvoke[*telemetry.Tracing](di),
+	}, nil
+}
+
+func promoError(code, public string) error {
+	return oops.Code(code).
+		With("status_code", http.StatusBadRequest).
+		Public(public).
+		New(code)
+}
+
+// NormalizeCode makes promo codes case insensitive
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// CustomerKey identifies the customer for per-customer promo code limits,
+// guests are told apart by IP
+func CustomerKey(ctx context.Context) string {
+	if customerID := util.CustomerID(ctx); customerID != nil {
+		return "customer:" + customerID.String()
+	}
+
+	ip, _ := ctx.Value(util.IpContextKey).(string)
+
+	return "ip:" + ip
+}
+
+func amountOf(kind api.DiscountKind, value int64, base money.Kopecks) money.Kopecks {
+	if kind == api.DiscountKindPercent {
+		return base * money.Kopecks(value) / 100
+	}
+
+	return min(money.Kopecks(value), base)
+}
+
+// inTimeWindow checks the local time of day, a window with from > to passes midnight
+func inTimeWindow(rule database.DiscountRule, local time.Time) bool {
+	if rule.FromMinute == nil || rule.ToMinute == nil {
+		return true
+	}
+
+	minute := int32(local.Hour()*60 + local.Minute()) //nolint:gosec
+	from, to := *rule.FromMinute, *rule.ToMinute
+
+	if from < to {
+		return minute >= from && minute < to
+	}
+
+	return minute >= from || minute < to
+}
+
+// Apply prices the items, automatic rules go first and the promo code applies to what is left.
+// groups maps product ids to their product groups. qtx must be the order transaction
+// when the result is going to be stored, so that promo code limits can't be exceeded
+// by concurrent orders.
+func (s *Service) Apply(
+	ctx context.Context,
+	qtx *database.Queries,
+	items []api.OrderItem,
+	groups map[uuid.UUID]uuid.UUID,
+	promoCode *string,
+	customer string,
+) (Result, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "apply")
+	defer span.End()
+
+	now := time.Now()
+
+	result := Result{
+		Subtotal:  0,
+		Discount:  0,
+		Total:     0,
+		Discounts: []api.OrderDiscount{},
+	}
+
+	groupTotals := make(map[uuid.UUID]money.Kopecks)
+	for _, item := range items {
+		lineTotal := item.Price.Times(item.Amount)
+		result.Subtotal += lineTotal
+		groupTotals[groups[item.Id]] += lineTotal
+	}
+
+	rules, err := qtx.GetActiveDiscountRules(ctx, now.UTC())
+	if err != nil {
+		return Result{}, s.tracing.Error(span, fmt.Errorf("GetActiveDiscountRules: %w", err))
+	}
+
+	local := now.In(s.cfg.Location)
+
+	for _, rule := range rules {
+		if !inTimeWindow(rule, local) {
+			continue
+		}
+
+		base := result.Subtotal
+		if rule.GroupID != nil {
+			base = groupTotals[*rule.GroupID]
+		}
+
+		amount := min(amountOf(rule.Kind, rule.Value, base), result.Subtotal-result.Discount)
+		if amount <= 0 {
+			continue
+		}
+
+		result.Discount += amount
+		result.Discounts = append(result.Discounts, api.OrderDiscount{
+			Title:  rule.Title,
+			Amount: amount,
+			RuleId: &rule.ID,
+		})
+	}
+
+	if promoCode != nil && strings.TrimSpace(*promoCode) != "" {
+		discount, err := s.applyPromoCode(ctx, qtx, NormalizeCode(*promoCode), customer, now, result)
+		if err != nil {
+			return Result{}, s.tracing.Error(span, err)
+		}
+
+		if discount.Amount > 0 {
+			result.Discount += discount.Amount
+			result.Discounts = append(result.Discounts, discount)
+		}
+	}
+
+	result.Total = result.Subtotal - result.Discount
+
+	s.tracing.Success(span)
+
+	return result, nil
+}
+
+func (s *Service) applyPromoCode(
+	ctx context.Context,
+	qtx *database.Queries,
+	code string,
+	customer string,
+	now time.Time,
+	result Result,
+) (api.OrderDiscount, error) {
+	promo, err := qtx.GetPromoCodeByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.OrderDiscount{}, promoError(CodePromoNotFound, "Промокод не найден.")
+		}
+
+		return api.OrderDiscount{}, fmt.Errorf("GetPromoCodeByCode: %w", err)
+	}
+
+	if !promo.Active {
+		return api.OrderDiscount{}, promoError(CodePromoNotFound, "Промокод не найден.")
+	}
+
+	if promo.Starts != nil && now.Before(*promo.Starts) {
+		return api.OrderDiscount{}, promoError(CodePromoNotStarted, "Промокод ещё не действует.")
+	}
+
+	if promo.Ends != nil && !now.Before(*promo.Ends) {
+		return api.OrderDiscount{}, promoError(CodePromoExpired, "Срок действия промокода истёк.")
+	}
+
+	if promo.MinOrderTotal != nil && result.Subtotal < *promo.MinOrderTotal {
+		return api.OrderDiscount{}, promoError(CodePromoMinTotal, fmt.Sprintf("Промокод действует для заказов от %s ₽.", promo.MinOrderTotal.String()))
+	}
+
+	exceeded, err := exceededLimit(ctx, qtx, promo, customer)
+	if err != nil {
+		return api.OrderDiscount{}, err
+	}
+
+	switch exceeded {
+	case CodePromoExhausted:
+		return api.OrderDiscount{}, promoError(CodePromoExhausted, "Промокод больше недоступен.")
+	case CodePromoCustomerLimit:
+		return api.OrderDiscount{}, promoError(CodePromoCustomerLimit, "Вы уже использовали этот промокод.")
+	}
+
+	amount := amountOf(promo.Kind, promo.Value, result.Subtotal-result.Discount)
+
+	return api.OrderDiscount{
+		Title:       "Промокод " + promo.Code,
+		Amount:      amount,
+		PromoCode:   &promo.Code,
+		PromoCodeId: &promo.ID,
+	}, nil
+}
+
+// exceededLimit returns the code of the promo code limit that one more use would exceed, or an empty string
+func exceededLimit(ctx context.Context, qtx *database.Queries, promo database.PromoCode, customer string) (string, error) {
+	if promo.MaxUses == nil && promo.MaxUsesPerCustomer == nil {
+		return "", nil
+	}
+
+	// serializes concurrent orders with the same code until the transaction ends
+	if err := qtx.LockPromoCode(ctx, promo.ID); err != nil {
+		return "", fmt.Errorf("LockPromoCode: %w", err)
+	}
+
+	uses, err := qtx.CountPromoCodeUses(ctx, database.CountPromoCodeUsesParams{
+		Customer:    customer,
+		PromoCodeID: &promo.ID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("CountPromoCodeUses: %w", err)
+	}
+
+	if promo.MaxUses != nil && uses.Total >= *promo.MaxUses {
+		return CodePromoExhausted, nil
+	}
+
+	if promo.MaxUsesPerCustomer != nil && uses.Customer >= *promo.MaxUsesPerCustomer {
+		return CodePromoCustomerLimit, nil
+	}
+
+	return "", nil
+}
+
+// RecordUsages stores applied discounts for limits and reports, it must run in the order transaction.
+// Loyalty points are a payment rather than a discount and are skipped, the loyalty ledger keeps them
+func (s *Service) RecordUsages(ctx context.Context, qtx *database.Queries, orderID uuid.UUID, customer string, discounts []api.OrderDiscount) error {
+	for _, discount := range discounts {
+		if discount.Points != nil {
+			continue
+		}
+
+		if err := qtx.CreateDiscountUsage(ctx, database.CreateDiscountUsageParams{
+			OrderID:     orderID,
+			PromoCodeID: discount.PromoCodeId,
+			RuleID:      discount.RuleId,
+			Customer:    customer,
+			Title:       discount.Title,
+			Amount:      discount.Amount,
+		}); err != nil {
+			return fmt.Errorf("CreateDiscountUsage: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ReleaseUsages voids discount usages of a cancelled order, so that they no longer count
+// against promo code limits. It must run in the transaction that cancels the order.
+func (s *Service) ReleaseUsages(ctx context.Context, qtx *database.Queries, orderID uuid.UUID) error {
+	if err := qtx.SetDiscountUsagesVoided(ctx, database.SetDiscountUsagesVoidedParams{
+		OrderID: orderID,
+		Voided:  true,
+	}); err != nil {
+		return fmt.Errorf("SetDiscountUsagesVoided: %w", err)
+	}
+
+	return nil
+}
+
+// ReclaimUsages counts discount usages of a restored order again. Promo codes are rechecked,
+// a code used up while the order was cancelled makes the restore fail with 409.
+func (s *Service) ReclaimUsages(ctx context.Context, qtx *database.Queries, orderID uuid.UUID) error {
+	usages, err := qtx.GetDiscountUsagesByOrder(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("GetDiscountUsagesByOrder: %w", err)
+	}
+
+	for _, usage := range usages {
+		if !usage.Voided || usage.PromoCodeID == nil {
+			continue
+		}
+
+		promo, err := qtx.GetPromoCodeByID(ctx, *usage.PromoCodeID)
+		if err != nil {
+			return fmt.Errorf("GetPromoCodeByID: %w", err)
+		}
+
+		exceeded, err := exceededLimit(ctx, qtx, promo, usage.Customer)
+		if err != nil {
+			return err
+		}
+
+		if exceeded != "" {
+			return oops.Code(exceeded).
+				With("status_code", http.StatusConflict).
+				Errorf("promo code %s was used up while the order was cancelled", promo.Code)
+		}
+	}
+
+	if err = qtx.SetDiscountUsagesVoided(ctx, database.SetDiscountUsagesVoidedParams{
+		OrderID: orderID,
+		Voided:  false,
+	}); err != nil {
+		return fmt.Errorf("SetDiscountUsagesVoided: %w", err)
+	}
+
+	return nil
+}
+
+// GetReport returns discounts and, separately, the part of the orders paid with loyalty points
+func (s *Service) GetReport(ctx context.Context, from, to time.Time) ([]database.GetDiscountReportRow, database.GetPointsReportRow, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_report")
+	defer span.End()
+
+	if !to.After(from) {
+		return nil, database.GetPointsReportRow{}, s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("to must be after from"))
+	}
+
+	rows, err := s.queries.GetDiscountReport(ctx, database.GetDiscountReportParams{
+		Since: from.UTC(),
+		Until: to.UTC(),
+	})
+	if err != nil {
+		return nil, database.GetPointsReportRow{}, s.tracing.Error(span, fmt.Errorf("GetDiscountReport: %w", err))
+	}
+
+	points, err := s.queries.GetPointsReport(ctx, database.GetPointsReportParams{
+		Since: from.UTC(),
+		Until: to.UTC(),
+	})
+	if err != nil {
+		return nil, database.GetPointsReportRow{}, s.tracing.Error(span, fmt.Errorf("GetPointsReport: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return rows, points, nil
+}
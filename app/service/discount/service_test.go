@@ -0,0 +1,354 @@
+package discount
+
+import (
+	"context"
+	"shantaram/app/api"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/database/dbtest"
+	"shantaram/pkg/money"
+	"shantaram/pkg/telemetry"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rofleksey/meg"
+	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestRecordUsagesSkipsPoints(t *testing.T) {
+	var titles []string
+
+	db := dbtest.New()
+	db.Handle("CreateDiscountUsage", func(args []any) ([][]any, error) {
+		titles = append(titles, args[4].(string))
+
+		return [][]any{{}}, nil
+	})
+
+	s := &Service{cfg: nil, queries: database.New(db), tracing: nil}
+
+	err := s.RecordUsages(context.Background(), database.New(db), uuid.New(), "+79120000000", []api.OrderDiscount{
+		{Title: "Скидка 10%", Amount: 5000, RuleId: meg.ToPtr(uuid.New())},
+		{Title: "Промокод SUMMER", Amount: 3000, PromoCode: meg.ToPtr("SUMMER"), PromoCodeId: meg.ToPtr(uuid.New())},
+		{Title: "Оплата баллами", Amount: 10000, Points: meg.ToPtr(100)},
+	})
+	if err != nil {
+		t.Fatalf("RecordUsages: %v", err)
+	}
+
+	if len(titles) != 2 || titles[0] != "Скидка 10%" || titles[1] != "Промокод SUMMER" {
+		t.Fatalf("recorded %q, want the rule and the promo code only", titles)
+	}
+}
+
+func rule(title string, kind api.DiscountKind, value int64, groupID *uuid.UUID) database.DiscountRule {
+	return database.DiscountRule{ //nolint:exhaustruct
+		ID:      uuid.New(),
+		Title:   title,
+		Kind:    kind,
+		Value:   value,
+		GroupID: groupID,
+		Active:  true,
+	}
+}
+
+// windowRule is active from the local minute now+from to now+to
+func windowRule(now time.Time, from, to int) database.DiscountRule {
+	minute := now.Hour()*60 + now.Minute()
+	r := rule("Счастливые часы", api.DiscountKindPercent, 10, nil)
+	r.FromMinute = meg.ToPtr(int32((minute + from + 1440) % 1440)) //nolint:gosec
+	r.ToMinute = meg.ToPtr(int32((minute + to + 1440) % 1440))     //nolint:gosec
+
+	return r
+}
+
+func promo(code string, kind api.DiscountKind, value int64) database.PromoCode {
+	return database.PromoCode{ //nolint:exhaustruct
+		ID:     uuid.New(),
+		Code:   code,
+		Kind:   kind,
+		Value:  value,
+		Active: true,
+	}
+}
+
+func TestApply(t *testing.T) {
+	drinks, food := uuid.New(), uuid.New()
+	tea, soup := uuid.New(), uuid.New()
+	groups := map[uuid.UUID]uuid.UUID{tea: drinks, soup: food}
+
+	// 2 x 15.00 tea and 1 x 70.00 soup, 100.00 in total
+	items := []api.OrderItem{
+		{Id: tea, Title: "Чай", Price: 1500, Amount: 2},  //nolint:exhaustruct
+		{Id: soup, Title: "Суп", Price: 7000, Amount: 1}, //nolint:exhaustruct
+	}
+
+	now := time.Now().UTC()
+
+	limited := promo("LIMITED", api.DiscountKindFixed, 1000)
+	limited.MaxUses = meg.ToPtr(int32(5))
+	limited.MaxUsesPerCustomer = meg.ToPtr(int32(1))
+
+	minTotal := promo("BIG", api.DiscountKindFixed, 1000)
+	minTotal.MinOrderTotal = meg.ToPtr(money.Kopecks(20000))
+
+	expired := promo("OLD", api.DiscountKindFixed, 1000)
+	expired.Ends = meg.ToPtr(now.Add(-time.Hour))
+
+	future := promo("SOON", api.DiscountKindFixed, 1000)
+	future.Starts = meg.ToPtr(now.Add(time.Hour))
+
+	inactive := promo("OFF", api.DiscountKindFixed, 1000)
+	inactive.Active = false
+
+	tests := []struct {
+		name      string
+		rules     []database.DiscountRule
+		promo     *database.PromoCode
+		uses      database.CountPromoCodeUsesRow
+		code      string
+		discounts []money.Kopecks
+		total     money.Kopecks
+		errCode   string
+	}{
+		{
+			name:  "no discounts",
+			total: 10000,
+		},
+		{
+			name:      "percent of the order",
+			rules:     []database.DiscountRule{rule("10%", api.DiscountKindPercent, 10, nil)},
+			discounts: []money.Kopecks{1000},
+			total:     9000,
+		},
+		{
+			name:      "group rule only counts its group",
+			rules:     []database.DiscountRule{rule("Напитки -50%", api.DiscountKindPercent, 50, &drinks)},
+			discounts: []money.Kopecks{1500},
+			total:     8500,
+		},
+		{
+			name:      "fixed rule above the group total is capped by it",
+			rules:     []database.DiscountRule{rule("Напитки -50 ₽", api.DiscountKindFixed, 5000, &drinks)},
+			discounts: []money.Kopecks{3000},
+			total:     7000,
+		},
+		{
+			name: "rules stack on the subtotal, not on each other",
+			rules: []database.DiscountRule{
+				rule("10%", api.DiscountKindPercent, 10, nil),
+				rule("Ещё 10%", api.DiscountKindPercent, 10, nil),
+				rule("5 ₽", api.DiscountKindFixed, 500, nil),
+			},
+			discounts: []money.Kopecks{1000, 1000, 500},
+			total:     7500,
+		},
+		{
+			name: "rules never take the total below zero",
+			rules: []database.DiscountRule{
+				rule("60%", api.DiscountKindPercent, 60, nil),
+				rule("Суп -70 ₽", api.DiscountKindFixed, 7000, &food),
+				rule("Ещё 10%", api.DiscountKindPercent, 10, nil),
+			},
+			discounts: []money.Kopecks{6000, 4000},
+			total:     0,
+		},
+		{
+			name:      "rule inside its time window",
+			rules:     []database.DiscountRule{windowRule(now, -60, 60)},
+			discounts: []money.Kopecks{1000},
+			total:     9000,
+		},
+		{
+			name:  "rule outside its time window",
+			rules: []database.DiscountRule{windowRule(now, 60, 120)},
+			total: 10000,
+		},
+		{
+			name:      "percent promo code applies to what rules left",
+			rules:     []database.DiscountRule{rule("10%", api.DiscountKindPercent, 10, nil)},
+			promo:     meg.ToPtr(promo("SUMMER", api.DiscountKindPercent, 10)),
+			code:      " summer ",
+			discounts: []money.Kopecks{1000, 900},
+			total:     8100,
+		},
+		{
+			name:      "fixed promo code is capped by what rules left",
+			rules:     []database.DiscountRule{rule("90%", api.DiscountKindPercent, 90, nil)},
+			promo:     meg.ToPtr(promo("GIFT", api.DiscountKindFixed, 5000)),
+			code:      "GIFT",
+			discounts: []money.Kopecks{9000, 1000},
+			total:     0,
+		},
+		{
+			name:      "promo code under its limits",
+			promo:     &limited,
+			uses:      database.CountPromoCodeUsesRow{Total: 4, Customer: 0},
+			code:      "LIMITED",
+			discounts: []money.Kopecks{1000},
+			total:     9000,
+		},
+		{name: "unknown promo code", code: "NOPE", errCode: CodePromoNotFound},
+		{name: "inactive promo code", promo: &inactive, code: "OFF", errCode: CodePromoNotFound},
+		{name: "promo code not started", promo: &future, code: "SOON", errCode: CodePromoNotStarted},
+		{name: "expired promo code", promo: &expired, code: "OLD", errCode: CodePromoExpired},
+		{name: "promo code below its min total", promo: &minTotal, code: "BIG", errCode: CodePromoMinTotal},
+		{
+			name:    "exhausted promo code",
+			promo:   &limited,
+			uses:    database.CountPromoCodeUsesRow{Total: 5, Customer: 0},
+			code:    "LIMITED",
+			errCode: CodePromoExhausted,
+		},
+		{
+			name:    "promo code already used by the customer",
+			promo:   &limited,
+			uses:    database.CountPromoCodeUsesRow{Total: 1, Customer: 1},
+			code:    "LIMITED",
+			errCode: CodePromoCustomerLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := dbtest.New()
+			db.Handle("GetActiveDiscountRules", func([]any) ([][]any, error) {
+				rows := make([][]any, 0, len(tt.rules))
+				for _, r := range tt.rules {
+					rows = append(rows, dbtest.Fields(r))
+				}
+
+				return rows, nil
+			})
+			db.Handle("GetPromoCodeByCode", func(args []any) ([][]any, error) {
+				if tt.promo == nil || args[0].(string) != tt.promo.Code {
+					return nil, nil
+				}
+
+				return [][]any{dbtest.Fields(*tt.promo)}, nil
+			})
+			db.Handle("LockPromoCode", func([]any) ([][]any, error) {
+				return [][]any{{}}, nil
+			})
+			db.Handle("CountPromoCodeUses", func([]any) ([][]any, error) {
+				return [][]any{dbtest.Fields(tt.uses)}, nil
+			})
+
+			cfg := &config.Config{Location: time.UTC} //nolint:exhaustruct
+			s := &Service{
+				cfg:     cfg,
+				queries: database.New(db),
+				tracing: telemetry.NewTracing(cfg, noop.NewTracerProvider().Tracer("test")),
+			}
+
+			var code *string
+			if tt.code != "" {
+				code = &tt.code
+			}
+
+			result, err := s.Apply(context.Background(), database.New(db), items, groups, code, "ip:127.0.0.1")
+			if tt.errCode != "" {
+				if oopsErr, ok := oops.AsOops(err); !ok || oopsErr.Code() != tt.errCode {
+					t.Fatalf("Apply error = %v, want %s", err, tt.errCode)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			amounts := make([]money.Kopecks, 0, len(result.Discounts))
+			for _, discount := range result.Discounts {
+				amounts = append(amounts, discount.Amount)
+			}
+
+			if len(amounts) != len(tt.discounts) {
+				t.Fatalf("discounts = %v, want %v", amounts, tt.discounts)
+			}
+			for i := range amounts {
+				if amounts[i] != tt.discounts[i] {
+					t.Fatalf("discounts = %v, want %v", amounts, tt.discounts)
+				}
+			}
+
+			if result.Subtotal != 10000 || result.Total != tt.total || result.Subtotal-result.Discount != result.Total {
+				t.Errorf("subtotal %d, discount %d, total %d, want total %d", result.Subtotal, result.Discount, result.Total, tt.total)
+			}
+		})
+	}
+}
+
+func TestReclaimUsages(t *testing.T) {
+	tests := []struct {
+		name    string
+		uses    database.CountPromoCodeUsesRow
+		wantErr string
+	}{
+		{name: "код свободен", uses: database.CountPromoCodeUsesRow{Total: 0, Customer: 0}, wantErr: ""},
+		{name: "код израсходован", uses: database.CountPromoCodeUsesRow{Total: 1, Customer: 0}, wantErr: CodePromoExhausted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := promo("SUMMER", api.DiscountKindPercent, 10)
+			code.MaxUses = meg.ToPtr(int32(1))
+			orderID := uuid.New()
+
+			var voided []bool
+
+			db := dbtest.New()
+			db.Handle("GetDiscountUsagesByOrder", func([]any) ([][]any, error) {
+				return [][]any{dbtest.Fields(database.DiscountUsage{ //nolint:exhaustruct
+					OrderID:     orderID,
+					PromoCodeID: &code.ID,
+					Customer:    "+79120000000",
+					Title:       "Промокод SUMMER",
+					Amount:      3000,
+					Voided:      true,
+				})}, nil
+			})
+			db.Handle("GetPromoCodeByID", func([]any) ([][]any, error) {
+				return [][]any{dbtest.Fields(code)}, nil
+			})
+			db.Handle("LockPromoCode", func([]any) ([][]any, error) {
+				return [][]any{{}}, nil
+			})
+			db.Handle("CountPromoCodeUses", func([]any) ([][]any, error) {
+				return [][]any{dbtest.Fields(tt.uses)}, nil
+			})
+			db.Handle("SetDiscountUsagesVoided", func(args []any) ([][]any, error) {
+				voided = append(voided, args[0].(bool))
+
+				return [][]any{{}}, nil
+			})
+
+			s := &Service{cfg: nil, queries: database.New(db), tracing: nil}
+
+			err := s.ReclaimUsages(context.Background(), database.New(db), orderID)
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ReclaimUsages: %v", err)
+				}
+
+				if len(voided) != 1 || voided[0] {
+					t.Fatalf("voided updates %v, want [false]", voided)
+				}
+
+				return
+			}
+
+			oopsErr, ok := oops.AsOops(err)
+			if !ok || oopsErr.Code() != tt.wantErr || oopsErr.Context()["status_code"] != 409 {
+				t.Fatalf("error %v, want %s with status 409", err, tt.wantErr)
+			}
+
+			if len(voided) != 0 {
+				t.Fatalf("usages updated to %v after a failed reclaim", voided)
+			}
+		})
+	}
+}
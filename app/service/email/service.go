@@ -0,0 +1,203 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"shantaram/pkg/config"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/do"
+)
+
+// sendTimeout bounds a whole SMTP session, attachments included
+const sendTimeout = time.Minute
+
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+type Service struct {
+	cfg *config.Config
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		cfg: do.MustInvoke[*config.Config](di),
+	}, nil
+}
+
+// Enabled reports whether the SMTP server and recipients are configured
+func (s *Service) Enabled() bool {
+	return s.cfg.Email.Host != "" && len(s.cfg.Email.To) > 0
+}
+
+// Send delivers a plain text email with attachments to the configured recipients.
+// The SMTP session ends when ctx is done or after sendTimeout, whichever comes first.
+func (s *Service) Send(ctx context.Context, subject, body string, attachments ...Attachment) error {
+	if !s.Enabled() {
+		return nil
+	}
+
+	msg, err := s.buildMessage(subject, body, attachments)
+	if err != nil {
+		return fmt.Errorf("buildMessage: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.cfg.Email.Host, strconv.Itoa(s.cfg.Email.Port)))
+	if err != nil {
+		return fmt.Errorf("DialContext: %w", err)
+	}
+	defer conn.Close()
+
+	// smtp.Client knows nothing about ctx, the deadline bounds every command
+	// and closing the connection interrupts the one in flight
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		return fmt.Errorf("SetDeadline: %w", err)
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+
+	if err = s.send(conn, msg); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("%w: %w", ctxErr, err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// send does what smtp.SendMail does over an open connection
+func (s *Service) send(conn net.Conn, msg []byte) error {
+	client, err := smtp.NewClient(conn, s.cfg.Email.Host)
+	if err != nil {
+		return fmt.Errorf("NewClient: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: s.cfg.Email.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("StartTLS: %w", err)
+		}
+	}
+
+	if s.cfg.Email.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", s.cfg.Email.Username, s.cfg.Email.Password, s.cfg.Email.Host)); err != nil {
+			return fmt.Errorf("Auth: %w", err)
+		}
+	}
+
+	if err = client.Mail(s.cfg.Email.From); err != nil {
+		return fmt.Errorf("Mail: %w", err)
+	}
+
+	for _, to := range s.cfg.Email.To {
+		if err = client.Rcpt(to); err != nil {
+			return fmt.Errorf("Rcpt %s: %w", to, err)
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("Data: %w", err)
+	}
+
+	if _, err = writer.Write(msg); err != nil {
+		return fmt.Errorf("Write: %w", err)
+	}
+
+	if err = writer.Close(); err != nil {
+		return fmt.Errorf("Close: %w", err)
+	}
+
+	if err = client.Quit(); err != nil {
+		return fmt.Errorf("Quit: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) buildMessage(subject, body string, attachments []Attachment) ([]byte, error) {
+	var buf bytes.Buffer
+
+	writer := multipart.NewWriter(&buf)
+
+	fmt.Fprintf(&buf, "From: %s\r\n", s.cfg.Email.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(s.cfg.Email.To, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%s\r\n\r\n", writer.Boundary())
+
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/plain; charset=utf-8"},
+		"Content-Transfer-Encoding": {"base64"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("CreatePart: %w", err)
+	}
+
+	if err = writeBase64(part, []byte(body)); err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range attachments {
+		part, err = writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {attachment.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename})},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("CreatePart: %w", err)
+		}
+
+		if err = writeBase64(part, attachment.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		return nil, fmt.Errorf("Close: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeBase64 wraps encoded lines at 76 characters as required by MIME
+func writeBase64(w io.Writer, data []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(data)
+
+	for len(encoded) > 0 {
+		n := min(len(encoded), 76)
+
+		if _, err := w.Write([]byte(encoded[:n] + "\r\n")); err != nil {
+			return fmt.Errorf("Write: %w", err)
+		}
+
+		encoded = encoded[n:]
+	}
+
+	return nil
+}
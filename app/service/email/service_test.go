@@ -0,0 +1,51 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"net"
+	"shantaram/pkg/config"
+	"testing"
+	"time"
+)
+
+func TestSendStopsWithContext(t *testing.T) {
+	// accepts connections and never greets, like a stuck SMTP server
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	cfg := &config.Config{} //nolint:exhaustruct
+	cfg.Email.Host = "127.0.0.1"
+	cfg.Email.Port = listener.Addr().(*net.TCPAddr).Port
+	cfg.Email.From = "shop@example.com"
+	cfg.Email.To = []string{"owner@example.com"}
+
+	s := &Service{cfg: cfg}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+
+	err = s.Send(ctx, "Z-отчёт", "Заказов: 0")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Send = %v, want context.DeadlineExceeded", err)
+	}
+
+	if elapsed := time.Since(started); elapsed > 5*time.Second {
+		t.Fatalf("Send returned after %s, want it bound to ctx", elapsed)
+	}
+}
@@ -0,0 +1,289 @@
+package eta
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/telemetry"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rofleksey/meg"
+	"github.com/samber/do"
+)
+
+var serviceName = "eta"
+
+var calibrationPeriod = 30 * 24 * time.Hour
+var calibrationRefresh = time.Hour
+var calibrationMinSamples = 10
+var minFactor = 0.5
+var maxFactor = 3.0
+
+type Service struct {
+	configReloader *config.Reloader
+	queries        *database.Queries
+	tracing        *telemetry.Tracing
+
+	// recalculations are serialized, so that concurrent status changes don't overwrite each other
+	recalcMu sync.Mutex
+
+	factorMu      sync.Mutex
+	factor        float64
+	factorUpdated time.Time
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		configReloader: do.MustInvoke[*config.Reloader](di),
+		queries:        do.MustInvoke[*database.Queries](di),
+		tracing:        do.MustInvoke[*telemetry.Tracing](di),
+		factor:         1,
+	}, nil
+}
+
+// estimate of a single order
+type estimate struct {
+	readyAt time.Time
+	// prep is the raw preparation time, without the calibration factor
+	prep time.Duration
+	// queue is the wait for a free kitchen slot
+	queue time.Duration
+}
+
+// Recalculate simulates the kitchen working through open orders in their creation order
+// and stores the estimated ready time of each of them
+func (s *Service) Recalculate(ctx context.Context) (map[uuid.UUID]estimate, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "recalculate")
+	defer span.End()
+
+	s.recalcMu.Lock()
+	defer s.recalcMu.Unlock()
+
+	orders, err := s.queries.GetOpenOrders(ctx)
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetOpenOrders: %w", err))
+	}
+
+	prepTimes, err := s.getPrepTimes(ctx)
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("getPrepTimes: %w", err))
+	}
+
+	factor := s.getFactor(ctx)
+	now := time.Now().UTC()
+
+	// time when each of the parallel kitchen slots becomes free
+	slots := make([]time.Time, s.configReloader.Runtime().ETA.Parallel)
+	result := make(map[uuid.UUID]estimate, len(orders))
+
+	for _, order := range orders {
+		rawPrep := s.orderPrep(order, prepTimes)
+		prep := time.Duration(float64(rawPrep) * factor)
+
+		slotIndex := 0
+		for i := range slots {
+			if slots[i].Before(slots[slotIndex]) {
+				slotIndex = i
+			}
+		}
+
+		start := order.Created
+		if slots[slotIndex].After(start) {
+			start = slots[slotIndex]
+		}
+
+		readyAt := start.Add(prep)
+		slots[slotIndex] = readyAt
+
+		// late orders are expected any minute now
+		if readyAt.Before(now) {
+			readyAt = now.Add(time.Minute)
+		}
+
+		readyAt = readyAt.Truncate(time.Minute)
+		result[order.ID] = estimate{
+			readyAt: readyAt,
+			prep:    rawPrep,
+			queue:   start.Sub(order.Created),
+		}
+
+		if order.ReadyAt != nil && order.ReadyAt.Equal(readyAt) {
+			continue
+		}
+
+		if err = s.queries.SetOrderReadyAt(ctx, database.SetOrderReadyAtParams{
+			ID:      order.ID,
+			ReadyAt: &readyAt,
+		}); err != nil {
+			return nil, s.tracing.Error(span, fmt.Errorf("SetOrderReadyAt: %w", err))
+		}
+	}
+
+	s.tracing.Success(span)
+
+	return result, nil
+}
+
+// Estimate recalculates the queue and stores the initial estimate of a newly created order.
+// The raw preparation time and the queue are stored as well, calibration compares the actual time with them
+func (s *Service) Estimate(ctx context.Context, order database.Order) (int, time.Time, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "estimate")
+	defer span.End()
+
+	estimates, err := s.Recalculate(ctx)
+	if err != nil {
+		return 0, time.Time{}, s.tracing.Error(span, fmt.Errorf("Recalculate: %w", err))
+	}
+
+	now := time.Now().UTC()
+	readyAt := now.Add(time.Duration(s.configReloader.Runtime().ETA.DefaultPrepMinutes) * time.Minute).Truncate(time.Minute)
+
+	var prepMinutes, queueMinutes *int32
+
+	// an order that is no longer open has no estimate and is not used for calibration
+	if e, ok := estimates[order.ID]; ok {
+		readyAt = e.readyAt
+		prepMinutes = meg.ToPtr(int32(math.Round(e.prep.Minutes())))  //nolint:gosec
+		queueMinutes = meg.ToPtr(int32(math.Ceil(e.queue.Minutes()))) //nolint:gosec
+	}
+
+	etaMinutes := int(math.Ceil(readyAt.Sub(now).Minutes()))
+	etaMinutes = max(etaMinutes, 1)
+
+	if err = s.queries.SetOrderEta(ctx, database.SetOrderEtaParams{
+		ID:           order.ID,
+		EtaMinutes:   meg.ToPtr(int32(etaMinutes)), //nolint:gosec
+		ReadyAt:      &readyAt,
+		PrepMinutes:  prepMinutes,
+		QueueMinutes: queueMinutes,
+	}); err != nil {
+		return 0, time.Time{}, s.tracing.Error(span, fmt.Errorf("SetOrderEta: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return etaMinutes, readyAt, nil
+}
+
+// RecalculateAsync is Recalculate for status changes that should not fail because of the estimate
+func (s *Service) RecalculateAsync(ctx context.Context) {
+	go func() {
+		if _, err := s.Recalculate(context.WithoutCancel(ctx)); err != nil {
+			slog.Error("Failed to recalculate ETA",
+				slog.Any("error", err),
+			)
+		}
+	}()
+}
+
+// orderPrep is the longest item of the order plus a little extra for every additional portion,
+// every component of a combo counts as a portion
+func (s *Service) orderPrep(order database.Order, prepTimes map[uuid.UUID]int32) time.Duration {
+	defaultPrep := int32(s.configReloader.Runtime().ETA.DefaultPrepMinutes) //nolint:gosec
+
+	var longest int32
+	var portions int
+
+	addPortion := func(productID uuid.UUID, amount int) {
+		prep, ok := prepTimes[productID]
+		if !ok || prep <= 0 {
+			prep = defaultPrep
+		}
+
+		longest = max(longest, prep)
+		portions += amount
+	}
+
+	for _, item := range order.Items {
+		if item.Components == nil {
+			addPortion(item.Id, item.Amount)
+
+			continue
+		}
+
+		for _, component := range *item.Components {
+			addPortion(component.Id, item.Amount)
+		}
+	}
+
+	if longest == 0 {
+		longest = defaultPrep
+	}
+
+	extra := max(portions-1, 0) * s.configReloader.Runtime().ETA.PerItemMinutes
+
+	return time.Duration(int(longest)+extra) * time.Minute
+}
+
+func (s *Service) getPrepTimes(ctx context.Context) (map[uuid.UUID]int32, error) {
+	rows, err := s.queries.GetProductPrepTimes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetProductPrepTimes: %w", err)
+	}
+
+	result := make(map[uuid.UUID]int32, len(rows))
+	for _, row := range rows {
+		result[row.ID] = row.PrepMinutes
+	}
+
+	return result, nil
+}
+
+// getFactor returns the median ratio of actual to raw estimated preparation times over the last 30 days.
+// Only orders that didn't wait for a kitchen slot are compared, their whole time is preparation
+func (s *Service) getFactor(ctx context.Context) float64 {
+	if !s.configReloader.Runtime().ETA.Calibrate {
+		return 1
+	}
+
+	s.factorMu.Lock()
+	defer s.factorMu.Unlock()
+
+	if time.Since(s.factorUpdated) < calibrationRefresh {
+		return s.factor
+	}
+
+	now := time.Now().UTC()
+	s.factorUpdated = now
+
+	timings, err := s.queries.GetOrderPrepTimings(ctx, now.Add(-calibrationPeriod))
+	if err != nil {
+		slog.Error("Failed to get order prep timings",
+			slog.Any("error", err),
+		)
+
+		return s.factor
+	}
+
+	ratios := make([]float64, 0, len(timings))
+	for _, timing := range timings {
+		if timing.PrepMinutes <= 0 {
+			continue
+		}
+
+		actual := timing.Done.Sub(timing.Created).Minutes()
+		ratios = append(ratios, actual/float64(timing.PrepMinutes))
+	}
+
+	if len(ratios) < calibrationMinSamples {
+		s.factor = 1
+
+		return s.factor
+	}
+
+	slices.Sort(ratios)
+	s.factor = min(max(ratios[len(ratios)/2], minFactor), maxFactor)
+
+	slog.Info("ETA calibrated",
+		slog.Float64("factor", s.factor),
+		slog.Int("samples", len(ratios)),
+	)
+
+	return s.factor
+}
@@ -0,0 +1,246 @@
+package eta
+
+import (
+	"context"
+	"shantaram/app/api"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/database/dbtest"
+	"shantaram/pkg/telemetry"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type kitchen struct {
+	open      []database.Order
+	prepTimes map[uuid.UUID]int32
+	timings   []database.GetOrderPrepTimingsRow
+	stored    map[uuid.UUID]database.SetOrderEtaParams
+
+	// timestamps passed to the database
+	timestamps []time.Time
+}
+
+func newTestService(t *testing.T, calibrate bool) (*Service, *kitchen) {
+	t.Helper()
+
+	k := &kitchen{
+		prepTimes: make(map[uuid.UUID]int32),
+		stored:    make(map[uuid.UUID]database.SetOrderEtaParams),
+	}
+
+	db := dbtest.New()
+	db.Handle("GetOpenOrders", func([]any) ([][]any, error) {
+		rows := make([][]any, 0, len(k.open))
+		for _, order := range k.open {
+			rows = append(rows, dbtest.Fields(order))
+		}
+
+		return rows, nil
+	})
+	db.Handle("GetProductPrepTimes", func([]any) ([][]any, error) {
+		rows := make([][]any, 0, len(k.prepTimes))
+		for id, prep := range k.prepTimes {
+			rows = append(rows, []any{id, prep})
+		}
+
+		return rows, nil
+	})
+	db.Handle("GetOrderPrepTimings", func(args []any) ([][]any, error) {
+		k.timestamps = append(k.timestamps, args[0].(time.Time))
+
+		rows := make([][]any, 0, len(k.timings))
+		for _, timing := range k.timings {
+			rows = append(rows, dbtest.Fields(timing))
+		}
+
+		return rows, nil
+	})
+	db.Handle("SetOrderReadyAt", func(args []any) ([][]any, error) {
+		k.timestamps = append(k.timestamps, *args[1].(*time.Time))
+
+		return [][]any{{}}, nil
+	})
+	db.Handle("SetOrderEta", func(args []any) ([][]any, error) {
+		k.stored[args[0].(uuid.UUID)] = database.SetOrderEtaParams{
+			ID:           args[0].(uuid.UUID),
+			EtaMinutes:   args[1].(*int32),
+			ReadyAt:      args[2].(*time.Time),
+			PrepMinutes:  args[3].(*int32),
+			QueueMinutes: args[4].(*int32),
+		}
+		k.timestamps = append(k.timestamps, *args[2].(*time.Time))
+
+		return [][]any{{}}, nil
+	})
+
+	cfg := &config.Config{} //nolint:exhaustruct
+	cfg.ETA = config.ETAConfig{
+		DefaultPrepMinutes: 10,
+		PerItemMinutes:     2,
+		Parallel:           1,
+		Calibrate:          calibrate,
+	}
+
+	return &Service{
+		configReloader: config.NewReloader(cfg, []string{t.TempDir() + "/config.yaml"}),
+		queries:        database.New(db),
+		tracing:        telemetry.NewTracing(cfg, noop.NewTracerProvider().Tracer("test")),
+		factor:         1,
+	}, k
+}
+
+func (k *kitchen) addOrder(created time.Time, prep int32, amount int) database.Order {
+	productID := uuid.New()
+	k.prepTimes[productID] = prep
+
+	order := database.Order{ //nolint:exhaustruct
+		ID:      uuid.New(),
+		Index:   int64(len(k.open) + 1),
+		Created: created,
+		Status:  api.OrderStatusOpen,
+		Items:   []api.OrderItem{{Id: productID, Title: "Карри", Amount: amount}}, //nolint:exhaustruct
+	}
+
+	k.open = append(k.open, order)
+
+	return order
+}
+
+func TestEstimateStoresRawPrepAndQueue(t *testing.T) {
+	s, k := newTestService(t, false)
+	now := time.Now()
+
+	// 20 minutes of preparation, the first 10 of them are over
+	first := k.addOrder(now.Add(-10*time.Minute), 20, 1)
+	// 15 minutes plus 2 for the second portion, waits for the first order
+	second := k.addOrder(now, 15, 2)
+
+	s.factor = 2
+
+	for _, order := range []database.Order{first, second} {
+		if _, _, err := s.Estimate(context.Background(), order); err != nil {
+			t.Fatalf("Estimate: %v", err)
+		}
+	}
+
+	tests := []struct {
+		order database.Order
+		prep  int32
+		queue int32
+	}{
+		{order: first, prep: 20, queue: 0},
+		{order: second, prep: 17, queue: 10},
+	}
+
+	for _, tt := range tests {
+		stored := k.stored[tt.order.ID]
+
+		if stored.PrepMinutes == nil || *stored.PrepMinutes != tt.prep {
+			t.Fatalf("order %d: prep = %v, want %d", tt.order.Index, stored.PrepMinutes, tt.prep)
+		}
+
+		if stored.QueueMinutes == nil || *stored.QueueMinutes != tt.queue {
+			t.Fatalf("order %d: queue = %v, want %d", tt.order.Index, stored.QueueMinutes, tt.queue)
+		}
+	}
+
+	// calibration is off, so the stored factor doesn't apply: 10 minutes left of the first order and 17 of the second
+	if eta := *k.stored[second.ID].EtaMinutes; eta < 26 || eta > 28 {
+		t.Fatalf("eta = %d, want about 27 minutes", eta)
+	}
+}
+
+func TestEstimateOfClosedOrder(t *testing.T) {
+	s, k := newTestService(t, false)
+
+	order := database.Order{ID: uuid.New()} //nolint:exhaustruct
+
+	if _, _, err := s.Estimate(context.Background(), order); err != nil {
+		t.Fatalf("Estimate: %v", err)
+	}
+
+	stored := k.stored[order.ID]
+	if stored.PrepMinutes != nil || stored.QueueMinutes != nil || *stored.EtaMinutes != 10 {
+		t.Fatalf("stored %+v, want the default eta without calibration data", stored)
+	}
+}
+
+func TestGetFactor(t *testing.T) {
+	timings := func(count int, prep int32, actual time.Duration) []database.GetOrderPrepTimingsRow {
+		created := time.Now().Add(-24 * time.Hour)
+
+		rows := make([]database.GetOrderPrepTimingsRow, 0, count)
+		for range count {
+			rows = append(rows, database.GetOrderPrepTimingsRow{Created: created, PrepMinutes: prep, Done: created.Add(actual)})
+		}
+
+		return rows
+	}
+
+	tests := []struct {
+		name      string
+		calibrate bool
+		timings   []database.GetOrderPrepTimingsRow
+		want      float64
+	}{
+		{name: "calibration off", calibrate: false, timings: timings(20, 10, 15*time.Minute), want: 1},
+		{name: "too few samples", calibrate: true, timings: timings(9, 10, 15*time.Minute), want: 1},
+		{name: "slower kitchen", calibrate: true, timings: timings(20, 10, 15*time.Minute), want: 1.5},
+		{name: "faster kitchen", calibrate: true, timings: timings(20, 20, 15*time.Minute), want: 0.75},
+		{name: "capped", calibrate: true, timings: timings(20, 10, 90*time.Minute), want: maxFactor},
+		{name: "floored", calibrate: true, timings: timings(20, 60, 10*time.Minute), want: minFactor},
+		{
+			name:      "median",
+			calibrate: true,
+			timings:   append(append(timings(6, 10, 10*time.Minute), timings(7, 10, 12*time.Minute)...), timings(6, 10, 60*time.Minute)...),
+			want:      1.2,
+		},
+		{name: "broken estimates are skipped", calibrate: true, timings: append(timings(9, 10, 12*time.Minute), timings(5, 0, 12*time.Minute)...), want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, k := newTestService(t, tt.calibrate)
+			k.timings = tt.timings
+
+			if got := s.getFactor(context.Background()); got < tt.want-0.001 || got > tt.want+0.001 {
+				t.Fatalf("factor = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+// TIMESTAMP columns have no zone, pgx stores the wall clock of whatever zone a time is in
+func TestTimestampsAreUTC(t *testing.T) {
+	local := time.Local
+	time.Local = time.FixedZone("UTC+3", 3*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	s, k := newTestService(t, true)
+
+	// a late order is expected in a minute from now
+	late := k.addOrder(time.Now().UTC().Add(-2*time.Hour), 10, 1)
+
+	if _, _, err := s.Estimate(context.Background(), late); err != nil {
+		t.Fatalf("Estimate: %v", err)
+	}
+
+	// a closed order gets the default estimate
+	if _, _, err := s.Estimate(context.Background(), database.Order{ID: uuid.New()}); err != nil { //nolint:exhaustruct
+		t.Fatalf("Estimate: %v", err)
+	}
+
+	if len(k.timestamps) < 4 {
+		t.Fatalf("got %d timestamps, want the calibration window, the ready time and two estimates", len(k.timestamps))
+	}
+
+	for _, timestamp := range k.timestamps {
+		if timestamp.Location() != time.UTC {
+			t.Errorf("timestamp %s is not in UTC", timestamp)
+		}
+	}
+}
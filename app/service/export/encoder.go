@@ -0,0 +1,131 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"shantaram/app/api"
+	"shantaram/pkg/money"
+	"shantaram/pkg/xlsx"
+	"strconv"
+	"time"
+)
+
+// encoder writes rows of mapper export values in one of the export formats
+type encoder interface {
+	Write(row []any) error
+	Close() error
+}
+
+func newEncoder(w io.Writer, format api.ExportFormat, columns []string) (encoder, error) {
+	var enc encoder
+
+	switch format {
+	case api.ExportFormatCsv:
+		enc = &csvEncoder{writer: csv.NewWriter(w)}
+	case api.ExportFormatXlsx:
+		writer, err := xlsx.NewWriter(w, "Orders")
+		if err != nil {
+			return nil, fmt.Errorf("xlsx.NewWriter: %w", err)
+		}
+
+		enc = &xlsxEncoder{writer: writer}
+	case api.ExportFormatNdjson:
+		// columns become object keys, so there is no header line
+		return &ndjsonEncoder{encoder: json.NewEncoder(w), columns: columns}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %s", format)
+	}
+
+	header := make([]any, len(columns))
+	for i, column := range columns {
+		header[i] = column
+	}
+
+	if err := enc.Write(header); err != nil {
+		return nil, fmt.Errorf("write header: %w", err)
+	}
+
+	return enc, nil
+}
+
+type csvEncoder struct {
+	writer *csv.Writer
+}
+
+func (e *csvEncoder) Write(row []any) error {
+	record := make([]string, len(row))
+
+	for i, value := range row {
+		switch v := value.(type) {
+		case nil:
+		case string:
+			record[i] = v
+		case int:
+			record[i] = strconv.Itoa(v)
+		case int64:
+			record[i] = strconv.FormatInt(v, 10)
+		case money.Kopecks:
+			record[i] = v.String()
+		case time.Time:
+			record[i] = v.Format(time.RFC3339)
+		default:
+			return fmt.Errorf("unsupported value type %T", value)
+		}
+	}
+
+	return e.writer.Write(record) //nolint:wrapcheck
+}
+
+func (e *csvEncoder) Close() error {
+	e.writer.Flush()
+
+	return e.writer.Error() //nolint:wrapcheck
+}
+
+// xlsxEncoder keeps amounts numeric in rubles so that they can be summed in a spreadsheet
+type xlsxEncoder struct {
+	writer *xlsx.Writer
+}
+
+func (e *xlsxEncoder) Write(row []any) error {
+	cells := make([]any, len(row))
+
+	for i, value := range row {
+		switch v := value.(type) {
+		case money.Kopecks:
+			cells[i] = float64(v) / 100
+		case time.Time:
+			cells[i] = v.Format(time.DateTime)
+		default:
+			cells[i] = v
+		}
+	}
+
+	return e.writer.Write(cells) //nolint:wrapcheck
+}
+
+func (e *xlsxEncoder) Close() error {
+	return e.writer.Close() //nolint:wrapcheck
+}
+
+// ndjsonEncoder writes one object per line, amounts stay in kopecks like everywhere in the API
+type ndjsonEncoder struct {
+	encoder *json.Encoder
+	columns []string
+}
+
+func (e *ndjsonEncoder) Write(row []any) error {
+	object := make(map[string]any, len(row))
+
+	for i, value := range row {
+		object[e.columns[i]] = value
+	}
+
+	return e.encoder.Encode(object) //nolint:wrapcheck
+}
+
+func (e *ndjsonEncoder) Close() error {
+	return nil
+}
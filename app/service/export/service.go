@@ -0,0 +1,137 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+	"shantaram/app/service/order"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/telemetry"
+
+	"github.com/samber/do"
+	"github.com/samber/oops"
+)
+
+var serviceName = "export"
+
+type Service struct {
+	cfg          *config.Config
+	orderService *order.Service
+	tracing      *telemetry.Tracing
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		cfg:          do.MustInvoke[*config.Config](di),
+		orderService: do.MustInvoke[*order.Service](di),
+		tracing:      do.MustInvoke[*telemetry.Tracing](di),
+	}, nil
+}
+
+type Options struct {
+	Format api.ExportFormat
+	Rows   api.ExportRows
+	// Filters select orders the same way as the admin order list, paging fields are ignored
+	Filters api.GetOrdersParams
+}
+
+func ContentType(format api.ExportFormat) string {
+	switch format {
+	case api.ExportFormatXlsx:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case api.ExportFormatNdjson:
+		return "application/x-ndjson"
+	default:
+		return "text/csv"
+	}
+}
+
+func check(opts Options) error {
+	if opts.Filters.From == nil || opts.Filters.To == nil || !opts.Filters.To.After(*opts.Filters.From) {
+		return oops.With("status_code", http.StatusBadRequest).New("to must be after from")
+	}
+
+	return nil
+}
+
+// Write exports matching orders to w, orders are fetched and written batch by batch
+func (s *Service) Write(ctx context.Context, w io.Writer, opts Options) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "write")
+	defer span.End()
+
+	if err := check(opts); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	columns := mapper.OrderExportColumns
+	if opts.Rows == api.ExportRowsItem {
+		columns = mapper.OrderItemExportColumns
+	}
+
+	enc, err := newEncoder(w, opts.Format, columns)
+	if err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	opts.Filters.Cursor = nil
+	opts.Filters.Limit = nil
+
+	if err = s.orderService.EachOrder(ctx, opts.Filters, func(o database.Order) error {
+		if opts.Rows == api.ExportRowsItem {
+			for _, row := range mapper.OrderItemExportRows(o, s.cfg.Location) {
+				if err := enc.Write(row); err != nil {
+					return fmt.Errorf("Write: %w", err)
+				}
+			}
+
+			return nil
+		}
+
+		if err := enc.Write(mapper.OrderExportRow(o, s.cfg.Location)); err != nil {
+			return fmt.Errorf("Write: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("EachOrder: %w", err))
+	}
+
+	if err = enc.Close(); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("Close: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+// Stream runs Write in the background and returns its output as it is produced.
+// Options are checked upfront since errors can't change the response once streaming has started,
+// later errors abort the stream.
+func (s *Service) Stream(ctx context.Context, opts Options) (io.ReadCloser, error) {
+	if err := check(opts); err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		err := s.Write(ctx, writer, opts)
+		// a closed pipe means the client has gone away
+		if err != nil && !errors.Is(err, io.ErrClosedPipe) {
+			slog.ErrorContext(ctx, "Order export failed",
+				slog.Any("error", err),
+			)
+		}
+
+		_ = writer.CloseWithError(err)
+	}()
+
+	return reader, nil
+}
@@ -0,0 +1,158 @@
+package hours
+
+import (
+	"fmt"
+	"shantaram/pkg/database"
+	"time"
+)
+
+// lookahead limits how far NextOpening searches for an opening day
+var lookahead = 31
+
+var dayLayout = "2006-01-02"
+
+// Schedule is a snapshot of opening hours, exceptions and the manual override
+type Schedule struct {
+	location     *time.Location
+	weekly       map[time.Weekday]database.OpeningHour
+	exceptions   map[string]database.OpeningException
+	closedUntil  *time.Time
+	closedReason *string
+}
+
+func newSchedule(
+	location *time.Location,
+	weekly []database.OpeningHour,
+	exceptions []database.OpeningException,
+	params database.Param,
+) *Schedule {
+	schedule := &Schedule{
+		location:     location,
+		weekly:       make(map[time.Weekday]database.OpeningHour, len(weekly)),
+		exceptions:   make(map[string]database.OpeningException, len(exceptions)),
+		closedUntil:  params.ClosedUntil,
+		closedReason: params.ClosedReason,
+	}
+
+	for _, day := range weekly {
+		schedule.weekly[time.Weekday(day.Weekday)] = day
+	}
+
+	for _, exception := range exceptions {
+		schedule.exceptions[exception.Day.Format(dayLayout)] = exception
+	}
+
+	return schedule
+}
+
+// interval returns opening time range of the given local day
+func (s *Schedule) interval(day time.Time) (time.Time, time.Time, bool) {
+	midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, s.location)
+
+	var opens, closes int32
+
+	if exception, ok := s.exceptions[midnight.Format(dayLayout)]; ok {
+		if exception.Opens == nil || exception.Closes == nil {
+			return time.Time{}, time.Time{}, false
+		}
+
+		opens, closes = *exception.Opens, *exception.Closes
+	} else if len(s.weekly) == 0 {
+		// no schedule configured, always open
+		return midnight, midnight.AddDate(0, 0, 1), true
+	} else if hours, ok := s.weekly[midnight.Weekday()]; ok {
+		opens, closes = hours.Opens, hours.Closes
+	} else {
+		return time.Time{}, time.Time{}, false
+	}
+
+	start := midnight.Add(time.Duration(opens) * time.Minute)
+
+	end := midnight.Add(time.Duration(closes) * time.Minute)
+	if closes == minutesPerDay {
+		end = midnight.AddDate(0, 0, 1)
+	} else if closes <= opens {
+		end = midnight.AddDate(0, 0, 1).Add(time.Duration(closes) * time.Minute)
+	}
+
+	return start, end, true
+}
+
+func (s *Schedule) scheduledAt(t time.Time) bool {
+	local := t.In(s.location)
+
+	// the previous day may pass midnight
+	for _, day := range []time.Time{local.AddDate(0, 0, -1), local} {
+		start, end, ok := s.interval(day)
+		if ok && !local.Before(start) && local.Before(end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Schedule) overriddenAt(t time.Time) bool {
+	return s.closedUntil != nil && t.Before(*s.closedUntil)
+}
+
+// OpenAt reports whether orders are accepted at the given time
+func (s *Schedule) OpenAt(t time.Time) bool {
+	return !s.overriddenAt(t) && s.scheduledAt(t)
+}
+
+// NextOpening returns the closest time starting from t when ordering is open
+func (s *Schedule) NextOpening(t time.Time) *time.Time {
+	from := t
+	if s.overriddenAt(from) {
+		from = *s.closedUntil
+	}
+
+	if s.scheduledAt(from) {
+		return &from
+	}
+
+	local := from.In(s.location)
+
+	for i := 0; i <= lookahead; i++ {
+		start, _, ok := s.interval(local.AddDate(0, 0, i))
+		if ok && start.After(from) {
+			return &start
+		}
+	}
+
+	return nil
+}
+
+// ClosedReason returns the customer facing explanation why ordering is closed at t
+func (s *Schedule) ClosedReason(t time.Time) string {
+	var reason string
+
+	local := t.In(s.location)
+
+	switch {
+	case s.overriddenAt(t) && s.closedReason != nil && *s.closedReason != "":
+		reason = *s.closedReason
+	case s.overriddenAt(t):
+		reason = "Онлайн-заказы временно не принимаются."
+	default:
+		reason = "Сейчас мы закрыты."
+
+		exception, ok := s.exceptions[local.Format(dayLayout)]
+		if ok && exception.Opens == nil && exception.Title != nil && *exception.Title != "" {
+			reason = fmt.Sprintf("Сегодня мы не работаем: %s.", *exception.Title)
+		}
+	}
+
+	next := s.NextOpening(t)
+	if next == nil {
+		return reason
+	}
+
+	nextLocal := next.In(s.location)
+	if nextLocal.Format(dayLayout) == local.Format(dayLayout) {
+		return fmt.Sprintf("%s Откроемся в %s.", reason, nextLocal.Format("15:04"))
+	}
+
+	return fmt.Sprintf("%s Откроемся %s в %s.", reason, nextLocal.Format("02.01"), nextLocal.Format("15:04"))
+}
@@ -0,0 +1,301 @@
+package hours
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/telemetry"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/do"
+	"github.com/samber/oops"
+)
+
+var serviceName = "hours"
+
+const minutesPerDay = 24 * 60
+
+type WeeklyHours struct {
+	Weekday int
+	Opens   string
+	Closes  string
+}
+
+type Exception struct {
+	Day    time.Time
+	Opens  *string
+	Closes *string
+	Title  *string
+}
+
+type Service struct {
+	cfg     *config.Config
+	dbConn  *pgxpool.Pool
+	queries *database.Queries
+	tracing *telemetry.Tracing
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		cfg:     do.MustInvoke[*config.Config](di),
+		dbConn:  do.MustInvoke[*pgxpool.Pool](di),
+		queries: do.MustInvoke[*database.Queries](di),
+		tracing: do.MustInvoke[*telemetry.Tracing](di),
+	}, nil
+}
+
+// ParseMinute converts HH:MM to minutes since midnight, 24:00 is the end of the day
+func ParseMinute(value string) (int32, error) {
+	if value == "24:00" {
+		return minutesPerDay, nil
+	}
+
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, oops.With("status_code", http.StatusBadRequest).Errorf("invalid time %q, expected HH:MM", value)
+	}
+
+	return int32(parsed.Hour()*60 + parsed.Minute()), nil //nolint:gosec
+}
+
+// parseRange parses opening and closing times, only closing may be at 24:00
+func parseRange(opens, closes string) (int32, int32, error) {
+	opensMinute, err := ParseMinute(opens)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if opensMinute == minutesPerDay {
+		return 0, 0, oops.With("status_code", http.StatusBadRequest).Errorf("opening time must be before 24:00")
+	}
+
+	closesMinute, err := ParseMinute(closes)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return opensMinute, closesMinute, nil
+}
+
+// FormatMinute converts minutes since midnight to HH:MM
+func FormatMinute(minute int32) string {
+	return fmt.Sprintf("%02d:%02d", minute/60%24, minute%60)
+}
+
+// GetSchedule loads the schedule, it's cheap enough to be called on every request
+func (s *Service) GetSchedule(ctx context.Context) (*Schedule, error) {
+	weekly, err := s.queries.GetOpeningHours(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetOpeningHours: %w", err)
+	}
+
+	// yesterday's exception may still be in effect after midnight
+	since := time.Now().In(s.cfg.Location).AddDate(0, 0, -1)
+
+	exceptions, err := s.queries.GetOpeningExceptions(ctx, time.Date(since.Year(), since.Month(), since.Day(), 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return nil, fmt.Errorf("GetOpeningExceptions: %w", err)
+	}
+
+	params, err := s.queries.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetParams: %w", err)
+	}
+
+	return newSchedule(s.cfg.Location, weekly, exceptions, params), nil
+}
+
+// CheckOpen rejects with a customer facing reason if ordering is closed now or at the pickup time
+func (s *Service) CheckOpen(ctx context.Context, pickupAt *time.Time) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "check_open")
+	defer span.End()
+
+	schedule, err := s.GetSchedule(ctx)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("GetSchedule: %w", err))
+	}
+
+	now := time.Now()
+
+	if !schedule.OpenAt(now) {
+		reason := schedule.ClosedReason(now)
+		return s.tracing.Error(span, oops.With("status_code", http.StatusForbidden).Public(reason).New("ordering is closed"))
+	}
+
+	if pickupAt != nil && !schedule.OpenAt(*pickupAt) {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).
+			Public("Мы не работаем в выбранное время выдачи.").
+			New("pickup time is outside of opening hours"))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) GetWeeklyHours(ctx context.Context) ([]WeeklyHours, []Exception, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_weekly_hours")
+	defer span.End()
+
+	weekly, err := s.queries.GetOpeningHours(ctx)
+	if err != nil {
+		return nil, nil, s.tracing.Error(span, fmt.Errorf("GetOpeningHours: %w", err))
+	}
+
+	today := time.Now().In(s.cfg.Location)
+
+	exceptions, err := s.queries.GetOpeningExceptions(ctx, time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return nil, nil, s.tracing.Error(span, fmt.Errorf("GetOpeningExceptions: %w", err))
+	}
+
+	weeklyResult := make([]WeeklyHours, 0, len(weekly))
+	for _, day := range weekly {
+		weeklyResult = append(weeklyResult, WeeklyHours{
+			Weekday: int(day.Weekday),
+			Opens:   FormatMinute(day.Opens),
+			Closes:  FormatMinute(day.Closes),
+		})
+	}
+
+	exceptionsResult := make([]Exception, 0, len(exceptions))
+	for _, exception := range exceptions {
+		result := Exception{
+			Day:    exception.Day,
+			Opens:  nil,
+			Closes: nil,
+			Title:  exception.Title,
+		}
+
+		if exception.Opens != nil && exception.Closes != nil {
+			opens, closes := FormatMinute(*exception.Opens), FormatMinute(*exception.Closes)
+			result.Opens, result.Closes = &opens, &closes
+		}
+
+		exceptionsResult = append(exceptionsResult, result)
+	}
+
+	s.tracing.Success(span)
+
+	return weeklyResult, exceptionsResult, nil
+}
+
+func (s *Service) SetWeeklyHours(ctx context.Context, weekly []WeeklyHours) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "set_weekly_hours")
+	defer span.End()
+
+	tx, err := s.dbConn.Begin(ctx)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("Begin: %w", err))
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	qtx := s.queries.WithTx(tx)
+
+	if err = qtx.DeleteOpeningHours(ctx); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("DeleteOpeningHours: %w", err))
+	}
+
+	seen := make(map[int]bool, len(weekly))
+
+	for _, day := range weekly {
+		if day.Weekday < 0 || day.Weekday > 6 || seen[day.Weekday] {
+			return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).Errorf("invalid weekday %d", day.Weekday))
+		}
+
+		seen[day.Weekday] = true
+
+		opens, closes, err := parseRange(day.Opens, day.Closes)
+		if err != nil {
+			return s.tracing.Error(span, err)
+		}
+
+		if err = qtx.CreateOpeningHours(ctx, database.CreateOpeningHoursParams{
+			Weekday: int32(day.Weekday), //nolint:gosec
+			Opens:   opens,
+			Closes:  closes,
+		}); err != nil {
+			return s.tracing.Error(span, fmt.Errorf("CreateOpeningHours: %w", err))
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("Commit: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) SaveException(ctx context.Context, exception Exception) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "save_exception")
+	defer span.End()
+
+	params := database.UpsertOpeningExceptionParams{
+		Day:    exception.Day,
+		Opens:  nil,
+		Closes: nil,
+		Title:  exception.Title,
+	}
+
+	if (exception.Opens == nil) != (exception.Closes == nil) {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("both opens and closes must be set"))
+	}
+
+	if exception.Opens != nil {
+		opens, closes, err := parseRange(*exception.Opens, *exception.Closes)
+		if err != nil {
+			return s.tracing.Error(span, err)
+		}
+
+		params.Opens, params.Closes = &opens, &closes
+	}
+
+	if err := s.queries.UpsertOpeningException(ctx, params); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("UpsertOpeningException: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) DeleteException(ctx context.Context, day time.Time) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "delete_exception")
+	defer span.End()
+
+	if err := s.queries.DeleteOpeningException(ctx, day); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("DeleteOpeningException: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) SetClosedUntil(ctx context.Context, until *time.Time, reason *string) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "set_closed_until")
+	defer span.End()
+
+	if until != nil {
+		utc := until.UTC()
+		until = &utc
+	} else {
+		reason = nil
+	}
+
+	if err := s.queries.SetParamsClosedUntil(ctx, database.SetParamsClosedUntilParams{
+		ClosedUntil:  until,
+		ClosedReason: reason,
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("SetParamsClosedUntil: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package hours
+
+import (
+	"net/http"
+	"shantaram/pkg/database"
+	"testing"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+func TestParseMinute(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int32
+		valid bool
+	}{
+		{value: "00:00", want: 0, valid: true},
+		{value: "09:30", want: 570, valid: true},
+		{value: "23:59", want: 1439, valid: true},
+		{value: "24:00", want: 1440, valid: true},
+		{value: "24:01", valid: false},
+		{value: "25:00", valid: false},
+		{value: "09:3x", valid: false},
+		{value: "", valid: false},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseMinute(tt.value)
+		if !tt.valid {
+			if oopsErr, ok := oops.AsOops(err); !ok || oopsErr.Context()["status_code"] != http.StatusBadRequest {
+				t.Errorf("ParseMinute(%q) = %d, %v, want a 400 error", tt.value, got, err)
+			}
+
+			continue
+		}
+
+		if err != nil || got != tt.want {
+			t.Errorf("ParseMinute(%q) = %d, %v, want %d", tt.value, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		opens, closes string
+		valid         bool
+	}{
+		{opens: "10:00", closes: "24:00", valid: true},
+		{opens: "10:00", closes: "02:00", valid: true},
+		{opens: "00:00", closes: "24:00", valid: true},
+		{opens: "24:00", closes: "02:00", valid: false},
+		{opens: "10:00", closes: "26:00", valid: false},
+	}
+
+	for _, tt := range tests {
+		_, _, err := parseRange(tt.opens, tt.closes)
+		if (err == nil) != tt.valid {
+			t.Errorf("parseRange(%s, %s) error = %v, valid %t", tt.opens, tt.closes, err, tt.valid)
+		}
+	}
+}
+
+func TestScheduleClosesAtMidnight(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	weekly := make([]database.OpeningHour, 0, 7)
+	for weekday := range int32(7) {
+		weekly = append(weekly, database.OpeningHour{Weekday: weekday, Opens: 600, Closes: 1440})
+	}
+
+	schedule := newSchedule(moscow, weekly, nil, database.Param{}) //nolint:exhaustruct
+
+	tests := []struct {
+		at   string
+		open bool
+	}{
+		{at: "2026-03-10T09:59:00+03:00", open: false},
+		{at: "2026-03-10T10:00:00+03:00", open: true},
+		{at: "2026-03-10T23:59:00+03:00", open: true},
+		{at: "2026-03-11T00:00:00+03:00", open: false},
+		{at: "2026-03-11T00:30:00+03:00", open: false},
+	}
+
+	for _, tt := range tests {
+		at, err := time.Parse(time.RFC3339, tt.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := schedule.OpenAt(at); got != tt.open {
+			t.Errorf("OpenAt(%s) = %t, want %t", tt.at, got, tt.open)
+		}
+	}
+
+	if got := FormatMinute(1440); got != "00:00" {
+		t.Errorf("FormatMinute(1440) = %s", got)
+	}
+}
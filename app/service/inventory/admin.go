@@ -0,0 +1,256 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rofleksey/meg"
+	"github.com/samber/oops"
+)
+
+const foreignKeyViolation = "23503"
+
+// inTx runs fn in a transaction and publishes the stop-list changes after the commit
+func (s *Service) inTx(ctx context.Context, fn func(qtx *database.Queries) error) error {
+	tx, err := s.dbConn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("Begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	qtx := s.queries.WithTx(tx)
+
+	if err = fn(qtx); err != nil {
+		return err
+	}
+
+	var changes Changes
+	if err = s.refreshStopList(ctx, qtx, &changes); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("Commit: %w", err)
+	}
+
+	s.Publish(changes)
+
+	return nil
+}
+
+func (s *Service) SetProductStock(ctx context.Context, productID uuid.UUID, req *api.SetProductStockRequest) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "set_product_stock")
+	defer span.End()
+
+	if err := s.inTx(ctx, func(qtx *database.Queries) error {
+		affected, err := qtx.SetProductStock(ctx, database.SetProductStockParams{
+			ID:       productID,
+			Stock:    meg.PtrIntToPtrInt32(req.Stock),
+			LowStock: meg.PtrIntToPtrInt32(req.LowStock),
+		})
+		if err != nil {
+			return fmt.Errorf("SetProductStock: %w", err)
+		}
+
+		if affected == 0 {
+			return oops.With("status_code", http.StatusNotFound).New("product not found")
+		}
+
+		return nil
+	}); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	s.pubsubService.NotifyMenuChanged()
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) GetRecipes(ctx context.Context) ([]database.ProductIngredient, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_recipes")
+	defer span.End()
+
+	recipes, err := s.queries.GetRecipes(ctx)
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetRecipes: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return recipes, nil
+}
+
+func (s *Service) SetProductRecipe(ctx context.Context, productID uuid.UUID, req *api.SetProductRecipeRequest) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "set_product_recipe")
+	defer span.End()
+
+	if err := s.inTx(ctx, func(qtx *database.Queries) error {
+		if _, err := qtx.GetProductByID(ctx, productID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return oops.With("status_code", http.StatusNotFound).New("product not found")
+			}
+
+			return fmt.Errorf("GetProductByID: %w", err)
+		}
+
+		if err := qtx.DeleteRecipe(ctx, productID); err != nil {
+			return fmt.Errorf("DeleteRecipe: %w", err)
+		}
+
+		seen := make(map[uuid.UUID]bool, len(req.Items))
+
+		for _, item := range req.Items {
+			if seen[item.IngredientId] {
+				return oops.With("status_code", http.StatusBadRequest).Errorf("ingredient %s is listed twice", item.IngredientId)
+			}
+
+			seen[item.IngredientId] = true
+
+			if item.Quantity < 1 {
+				return oops.With("status_code", http.StatusBadRequest).New("quantity must be positive")
+			}
+
+			if err := qtx.CreateRecipeItem(ctx, database.CreateRecipeItemParams{
+				ProductID:    productID,
+				IngredientID: item.IngredientId,
+				Quantity:     int32(item.Quantity), //nolint:gosec
+			}); err != nil {
+				var pgErr *pgconn.PgError
+				if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+					return oops.With("status_code", http.StatusBadRequest).Errorf("ingredient %s not found", item.IngredientId)
+				}
+
+				return fmt.Errorf("CreateRecipeItem: %w", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) GetIngredients(ctx context.Context) ([]database.Ingredient, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_ingredients")
+	defer span.End()
+
+	ingredients, err := s.queries.GetIngredients(ctx)
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetIngredients: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return ingredients, nil
+}
+
+func validateIngredient(title, unit string, stock int) error {
+	if strings.TrimSpace(title) == "" {
+		return oops.With("status_code", http.StatusBadRequest).New("title is empty")
+	}
+
+	if strings.TrimSpace(unit) == "" {
+		return oops.With("status_code", http.StatusBadRequest).New("unit is empty")
+	}
+
+	if stock < 0 {
+		return oops.With("status_code", http.StatusBadRequest).New("stock must not be negative")
+	}
+
+	return nil
+}
+
+func (s *Service) AddIngredient(ctx context.Context, req *api.AddIngredientRequest) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "add_ingredient")
+	defer span.End()
+
+	if err := validateIngredient(req.Title, req.Unit, req.Stock); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	if err := s.queries.CreateIngredient(ctx, database.CreateIngredientParams{
+		ID:       req.Id,
+		Title:    strings.TrimSpace(req.Title),
+		Unit:     strings.TrimSpace(req.Unit),
+		Stock:    int32(req.Stock), //nolint:gosec
+		LowStock: meg.PtrIntToPtrInt32(req.LowStock),
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("CreateIngredient: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+// EditIngredient also restocks, products waiting for the ingredient come back on sale
+func (s *Service) EditIngredient(ctx context.Context, id uuid.UUID, req *api.EditIngredientRequest) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "edit_ingredient")
+	defer span.End()
+
+	if err := validateIngredient(req.Title, req.Unit, req.Stock); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	if err := s.inTx(ctx, func(qtx *database.Queries) error {
+		affected, err := qtx.UpdateIngredient(ctx, database.UpdateIngredientParams{
+			ID:       id,
+			Title:    strings.TrimSpace(req.Title),
+			Unit:     strings.TrimSpace(req.Unit),
+			Stock:    int32(req.Stock), //nolint:gosec
+			LowStock: meg.PtrIntToPtrInt32(req.LowStock),
+		})
+		if err != nil {
+			return fmt.Errorf("UpdateIngredient: %w", err)
+		}
+
+		if affected == 0 {
+			return oops.With("status_code", http.StatusNotFound).New("ingredient not found")
+		}
+
+		return nil
+	}); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) DeleteIngredient(ctx context.Context, id uuid.UUID) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "delete_ingredient")
+	defer span.End()
+
+	if err := s.inTx(ctx, func(qtx *database.Queries) error {
+		affected, err := qtx.DeleteIngredient(ctx, id)
+		if err != nil {
+			return fmt.Errorf("DeleteIngredient: %w", err)
+		}
+
+		if affected == 0 {
+			return oops.With("status_code", http.StatusNotFound).New("ingredient not found")
+		}
+
+		return nil
+	}); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
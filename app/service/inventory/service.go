@@ -0,0 +1,314 @@
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/service/pubsub"
+	"shantaram/app/service/telegram"
+	"shantaram/pkg/database"
+	"shantaram/pkg/telemetry"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/do"
+	"github.com/samber/oops"
+)
+
+var serviceName = "inventory"
+
+// CodeOutOfStock is returned to clients when the order needs more than is left
+const CodeOutOfStock = "out_of_stock"
+
+// Changes are collected inside a transaction and published once it is committed
+type Changes struct {
+	Stopped []string
+	Resumed []string
+	Alerts  []string
+}
+
+type Service struct {
+	dbConn          *pgxpool.Pool
+	queries         *database.Queries
+	pubsubService   *pubsub.Service
+	telegramService *telegram.Service
+	tracing         *telemetry.Tracing
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		dbConn:          do.MustInvoke[*pgxpool.Pool](di),
+		queries:         do.MustInvoke[*database.Queries](di),
+		pubsubService:   do.MustInvoke[*pubsub.Service](di),
+		telegramService: do.MustInvoke[*telegram.Service](di),
+		tracing:         do.MustInvoke[*telemetry.Tracing](di),
+	}, nil
+}
+
+func outOfStockError(title string) error {
+	return oops.Code(CodeOutOfStock).
+		With("status_code", http.StatusBadRequest).
+		Public(fmt.Sprintf("«%s» закончился.", title)).
+		New(CodeOutOfStock)
+}
+
+// crossedLow reports whether the stock has just dropped to the alert level
+func crossedLow(before, after int32, low *int32) bool {
+	return low != nil && after <= *low && before > *low
+}
+
+// lockOrder returns the ids sorted, stock rows are always updated in this order
+// so that two orders sharing products or ingredients can't deadlock
+func lockOrder(amounts map[uuid.UUID]int32) []uuid.UUID {
+	return slices.SortedFunc(maps.Keys(amounts), func(a, b uuid.UUID) int {
+		return bytes.Compare(a[:], b[:])
+	})
+}
+
+// portions counts consumed portions per product, combos consume their components as well
+func portions(items []api.OrderItem) (map[uuid.UUID]int32, map[uuid.UUID]string) {
+	amounts := make(map[uuid.UUID]int32)
+	titles := make(map[uuid.UUID]string)
+
+	for _, item := range items {
+		amounts[item.Id] += int32(item.Amount) //nolint:gosec
+		titles[item.Id] = item.Title
+
+		if item.Components == nil {
+			continue
+		}
+
+		for _, component := range *item.Components {
+			amounts[component.Id] += int32(item.Amount) //nolint:gosec
+			titles[component.Id] = component.Title
+		}
+	}
+
+	return amounts, titles
+}
+
+// Consume decrements product and ingredient stock, must be called inside the order transaction
+// after the order is created. Taken amounts are recorded with the order for Restore.
+// Rows stay locked until the transaction ends, so concurrent orders can't oversell.
+// Products are locked before ingredients, each in lockOrder.
+func (s *Service) Consume(ctx context.Context, qtx *database.Queries, orderID uuid.UUID, items []api.OrderItem) (Changes, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "consume")
+	defer span.End()
+
+	var changes Changes
+
+	amounts, titles := portions(items)
+
+	for _, productID := range lockOrder(amounts) {
+		amount := amounts[productID]
+
+		product, err := qtx.GetProductByID(ctx, productID)
+		if err != nil {
+			return Changes{}, s.tracing.Error(span, fmt.Errorf("GetProductByID %s: %w", productID, err))
+		}
+
+		if product.Stock == nil {
+			continue
+		}
+
+		row, err := qtx.ConsumeProductStock(ctx, database.ConsumeProductStockParams{
+			ID:     productID,
+			Amount: amount,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return Changes{}, s.tracing.Error(span, outOfStockError(titles[productID]))
+			}
+
+			return Changes{}, s.tracing.Error(span, fmt.Errorf("ConsumeProductStock: %w", err))
+		}
+
+		if err = qtx.CreateOrderProductStock(ctx, database.CreateOrderProductStockParams{
+			OrderID:   orderID,
+			ProductID: productID,
+			Amount:    amount,
+		}); err != nil {
+			return Changes{}, s.tracing.Error(span, fmt.Errorf("CreateOrderProductStock: %w", err))
+		}
+
+		if crossedLow(row.Stock+amount, row.Stock, row.LowStock) {
+			changes.Alerts = append(changes.Alerts, fmt.Sprintf("«%s»: осталось %d шт.", titles[productID], row.Stock))
+		}
+	}
+
+	needs, users, err := s.ingredientNeeds(ctx, qtx, amounts, titles)
+	if err != nil {
+		return Changes{}, s.tracing.Error(span, err)
+	}
+
+	for _, ingredientID := range lockOrder(needs) {
+		amount := needs[ingredientID]
+
+		ingredient, err := qtx.ConsumeIngredientStock(ctx, database.ConsumeIngredientStockParams{
+			ID:     ingredientID,
+			Amount: amount,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return Changes{}, s.tracing.Error(span, outOfStockError(users[ingredientID]))
+			}
+
+			return Changes{}, s.tracing.Error(span, fmt.Errorf("ConsumeIngredientStock: %w", err))
+		}
+
+		if err = qtx.CreateOrderIngredientStock(ctx, database.CreateOrderIngredientStockParams{
+			OrderID:      orderID,
+			IngredientID: ingredientID,
+			Amount:       amount,
+		}); err != nil {
+			return Changes{}, s.tracing.Error(span, fmt.Errorf("CreateOrderIngredientStock: %w", err))
+		}
+
+		if crossedLow(ingredient.Stock+amount, ingredient.Stock, ingredient.LowStock) {
+			changes.Alerts = append(changes.Alerts, fmt.Sprintf("Ингредиент «%s»: осталось %d %s", ingredient.Title, ingredient.Stock, ingredient.Unit))
+		}
+	}
+
+	if err = s.refreshStopList(ctx, qtx, &changes); err != nil {
+		return Changes{}, s.tracing.Error(span, err)
+	}
+
+	s.tracing.Success(span)
+
+	return changes, nil
+}
+
+// Restore returns the stock recorded by Consume for a cancelled order, must be called
+// inside the status transaction. Recipes may have changed since the order was placed,
+// so they are not looked at.
+func (s *Service) Restore(ctx context.Context, qtx *database.Queries, orderID uuid.UUID) (Changes, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "restore")
+	defer span.End()
+
+	var changes Changes
+
+	products, err := qtx.DeleteOrderProductStock(ctx, orderID)
+	if err != nil {
+		return Changes{}, s.tracing.Error(span, fmt.Errorf("DeleteOrderProductStock: %w", err))
+	}
+
+	amounts := make(map[uuid.UUID]int32, len(products))
+	for _, product := range products {
+		amounts[product.ProductID] = product.Amount
+	}
+
+	for _, productID := range lockOrder(amounts) {
+		if err = qtx.RestoreProductStock(ctx, database.RestoreProductStockParams{
+			ID:     productID,
+			Amount: amounts[productID],
+		}); err != nil {
+			return Changes{}, s.tracing.Error(span, fmt.Errorf("RestoreProductStock: %w", err))
+		}
+	}
+
+	ingredients, err := qtx.DeleteOrderIngredientStock(ctx, orderID)
+	if err != nil {
+		return Changes{}, s.tracing.Error(span, fmt.Errorf("DeleteOrderIngredientStock: %w", err))
+	}
+
+	needs := make(map[uuid.UUID]int32, len(ingredients))
+	for _, ingredient := range ingredients {
+		needs[ingredient.IngredientID] = ingredient.Amount
+	}
+
+	for _, ingredientID := range lockOrder(needs) {
+		if err = qtx.RestoreIngredientStock(ctx, database.RestoreIngredientStockParams{
+			ID:     ingredientID,
+			Amount: needs[ingredientID],
+		}); err != nil {
+			return Changes{}, s.tracing.Error(span, fmt.Errorf("RestoreIngredientStock: %w", err))
+		}
+	}
+
+	if err = s.refreshStopList(ctx, qtx, &changes); err != nil {
+		return Changes{}, s.tracing.Error(span, err)
+	}
+
+	s.tracing.Success(span)
+
+	return changes, nil
+}
+
+// ingredientNeeds sums recipe quantities per ingredient, users holds a product title
+// for every ingredient to tell the customer what can't be cooked
+func (s *Service) ingredientNeeds(
+	ctx context.Context,
+	qtx *database.Queries,
+	amounts map[uuid.UUID]int32,
+	titles map[uuid.UUID]string,
+) (map[uuid.UUID]int32, map[uuid.UUID]string, error) {
+	recipes, err := qtx.GetRecipesByProducts(ctx, lockOrder(amounts))
+	if err != nil {
+		return nil, nil, fmt.Errorf("GetRecipesByProducts: %w", err)
+	}
+
+	needs := make(map[uuid.UUID]int32)
+	users := make(map[uuid.UUID]string)
+
+	for _, recipe := range recipes {
+		needs[recipe.IngredientID] += recipe.Quantity * amounts[recipe.ProductID]
+		users[recipe.IngredientID] = titles[recipe.ProductID]
+	}
+
+	return needs, users, nil
+}
+
+// refreshStopList hides products that ran out and shows those that were restocked
+func (s *Service) refreshStopList(ctx context.Context, qtx *database.Queries, changes *Changes) error {
+	stopped, err := qtx.StopOutOfStockProducts(ctx)
+	if err != nil {
+		return fmt.Errorf("StopOutOfStockProducts: %w", err)
+	}
+
+	resumed, err := qtx.ResumeRestockedProducts(ctx)
+	if err != nil {
+		return fmt.Errorf("ResumeRestockedProducts: %w", err)
+	}
+
+	changes.Stopped = append(changes.Stopped, stopped...)
+	changes.Resumed = append(changes.Resumed, resumed...)
+
+	return nil
+}
+
+// Publish notifies clients and admins about committed changes
+func (s *Service) Publish(changes Changes) {
+	if len(changes.Stopped) > 0 || len(changes.Resumed) > 0 {
+		s.pubsubService.NotifyMenuChanged()
+	}
+
+	var builder strings.Builder
+
+	for _, alert := range changes.Alerts {
+		builder.WriteString(alert)
+		builder.WriteString("\n")
+	}
+
+	if len(changes.Stopped) > 0 {
+		builder.WriteString("Сняты с продажи: ")
+		builder.WriteString(strings.Join(changes.Stopped, ", "))
+		builder.WriteString("\n")
+	}
+
+	if len(changes.Resumed) > 0 {
+		builder.WriteString("Снова в продаже: ")
+		builder.WriteString(strings.Join(changes.Resumed, ", "))
+		builder.WriteString("\n")
+	}
+
+	if builder.Len() > 0 {
+		go s.telegramService.Notify(strings.TrimSpace(builder.String()))
+	}
+}
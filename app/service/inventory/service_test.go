@@ -0,0 +1,362 @@
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"shantaram/app/api"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/database/dbtest"
+	"shantaram/pkg/telemetry"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// stock keeps products, ingredients and amounts taken by orders in memory
+// and records the order rows are locked in
+type stock struct {
+	products         map[uuid.UUID]database.Product
+	ingredients      map[uuid.UUID]database.Ingredient
+	recipes          []database.ProductIngredient
+	taken            []database.OrderProductStock
+	takenIngredients []database.OrderIngredientStock
+	locked           []uuid.UUID
+}
+
+func newTestService(t *testing.T) (*Service, *stock) {
+	t.Helper()
+
+	st := &stock{
+		products:    make(map[uuid.UUID]database.Product),
+		ingredients: make(map[uuid.UUID]database.Ingredient),
+	}
+
+	db := dbtest.New()
+	db.Handle("GetProductByID", func(args []any) ([][]any, error) {
+		return [][]any{dbtest.Fields(st.products[args[0].(uuid.UUID)])}, nil
+	})
+	db.Handle("ConsumeProductStock", func(args []any) ([][]any, error) {
+		amount, id := args[0].(int32), args[1].(uuid.UUID)
+		st.locked = append(st.locked, id)
+
+		product := st.products[id]
+		if *product.Stock < amount {
+			return nil, nil
+		}
+
+		*product.Stock -= amount
+
+		return [][]any{{*product.Stock, product.LowStock}}, nil
+	})
+	db.Handle("RestoreProductStock", func(args []any) ([][]any, error) {
+		amount, id := args[0].(int32), args[1].(uuid.UUID)
+		st.locked = append(st.locked, id)
+
+		if product := st.products[id]; product.Stock != nil {
+			*product.Stock += amount
+		}
+
+		return [][]any{{}}, nil
+	})
+	db.Handle("GetRecipesByProducts", func(args []any) ([][]any, error) {
+		var rows [][]any
+
+		for _, recipe := range st.recipes {
+			if slices.Contains(args[0].([]uuid.UUID), recipe.ProductID) {
+				rows = append(rows, dbtest.Fields(recipe))
+			}
+		}
+
+		return rows, nil
+	})
+	db.Handle("ConsumeIngredientStock", func(args []any) ([][]any, error) {
+		amount, id := args[0].(int32), args[1].(uuid.UUID)
+		st.locked = append(st.locked, id)
+
+		ingredient := st.ingredients[id]
+		if ingredient.Stock < amount {
+			return nil, nil
+		}
+
+		ingredient.Stock -= amount
+		st.ingredients[id] = ingredient
+
+		return [][]any{dbtest.Fields(ingredient)}, nil
+	})
+	db.Handle("RestoreIngredientStock", func(args []any) ([][]any, error) {
+		amount, id := args[0].(int32), args[1].(uuid.UUID)
+		st.locked = append(st.locked, id)
+
+		ingredient := st.ingredients[id]
+		ingredient.Stock += amount
+		st.ingredients[id] = ingredient
+
+		return [][]any{{}}, nil
+	})
+	db.Handle("CreateOrderProductStock", func(args []any) ([][]any, error) {
+		st.taken = append(st.taken, database.OrderProductStock{
+			OrderID:   args[0].(uuid.UUID),
+			ProductID: args[1].(uuid.UUID),
+			Amount:    args[2].(int32),
+		})
+
+		return [][]any{{}}, nil
+	})
+	db.Handle("CreateOrderIngredientStock", func(args []any) ([][]any, error) {
+		st.takenIngredients = append(st.takenIngredients, database.OrderIngredientStock{
+			OrderID:      args[0].(uuid.UUID),
+			IngredientID: args[1].(uuid.UUID),
+			Amount:       args[2].(int32),
+		})
+
+		return [][]any{{}}, nil
+	})
+	db.Handle("DeleteOrderProductStock", func(args []any) ([][]any, error) {
+		var rows [][]any
+
+		st.taken = slices.DeleteFunc(st.taken, func(row database.OrderProductStock) bool {
+			if row.OrderID != args[0].(uuid.UUID) {
+				return false
+			}
+
+			rows = append(rows, dbtest.Fields(row))
+
+			return true
+		})
+
+		return rows, nil
+	})
+	db.Handle("DeleteOrderIngredientStock", func(args []any) ([][]any, error) {
+		var rows [][]any
+
+		st.takenIngredients = slices.DeleteFunc(st.takenIngredients, func(row database.OrderIngredientStock) bool {
+			if row.OrderID != args[0].(uuid.UUID) {
+				return false
+			}
+
+			rows = append(rows, dbtest.Fields(row))
+
+			return true
+		})
+
+		return rows, nil
+	})
+	db.Handle("StopOutOfStockProducts", func([]any) ([][]any, error) {
+		return nil, nil
+	})
+	db.Handle("ResumeRestockedProducts", func([]any) ([][]any, error) {
+		return nil, nil
+	})
+
+	cfg := &config.Config{} //nolint:exhaustruct
+
+	return &Service{
+		dbConn:          nil,
+		queries:         database.New(db),
+		pubsubService:   nil,
+		telegramService: nil,
+		tracing:         telemetry.NewTracing(cfg, noop.NewTracerProvider().Tracer("test")),
+	}, st
+}
+
+func (st *stock) addProduct(stock *int32) uuid.UUID {
+	product := database.Product{ID: uuid.New(), Stock: stock} //nolint:exhaustruct
+	st.products[product.ID] = product
+
+	return product.ID
+}
+
+func (st *stock) addIngredient(productID uuid.UUID, stock, quantity int32) uuid.UUID {
+	ingredient := database.Ingredient{ID: uuid.New(), Title: "Рис", Unit: "г", Stock: stock} //nolint:exhaustruct
+	st.ingredients[ingredient.ID] = ingredient
+	st.recipes = append(st.recipes, database.ProductIngredient{ProductID: productID, IngredientID: ingredient.ID, Quantity: quantity})
+
+	return ingredient.ID
+}
+
+func ptr(v int32) *int32 {
+	return &v
+}
+
+func items(ids ...uuid.UUID) []api.OrderItem {
+	result := make([]api.OrderItem, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, api.OrderItem{Id: id, Title: "Блюдо", Amount: 1}) //nolint:exhaustruct
+	}
+
+	return result
+}
+
+func isSorted(ids []uuid.UUID) bool {
+	return slices.IsSortedFunc(ids, func(a, b uuid.UUID) int {
+		return bytes.Compare(a[:], b[:])
+	})
+}
+
+func TestLockOrder(t *testing.T) {
+	s, st := newTestService(t)
+	ctx := context.Background()
+
+	var productIDs, ingredientIDs []uuid.UUID
+
+	for range 8 {
+		productID := st.addProduct(ptr(10))
+		productIDs = append(productIDs, productID)
+		ingredientIDs = append(ingredientIDs, st.addIngredient(productID, 1000, 100))
+	}
+
+	// the same products listed in opposite orders lock rows in the same order
+	reversed := slices.Clone(productIDs)
+	slices.Reverse(reversed)
+
+	var sequences [][]uuid.UUID
+
+	for _, order := range [][]uuid.UUID{productIDs, reversed} {
+		orderID := uuid.New()
+		st.locked = nil
+
+		if _, err := s.Consume(ctx, s.queries, orderID, items(order...)); err != nil {
+			t.Fatalf("Consume: %v", err)
+		}
+
+		sequences = append(sequences, st.locked)
+
+		st.locked = nil
+
+		if _, err := s.Restore(ctx, s.queries, orderID); err != nil {
+			t.Fatalf("Restore: %v", err)
+		}
+
+		sequences = append(sequences, st.locked)
+	}
+
+	for i, locked := range sequences {
+		if len(locked) != 16 {
+			t.Fatalf("sequence %d locked %d rows, want 16", i, len(locked))
+		}
+
+		products, ingredients := locked[:8], locked[8:]
+		if !isSorted(products) || !isSorted(ingredients) {
+			t.Fatalf("sequence %d is not sorted: %v", i, locked)
+		}
+
+		if !slices.Equal(locked, sequences[0]) {
+			t.Fatalf("sequence %d = %v, want %v", i, locked, sequences[0])
+		}
+	}
+
+	for _, id := range ingredientIDs {
+		if st.ingredients[id].Stock != 1000 {
+			t.Fatalf("ingredient stock = %d, want it restored to 1000", st.ingredients[id].Stock)
+		}
+	}
+}
+
+func TestConsume(t *testing.T) {
+	tests := []struct {
+		name            string
+		productStock    *int32
+		ingredientStock int32
+		amount          int
+		wantErr         bool
+		wantStock       int32
+		wantIngredient  int32
+	}{
+		{name: "enough of everything", productStock: ptr(5), ingredientStock: 500, amount: 2, wantStock: 3, wantIngredient: 300},
+		{name: "untracked product", productStock: nil, ingredientStock: 500, amount: 2, wantIngredient: 300},
+		{name: "product ran out", productStock: ptr(1), ingredientStock: 500, amount: 2, wantErr: true},
+		{name: "ingredient ran out", productStock: ptr(5), ingredientStock: 150, amount: 2, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, st := newTestService(t)
+
+			productID := st.addProduct(tt.productStock)
+			ingredientID := st.addIngredient(productID, tt.ingredientStock, 100)
+
+			_, err := s.Consume(context.Background(), s.queries, uuid.New(), []api.OrderItem{
+				{Id: productID, Title: "Бирьяни", Amount: tt.amount}, //nolint:exhaustruct
+			})
+			if tt.wantErr {
+				oopsErr, ok := oops.AsOops(err)
+				if !ok || oopsErr.Code() != CodeOutOfStock {
+					t.Fatalf("Consume = %v, want %s", err, CodeOutOfStock)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Consume: %v", err)
+			}
+
+			if tt.productStock != nil && *st.products[productID].Stock != tt.wantStock {
+				t.Fatalf("product stock = %d, want %d", *st.products[productID].Stock, tt.wantStock)
+			}
+
+			if st.ingredients[ingredientID].Stock != tt.wantIngredient {
+				t.Fatalf("ingredient stock = %d, want %d", st.ingredients[ingredientID].Stock, tt.wantIngredient)
+			}
+		})
+	}
+}
+
+func TestRestoreReturnsTakenAmounts(t *testing.T) {
+	s, st := newTestService(t)
+	ctx := context.Background()
+	orderID := uuid.New()
+
+	productID := st.addProduct(ptr(5))
+	ingredientID := st.addIngredient(productID, 1000, 100)
+
+	if _, err := s.Consume(ctx, s.queries, orderID, []api.OrderItem{
+		{Id: productID, Title: "Бирьяни", Amount: 2}, //nolint:exhaustruct
+	}); err != nil {
+		t.Fatalf("Consume: %v", err)
+	}
+
+	// the recipe changes before the order is cancelled
+	st.recipes[0].Quantity = 250
+
+	if _, err := s.Restore(ctx, s.queries, orderID); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	if *st.products[productID].Stock != 5 {
+		t.Fatalf("product stock = %d, want it restored to 5", *st.products[productID].Stock)
+	}
+
+	if st.ingredients[ingredientID].Stock != 1000 {
+		t.Fatalf("ingredient stock = %d, want it restored to 1000", st.ingredients[ingredientID].Stock)
+	}
+
+	if len(st.taken) != 0 || len(st.takenIngredients) != 0 {
+		t.Fatalf("taken amounts %v %v are kept after Restore", st.taken, st.takenIngredients)
+	}
+}
+
+func TestPortionsCountComboComponents(t *testing.T) {
+	rice, curry, combo := uuid.New(), uuid.New(), uuid.New()
+
+	amounts, _ := portions([]api.OrderItem{
+		{Id: rice, Title: "Рис", Amount: 1}, //nolint:exhaustruct
+		{ //nolint:exhaustruct
+			Id:     combo,
+			Title:  "Обед",
+			Amount: 2,
+			Components: &[]api.OrderItemComponent{
+				{Id: rice, Title: "Рис"},    //nolint:exhaustruct
+				{Id: curry, Title: "Карри"}, //nolint:exhaustruct
+			},
+		},
+	})
+
+	if amounts[rice] != 3 || amounts[curry] != 2 || amounts[combo] != 2 {
+		t.Fatalf("amounts = %v, want rice 3, curry 2, combo 2", amounts)
+	}
+}
@@ -0,0 +1,271 @@
+package kitchen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/service/eta"
+	"shantaram/app/service/pubsub"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/telemetry"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/do"
+	"github.com/samber/oops"
+)
+
+var serviceName = "kitchen"
+
+// txBeginner is the connection pool, tests pass a stand-in
+type txBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// etaRecalculator is the eta service, tests pass a stand-in
+type etaRecalculator interface {
+	RecalculateAsync(ctx context.Context)
+}
+
+type Service struct {
+	cfg           *config.Config
+	dbConn        txBeginner
+	queries       *database.Queries
+	pubsubService *pubsub.Service
+	etaService    etaRecalculator
+	tracing       *telemetry.Tracing
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		cfg:           do.MustInvoke[*config.Config](di),
+		dbConn:        do.MustInvoke[*pgxpool.Pool](di),
+		queries:       do.MustInvoke[*database.Queries](di),
+		pubsubService: do.MustInvoke[*pubsub.Service](di),
+		etaService:    do.MustInvoke[*eta.Service](di),
+		tracing:       do.MustInvoke[*telemetry.Tracing](di),
+	}, nil
+}
+
+func (s *Service) GetStations(ctx context.Context) ([]database.KitchenStation, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_stations")
+	defer span.End()
+
+	stations, err := s.queries.GetKitchenStations(ctx)
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetKitchenStations: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return stations, nil
+}
+
+func (s *Service) SaveStation(ctx context.Context, req *api.KitchenStation) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "save_station")
+	defer span.End()
+
+	if err := s.queries.UpsertKitchenStation(ctx, database.UpsertKitchenStationParams{
+		ID:             req.Id,
+		Title:          req.Title,
+		PrinterAddress: req.PrinterAddress,
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("UpsertKitchenStation: %w", err))
+	}
+
+	s.pubsubService.NotifyMenuChanged()
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) DeleteStation(ctx context.Context, id string) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "delete_station")
+	defer span.End()
+
+	if err := s.queries.DeleteKitchenStation(ctx, id); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("DeleteKitchenStation: %w", err))
+	}
+
+	s.pubsubService.NotifyMenuChanged()
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) SetProductGroupStation(ctx context.Context, groupID uuid.UUID, stationID *string) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "set_product_group_station")
+	defer span.End()
+
+	if err := s.queries.SetProductGroupStation(ctx, database.SetProductGroupStationParams{
+		ID:        groupID,
+		StationID: stationID,
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("SetProductGroupStation: %w", err))
+	}
+
+	s.pubsubService.NotifyMenuChanged()
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) GetQueue(ctx context.Context, stationID string) ([]database.GetKitchenQueueRow, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_queue")
+	defer span.End()
+
+	tickets, err := s.queries.GetKitchenQueue(ctx, stationID)
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetKitchenQueue: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return tickets, nil
+}
+
+// ticketItem is a product cooked at a station, combos are split into their components
+type ticketItem struct {
+	productID uuid.UUID
+	title     string
+	amount    int
+}
+
+func ticketItems(order database.Order) []ticketItem {
+	var result []ticketItem
+
+	for _, item := range order.Items {
+		if item.Components == nil {
+			result = append(result, ticketItem{productID: item.Id, title: item.Title, amount: item.Amount})
+
+			continue
+		}
+
+		for _, component := range *item.Components {
+			result = append(result, ticketItem{productID: component.Id, title: component.Title, amount: item.Amount})
+		}
+	}
+
+	return result
+}
+
+// CreateTickets splits order items into station tickets, must be called inside the order transaction.
+// Items of groups without a station, like bottled drinks handed out at the counter, get no ticket
+// and don't hold the order back from becoming ready. An order without tickets is moved by the staff.
+// Returns ids of the affected stations.
+func (s *Service) CreateTickets(ctx context.Context, qtx *database.Queries, order database.Order) ([]string, error) {
+	var stationIDs []string
+
+	for _, item := range ticketItems(order) {
+		product, err := qtx.GetProductByID(ctx, item.productID)
+		if err != nil {
+			return nil, fmt.Errorf("GetProductByID %s: %w", item.productID, err)
+		}
+
+		group, err := qtx.GetProductGroupByID(ctx, product.GroupID)
+		if err != nil {
+			return nil, fmt.Errorf("GetProductGroupByID %s: %w", product.GroupID, err)
+		}
+
+		if group.StationID == nil {
+			continue
+		}
+
+		if err = qtx.CreateKitchenTicket(ctx, database.CreateKitchenTicketParams{
+			ID:        uuid.New(),
+			OrderID:   order.ID,
+			StationID: *group.StationID,
+			ProductID: product.ID,
+			Title:     item.title,
+			Amount:    int32(item.amount), //nolint:gosec
+		}); err != nil {
+			return nil, fmt.Errorf("CreateKitchenTicket: %w", err)
+		}
+
+		stationIDs = append(stationIDs, *group.StationID)
+	}
+
+	return stationIDs, nil
+}
+
+// Bump marks the ticket as done, the order becomes ready once all of its tickets are done.
+// The order row is locked first, so that stations bumping the last tickets of an order
+// at the same time see each other's tickets done and one of them moves the order
+func (s *Service) Bump(ctx context.Context, ticketID uuid.UUID) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "bump")
+	defer span.End()
+
+	tx, err := s.dbConn.Begin(ctx)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("Begin: %w", err))
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	qtx := s.queries.WithTx(tx)
+
+	orderID, err := qtx.GetKitchenTicketOrderID(ctx, ticketID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).Errorf("ticket not found"))
+		}
+
+		return s.tracing.Error(span, fmt.Errorf("GetKitchenTicketOrderID: %w", err))
+	}
+
+	order, err := qtx.GetOrderByIDForUpdate(ctx, orderID)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("GetOrderByIDForUpdate: %w", err))
+	}
+
+	ticket, err := qtx.BumpKitchenTicket(ctx, ticketID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).Errorf("ticket not found"))
+		}
+
+		return s.tracing.Error(span, fmt.Errorf("BumpKitchenTicket: %w", err))
+	}
+
+	pending, err := qtx.CountPendingKitchenTickets(ctx, order.ID)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("CountPendingKitchenTickets: %w", err))
+	}
+
+	orderReady := false
+
+	if pending == 0 && order.Status == api.OrderStatusOpen {
+		if err = qtx.UpdateOrderStatus(ctx, database.UpdateOrderStatusParams{
+			ID:     order.ID,
+			Status: api.OrderStatusReady,
+		}); err != nil {
+			return s.tracing.Error(span, fmt.Errorf("UpdateOrderStatus: %w", err))
+		}
+
+		if err = qtx.CreateOrderStatusHistory(ctx, database.CreateOrderStatusHistoryParams{
+			OrderID: order.ID,
+			Status:  api.OrderStatusReady,
+		}); err != nil {
+			return s.tracing.Error(span, fmt.Errorf("CreateOrderStatusHistory: %w", err))
+		}
+
+		orderReady = true
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("Commit: %w", err))
+	}
+
+	s.pubsubService.NotifyKitchenChanged(ticket.StationID)
+	if orderReady {
+		s.etaService.RecalculateAsync(ctx)
+		s.pubsubService.NotifyOrdersChanged()
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
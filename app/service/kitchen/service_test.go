@@ -0,0 +1,265 @@
+package kitchen
+
+import (
+	"context"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/service/pubsub"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/database/dbtest"
+	"shantaram/pkg/telemetry"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rofleksey/meg"
+	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type fakeETA struct{}
+
+func (fakeETA) RecalculateAsync(context.Context) {}
+
+// kitchen keeps orders, menu and tickets in memory
+type kitchen struct {
+	orders   map[uuid.UUID]database.Order
+	products map[uuid.UUID]database.Product
+	groups   map[uuid.UUID]database.ProductGroup
+	tickets  []database.KitchenTicket
+	history  []api.OrderStatus
+}
+
+func newTestService(t *testing.T) (*Service, *kitchen) {
+	t.Helper()
+
+	k := &kitchen{
+		orders:   make(map[uuid.UUID]database.Order),
+		products: make(map[uuid.UUID]database.Product),
+		groups:   make(map[uuid.UUID]database.ProductGroup),
+	}
+
+	db := dbtest.New()
+	db.Handle("GetKitchenTicketOrderID", func(args []any) ([][]any, error) {
+		for _, ticket := range k.tickets {
+			if ticket.ID == args[0].(uuid.UUID) {
+				return [][]any{{ticket.OrderID}}, nil
+			}
+		}
+
+		return nil, nil
+	})
+	db.Handle("GetOrderByIDForUpdate", func(args []any) ([][]any, error) {
+		return [][]any{dbtest.Fields(k.orders[args[0].(uuid.UUID)])}, nil
+	})
+	db.Handle("BumpKitchenTicket", func(args []any) ([][]any, error) {
+		for i, ticket := range k.tickets {
+			if ticket.ID == args[0].(uuid.UUID) {
+				k.tickets[i].Done = true
+
+				return [][]any{dbtest.Fields(k.tickets[i])}, nil
+			}
+		}
+
+		return nil, nil
+	})
+	db.Handle("CountPendingKitchenTickets", func(args []any) ([][]any, error) {
+		var pending int64
+
+		for _, ticket := range k.tickets {
+			if ticket.OrderID == args[0].(uuid.UUID) && !ticket.Done {
+				pending++
+			}
+		}
+
+		return [][]any{{pending}}, nil
+	})
+	db.Handle("UpdateOrderStatus", func(args []any) ([][]any, error) {
+		order := k.orders[args[0].(uuid.UUID)]
+		order.Status = args[1].(api.OrderStatus)
+		k.orders[order.ID] = order
+
+		return [][]any{{}}, nil
+	})
+	db.Handle("CreateOrderStatusHistory", func(args []any) ([][]any, error) {
+		k.history = append(k.history, args[1].(api.OrderStatus))
+
+		return [][]any{{}}, nil
+	})
+	db.Handle("GetProductByID", func(args []any) ([][]any, error) {
+		return [][]any{dbtest.Fields(k.products[args[0].(uuid.UUID)])}, nil
+	})
+	db.Handle("GetProductGroupByID", func(args []any) ([][]any, error) {
+		return [][]any{dbtest.Fields(k.groups[args[0].(uuid.UUID)])}, nil
+	})
+	db.Handle("CreateKitchenTicket", func(args []any) ([][]any, error) {
+		k.tickets = append(k.tickets, database.KitchenTicket{ //nolint:exhaustruct
+			ID:        args[0].(uuid.UUID),
+			OrderID:   args[1].(uuid.UUID),
+			StationID: args[2].(string),
+			ProductID: args[3].(uuid.UUID),
+			Title:     args[4].(string),
+			Amount:    args[5].(int32),
+		})
+
+		return [][]any{{}}, nil
+	})
+
+	pubsubService, err := pubsub.New(nil)
+	if err != nil {
+		t.Fatalf("pubsub.New: %v", err)
+	}
+
+	cfg := &config.Config{} //nolint:exhaustruct
+
+	return &Service{
+		cfg:           cfg,
+		dbConn:        db,
+		queries:       database.New(db),
+		pubsubService: pubsubService,
+		etaService:    fakeETA{},
+		tracing:       telemetry.NewTracing(cfg, noop.NewTracerProvider().Tracer("test")),
+	}, k
+}
+
+func (k *kitchen) addProduct(title string, stationID *string) uuid.UUID {
+	group := database.ProductGroup{ID: uuid.New(), Title: title, StationID: stationID} //nolint:exhaustruct
+	product := database.Product{ID: uuid.New(), GroupID: group.ID, Title: title}       //nolint:exhaustruct
+
+	k.groups[group.ID] = group
+	k.products[product.ID] = product
+
+	return product.ID
+}
+
+func (k *kitchen) addOrder(items ...api.OrderItem) database.Order {
+	order := database.Order{ //nolint:exhaustruct
+		ID:     uuid.New(),
+		Status: api.OrderStatusOpen,
+		Items:  items,
+	}
+
+	k.orders[order.ID] = order
+
+	return order
+}
+
+func item(id uuid.UUID, title string) api.OrderItem {
+	return api.OrderItem{Id: id, Title: title, Amount: 1} //nolint:exhaustruct
+}
+
+func TestCreateTicketsSkipsItemsWithoutStation(t *testing.T) {
+	s, k := newTestService(t)
+
+	curry := k.addProduct("Карри", meg.ToPtr("hot"))
+	lassi := k.addProduct("Ласси", meg.ToPtr("bar"))
+	water := k.addProduct("Вода", nil)
+
+	order := k.addOrder(item(curry, "Карри"), item(water, "Вода"), item(lassi, "Ласси"))
+
+	stations, err := s.CreateTickets(context.Background(), s.queries, order)
+	if err != nil {
+		t.Fatalf("CreateTickets: %v", err)
+	}
+
+	if len(stations) != 2 || stations[0] != "hot" || stations[1] != "bar" {
+		t.Fatalf("stations = %q, want hot and bar", stations)
+	}
+
+	if len(k.tickets) != 2 {
+		t.Fatalf("tickets = %+v, want two", k.tickets)
+	}
+
+	// the water has no ticket and doesn't hold the order back
+	for _, ticket := range k.tickets {
+		if err = s.Bump(context.Background(), ticket.ID); err != nil {
+			t.Fatalf("Bump: %v", err)
+		}
+	}
+
+	if k.orders[order.ID].Status != api.OrderStatusReady {
+		t.Fatalf("status = %s, want ready", k.orders[order.ID].Status)
+	}
+}
+
+func TestBump(t *testing.T) {
+	tests := []struct {
+		name   string
+		status api.OrderStatus
+		bumps  int
+		want   api.OrderStatus
+	}{
+		{name: "some tickets pending", status: api.OrderStatusOpen, bumps: 2, want: api.OrderStatusOpen},
+		{name: "all tickets done", status: api.OrderStatusOpen, bumps: 3, want: api.OrderStatusReady},
+		{name: "cancelled order stays cancelled", status: api.OrderStatusCancelled, bumps: 3, want: api.OrderStatusCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, k := newTestService(t)
+
+			curry := k.addProduct("Карри", meg.ToPtr("hot"))
+			order := k.addOrder(item(curry, "Карри"), item(curry, "Карри"), item(curry, "Карри"))
+
+			if _, err := s.CreateTickets(context.Background(), s.queries, order); err != nil {
+				t.Fatalf("CreateTickets: %v", err)
+			}
+
+			order.Status = tt.status
+			k.orders[order.ID] = order
+
+			for _, ticket := range k.tickets[:tt.bumps] {
+				if err := s.Bump(context.Background(), ticket.ID); err != nil {
+					t.Fatalf("Bump: %v", err)
+				}
+			}
+
+			if got := k.orders[order.ID].Status; got != tt.want {
+				t.Fatalf("status = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBumpUnknownTicket(t *testing.T) {
+	s, _ := newTestService(t)
+
+	err := s.Bump(context.Background(), uuid.New())
+
+	oopsErr, ok := oops.AsOops(err)
+	if !ok || oopsErr.Context()["status_code"] != http.StatusNotFound {
+		t.Fatalf("Bump = %v, want not found", err)
+	}
+}
+
+func TestBumpLastTicketsAtOnce(t *testing.T) {
+	s, k := newTestService(t)
+
+	order := k.addOrder()
+
+	for _, station := range []string{"hot", "cold", "bar", "tandoor"} {
+		product := k.addProduct(station, meg.ToPtr(station))
+		order.Items = append(order.Items, item(product, station))
+	}
+
+	if _, err := s.CreateTickets(context.Background(), s.queries, order); err != nil {
+		t.Fatalf("CreateTickets: %v", err)
+	}
+
+	var wg sync.WaitGroup
+
+	for _, ticket := range k.tickets {
+		wg.Go(func() {
+			if err := s.Bump(context.Background(), ticket.ID); err != nil {
+				t.Errorf("Bump: %v", err)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if len(k.history) != 1 || k.orders[order.ID].Status != api.OrderStatusReady {
+		t.Fatalf("history = %v, status = %s, want ready once", k.history, k.orders[order.ID].Status)
+	}
+}
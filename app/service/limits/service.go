@@ -3,6 +3,7 @@ package limits
 import (
 	"context"
 	"log/slog"
+	"shantaram/pkg/config"
 	"shantaram/pkg/util"
 	"time"
 
@@ -16,13 +17,23 @@ type Service struct {
 	alertCache  *ttlcache.Cache[string, struct{}]
 }
 
-func New(_ *do.Injector) (*Service, error) {
+func New(di *do.Injector) (*Service, error) {
 	limitersMap := ttlcache.New[string, *rate.Limiter]()
 	alertCache := ttlcache.New[string, struct{}]()
 
 	go limitersMap.Start()
 	go alertCache.Start()
 
+	// limiters keep the rate they were created with, so they are dropped when limits change
+	configReloader := do.MustInvoke[*config.Reloader](di)
+	limitsConfig := configReloader.Runtime().Limits
+	configReloader.Subscribe(func(runtime *config.Runtime) {
+		if runtime.Limits != limitsConfig {
+			limitsConfig = runtime.Limits
+			limitersMap.DeleteAll()
+		}
+	})
+
 	return &Service{
 		limitersMap: limitersMap,
 		alertCache:  alertCache,
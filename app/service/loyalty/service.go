@@ -0,0 +1,415 @@
+package loyalty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/service/discount"
+	"shantaram/pkg/database"
+	"shantaram/pkg/money"
+	"shantaram/pkg/telemetry"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rofleksey/meg"
+	"github.com/samber/do"
+	"github.com/samber/oops"
+)
+
+var serviceName = "loyalty"
+
+// KopecksPerPoint is the value of one point, a ruble
+const KopecksPerPoint = 100
+
+// CodeLoginRequired is returned to guests trying to pay with points
+const CodeLoginRequired = "loyalty_login_required"
+
+const accountEntries = 50
+
+// Account is the points balance of a customer with the latest ledger entries
+type Account struct {
+	Balance int32
+	Entries []database.LoyaltyEntry
+}
+
+type Service struct {
+	dbConn  *pgxpool.Pool
+	queries *database.Queries
+	tracing *telemetry.Tracing
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		dbConn:  do.MustInvoke[*pgxpool.Pool](di),
+		queries: do.MustInvoke[*database.Queries](di),
+		tracing: do.MustInvoke[*telemetry.Tracing](di),
+	}, nil
+}
+
+// lockCustomer keeps concurrent transactions from spending the same points
+func lockCustomer(ctx context.Context, qtx *database.Queries, id uuid.UUID) error {
+	if _, err := qtx.GetCustomerByIDForUpdate(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return oops.With("status_code", http.StatusNotFound).Errorf("customer not found")
+		}
+
+		return fmt.Errorf("GetCustomerByIDForUpdate: %w", err)
+	}
+
+	return nil
+}
+
+// Apply pays for the order with up to the requested points, capped by the balance and
+// the redeem share of the policy. The points line goes after all discounts. qtx must be
+// the order transaction when the result is going to be stored, the customer stays locked
+// until it ends.
+func (s *Service) Apply(
+	ctx context.Context,
+	qtx *database.Queries,
+	customerID *uuid.UUID,
+	points *int,
+	result discount.Result,
+) (discount.Result, error) {
+	if points == nil {
+		return result, nil
+	}
+
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "apply")
+	defer span.End()
+
+	if customerID == nil {
+		return discount.Result{}, s.tracing.Error(span, oops.Code(CodeLoginRequired).
+			With("status_code", http.StatusBadRequest).
+			Public("Войдите, чтобы оплатить заказ баллами.").
+			New(CodeLoginRequired))
+	}
+
+	params, err := qtx.GetParams(ctx)
+	if err != nil {
+		return discount.Result{}, s.tracing.Error(span, fmt.Errorf("GetParams: %w", err))
+	}
+
+	if err = lockCustomer(ctx, qtx, *customerID); err != nil {
+		return discount.Result{}, s.tracing.Error(span, err)
+	}
+
+	balance, err := qtx.GetLoyaltyBalance(ctx, *customerID)
+	if err != nil {
+		return discount.Result{}, s.tracing.Error(span, fmt.Errorf("GetLoyaltyBalance: %w", err))
+	}
+
+	limit := result.Total * money.Kopecks(params.LoyaltyMaxRedeemPercent) / 100 / KopecksPerPoint
+	spend := min(int64(*points), int64(balance), int64(limit))
+
+	if spend > 0 {
+		amount := money.Kopecks(spend * KopecksPerPoint)
+
+		result.Discounts = append(result.Discounts, api.OrderDiscount{
+			Title:  "Оплата баллами",
+			Amount: amount,
+			Points: meg.ToPtr(int(spend)),
+		})
+		result.Discount += amount
+		result.Total -= amount
+	}
+
+	s.tracing.Success(span)
+
+	return result, nil
+}
+
+// Sync brings the ledger of a customer order in line with its status: points paid with
+// are charged unless the order is cancelled, and points are earned once it is closed.
+// Whatever is off is corrected with entries of the same kind, so it can run on every change.
+// Restoring a cancelled order fails when its points were spent in the meantime.
+func (s *Service) Sync(ctx context.Context, qtx *database.Queries, order database.Order) error {
+	if order.CustomerID == nil {
+		return nil
+	}
+
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "sync")
+	defer span.End()
+
+	params, err := qtx.GetParams(ctx)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("GetParams: %w", err))
+	}
+
+	current, err := qtx.GetOrderLoyaltyPoints(ctx, &order.ID)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("GetOrderLoyaltyPoints: %w", err))
+	}
+
+	var redeemed, earned int32
+
+	if order.Status != api.OrderStatusCancelled {
+		for _, d := range order.Discounts {
+			if d.Points != nil {
+				redeemed -= int32(*d.Points) //nolint:gosec
+			}
+		}
+	}
+
+	if order.Status == api.OrderStatusClosed {
+		earned = current.Earned
+		if earned <= 0 {
+			earned = int32(order.Total * money.Kopecks(params.LoyaltyEarnPercent) / 100 / KopecksPerPoint) //nolint:gosec
+		}
+	}
+
+	if delta := redeemed - current.Redeemed; delta != 0 {
+		// points returned on cancellation may have been spent since, restoring the order charges them again
+		if delta < 0 {
+			if err = s.checkBalance(ctx, qtx, *order.CustomerID, delta); err != nil {
+				return s.tracing.Error(span, err)
+			}
+		}
+
+		if _, err = qtx.CreateLoyaltyEntry(ctx, database.CreateLoyaltyEntryParams{
+			CustomerID: *order.CustomerID,
+			OrderID:    &order.ID,
+			Kind:       api.LoyaltyEntryKindRedeem,
+			Points:     delta,
+			Expires:    nil,
+			Comment:    nil,
+		}); err != nil {
+			return s.tracing.Error(span, fmt.Errorf("CreateLoyaltyEntry: %w", err))
+		}
+	}
+
+	delta := earned - current.Earned
+	if delta < 0 {
+		// earned points that were already spent are not taken back
+		if delta, err = s.revocable(ctx, qtx, *order.CustomerID, delta); err != nil {
+			return s.tracing.Error(span, err)
+		}
+	}
+
+	if delta != 0 {
+		var expires *time.Time
+		if delta > 0 && params.LoyaltyExpireDays != nil {
+			expires = meg.ToPtr(time.Now().UTC().AddDate(0, 0, int(*params.LoyaltyExpireDays)))
+		}
+
+		if _, err = qtx.CreateLoyaltyEntry(ctx, database.CreateLoyaltyEntryParams{
+			CustomerID: *order.CustomerID,
+			OrderID:    &order.ID,
+			Kind:       api.LoyaltyEntryKindEarn,
+			Points:     delta,
+			Expires:    expires,
+			Comment:    nil,
+		}); err != nil {
+			return s.tracing.Error(span, fmt.Errorf("CreateLoyaltyEntry: %w", err))
+		}
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+// checkBalance locks the customer and makes sure the balance covers the change
+func (s *Service) checkBalance(ctx context.Context, qtx *database.Queries, customerID uuid.UUID, delta int32) error {
+	if err := lockCustomer(ctx, qtx, customerID); err != nil {
+		return err
+	}
+
+	balance, err := qtx.GetLoyaltyBalance(ctx, customerID)
+	if err != nil {
+		return fmt.Errorf("GetLoyaltyBalance: %w", err)
+	}
+
+	if int64(balance)+int64(delta) < 0 {
+		return oops.With("status_code", http.StatusConflict).Errorf("the order was paid with %d points, the customer has only %d left", -delta, balance)
+	}
+
+	return nil
+}
+
+// revocable locks the customer and caps taking points back at the balance
+func (s *Service) revocable(ctx context.Context, qtx *database.Queries, customerID uuid.UUID, delta int32) (int32, error) {
+	if err := lockCustomer(ctx, qtx, customerID); err != nil {
+		return 0, err
+	}
+
+	balance, err := qtx.GetLoyaltyBalance(ctx, customerID)
+	if err != nil {
+		return 0, fmt.Errorf("GetLoyaltyBalance: %w", err)
+	}
+
+	return max(delta, -max(balance, 0)), nil
+}
+
+func (s *Service) Balance(ctx context.Context, customerID uuid.UUID) (int32, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "balance")
+	defer span.End()
+
+	balance, err := s.queries.GetLoyaltyBalance(ctx, customerID)
+	if err != nil {
+		return 0, s.tracing.Error(span, fmt.Errorf("GetLoyaltyBalance: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return balance, nil
+}
+
+func (s *Service) Account(ctx context.Context, customerID uuid.UUID) (Account, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "account")
+	defer span.End()
+
+	if _, err := s.queries.GetCustomerByID(ctx, customerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Account{}, s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).Errorf("customer not found"))
+		}
+
+		return Account{}, s.tracing.Error(span, fmt.Errorf("GetCustomerByID: %w", err))
+	}
+
+	account, err := s.account(ctx, s.queries, customerID)
+	if err != nil {
+		return Account{}, s.tracing.Error(span, err)
+	}
+
+	s.tracing.Success(span)
+
+	return account, nil
+}
+
+func (s *Service) account(ctx context.Context, qtx *database.Queries, customerID uuid.UUID) (Account, error) {
+	balance, err := qtx.GetLoyaltyBalance(ctx, customerID)
+	if err != nil {
+		return Account{}, fmt.Errorf("GetLoyaltyBalance: %w", err)
+	}
+
+	entries, err := qtx.GetLoyaltyEntries(ctx, database.GetLoyaltyEntriesParams{
+		CustomerID: customerID,
+		Limit:      accountEntries,
+	})
+	if err != nil {
+		return Account{}, fmt.Errorf("GetLoyaltyEntries: %w", err)
+	}
+
+	return Account{
+		Balance: balance,
+		Entries: entries,
+	}, nil
+}
+
+// Adjust adds or takes away points by hand, the balance can't go below zero
+func (s *Service) Adjust(ctx context.Context, customerID uuid.UUID, req *api.LoyaltyAdjustment) (Account, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "adjust")
+	defer span.End()
+
+	comment := strings.TrimSpace(req.Comment)
+	if req.Points == 0 || comment == "" {
+		return Account{}, s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("points must not be zero and comment must not be empty"))
+	}
+
+	tx, err := s.dbConn.Begin(ctx)
+	if err != nil {
+		return Account{}, s.tracing.Error(span, fmt.Errorf("Begin: %w", err))
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	qtx := s.queries.WithTx(tx)
+
+	if err = lockCustomer(ctx, qtx, customerID); err != nil {
+		return Account{}, s.tracing.Error(span, err)
+	}
+
+	balance, err := qtx.GetLoyaltyBalance(ctx, customerID)
+	if err != nil {
+		return Account{}, s.tracing.Error(span, fmt.Errorf("GetLoyaltyBalance: %w", err))
+	}
+
+	if int64(balance)+int64(req.Points) < 0 {
+		return Account{}, s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).Errorf("balance is only %d points", balance))
+	}
+
+	if _, err = qtx.CreateLoyaltyEntry(ctx, database.CreateLoyaltyEntryParams{
+		CustomerID: customerID,
+		OrderID:    nil,
+		Kind:       api.LoyaltyEntryKindAdjust,
+		Points:     int32(req.Points), //nolint:gosec
+		Expires:    nil,
+		Comment:    &comment,
+	}); err != nil {
+		return Account{}, s.tracing.Error(span, fmt.Errorf("CreateLoyaltyEntry: %w", err))
+	}
+
+	account, err := s.account(ctx, qtx, customerID)
+	if err != nil {
+		return Account{}, s.tracing.Error(span, err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return Account{}, s.tracing.Error(span, fmt.Errorf("Commit: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return account, nil
+}
+
+// ExpirePoints writes off earned points past their date, spending is counted
+// against the expiring points first
+func (s *Service) ExpirePoints(ctx context.Context) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "expire_points")
+	defer span.End()
+
+	now := time.Now().UTC()
+
+	customerIDs, err := s.queries.GetLoyaltyExpiringCustomers(ctx, now)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("GetLoyaltyExpiringCustomers: %w", err))
+	}
+
+	var errs []error
+
+	for _, customerID := range customerIDs {
+		if err = s.expireCustomer(ctx, customerID, now); err != nil {
+			errs = append(errs, fmt.Errorf("expireCustomer %s: %w", customerID, err))
+		}
+	}
+
+	if err = errors.Join(errs...); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) expireCustomer(ctx context.Context, customerID uuid.UUID, now time.Time) error {
+	tx, err := s.dbConn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("Begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	qtx := s.queries.WithTx(tx)
+
+	if err = lockCustomer(ctx, qtx, customerID); err != nil {
+		return err
+	}
+
+	if _, err = qtx.ExpireLoyaltyPoints(ctx, database.ExpireLoyaltyPointsParams{
+		Now:        now,
+		CustomerID: customerID,
+	}); err != nil {
+		return fmt.Errorf("ExpireLoyaltyPoints: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("Commit: %w", err)
+	}
+
+	return nil
+}
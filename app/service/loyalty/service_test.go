@@ -0,0 +1,352 @@
+package loyalty
+
+import (
+	"context"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/service/discount"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/database/dbtest"
+	"shantaram/pkg/money"
+	"shantaram/pkg/telemetry"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rofleksey/meg"
+	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// ledger keeps loyalty entries in memory
+type ledger struct {
+	params  database.Param
+	entries []database.LoyaltyEntry
+}
+
+func (l *ledger) balance(customerID uuid.UUID) int32 {
+	var sum int32
+
+	for _, e := range l.entries {
+		if e.CustomerID == customerID {
+			sum += e.Points
+		}
+	}
+
+	return sum
+}
+
+func (l *ledger) add(customerID uuid.UUID, orderID *uuid.UUID, kind api.LoyaltyEntryKind, points int32) {
+	l.entries = append(l.entries, database.LoyaltyEntry{
+		ID:         int64(len(l.entries) + 1),
+		CustomerID: customerID,
+		OrderID:    orderID,
+		Kind:       kind,
+		Points:     points,
+		Expires:    nil,
+		Comment:    nil,
+		Created:    time.Now().UTC(),
+	})
+}
+
+func newTestService(t *testing.T) (*Service, *database.Queries, *ledger) {
+	t.Helper()
+
+	l := &ledger{
+		params: database.Param{ //nolint:exhaustruct
+			ID:                      1,
+			LoyaltyEarnPercent:      5,
+			LoyaltyMaxRedeemPercent: 50,
+		},
+	}
+
+	db := dbtest.New()
+	db.Handle("GetParams", func([]any) ([][]any, error) {
+		return [][]any{dbtest.Fields(l.params)}, nil
+	})
+	db.Handle("GetCustomerByIDForUpdate", func(args []any) ([][]any, error) {
+		return [][]any{dbtest.Fields(database.Customer{ //nolint:exhaustruct
+			ID:    args[0].(uuid.UUID),
+			Phone: "+79120000000",
+		})}, nil
+	})
+	db.Handle("GetLoyaltyBalance", func(args []any) ([][]any, error) {
+		return [][]any{{l.balance(args[0].(uuid.UUID))}}, nil
+	})
+	db.Handle("GetOrderLoyaltyPoints", func(args []any) ([][]any, error) {
+		var earned, redeemed int32
+
+		for _, e := range l.entries {
+			if e.OrderID == nil || *e.OrderID != *args[0].(*uuid.UUID) {
+				continue
+			}
+
+			switch e.Kind {
+			case api.LoyaltyEntryKindEarn:
+				earned += e.Points
+			case api.LoyaltyEntryKindRedeem:
+				redeemed += e.Points
+			}
+		}
+
+		return [][]any{{earned, redeemed}}, nil
+	})
+	db.Handle("CreateLoyaltyEntry", func(args []any) ([][]any, error) {
+		l.add(args[0].(uuid.UUID), args[1].(*uuid.UUID), args[2].(api.LoyaltyEntryKind), args[3].(int32))
+
+		entry := l.entries[len(l.entries)-1]
+		entry.Expires = args[4].(*time.Time)
+		l.entries[len(l.entries)-1] = entry
+
+		return [][]any{dbtest.Fields(entry)}, nil
+	})
+
+	cfg := &config.Config{} //nolint:exhaustruct
+
+	return &Service{
+		dbConn:  nil,
+		queries: database.New(db),
+		tracing: telemetry.NewTracing(cfg, noop.NewTracerProvider().Tracer("test")),
+	}, database.New(db), l
+}
+
+func priced(total money.Kopecks) discount.Result {
+	return discount.Result{
+		Subtotal:  total,
+		Discount:  0,
+		Total:     total,
+		Discounts: []api.OrderDiscount{},
+	}
+}
+
+func statusCode(err error) int {
+	if oopsErr, ok := oops.AsOops(err); ok {
+		code, _ := oopsErr.Context()["status_code"].(int)
+
+		return code
+	}
+
+	return 0
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name       string
+		balance    int32
+		requested  *int
+		total      money.Kopecks
+		maxPercent int32
+		want       int
+	}{
+		{name: "not requested", balance: 100, requested: nil, total: 100000, maxPercent: 50, want: 0},
+		{name: "within limits", balance: 100, requested: meg.ToPtr(30), total: 100000, maxPercent: 50, want: 30},
+		{name: "capped by balance", balance: 50, requested: meg.ToPtr(100), total: 100000, maxPercent: 50, want: 50},
+		{name: "capped by policy", balance: 2000, requested: meg.ToPtr(1000), total: 100000, maxPercent: 50, want: 500},
+		{name: "policy rounds down to whole points", balance: 100, requested: meg.ToPtr(100), total: 399, maxPercent: 50, want: 1},
+		{name: "whole order", balance: 2000, requested: meg.ToPtr(1000), total: 100000, maxPercent: 100, want: 1000},
+		{name: "redeeming off", balance: 100, requested: meg.ToPtr(100), total: 100000, maxPercent: 0, want: 0},
+		{name: "empty balance", balance: 0, requested: meg.ToPtr(100), total: 100000, maxPercent: 50, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, qtx, l := newTestService(t)
+			customerID := uuid.New()
+
+			l.params.LoyaltyMaxRedeemPercent = tt.maxPercent
+			if tt.balance > 0 {
+				l.add(customerID, nil, api.LoyaltyEntryKindAdjust, tt.balance)
+			}
+
+			result, err := s.Apply(context.Background(), qtx, &customerID, tt.requested, priced(tt.total))
+			if err != nil {
+				t.Fatalf("Apply: %v", err)
+			}
+
+			amount := money.Kopecks(tt.want * KopecksPerPoint)
+			if result.Total != tt.total-amount || result.Discount != amount {
+				t.Fatalf("total = %d, discount = %d, want %d and %d", result.Total, result.Discount, tt.total-amount, amount)
+			}
+
+			if tt.want == 0 {
+				if len(result.Discounts) != 0 {
+					t.Fatalf("discounts = %+v, want none", result.Discounts)
+				}
+
+				return
+			}
+
+			if len(result.Discounts) != 1 || result.Discounts[0].Points == nil || *result.Discounts[0].Points != tt.want {
+				t.Fatalf("discounts = %+v, want a line for %d points", result.Discounts, tt.want)
+			}
+
+			// nothing is charged until the order is stored
+			if l.balance(customerID) != tt.balance {
+				t.Fatalf("balance = %d, want %d", l.balance(customerID), tt.balance)
+			}
+		})
+	}
+}
+
+func TestApplyGuest(t *testing.T) {
+	s, qtx, _ := newTestService(t)
+
+	_, err := s.Apply(context.Background(), qtx, nil, meg.ToPtr(10), priced(100000))
+
+	oopsErr, ok := oops.AsOops(err)
+	if !ok || oopsErr.Code() != CodeLoginRequired {
+		t.Fatalf("Apply for a guest = %v, want %s", err, CodeLoginRequired)
+	}
+}
+
+func pointsOrder(customerID uuid.UUID, status api.OrderStatus, total money.Kopecks, points int) database.Order {
+	return database.Order{ //nolint:exhaustruct
+		ID:         uuid.New(),
+		Status:     status,
+		CustomerID: &customerID,
+		Total:      total,
+		Discounts: []api.OrderDiscount{{
+			Title:  "Оплата баллами",
+			Amount: money.Kopecks(points * KopecksPerPoint),
+			Points: meg.ToPtr(points),
+		}},
+	}
+}
+
+func TestSyncRedeemAndCancel(t *testing.T) {
+	s, qtx, l := newTestService(t)
+	ctx := context.Background()
+	customerID := uuid.New()
+
+	l.add(customerID, nil, api.LoyaltyEntryKindAdjust, 100)
+
+	order := pointsOrder(customerID, api.OrderStatusOpen, 70000, 30)
+
+	steps := []struct {
+		status  api.OrderStatus
+		balance int32
+	}{
+		{status: api.OrderStatusOpen, balance: 70},
+		{status: api.OrderStatusOpen, balance: 70},
+		{status: api.OrderStatusCancelled, balance: 100},
+		{status: api.OrderStatusOpen, balance: 70},
+	}
+
+	for i, step := range steps {
+		order.Status = step.status
+
+		if err := s.Sync(ctx, qtx, order); err != nil {
+			t.Fatalf("step %d: Sync: %v", i, err)
+		}
+
+		if got := l.balance(customerID); got != step.balance {
+			t.Fatalf("step %d: balance = %d, want %d", i, got, step.balance)
+		}
+	}
+}
+
+func TestSyncRestoreAfterPointsWereSpent(t *testing.T) {
+	s, qtx, l := newTestService(t)
+	ctx := context.Background()
+	customerID := uuid.New()
+
+	l.add(customerID, nil, api.LoyaltyEntryKindAdjust, 100)
+
+	order := pointsOrder(customerID, api.OrderStatusOpen, 70000, 30)
+	if err := s.Sync(ctx, qtx, order); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	order.Status = api.OrderStatusCancelled
+	if err := s.Sync(ctx, qtx, order); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	// the returned points go to another order
+	l.add(customerID, meg.ToPtr(uuid.New()), api.LoyaltyEntryKindRedeem, -90)
+
+	entries := len(l.entries)
+
+	order.Status = api.OrderStatusOpen
+
+	err := s.Sync(ctx, qtx, order)
+	if statusCode(err) != http.StatusConflict {
+		t.Fatalf("restoring the order = %v, want a conflict", err)
+	}
+
+	if len(l.entries) != entries || l.balance(customerID) != 10 {
+		t.Fatalf("the ledger changed: %d entries, balance %d", len(l.entries), l.balance(customerID))
+	}
+}
+
+func TestSyncEarn(t *testing.T) {
+	s, qtx, l := newTestService(t)
+	ctx := context.Background()
+	customerID := uuid.New()
+
+	l.params.LoyaltyExpireDays = meg.ToPtr(int32(30))
+
+	// 5% of 1234.56 ₽ is 61.73 ₽, whole points only
+	order := database.Order{ //nolint:exhaustruct
+		ID:         uuid.New(),
+		Status:     api.OrderStatusClosed,
+		CustomerID: &customerID,
+		Total:      123456,
+		Discounts:  []api.OrderDiscount{},
+	}
+
+	for range 2 {
+		if err := s.Sync(ctx, qtx, order); err != nil {
+			t.Fatalf("Sync: %v", err)
+		}
+	}
+
+	if len(l.entries) != 1 || l.entries[0].Kind != api.LoyaltyEntryKindEarn || l.entries[0].Points != 61 {
+		t.Fatalf("entries = %+v, want a single earn of 61 points", l.entries)
+	}
+
+	if expires := l.entries[0].Expires; expires == nil || expires.Before(time.Now().AddDate(0, 0, 29)) {
+		t.Fatalf("expires = %v, want in 30 days", expires)
+	}
+
+	// a policy change doesn't touch points already earned
+	l.params.LoyaltyEarnPercent = 10
+	if err := s.Sync(ctx, qtx, order); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	if l.balance(customerID) != 61 {
+		t.Fatalf("balance = %d, want 61", l.balance(customerID))
+	}
+
+	// most of the points are spent before the order is cancelled
+	l.add(customerID, meg.ToPtr(uuid.New()), api.LoyaltyEntryKindRedeem, -50)
+
+	order.Status = api.OrderStatusCancelled
+	if err := s.Sync(ctx, qtx, order); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	if l.balance(customerID) != 0 {
+		t.Fatalf("balance = %d, want 0", l.balance(customerID))
+	}
+}
+
+func TestSyncGuestOrder(t *testing.T) {
+	s, qtx, l := newTestService(t)
+
+	order := database.Order{ //nolint:exhaustruct
+		ID:     uuid.New(),
+		Status: api.OrderStatusClosed,
+		Total:  100000,
+	}
+
+	if err := s.Sync(context.Background(), qtx, order); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	if len(l.entries) != 0 {
+		t.Fatalf("entries = %+v, want none", l.entries)
+	}
+}
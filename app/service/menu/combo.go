@@ -0,0 +1,159 @@
+package menu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+	"shantaram/pkg/database"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/oops"
+)
+
+// getComboSlots returns slots of all combo products by product id
+func (s *Service) getComboSlots(ctx context.Context) (map[uuid.UUID][]api.ComboSlot, error) {
+	slots, err := s.queries.GetAllComboSlots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetAllComboSlots: %w", err)
+	}
+
+	options, err := s.queries.GetAllComboSlotOptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetAllComboSlotOptions: %w", err)
+	}
+
+	result := make(map[uuid.UUID][]api.ComboSlot)
+	for _, slot := range slots {
+		result[slot.ProductID] = append(result[slot.ProductID], mapper.MapComboSlot(slot, options))
+	}
+
+	return result, nil
+}
+
+func (s *Service) SetComboSlots(ctx context.Context, productID uuid.UUID, req *api.SetComboSlotsRequest) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "set_combo_slots")
+	defer span.End()
+
+	tx, err := s.dbConn.Begin(ctx)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("Begin: %w", err))
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	qtx := s.queries.WithTx(tx)
+
+	if _, err = qtx.GetProductByID(ctx, productID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).Errorf("product %s not found", productID))
+		}
+
+		return s.tracing.Error(span, fmt.Errorf("GetProductByID: %w", err))
+	}
+
+	if err = replaceComboSlots(ctx, qtx, productID, req.Slots); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("Commit: %w", err))
+	}
+
+	s.pubsubService.NotifyMenuChanged()
+	s.tracing.Success(span)
+
+	return nil
+}
+
+// replaceComboSlots validates and stores the slots, combos can't be nested
+// so a slot option can't point to another combo product
+func replaceComboSlots(ctx context.Context, qtx *database.Queries, productID uuid.UUID, slots []api.ComboSlot) error {
+	if err := qtx.DeleteComboSlots(ctx, productID); err != nil {
+		return fmt.Errorf("DeleteComboSlots: %w", err)
+	}
+
+	for index, slot := range slots {
+		if strings.TrimSpace(slot.Title) == "" {
+			return oops.With("status_code", http.StatusBadRequest).Errorf("slot %d title is empty", index+1)
+		}
+
+		if len(slot.Options) == 0 {
+			return oops.With("status_code", http.StatusBadRequest).Errorf("slot %q has no options", slot.Title)
+		}
+
+		if err := qtx.CreateComboSlot(ctx, database.CreateComboSlotParams{
+			ID:        slot.Id,
+			ProductID: productID,
+			Index:     int32(index), //nolint:gosec
+			Title:     strings.TrimSpace(slot.Title),
+		}); err != nil {
+			return fmt.Errorf("CreateComboSlot: %w", err)
+		}
+
+		for _, option := range slot.Options {
+			if err := checkComboSlotOption(ctx, qtx, productID, option); err != nil {
+				return fmt.Errorf("slot %q: %w", slot.Title, err)
+			}
+
+			if err := qtx.CreateComboSlotOption(ctx, database.CreateComboSlotOptionParams{
+				SlotID:    slot.Id,
+				ProductID: option.ProductId,
+				GroupID:   option.GroupId,
+				Surcharge: option.Surcharge,
+			}); err != nil {
+				return fmt.Errorf("CreateComboSlotOption: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkComboSlotOption(ctx context.Context, qtx *database.Queries, productID uuid.UUID, option api.ComboSlotOption) error {
+	if (option.ProductId == nil) == (option.GroupId == nil) {
+		return oops.With("status_code", http.StatusBadRequest).New("option must have either productId or groupId")
+	}
+
+	if option.Surcharge < 0 {
+		return oops.With("status_code", http.StatusBadRequest).New("surcharge must not be negative")
+	}
+
+	if option.GroupId != nil {
+		if _, err := qtx.GetProductGroupByID(ctx, *option.GroupId); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return oops.With("status_code", http.StatusBadRequest).Errorf("product group %s not found", *option.GroupId)
+			}
+
+			return fmt.Errorf("GetProductGroupByID: %w", err)
+		}
+
+		return nil
+	}
+
+	if *option.ProductId == productID {
+		return oops.With("status_code", http.StatusBadRequest).New("combo can't contain itself")
+	}
+
+	if _, err := qtx.GetProductByID(ctx, *option.ProductId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return oops.With("status_code", http.StatusBadRequest).Errorf("product %s not found", *option.ProductId)
+		}
+
+		return fmt.Errorf("GetProductByID: %w", err)
+	}
+
+	nested, err := qtx.GetComboSlotsByProduct(ctx, *option.ProductId)
+	if err != nil {
+		return fmt.Errorf("GetComboSlotsByProduct: %w", err)
+	}
+
+	if len(nested) > 0 {
+		return oops.With("status_code", http.StatusBadRequest).Errorf("product %s is a combo itself", *option.ProductId)
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rofleksey/meg"
 	"github.com/samber/do"
 	"github.com/samber/oops"
 )
@@ -69,11 +70,21 @@ func (s *Service) GetMenu(ctx context.Context) ([]api.Menu, error) {
 		return nil, s.tracing.Error(span, fmt.Errorf("GetAllProducts: %w", err))
 	}
 
+	comboSlots, err := s.getComboSlots(ctx)
+	if err != nil {
+		return nil, s.tracing.Error(span, err)
+	}
+
 	for _, product := range products {
+		apiProduct := mapper.MapProduct(product)
+		if slots, ok := comboSlots[product.ID]; ok {
+			apiProduct.Slots = &slots
+		}
+
 		for menuIndex := range result {
 			for groupIndex := range result[menuIndex].Groups {
 				if result[menuIndex].Groups[groupIndex].Id == product.GroupID {
-					result[menuIndex].Groups[groupIndex].Products = append(result[menuIndex].Groups[groupIndex].Products, mapper.MapProduct(product))
+					result[menuIndex].Groups[groupIndex].Products = append(result[menuIndex].Groups[groupIndex].Products, apiProduct)
 				}
 			}
 		}
@@ -192,6 +203,8 @@ func (s *Service) EditProduct(ctx context.Context, id uuid.UUID, req *api.EditPr
 		Description: req.Description,
 		Price:       req.Price,
 		Available:   req.Available,
+		PrepMinutes: meg.PtrIntToPtrInt32(req.PrepMinutes),
+		MaxQuantity: meg.PtrIntToPtrInt32(req.MaxQuantity),
 	}); err != nil {
 		return s.tracing.Error(span, fmt.Errorf("EditProduct: %w", err))
 	}
@@ -221,8 +234,9 @@ func (s *Service) EditProductGroup(ctx context.Context, id uuid.UUID, req *api.E
 	defer span.End()
 
 	if err := s.queries.UpdateProductGroup(ctx, database.UpdateProductGroupParams{
-		ID:    id,
-		Title: req.Title,
+		ID:          id,
+		Title:       req.Title,
+		PrepMinutes: meg.PtrIntToPtrInt32(req.PrepMinutes),
 	}); err != nil {
 		return s.tracing.Error(span, fmt.Errorf("EditProductGroup: %w", err))
 	}
@@ -243,6 +257,8 @@ func (s *Service) AddProduct(ctx context.Context, req *api.AddProductRequest) er
 		Title:       req.Title,
 		Description: req.Description,
 		Price:       req.Price,
+		PrepMinutes: meg.PtrIntToPtrInt32(req.PrepMinutes),
+		MaxQuantity: meg.PtrIntToPtrInt32(req.MaxQuantity),
 	}); err != nil {
 		return s.tracing.Error(span, fmt.Errorf("AddProduct: %w", err))
 	}
@@ -270,3 +286,106 @@ func (s *Service) AddProductGroup(ctx context.Context, req *api.AddProductGroupR
 
 	return nil
 }
+
+// ImportMenu upserts menus with their groups and products keeping the order from the input,
+// with prune groups and products missing from an imported menu are deleted.
+// Combo slots are replaced only for products that list them.
+func (s *Service) ImportMenu(ctx context.Context, menus []api.Menu, prune bool) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "import")
+	defer span.End()
+
+	tx, err := s.dbConn.Begin(ctx)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("Begin: %w", err))
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	qtx := s.queries.WithTx(tx)
+
+	for _, menu := range menus {
+		if err = qtx.UpsertMenu(ctx, database.UpsertMenuParams{
+			ID:    menu.Id,
+			Title: menu.Title,
+		}); err != nil {
+			return s.tracing.Error(span, fmt.Errorf("UpsertMenu %s: %w", menu.Id, err))
+		}
+
+		groupIDs := make([]uuid.UUID, 0, len(menu.Groups))
+
+		for groupIndex, group := range menu.Groups {
+			groupIDs = append(groupIDs, group.Id)
+
+			if err = qtx.UpsertProductGroup(ctx, database.UpsertProductGroupParams{
+				ID:          group.Id,
+				MenuID:      menu.Id,
+				Title:       group.Title,
+				Index:       int32(groupIndex + 1), //nolint:gosec
+				PrepMinutes: meg.PtrIntToPtrInt32(group.PrepMinutes),
+			}); err != nil {
+				return s.tracing.Error(span, fmt.Errorf("UpsertProductGroup %s: %w", group.Id, err))
+			}
+
+			productIDs := make([]uuid.UUID, 0, len(group.Products))
+
+			for productIndex, product := range group.Products {
+				productIDs = append(productIDs, product.Id)
+
+				if err = qtx.UpsertProduct(ctx, database.UpsertProductParams{
+					ID:          product.Id,
+					GroupID:     group.Id,
+					Title:       product.Title,
+					Description: product.Description,
+					Price:       product.Price,
+					Available:   product.Available,
+					Index:       int32(productIndex + 1), //nolint:gosec
+					PrepMinutes: meg.PtrIntToPtrInt32(product.PrepMinutes),
+					MaxQuantity: meg.PtrIntToPtrInt32(product.MaxQuantity),
+				}); err != nil {
+					return s.tracing.Error(span, fmt.Errorf("UpsertProduct %s: %w", product.Id, err))
+				}
+			}
+
+			if prune {
+				if err = qtx.DeleteProductsNotIn(ctx, database.DeleteProductsNotInParams{
+					GroupID: group.Id,
+					Ids:     productIDs,
+				}); err != nil {
+					return s.tracing.Error(span, fmt.Errorf("DeleteProductsNotIn: %w", err))
+				}
+			}
+		}
+
+		if prune {
+			if err = qtx.DeleteProductGroupsNotIn(ctx, database.DeleteProductGroupsNotInParams{
+				MenuID: menu.Id,
+				Ids:    groupIDs,
+			}); err != nil {
+				return s.tracing.Error(span, fmt.Errorf("DeleteProductGroupsNotIn: %w", err))
+			}
+		}
+	}
+
+	// slots go last, their options may point to products of any imported menu
+	for _, menu := range menus {
+		for _, group := range menu.Groups {
+			for _, product := range group.Products {
+				if product.Slots == nil {
+					continue
+				}
+
+				if err = replaceComboSlots(ctx, qtx, product.Id, *product.Slots); err != nil {
+					return s.tracing.Error(span, fmt.Errorf("replaceComboSlots %s: %w", product.Id, err))
+				}
+			}
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("Commit: %w", err))
+	}
+
+	s.pubsubService.NotifyMenuChanged()
+	s.tracing.Success(span)
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+	"shantaram/pkg/money"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// error codes returned to clients when combo choices don't match the combo slots
+const (
+	CodeComboChoiceMissing = "combo_choice_missing"
+	CodeComboChoiceInvalid = "combo_choice_invalid"
+)
+
+// mapComboChoices resolves a product for every combo slot, returns nil components for regular products.
+// The surcharge is the sum of surcharges of the chosen options for a single combo.
+func (s *Service) mapComboChoices(ctx context.Context, product database.Product, item api.NewOrderItem) ([]api.OrderItemComponent, money.Kopecks, error) {
+	var choices []api.ComboChoice
+	if item.Choices != nil {
+		choices = *item.Choices
+	}
+
+	slots, err := s.queries.GetComboSlotsByProduct(ctx, product.ID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("GetComboSlotsByProduct: %w", err)
+	}
+
+	if len(slots) == 0 {
+		if len(choices) > 0 {
+			return nil, 0, policyError(CodeComboChoiceInvalid, fmt.Sprintf("«%s» не является комбо.", product.Title))
+		}
+
+		return nil, 0, nil
+	}
+
+	if len(choices) != len(slots) {
+		for _, slot := range slots {
+			if !hasChoice(choices, slot.ID) {
+				return nil, 0, policyError(CodeComboChoiceMissing, fmt.Sprintf("«%s»: выберите «%s».", product.Title, slot.Title))
+			}
+		}
+
+		return nil, 0, policyError(CodeComboChoiceInvalid, fmt.Sprintf("«%s»: выбрано больше позиций, чем есть в комбо.", product.Title))
+	}
+
+	options, err := s.queries.GetComboSlotOptionsByProduct(ctx, product.ID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("GetComboSlotOptionsByProduct: %w", err)
+	}
+
+	components := make([]api.OrderItemComponent, 0, len(slots))
+
+	var surcharge money.Kopecks
+
+	for _, slot := range slots {
+		if !hasChoice(choices, slot.ID) {
+			return nil, 0, policyError(CodeComboChoiceMissing, fmt.Sprintf("«%s»: выберите «%s».", product.Title, slot.Title))
+		}
+
+		for _, choice := range choices {
+			if choice.SlotId != slot.ID {
+				continue
+			}
+
+			component, err := s.mapComboChoice(ctx, product, slot, options, choice)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			components = append(components, component)
+			surcharge += component.Surcharge
+		}
+	}
+
+	return components, surcharge, nil
+}
+
+func hasChoice(choices []api.ComboChoice, slotID uuid.UUID) bool {
+	for _, choice := range choices {
+		if choice.SlotId == slotID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Service) mapComboChoice(
+	ctx context.Context,
+	combo database.Product,
+	slot database.ComboSlot,
+	options []database.ComboSlotOption,
+	choice api.ComboChoice,
+) (api.OrderItemComponent, error) {
+	invalid := policyError(CodeComboChoiceInvalid, fmt.Sprintf("«%s»: недопустимый выбор для «%s».", combo.Title, slot.Title))
+
+	chosen, err := s.queries.GetProductByID(ctx, choice.ProductId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.OrderItemComponent{}, invalid
+		}
+
+		return api.OrderItemComponent{}, fmt.Errorf("GetProductByID: %w", err)
+	}
+
+	if !chosen.Available {
+		return api.OrderItemComponent{}, policyError(CodeComboChoiceInvalid, fmt.Sprintf("«%s» сейчас недоступен.", chosen.Title))
+	}
+
+	// the first matching option wins, so a product listed explicitly before its group keeps its own surcharge
+	var matched *database.ComboSlotOption
+
+	for i := range options {
+		option := &options[i]
+		if option.SlotID != slot.ID {
+			continue
+		}
+
+		if (option.ProductID != nil && *option.ProductID == chosen.ID) ||
+			(option.GroupID != nil && *option.GroupID == chosen.GroupID) {
+			matched = option
+
+			break
+		}
+	}
+
+	if matched == nil {
+		return api.OrderItemComponent{}, invalid
+	}
+
+	// group options may contain combos, they can't be nested
+	nested, err := s.queries.GetComboSlotsByProduct(ctx, chosen.ID)
+	if err != nil {
+		return api.OrderItemComponent{}, fmt.Errorf("GetComboSlotsByProduct: %w", err)
+	}
+
+	if len(nested) > 0 {
+		return api.OrderItemComponent{}, invalid
+	}
+
+	return api.OrderItemComponent{
+		SlotId:    slot.ID,
+		Id:        chosen.ID,
+		Title:     chosen.Title,
+		Surcharge: matched.Surcharge,
+	}, nil
+}
@@ -0,0 +1,215 @@
+package order
+
+import (
+	"context"
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+	"shantaram/pkg/database/dbtest"
+	"shantaram/pkg/money"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rofleksey/meg"
+	"github.com/samber/oops"
+)
+
+func TestMapComboChoices(t *testing.T) {
+	drinks, sides := uuid.New(), uuid.New()
+
+	product := func(title string, groupID uuid.UUID) database.Product {
+		return database.Product{ //nolint:exhaustruct
+			ID:        uuid.New(),
+			GroupID:   groupID,
+			Title:     title,
+			Price:     10000,
+			Available: true,
+		}
+	}
+
+	combo := product("Бизнес-ланч", uuid.New())
+	tea := product("Чай", drinks)
+	lemonade := product("Лимонад", drinks)
+	fries := product("Картофель фри", sides)
+	salad := product("Салат", sides)
+	soup := product("Суп", uuid.New())
+	nestedCombo := product("Детское комбо", drinks)
+	soldOut := product("Морс", drinks)
+	soldOut.Available = false
+
+	drinkSlot := database.ComboSlot{ID: uuid.New(), ProductID: combo.ID, Index: 0, Title: "Напиток"} //nolint:exhaustruct
+	sideSlot := database.ComboSlot{ID: uuid.New(), ProductID: combo.ID, Index: 1, Title: "Гарнир"}   //nolint:exhaustruct
+
+	products := map[uuid.UUID]database.Product{}
+	for _, p := range []database.Product{combo, tea, lemonade, fries, salad, soup, nestedCombo, soldOut} {
+		products[p.ID] = p
+	}
+
+	slots := map[uuid.UUID][]database.ComboSlot{
+		combo.ID:       {drinkSlot, sideSlot},
+		nestedCombo.ID: {{ID: uuid.New(), ProductID: nestedCombo.ID, Title: "Игрушка"}}, //nolint:exhaustruct
+	}
+
+	// lemonade is listed before the drinks group, so its own surcharge wins over the group one
+	options := []database.ComboSlotOption{
+		{ID: 1, SlotID: drinkSlot.ID, ProductID: &lemonade.ID, GroupID: nil, Surcharge: 5000},
+		{ID: 2, SlotID: drinkSlot.ID, ProductID: nil, GroupID: &drinks, Surcharge: 0},
+		{ID: 3, SlotID: sideSlot.ID, ProductID: &fries.ID, GroupID: nil, Surcharge: 0},
+		{ID: 4, SlotID: sideSlot.ID, ProductID: &salad.ID, GroupID: nil, Surcharge: 3000},
+	}
+
+	choice := func(slot database.ComboSlot, p database.Product) api.ComboChoice {
+		return api.ComboChoice{SlotId: slot.ID, ProductId: p.ID}
+	}
+
+	tests := []struct {
+		name       string
+		product    database.Product
+		choices    []api.ComboChoice
+		components []uuid.UUID
+		surcharge  money.Kopecks
+		errCode    string
+	}{
+		{
+			name:    "regular product without choices",
+			product: soup,
+		},
+		{
+			name:    "regular product with choices",
+			product: soup,
+			choices: []api.ComboChoice{choice(drinkSlot, tea)},
+			errCode: CodeComboChoiceInvalid,
+		},
+		{
+			name:       "group option",
+			product:    combo,
+			choices:    []api.ComboChoice{choice(drinkSlot, tea), choice(sideSlot, fries)},
+			components: []uuid.UUID{tea.ID, fries.ID},
+		},
+		{
+			name:       "components follow the slot order and surcharges add up",
+			product:    combo,
+			choices:    []api.ComboChoice{choice(sideSlot, salad), choice(drinkSlot, lemonade)},
+			components: []uuid.UUID{lemonade.ID, salad.ID},
+			surcharge:  8000,
+		},
+		{
+			name:    "no choices for a combo",
+			product: combo,
+			errCode: CodeComboChoiceMissing,
+		},
+		{
+			name:    "slot without a choice",
+			product: combo,
+			choices: []api.ComboChoice{choice(drinkSlot, tea)},
+			errCode: CodeComboChoiceMissing,
+		},
+		{
+			name:    "two choices for one slot and none for another",
+			product: combo,
+			choices: []api.ComboChoice{choice(drinkSlot, tea), choice(drinkSlot, lemonade)},
+			errCode: CodeComboChoiceMissing,
+		},
+		{
+			name:    "more choices than slots",
+			product: combo,
+			choices: []api.ComboChoice{choice(drinkSlot, tea), choice(sideSlot, fries), choice(sideSlot, salad)},
+			errCode: CodeComboChoiceInvalid,
+		},
+		{
+			name:    "choice from another slot",
+			product: combo,
+			choices: []api.ComboChoice{choice(drinkSlot, fries), choice(sideSlot, salad)},
+			errCode: CodeComboChoiceInvalid,
+		},
+		{
+			name:    "product outside of the options",
+			product: combo,
+			choices: []api.ComboChoice{choice(drinkSlot, tea), choice(sideSlot, soup)},
+			errCode: CodeComboChoiceInvalid,
+		},
+		{
+			name:    "unknown product",
+			product: combo,
+			choices: []api.ComboChoice{choice(drinkSlot, tea), {SlotId: sideSlot.ID, ProductId: uuid.New()}},
+			errCode: CodeComboChoiceInvalid,
+		},
+		{
+			name:    "unavailable product",
+			product: combo,
+			choices: []api.ComboChoice{choice(drinkSlot, soldOut), choice(sideSlot, fries)},
+			errCode: CodeComboChoiceInvalid,
+		},
+		{
+			name:    "nested combo",
+			product: combo,
+			choices: []api.ComboChoice{choice(drinkSlot, nestedCombo), choice(sideSlot, fries)},
+			errCode: CodeComboChoiceInvalid,
+		},
+	}
+
+	db := dbtest.New()
+	db.Handle("GetComboSlotsByProduct", func(args []any) ([][]any, error) {
+		var rows [][]any
+		for _, slot := range slots[args[0].(uuid.UUID)] {
+			rows = append(rows, dbtest.Fields(slot))
+		}
+
+		return rows, nil
+	})
+	db.Handle("GetComboSlotOptionsByProduct", func(args []any) ([][]any, error) {
+		if args[0].(uuid.UUID) != combo.ID {
+			return nil, nil
+		}
+
+		rows := make([][]any, 0, len(options))
+		for _, option := range options {
+			rows = append(rows, dbtest.Fields(option))
+		}
+
+		return rows, nil
+	})
+	db.Handle("GetProductByID", func(args []any) ([][]any, error) {
+		p, ok := products[args[0].(uuid.UUID)]
+		if !ok {
+			return nil, nil
+		}
+
+		return [][]any{dbtest.Fields(p)}, nil
+	})
+
+	s := &Service{queries: database.New(db)} //nolint:exhaustruct
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := api.NewOrderItem{Id: tt.product.ID, Amount: 1, Choices: nil}
+			if tt.choices != nil {
+				item.Choices = meg.ToPtr(tt.choices)
+			}
+
+			components, surcharge, err := s.mapComboChoices(context.Background(), tt.product, item)
+			if tt.errCode != "" {
+				if oopsErr, ok := oops.AsOops(err); !ok || oopsErr.Code() != tt.errCode {
+					t.Fatalf("mapComboChoices error = %v, want %s", err, tt.errCode)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(components) != len(tt.components) {
+				t.Fatalf("got %d components, want %d", len(components), len(tt.components))
+			}
+			for i, component := range components {
+				if component.Id != tt.components[i] {
+					t.Errorf("component %d is %s, want %s", i, component.Title, products[tt.components[i]].Title)
+				}
+			}
+
+			if surcharge != tt.surcharge {
+				t.Errorf("surcharge = %d, want %d", surcharge, tt.surcharge)
+			}
+		})
+	}
+}
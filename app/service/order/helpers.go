@@ -2,26 +2,40 @@ package order
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"shantaram/app/api"
+	"shantaram/pkg/database"
+
+	"github.com/jackc/pgx/v5"
 )
 
-func (s *Service) mapNewOrderItem(ctx context.Context, item api.NewOrderItem) (api.OrderItem, error) {
+// mapNewOrderItem also returns the product, its limits are checked against the whole order
+func (s *Service) mapNewOrderItem(ctx context.Context, item api.NewOrderItem) (api.OrderItem, database.Product, error) {
 	product, err := s.queries.GetProductByID(ctx, item.Id)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return api.OrderItem{}, fmt.Errorf("product %s not found: %w", item.Id, err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.OrderItem{}, database.Product{}, policyError(CodeProductNotFound, "Товар не найден в меню, обновите страницу.")
 		}
 
-		return api.OrderItem{}, fmt.Errorf("GetProductByID: %w", err)
+		return api.OrderItem{}, database.Product{}, fmt.Errorf("GetProductByID: %w", err)
+	}
+
+	components, surcharge, err := s.mapComboChoices(ctx, product, item)
+	if err != nil {
+		return api.OrderItem{}, database.Product{}, err
 	}
 
-	return api.OrderItem{
+	result := api.OrderItem{
 		Amount: item.Amount,
 		Id:     item.Id,
-		Price:  product.Price,
+		Price:  product.Price + surcharge,
 		Title:  product.Title,
-	}, nil
+	}
+
+	if components != nil {
+		result.Components = &components
+	}
+
+	return result, product, nil
 }
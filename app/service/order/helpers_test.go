@@ -0,0 +1,46 @@
+package order
+
+import (
+	"context"
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+	"shantaram/pkg/database/dbtest"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestMapNewOrderItem(t *testing.T) {
+	soup := database.Product{ //nolint:exhaustruct
+		ID:        uuid.New(),
+		GroupID:   uuid.New(),
+		Title:     "Суп",
+		Price:     7000,
+		Available: true,
+	}
+
+	db := dbtest.New()
+	db.Handle("GetProductByID", func(args []any) ([][]any, error) {
+		if args[0].(uuid.UUID) != soup.ID {
+			return nil, nil
+		}
+
+		return [][]any{dbtest.Fields(soup)}, nil
+	})
+	db.Handle("GetComboSlotsByProduct", func([]any) ([][]any, error) {
+		return nil, nil
+	})
+
+	s := &Service{queries: database.New(db)} //nolint:exhaustruct
+
+	item, product, err := s.mapNewOrderItem(context.Background(), api.NewOrderItem{Id: soup.ID, Amount: 2, Choices: nil})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Price != 7000 || item.Amount != 2 || item.Title != "Суп" || item.Components != nil || product.ID != soup.ID {
+		t.Errorf("mapped %+v", item)
+	}
+
+	_, _, err = s.mapNewOrderItem(context.Background(), api.NewOrderItem{Id: uuid.New(), Amount: 1, Choices: nil})
+	checkPolicyError(t, err, CodeProductNotFound)
+}
@@ -0,0 +1,70 @@
+package order
+
+import (
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+	"shantaram/pkg/money"
+
+	"github.com/google/uuid"
+	"github.com/samber/oops"
+)
+
+// error codes returned to clients when an order violates the policy
+const (
+	CodeTooManyLines            = "order_too_many_lines"
+	CodeLineQuantityExceeded    = "order_line_quantity_exceeded"
+	CodeProductQuantityExceeded = "order_product_quantity_exceeded"
+	CodeTotalTooLow             = "order_total_too_low"
+	CodeTotalTooHigh            = "order_total_too_high"
+	CodeProductNotFound         = "order_product_not_found"
+)
+
+func policyError(code, public string) error {
+	return oops.Code(code).
+		With("status_code", http.StatusBadRequest).
+		Public(public).
+		New(code)
+}
+
+// checkLines runs before products are loaded, so that oversized orders are rejected early
+func checkLines(params database.Param, items []api.NewOrderItem) error {
+	if len(items) > int(params.MaxLines) {
+		return policyError(CodeTooManyLines, fmt.Sprintf("В заказе может быть не более %d позиций.", params.MaxLines))
+	}
+
+	for _, item := range items {
+		if item.Amount > int(params.MaxLineQuantity) {
+			return policyError(CodeLineQuantityExceeded, fmt.Sprintf("Можно заказать не более %d шт. одной позиции.", params.MaxLineQuantity))
+		}
+	}
+
+	return nil
+}
+
+// checkItems validates per product limits and the order total,
+// the same product in several lines counts as one
+func checkItems(params database.Param, items []api.OrderItem, maxQuantities map[uuid.UUID]*int32) error {
+	var total money.Kopecks
+	quantities := make(map[uuid.UUID]int, len(items))
+
+	for _, item := range items {
+		total += item.Price.Times(item.Amount)
+		quantities[item.Id] += item.Amount
+
+		if limit := maxQuantities[item.Id]; limit != nil && quantities[item.Id] > int(*limit) {
+			return policyError(CodeProductQuantityExceeded, fmt.Sprintf("«%s»: можно заказать не более %d шт.", item.Title, *limit))
+		}
+	}
+
+	if params.MinOrderTotal != nil && total < *params.MinOrderTotal {
+		return policyError(CodeTotalTooLow, fmt.Sprintf("Минимальная сумма заказа — %s ₽.", params.MinOrderTotal.String()))
+	}
+
+	if params.MaxOrderTotal != nil && total > *params.MaxOrderTotal {
+		return policyError(CodeTotalTooHigh, fmt.Sprintf("Максимальная сумма заказа — %s ₽.", params.MaxOrderTotal.String()))
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package order
+
+import (
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+	"shantaram/pkg/money"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rofleksey/meg"
+	"github.com/samber/oops"
+)
+
+// checkPolicyError fails unless err is a public 400 with the code, an empty code expects no error
+func checkPolicyError(t *testing.T, err error, code string) {
+	t.Helper()
+
+	if code == "" {
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+
+		return
+	}
+
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		t.Fatalf("error = %v, want %s", err, code)
+	}
+
+	if oopsErr.Code() != code {
+		t.Errorf("code = %v, want %s", oopsErr.Code(), code)
+	}
+	if oopsErr.Context()["status_code"] != http.StatusBadRequest {
+		t.Errorf("status code = %v, want 400", oopsErr.Context()["status_code"])
+	}
+	if oopsErr.Public() == "" {
+		t.Error("no public message")
+	}
+}
+
+func TestCheckLines(t *testing.T) {
+	params := database.Param{MaxLines: 3, MaxLineQuantity: 5} //nolint:exhaustruct
+
+	lines := func(amounts ...int) []api.NewOrderItem {
+		items := make([]api.NewOrderItem, 0, len(amounts))
+		for _, amount := range amounts {
+			items = append(items, api.NewOrderItem{Id: uuid.New(), Amount: amount, Choices: nil})
+		}
+
+		return items
+	}
+
+	tests := []struct {
+		name  string
+		items []api.NewOrderItem
+		code  string
+	}{
+		{name: "empty order", items: nil},
+		{name: "at the limits", items: lines(5, 5, 5)},
+		{name: "too many lines", items: lines(1, 1, 1, 1), code: CodeTooManyLines},
+		{name: "line quantity above the limit", items: lines(1, 6), code: CodeLineQuantityExceeded},
+		{name: "lines are checked before quantities", items: lines(9, 1, 1, 1), code: CodeTooManyLines},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkPolicyError(t, checkLines(params, tt.items), tt.code)
+		})
+	}
+}
+
+func TestCheckItems(t *testing.T) {
+	tea, soup := uuid.New(), uuid.New()
+
+	item := func(id uuid.UUID, price money.Kopecks, amount int) api.OrderItem {
+		return api.OrderItem{Id: id, Title: "Товар", Price: price, Amount: amount} //nolint:exhaustruct
+	}
+
+	tests := []struct {
+		name          string
+		min, max      *money.Kopecks
+		items         []api.OrderItem
+		maxQuantities map[uuid.UUID]*int32
+		code          string
+	}{
+		{
+			name:  "no limits",
+			items: []api.OrderItem{item(tea, 1500, 100)},
+		},
+		{
+			name:          "product at its limit",
+			items:         []api.OrderItem{item(tea, 1500, 2)},
+			maxQuantities: map[uuid.UUID]*int32{tea: meg.ToPtr(int32(2))},
+		},
+		{
+			name:          "product above its limit",
+			items:         []api.OrderItem{item(tea, 1500, 3)},
+			maxQuantities: map[uuid.UUID]*int32{tea: meg.ToPtr(int32(2))},
+			code:          CodeProductQuantityExceeded,
+		},
+		{
+			name:          "the same product in several lines counts as one",
+			items:         []api.OrderItem{item(tea, 1500, 2), item(soup, 7000, 1), item(tea, 1500, 1)},
+			maxQuantities: map[uuid.UUID]*int32{tea: meg.ToPtr(int32(2))},
+			code:          CodeProductQuantityExceeded,
+		},
+		{
+			name:          "other products are not limited",
+			items:         []api.OrderItem{item(soup, 7000, 10)},
+			maxQuantities: map[uuid.UUID]*int32{tea: meg.ToPtr(int32(1)), soup: nil},
+		},
+		{
+			name:  "total at the min",
+			min:   meg.ToPtr(money.Kopecks(10000)),
+			items: []api.OrderItem{item(tea, 1500, 2), item(soup, 7000, 1)},
+		},
+		{
+			name:  "total below the min",
+			min:   meg.ToPtr(money.Kopecks(10000)),
+			items: []api.OrderItem{item(tea, 1500, 2), item(soup, 6999, 1)},
+			code:  CodeTotalTooLow,
+		},
+		{
+			name:  "total at the max",
+			max:   meg.ToPtr(money.Kopecks(10000)),
+			items: []api.OrderItem{item(tea, 1500, 2), item(soup, 7000, 1)},
+		},
+		{
+			name:  "total above the max",
+			max:   meg.ToPtr(money.Kopecks(10000)),
+			items: []api.OrderItem{item(tea, 1500, 2), item(soup, 7001, 1)},
+			code:  CodeTotalTooHigh,
+		},
+		{
+			name:          "product limits are checked before the total",
+			min:           meg.ToPtr(money.Kopecks(100000)),
+			items:         []api.OrderItem{item(tea, 1500, 3)},
+			maxQuantities: map[uuid.UUID]*int32{tea: meg.ToPtr(int32(2))},
+			code:          CodeProductQuantityExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := database.Param{MinOrderTotal: tt.min, MaxOrderTotal: tt.max} //nolint:exhaustruct
+
+			checkPolicyError(t, checkItems(params, tt.items, tt.maxQuantities), tt.code)
+		})
+	}
+}
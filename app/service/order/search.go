@@ -0,0 +1,293 @@
+package order
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/pkg/database"
+	"strings"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/samber/oops"
+)
+
+// cursor points at the last order of a page, it is only valid for the sort it was issued for
+type cursor struct {
+	Sort  api.OrderSort `json:"s"`
+	Index int64         `json:"i"`
+	Total int64         `json:"t"`
+}
+
+func encodeCursor(c cursor) string {
+	data, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(value string, sort api.OrderSort) (cursor, error) {
+	var c cursor
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err == nil {
+		err = json.Unmarshal(data, &c)
+	}
+	if err != nil || c.Sort != sort {
+		return cursor{}, oops.With("status_code", http.StatusBadRequest).New("invalid cursor")
+	}
+
+	return c, nil
+}
+
+// searchPattern matches the query as a substring in ILIKE
+func searchPattern(q *string) *string {
+	if q == nil || strings.TrimSpace(*q) == "" {
+		return nil
+	}
+
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(strings.TrimSpace(*q))
+	pattern := "%" + escaped + "%"
+
+	return &pattern
+}
+
+func searchFilters(params api.GetOrdersParams) (database.CountSearchOrdersParams, error) {
+	if params.From != nil && params.To != nil && !params.To.After(*params.From) {
+		return database.CountSearchOrdersParams{}, oops.With("status_code", http.StatusBadRequest).New("to must be after from")
+	}
+
+	filters := database.CountSearchOrdersParams{
+		Statuses:   nil,
+		Seen:       params.Seen,
+		Since:      nil,
+		Until:      nil,
+		TableID:    params.TableId,
+		Pattern:    searchPattern(params.Q),
+		ProductID:  nil,
+		MinTotal:   nil,
+		MaxTotal:   nil,
+		CustomerID: params.CustomerId,
+	}
+
+	if params.Status != nil {
+		filters.Statuses = pie.Map(*params.Status, func(status api.OrderStatus) string {
+			return string(status)
+		})
+	}
+	if params.From != nil {
+		since := params.From.UTC()
+		filters.Since = &since
+	}
+	if params.To != nil {
+		until := params.To.UTC()
+		filters.Until = &until
+	}
+	if params.ProductId != nil {
+		productID := params.ProductId.String()
+		filters.ProductID = &productID
+	}
+	if params.MinTotal != nil {
+		minTotal := int64(*params.MinTotal)
+		filters.MinTotal = &minTotal
+	}
+	if params.MaxTotal != nil {
+		maxTotal := int64(*params.MaxTotal)
+		filters.MaxTotal = &maxTotal
+	}
+
+	return filters, nil
+}
+
+// exportBatch is the page size used to walk over all matching orders
+const exportBatch = 500
+
+// firstCursor comes before every order in the given sort
+func firstCursor(sort api.OrderSort) cursor {
+	if sort == api.OrderSortOldest || sort == api.OrderSortTotalAsc {
+		return cursor{Sort: sort, Index: math.MinInt64, Total: math.MinInt64}
+	}
+
+	return cursor{Sort: sort, Index: math.MaxInt64, Total: math.MaxInt64}
+}
+
+// searchPage returns up to limit orders after the cursor, every sort has a query of its own
+// so that the planner sees a literal ORDER BY. Unknown sorts fall back to the newest first.
+func (s *Service) searchPage(ctx context.Context, filters database.CountSearchOrdersParams, c cursor, limit int) ([]database.Order, error) {
+	rowLimit := int32(limit) //nolint:gosec
+
+	switch c.Sort {
+	case api.OrderSortOldest:
+		return s.queries.SearchOrdersOldest(ctx, database.SearchOrdersOldestParams{
+			Statuses:    filters.Statuses,
+			Seen:        filters.Seen,
+			Since:       filters.Since,
+			Until:       filters.Until,
+			TableID:     filters.TableID,
+			Pattern:     filters.Pattern,
+			ProductID:   filters.ProductID,
+			MinTotal:    filters.MinTotal,
+			MaxTotal:    filters.MaxTotal,
+			CustomerID:  filters.CustomerID,
+			CursorIndex: c.Index,
+			RowLimit:    rowLimit,
+		})
+	case api.OrderSortTotalAsc:
+		return s.queries.SearchOrdersTotalAsc(ctx, database.SearchOrdersTotalAscParams{
+			Statuses:    filters.Statuses,
+			Seen:        filters.Seen,
+			Since:       filters.Since,
+			Until:       filters.Until,
+			TableID:     filters.TableID,
+			Pattern:     filters.Pattern,
+			ProductID:   filters.ProductID,
+			MinTotal:    filters.MinTotal,
+			MaxTotal:    filters.MaxTotal,
+			CustomerID:  filters.CustomerID,
+			CursorTotal: c.Total,
+			CursorIndex: c.Index,
+			RowLimit:    rowLimit,
+		})
+	case api.OrderSortTotalDesc:
+		return s.queries.SearchOrdersTotalDesc(ctx, database.SearchOrdersTotalDescParams{
+			Statuses:    filters.Statuses,
+			Seen:        filters.Seen,
+			Since:       filters.Since,
+			Until:       filters.Until,
+			TableID:     filters.TableID,
+			Pattern:     filters.Pattern,
+			ProductID:   filters.ProductID,
+			MinTotal:    filters.MinTotal,
+			MaxTotal:    filters.MaxTotal,
+			CustomerID:  filters.CustomerID,
+			CursorTotal: c.Total,
+			CursorIndex: c.Index,
+			RowLimit:    rowLimit,
+		})
+	default:
+		return s.queries.SearchOrdersNewest(ctx, database.SearchOrdersNewestParams{
+			Statuses:    filters.Statuses,
+			Seen:        filters.Seen,
+			Since:       filters.Since,
+			Until:       filters.Until,
+			TableID:     filters.TableID,
+			Pattern:     filters.Pattern,
+			ProductID:   filters.ProductID,
+			MinTotal:    filters.MinTotal,
+			MaxTotal:    filters.MaxTotal,
+			CustomerID:  filters.CustomerID,
+			CursorIndex: c.Index,
+			RowLimit:    rowLimit,
+		})
+	}
+}
+
+// nextCursor points after the given order
+func nextCursor(sort api.OrderSort, last database.Order) cursor {
+	return cursor{Sort: sort, Index: last.Index, Total: int64(last.Total)}
+}
+
+// SearchOrders returns a page of matching orders and the cursor of the next page.
+// The cursor is empty on the last page. The total count is only read with withTotal,
+// since it has to go over every match.
+func (s *Service) SearchOrders(ctx context.Context, params api.GetOrdersParams) ([]database.Order, *int64, string, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "search_orders")
+	defer span.End()
+
+	filters, err := searchFilters(params)
+	if err != nil {
+		return nil, nil, "", s.tracing.Error(span, err)
+	}
+
+	sort := api.OrderSortNewest
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+
+	limit := 10
+	if params.Limit != nil {
+		limit = min(max(*params.Limit, 1), 100)
+	}
+
+	c := firstCursor(sort)
+
+	if params.Cursor != nil {
+		c, err = decodeCursor(*params.Cursor, sort)
+		if err != nil {
+			return nil, nil, "", s.tracing.Error(span, err)
+		}
+	}
+
+	// one extra row tells whether there is a next page
+	orders, err := s.searchPage(ctx, filters, c, limit+1)
+	if err != nil {
+		return nil, nil, "", s.tracing.Error(span, fmt.Errorf("searchPage: %w", err))
+	}
+
+	var totalCount *int64
+
+	if params.WithTotal != nil && *params.WithTotal {
+		count, err := s.queries.CountSearchOrders(ctx, filters)
+		if err != nil {
+			return nil, nil, "", s.tracing.Error(span, fmt.Errorf("CountSearchOrders: %w", err))
+		}
+
+		totalCount = &count
+	}
+
+	var next string
+
+	if len(orders) > limit {
+		orders = orders[:limit]
+		next = encodeCursor(nextCursor(sort, orders[len(orders)-1]))
+	}
+
+	s.tracing.Success(span)
+
+	return orders, totalCount, next, nil
+}
+
+// EachOrder calls fn for every order matching the filters, oldest first unless another sort is given.
+// Orders are read page by page with the search cursor, so only one page is held in memory.
+// Every page starts at the cursor in the index of the sort and doesn't scan the pages before it.
+func (s *Service) EachOrder(ctx context.Context, params api.GetOrdersParams, fn func(order database.Order) error) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "each_order")
+	defer span.End()
+
+	filters, err := searchFilters(params)
+	if err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	sort := api.OrderSortOldest
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+
+	c := firstCursor(sort)
+
+	for {
+		orders, err := s.searchPage(ctx, filters, c, exportBatch)
+		if err != nil {
+			return s.tracing.Error(span, fmt.Errorf("searchPage: %w", err))
+		}
+
+		for _, order := range orders {
+			if err = fn(order); err != nil {
+				return s.tracing.Error(span, err)
+			}
+		}
+
+		if len(orders) < exportBatch {
+			break
+		}
+
+		c = nextCursor(sort, orders[len(orders)-1])
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
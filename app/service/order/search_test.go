@@ -0,0 +1,305 @@
+package order
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"math"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/database/dbtest"
+	"shantaram/pkg/money"
+	"shantaram/pkg/telemetry"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rofleksey/meg"
+	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestCursor(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		sort  api.OrderSort
+		want  cursor
+		valid bool
+	}{
+		{
+			name:  "newest",
+			value: encodeCursor(cursor{Sort: api.OrderSortNewest, Index: 42, Total: 0}),
+			sort:  api.OrderSortNewest,
+			want:  cursor{Sort: api.OrderSortNewest, Index: 42, Total: 0},
+			valid: true,
+		},
+		{
+			name:  "total",
+			value: encodeCursor(cursor{Sort: api.OrderSortTotalDesc, Index: 7, Total: 123456}),
+			sort:  api.OrderSortTotalDesc,
+			want:  cursor{Sort: api.OrderSortTotalDesc, Index: 7, Total: 123456},
+			valid: true,
+		},
+		{
+			name:  "extreme values",
+			value: encodeCursor(cursor{Sort: api.OrderSortTotalAsc, Index: math.MaxInt64, Total: math.MinInt64}),
+			sort:  api.OrderSortTotalAsc,
+			want:  cursor{Sort: api.OrderSortTotalAsc, Index: math.MaxInt64, Total: math.MinInt64},
+			valid: true,
+		},
+		{
+			name:  "issued for another sort",
+			value: encodeCursor(cursor{Sort: api.OrderSortNewest, Index: 42, Total: 0}),
+			sort:  api.OrderSortOldest,
+		},
+		{
+			name:  "not base64",
+			value: "%%%",
+			sort:  api.OrderSortNewest,
+		},
+		{
+			name:  "not json",
+			value: base64.RawURLEncoding.EncodeToString([]byte("index=42")),
+			sort:  api.OrderSortNewest,
+		},
+		{
+			name:  "wrong types",
+			value: base64.RawURLEncoding.EncodeToString([]byte(`{"s":"newest","i":"42"}`)),
+			sort:  api.OrderSortNewest,
+		},
+		{
+			name:  "empty",
+			value: "",
+			sort:  api.OrderSortNewest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeCursor(tt.value, tt.sort)
+
+			if !tt.valid {
+				oopsErr, ok := oops.AsOops(err)
+				if !ok || oopsErr.Context()["status_code"] != http.StatusBadRequest {
+					t.Fatalf("decodeCursor = %+v, %v, want a bad request", got, err)
+				}
+
+				return
+			}
+
+			if err != nil || got != tt.want {
+				t.Fatalf("decodeCursor = %+v, %v, want %+v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+// orderStore answers the search queries over orders in memory the way the SQL does
+type orderStore struct {
+	orders  []database.Order
+	queries []string
+	counts  int
+}
+
+func (st *orderStore) page(name string, less func(a, b database.Order) int, after func(o database.Order, args []any) bool) dbtest.Handler {
+	return func(args []any) ([][]any, error) {
+		st.queries = append(st.queries, name)
+
+		sorted := slices.SortedFunc(slices.Values(st.orders), less)
+		limit := int(args[len(args)-1].(int32))
+
+		var rows [][]any
+
+		for _, order := range sorted {
+			if statuses := args[0].([]string); statuses != nil && !slices.Contains(statuses, string(order.Status)) {
+				continue
+			}
+
+			if after(order, args) && len(rows) < limit {
+				rows = append(rows, dbtest.Fields(order))
+			}
+		}
+
+		return rows, nil
+	}
+}
+
+func newSearchService(t *testing.T, orders int) (*Service, *orderStore) {
+	t.Helper()
+
+	st := &orderStore{}
+
+	for i := range orders {
+		status := api.OrderStatusClosed
+		if i%4 == 0 {
+			status = api.OrderStatusCancelled
+		}
+
+		st.orders = append(st.orders, database.Order{ //nolint:exhaustruct
+			ID:     uuid.New(),
+			Index:  int64(i + 1),
+			Status: status,
+			// a few orders share a total, the index breaks the tie
+			Total: money.Kopecks((i * 7919 % 13) * 10000),
+		})
+	}
+
+	byIndex := func(a, b database.Order) int { return cmp.Compare(a.Index, b.Index) }
+	byTotal := func(a, b database.Order) int {
+		return cmp.Or(cmp.Compare(a.Total, b.Total), cmp.Compare(a.Index, b.Index))
+	}
+	reversed := func(less func(a, b database.Order) int) func(a, b database.Order) int {
+		return func(a, b database.Order) int { return less(b, a) }
+	}
+
+	db := dbtest.New()
+	db.Handle("SearchOrdersNewest", st.page("SearchOrdersNewest", reversed(byIndex), func(o database.Order, args []any) bool {
+		return o.Index < args[10].(int64)
+	}))
+	db.Handle("SearchOrdersOldest", st.page("SearchOrdersOldest", byIndex, func(o database.Order, args []any) bool {
+		return o.Index > args[10].(int64)
+	}))
+	db.Handle("SearchOrdersTotalDesc", st.page("SearchOrdersTotalDesc", reversed(byTotal), func(o database.Order, args []any) bool {
+		return cmp.Or(cmp.Compare(int64(o.Total), args[10].(int64)), cmp.Compare(o.Index, args[11].(int64))) < 0
+	}))
+	db.Handle("SearchOrdersTotalAsc", st.page("SearchOrdersTotalAsc", byTotal, func(o database.Order, args []any) bool {
+		return cmp.Or(cmp.Compare(int64(o.Total), args[10].(int64)), cmp.Compare(o.Index, args[11].(int64))) > 0
+	}))
+	db.Handle("CountSearchOrders", func(args []any) ([][]any, error) {
+		st.counts++
+
+		var count int64
+
+		for _, order := range st.orders {
+			if statuses := args[0].([]string); statuses == nil || slices.Contains(statuses, string(order.Status)) {
+				count++
+			}
+		}
+
+		return [][]any{{count}}, nil
+	})
+
+	cfg := &config.Config{} //nolint:exhaustruct
+
+	return &Service{ //nolint:exhaustruct
+		cfg:     cfg,
+		queries: database.New(db),
+		tracing: telemetry.NewTracing(cfg, noop.NewTracerProvider().Tracer("test")),
+	}, st
+}
+
+func TestSearchOrdersPages(t *testing.T) {
+	tests := []struct {
+		sort  api.OrderSort
+		query string
+	}{
+		{sort: api.OrderSortNewest, query: "SearchOrdersNewest"},
+		{sort: api.OrderSortOldest, query: "SearchOrdersOldest"},
+		{sort: api.OrderSortTotalDesc, query: "SearchOrdersTotalDesc"},
+		{sort: api.OrderSortTotalAsc, query: "SearchOrdersTotalAsc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			s, st := newSearchService(t, 40)
+			ctx := context.Background()
+
+			var (
+				all    []database.Order
+				cursor *string
+			)
+
+			for {
+				orders, totalCount, next, err := s.SearchOrders(ctx, api.GetOrdersParams{ //nolint:exhaustruct
+					Cursor:    cursor,
+					Limit:     meg.ToPtr(7),
+					Sort:      &tt.sort,
+					Status:    &[]api.OrderStatus{api.OrderStatusClosed},
+					WithTotal: meg.ToPtr(cursor == nil),
+				})
+				if err != nil {
+					t.Fatalf("SearchOrders: %v", err)
+				}
+
+				if (totalCount != nil) != (cursor == nil) || (totalCount != nil && *totalCount != 30) {
+					t.Fatalf("totalCount = %v on page %d, want 30 on the first page only", totalCount, len(all)/7)
+				}
+
+				all = append(all, orders...)
+
+				if next == "" {
+					break
+				}
+
+				cursor = &next
+			}
+
+			if len(all) != 30 || st.counts != 1 {
+				t.Fatalf("got %d orders with %d counts, want 30 closed ones counted once", len(all), st.counts)
+			}
+
+			seen := make(map[uuid.UUID]bool)
+			for i, order := range all {
+				if seen[order.ID] {
+					t.Fatalf("order %d is returned twice", order.Index)
+				}
+
+				seen[order.ID] = true
+
+				if i == 0 {
+					continue
+				}
+
+				prev := all[i-1]
+
+				var ordered bool
+
+				switch tt.sort {
+				case api.OrderSortNewest:
+					ordered = prev.Index > order.Index
+				case api.OrderSortOldest:
+					ordered = prev.Index < order.Index
+				case api.OrderSortTotalDesc:
+					ordered = prev.Total > order.Total || prev.Total == order.Total && prev.Index > order.Index
+				case api.OrderSortTotalAsc:
+					ordered = prev.Total < order.Total || prev.Total == order.Total && prev.Index < order.Index
+				}
+
+				if !ordered {
+					t.Fatalf("order %d (total %d) comes after %d (total %d)", order.Index, order.Total, prev.Index, prev.Total)
+				}
+			}
+
+			for _, query := range st.queries {
+				if query != tt.query {
+					t.Fatalf("ran %s, want %s only", query, tt.query)
+				}
+			}
+		})
+	}
+}
+
+func TestEachOrder(t *testing.T) {
+	s, st := newSearchService(t, 2*exportBatch+3)
+
+	var indexes []int64
+
+	if err := s.EachOrder(context.Background(), api.GetOrdersParams{}, func(order database.Order) error { //nolint:exhaustruct
+		indexes = append(indexes, order.Index)
+
+		return nil
+	}); err != nil {
+		t.Fatalf("EachOrder: %v", err)
+	}
+
+	if len(indexes) != len(st.orders) || !slices.IsSorted(indexes) {
+		t.Fatalf("got %d orders, want all %d oldest first", len(indexes), len(st.orders))
+	}
+
+	if len(st.queries) != 3 || st.counts != 0 {
+		t.Fatalf("ran %d page queries and %d counts, want 3 pages and no count", len(st.queries), st.counts)
+	}
+}
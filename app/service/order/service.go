@@ -4,78 +4,180 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"shantaram/app/api"
 	"shantaram/app/mapper"
+	"shantaram/app/service/capacity"
+	"shantaram/app/service/discount"
+	"shantaram/app/service/eta"
+	"shantaram/app/service/hours"
+	"shantaram/app/service/inventory"
+	"shantaram/app/service/kitchen"
+	"shantaram/app/service/loyalty"
+	"shantaram/app/service/printing"
 	"shantaram/app/service/pubsub"
 	"shantaram/app/service/telegram"
 	"shantaram/pkg/config"
 	"shantaram/pkg/database"
 	"shantaram/pkg/telemetry"
+	"shantaram/pkg/util"
 
+	"github.com/elliotchance/pie/v2"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rofleksey/meg"
 	"github.com/samber/do"
 	"github.com/samber/oops"
 )
 
 var serviceName = "order"
 
-var maxPositions = 10
-var maxPrice = 99999.0
-var maxAmount = 10
-
 type Service struct {
-	cfg             *config.Config
-	dbConn          *pgxpool.Pool
-	queries         *database.Queries
-	pubsubService   *pubsub.Service
-	telegramService *telegram.Service
-	tracing         *telemetry.Tracing
+	cfg              *config.Config
+	dbConn           *pgxpool.Pool
+	queries          *database.Queries
+	pubsubService    *pubsub.Service
+	telegramService  *telegram.Service
+	kitchenService   *kitchen.Service
+	printingService  *printing.Service
+	etaService       *eta.Service
+	capacityService  *capacity.Service
+	hoursService     *hours.Service
+	discountService  *discount.Service
+	inventoryService *inventory.Service
+	loyaltyService   *loyalty.Service
+	tracing          *telemetry.Tracing
 }
 
 func New(di *do.Injector) (*Service, error) {
 	return &Service{
-		cfg:             do.MustInvoke[*config.Config](di),
-		dbConn:          do.MustInvoke[*pgxpool.Pool](di),
-		queries:         do.MustInvoke[*database.Queries](di),
-		pubsubService:   do.MustInvoke[*pubsub.Service](di),
-		telegramService: do.MustInvoke[*telegram.Service](di),
-		tracing:         do.MustInvoke[*telemetry.Tracing](di),
+		cfg:              do.MustInvoke[*config.Config](di),
+		dbConn:           do.MustInvoke[*pgxpool.Pool](di),
+		queries:          do.MustInvoke[*database.Queries](di),
+		pubsubService:    do.MustInvoke[*pubsub.Service](di),
+		telegramService:  do.MustInvoke[*telegram.Service](di),
+		kitchenService:   do.MustInvoke[*kitchen.Service](di),
+		printingService:  do.MustInvoke[*printing.Service](di),
+		etaService:       do.MustInvoke[*eta.Service](di),
+		capacityService:  do.MustInvoke[*capacity.Service](di),
+		hoursService:     do.MustInvoke[*hours.Service](di),
+		discountService:  do.MustInvoke[*discount.Service](di),
+		inventoryService: do.MustInvoke[*inventory.Service](di),
+		loyaltyService:   do.MustInvoke[*loyalty.Service](di),
+		tracing:          do.MustInvoke[*telemetry.Tracing](di),
 	}, nil
 }
 
-func (s *Service) CreateOrder(ctx context.Context, req *api.NewOrderRequest) error {
+// priceItems checks the order policy and resolves current prices,
+// the returned map holds the product group of every product
+func (s *Service) priceItems(ctx context.Context, params database.Param, newItems []api.NewOrderItem) ([]api.OrderItem, map[uuid.UUID]uuid.UUID, error) {
+	if err := checkLines(params, newItems); err != nil {
+		return nil, nil, err
+	}
+
+	orderItems := make([]api.OrderItem, 0, len(newItems))
+	groups := make(map[uuid.UUID]uuid.UUID, len(newItems))
+	maxQuantities := make(map[uuid.UUID]*int32, len(newItems))
+
+	for _, newItem := range newItems {
+		item, product, err := s.mapNewOrderItem(ctx, newItem)
+		if err != nil {
+			return nil, nil, fmt.Errorf("mapNewOrderItem %s: %w", newItem.Id, err)
+		}
+
+		orderItems = append(orderItems, item)
+		groups[product.ID] = product.GroupID
+		maxQuantities[product.ID] = product.MaxQuantity
+	}
+
+	if err := checkItems(params, orderItems, maxQuantities); err != nil {
+		return nil, nil, err
+	}
+
+	return orderItems, groups, nil
+}
+
+// Quote prices the order the same way CreateOrder does without placing it
+func (s *Service) Quote(ctx context.Context, req *api.QuoteRequest) ([]api.OrderItem, discount.Result, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "quote")
+	defer span.End()
+
+	params, err := s.queries.GetParams(ctx)
+	if err != nil {
+		return nil, discount.Result{}, s.tracing.Error(span, fmt.Errorf("GetParams: %w", err))
+	}
+
+	orderItems, groups, err := s.priceItems(ctx, params, req.Items)
+	if err != nil {
+		return nil, discount.Result{}, s.tracing.Error(span, err)
+	}
+
+	result, err := s.discountService.Apply(ctx, s.queries, orderItems, groups, req.PromoCode, discount.CustomerKey(ctx))
+	if err != nil {
+		return nil, discount.Result{}, s.tracing.Error(span, fmt.Errorf("Apply: %w", err))
+	}
+
+	result, err = s.loyaltyService.Apply(ctx, s.queries, util.CustomerID(ctx), req.Points, result)
+	if err != nil {
+		return nil, discount.Result{}, s.tracing.Error(span, fmt.Errorf("loyalty.Apply: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return orderItems, result, nil
+}
+
+func (s *Service) CreateOrder(ctx context.Context, req *api.NewOrderRequest) (database.Order, error) {
 	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "create")
 	defer span.End()
 
-	if len(req.Items) > maxPositions {
-		return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("too many items"))
+	params, err := s.queries.GetParams(ctx)
+	if err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("GetParams: %w", err))
 	}
 
-	var totalPrice float64
+	orderItems, groups, err := s.priceItems(ctx, params, req.Items)
+	if err != nil {
+		return database.Order{}, s.tracing.Error(span, err)
+	}
 
-	orderItems := make([]api.OrderItem, 0, len(req.Items))
-	for _, newItem := range req.Items {
-		if newItem.Amount > maxAmount {
-			return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("too many items"))
-		}
+	var totalAmount int
+	for _, item := range orderItems {
+		totalAmount += item.Amount
+	}
 
-		item, err := s.mapNewOrderItem(ctx, newItem)
-		if err != nil {
-			return s.tracing.Error(span, fmt.Errorf("mapNewOrderItem %d: %w", newItem.Id, err))
-		}
+	if err := s.hoursService.CheckOpen(ctx, req.PickupAt); err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("CheckOpen: %w", err))
+	}
 
-		orderItems = append(orderItems, item)
-		totalPrice += item.Price
+	tx, err := s.dbConn.Begin(ctx)
+	if err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("Begin: %w", err))
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	qtx := s.queries.WithTx(tx)
+
+	pickupAt, err := s.capacityService.CheckSlot(ctx, qtx, req.PickupAt, totalAmount)
+	if err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("CheckSlot: %w", err))
+	}
+
+	customer := discount.CustomerKey(ctx)
+
+	priced, err := s.discountService.Apply(ctx, qtx, orderItems, groups, req.PromoCode, customer)
+	if err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("Apply: %w", err))
 	}
 
-	if totalPrice > maxPrice {
-		return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("too many items"))
+	priced, err = s.loyaltyService.Apply(ctx, qtx, util.CustomerID(ctx), req.Points, priced)
+	if err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("loyalty.Apply: %w", err))
 	}
 
-	dbOrder, err := s.queries.CreateOrder(ctx, database.CreateOrderParams{
+	dbOrder, err := qtx.CreateOrder(ctx, database.CreateOrderParams{
 		ID:            req.Id,
 		TableID:       nil,
 		ClientName:    req.Name,
@@ -83,31 +185,143 @@ func (s *Service) CreateOrder(ctx context.Context, req *api.NewOrderRequest) err
 		Status:        api.OrderStatusOpen,
 		Seen:          false,
 		Items:         orderItems,
+		PickupAt:      &pickupAt,
+		Discounts:     priced.Discounts,
+		CustomerID:    util.CustomerID(ctx),
 	})
 	if err != nil {
-		return s.tracing.Error(span, fmt.Errorf("CreateOrder: %w", err))
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("CreateOrder: %w", err))
+	}
+
+	stock, err := s.inventoryService.Consume(ctx, qtx, dbOrder.ID, orderItems)
+	if err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("Consume: %w", err))
+	}
+
+	if err = s.discountService.RecordUsages(ctx, qtx, dbOrder.ID, customer, priced.Discounts); err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("RecordUsages: %w", err))
+	}
+
+	if err = s.loyaltyService.Sync(ctx, qtx, dbOrder); err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("Sync: %w", err))
+	}
+
+	if err = qtx.CreateOrderStatusHistory(ctx, database.CreateOrderStatusHistoryParams{
+		OrderID: dbOrder.ID,
+		Status:  dbOrder.Status,
+	}); err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("CreateOrderStatusHistory: %w", err))
+	}
+
+	stationIDs, err := s.kitchenService.CreateTickets(ctx, qtx, dbOrder)
+	if err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("CreateTickets: %w", err))
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return database.Order{}, s.tracing.Error(span, fmt.Errorf("Commit: %w", err))
+	}
+
+	etaMinutes, readyAt, err := s.etaService.Estimate(ctx, dbOrder)
+	if err != nil {
+		// the order is already accepted, don't fail because of the estimate
+		slog.ErrorContext(ctx, "Failed to estimate order",
+			slog.Any("error", err),
+		)
+	} else {
+		dbOrder.EtaMinutes = meg.ToPtr(int32(etaMinutes)) //nolint:gosec
+		dbOrder.ReadyAt = &readyAt
 	}
 
 	msg := mapper.OrderToNotificationText(dbOrder)
 	go s.telegramService.Notify(msg)
 
+	s.inventoryService.Publish(stock)
+
+	if s.cfg.Printing.Trigger == "created" {
+		s.printingService.PrintOrderAsync(dbOrder)
+	}
+
 	s.pubsubService.NotifyOrdersChanged()
+	for _, stationID := range pie.Unique(stationIDs) {
+		s.pubsubService.NotifyKitchenChanged(stationID)
+	}
 	s.tracing.Success(span)
 
-	return nil
+	return dbOrder, nil
 }
 
 func (s *Service) SetStatus(ctx context.Context, id uuid.UUID, status api.OrderStatus) error {
 	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "set_status")
 	defer span.End()
 
-	if err := s.queries.UpdateOrderStatus(ctx, database.UpdateOrderStatusParams{
+	tx, err := s.dbConn.Begin(ctx)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("Begin: %w", err))
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	qtx := s.queries.WithTx(tx)
+
+	order, err := qtx.GetOrderByIDForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).Errorf("order not found"))
+		}
+
+		return s.tracing.Error(span, fmt.Errorf("GetOrderByIDForUpdate: %w", err))
+	}
+
+	// stock and promo code uses of a cancelled order go back, and are taken again if the order is restored
+	var stock inventory.Changes
+
+	switch {
+	case status == api.OrderStatusCancelled && order.Status != api.OrderStatusCancelled:
+		stock, err = s.inventoryService.Restore(ctx, qtx, id)
+		if err != nil {
+			return s.tracing.Error(span, fmt.Errorf("Restore: %w", err))
+		}
+
+		if err = s.discountService.ReleaseUsages(ctx, qtx, id); err != nil {
+			return s.tracing.Error(span, fmt.Errorf("ReleaseUsages: %w", err))
+		}
+	case status != api.OrderStatusCancelled && order.Status == api.OrderStatusCancelled:
+		stock, err = s.inventoryService.Consume(ctx, qtx, id, order.Items)
+		if err != nil {
+			return s.tracing.Error(span, fmt.Errorf("Consume: %w", err))
+		}
+
+		if err = s.discountService.ReclaimUsages(ctx, qtx, id); err != nil {
+			return s.tracing.Error(span, fmt.Errorf("ReclaimUsages: %w", err))
+		}
+	}
+
+	if err = qtx.UpdateOrderStatus(ctx, database.UpdateOrderStatusParams{
 		ID:     id,
 		Status: status,
 	}); err != nil {
 		return s.tracing.Error(span, fmt.Errorf("UpdateOrderStatus: %w", err))
 	}
 
+	if err = qtx.CreateOrderStatusHistory(ctx, database.CreateOrderStatusHistoryParams{
+		OrderID: id,
+		Status:  status,
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("CreateOrderStatusHistory: %w", err))
+	}
+
+	// points are charged back on cancellation and earned once the order is closed
+	order.Status = status
+	if err = s.loyaltyService.Sync(ctx, qtx, order); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("Sync: %w", err))
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("Commit: %w", err))
+	}
+
+	s.inventoryService.Publish(stock)
+	s.etaService.RecalculateAsync(ctx)
 	s.pubsubService.NotifyOrdersChanged()
 	s.tracing.Success(span)
 
@@ -122,6 +336,7 @@ func (s *Service) DeleteOrderByID(ctx context.Context, id uuid.UUID) error {
 		return s.tracing.Error(span, fmt.Errorf("DeleteOrder: %w", err))
 	}
 
+	s.etaService.RecalculateAsync(ctx)
 	s.pubsubService.NotifyOrdersChanged()
 	s.tracing.Success(span)
 
@@ -146,34 +361,43 @@ func (s *Service) GetOrderByID(ctx context.Context, id uuid.UUID) (database.Orde
 	return order, nil
 }
 
-func (s *Service) GetOrdersPaginated(ctx context.Context, offset, limit int) ([]database.Order, int64, error) {
-	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_orders_paginated")
+func (s *Service) MarkOrderSeen(ctx context.Context, id uuid.UUID) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "mark_order_seen")
 	defer span.End()
 
-	orders, err := s.queries.GetOrdersPaginated(ctx, database.GetOrdersPaginatedParams{
-		Offset: int64(offset),
-		Limit:  int64(limit),
-	})
+	order, err := s.queries.GetOrderByID(ctx, id)
 	if err != nil {
-		return nil, 0, s.tracing.Error(span, fmt.Errorf("GetOrdersPaginated: %w", err))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).Errorf("order not found"))
+		}
+
+		return s.tracing.Error(span, fmt.Errorf("GetOrderByID: %w", err))
 	}
 
-	totalCount, err := s.queries.CountOrders(ctx)
-	if err != nil {
-		return nil, 0, s.tracing.Error(span, fmt.Errorf("CountOrders: %w", err))
+	if err = s.queries.SetOrderSeen(ctx, id); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("SetOrderSeen: %w", err))
+	}
+
+	if !order.Seen && s.cfg.Printing.Trigger == "seen" {
+		s.printingService.PrintOrderAsync(order)
 	}
 
 	s.tracing.Success(span)
 
-	return orders, totalCount, nil
+	return nil
 }
 
-func (s *Service) MarkOrderSeen(ctx context.Context, id uuid.UUID) error {
-	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "mark_order_seen")
+func (s *Service) PrintOrder(ctx context.Context, id uuid.UUID) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "print_order")
 	defer span.End()
 
-	if err := s.queries.SetOrderSeen(ctx, id); err != nil {
-		return s.tracing.Error(span, fmt.Errorf("SetOrderSeen: %w", err))
+	order, err := s.GetOrderByID(ctx, id)
+	if err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	if err = s.printingService.PrintOrder(ctx, order, true); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("PrintOrder: %w", err))
 	}
 
 	s.tracing.Success(span)
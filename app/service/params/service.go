@@ -4,13 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
+	"shantaram/app/api"
 	"shantaram/pkg/config"
 	"shantaram/pkg/database"
 	"shantaram/pkg/telemetry"
-	"time"
 
 	"github.com/rofleksey/meg"
 	"github.com/samber/do"
+	"github.com/samber/oops"
 )
 
 var serviceName = "params"
@@ -29,48 +31,105 @@ func New(di *do.Injector) (*Service, error) {
 	}, nil
 }
 
-func (s *Service) SetHeaderText(ctx context.Context, text *string, deadline *time.Time) error {
-	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "set_header_text")
+func (s *Service) SetOrderingPaused(ctx context.Context, paused bool) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "set_ordering_paused")
 	defer span.End()
 
-	if err := s.queries.SetParamsHeader(ctx, database.SetParamsHeaderParams{
-		HeaderText:     text,
-		HeaderDeadline: deadline,
-	}); err != nil {
-		return s.tracing.Error(span, fmt.Errorf("SetParamsHeader: %w", err))
+	if err := s.queries.SetParamsOrderingPaused(ctx, paused); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("SetParamsOrderingPaused: %w", err))
 	}
 
+	slog.Info("Online ordering state changed",
+		slog.Bool("paused", paused),
+	)
+
 	s.tracing.Success(span)
 
 	return nil
 }
 
-func (s *Service) RunHeaderDeadline(ctx context.Context) {
-	meg.RunTicker(ctx, time.Minute, func() {
-		if err := s.checkHeaderDeadline(ctx); err != nil {
-			slog.Error("checkHeaderDeadline error",
-				slog.Any("error", err),
-			)
-		}
-	})
+func (s *Service) SetCapacity(ctx context.Context, slotMinutes int, maxOrders, maxItems *int) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "set_capacity")
+	defer span.End()
+
+	if slotMinutes <= 0 || 60%slotMinutes != 0 && slotMinutes%60 != 0 {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("slot length must divide an hour or be a multiple of it"))
+	}
+
+	if err := s.queries.SetParamsCapacity(ctx, database.SetParamsCapacityParams{
+		SlotMinutes:   int32(slotMinutes), //nolint:gosec
+		SlotMaxOrders: meg.PtrIntToPtrInt32(maxOrders),
+		SlotMaxItems:  meg.PtrIntToPtrInt32(maxItems),
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("SetParamsCapacity: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
 }
 
-func (s *Service) checkHeaderDeadline(ctx context.Context) error {
+func (s *Service) GetParams(ctx context.Context) (database.Param, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_params")
+	defer span.End()
+
 	params, err := s.queries.GetParams(ctx)
 	if err != nil {
-		return fmt.Errorf("GetParams: %w", err)
+		return database.Param{}, s.tracing.Error(span, fmt.Errorf("GetParams: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return params, nil
+}
+
+func (s *Service) SetOrderPolicy(ctx context.Context, req *api.OrderPolicy) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "set_order_policy")
+	defer span.End()
+
+	if req.MaxLines < 1 || req.MaxLineQuantity < 1 {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("max lines and max line quantity must be positive"))
+	}
+
+	if req.MinTotal != nil && req.MaxTotal != nil && *req.MinTotal > *req.MaxTotal {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("min total must not exceed max total"))
+	}
+
+	if err := s.queries.SetParamsOrderPolicy(ctx, database.SetParamsOrderPolicyParams{
+		MaxLines:        int32(req.MaxLines),        //nolint:gosec
+		MaxLineQuantity: int32(req.MaxLineQuantity), //nolint:gosec
+		MinOrderTotal:   req.MinTotal,
+		MaxOrderTotal:   req.MaxTotal,
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("SetParamsOrderPolicy: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) SetLoyaltyPolicy(ctx context.Context, req *api.LoyaltyPolicy) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "set_loyalty_policy")
+	defer span.End()
+
+	if req.EarnPercent < 0 || req.EarnPercent > 100 || req.MaxRedeemPercent < 0 || req.MaxRedeemPercent > 100 {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("percents must be between 0 and 100"))
 	}
 
-	if params.HeaderDeadline != nil && time.Now().After(*params.HeaderDeadline) {
-		if err := s.queries.SetParamsHeader(ctx, database.SetParamsHeaderParams{
-			HeaderText:     nil,
-			HeaderDeadline: nil,
-		}); err != nil {
-			return fmt.Errorf("SetParamsHeader: %w", err)
-		}
+	if req.ExpireDays != nil && *req.ExpireDays < 1 {
+		return s.tracing.Error(span, oops.With("status_code", http.StatusBadRequest).New("expire days must be positive"))
+	}
 
-		slog.Info("Header was reset on deadline")
+	if err := s.queries.SetParamsLoyaltyPolicy(ctx, database.SetParamsLoyaltyPolicyParams{
+		LoyaltyEarnPercent:      int32(req.EarnPercent),      //nolint:gosec
+		LoyaltyMaxRedeemPercent: int32(req.MaxRedeemPercent), //nolint:gosec
+		LoyaltyExpireDays:       meg.PtrIntToPtrInt32(req.ExpireDays),
+	}); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("SetParamsLoyaltyPolicy: %w", err))
 	}
 
+	s.tracing.Success(span)
+
 	return nil
 }
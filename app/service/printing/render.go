@@ -0,0 +1,118 @@
+package printing
+
+import (
+	"fmt"
+	"shantaram/app/mapper"
+	"shantaram/pkg/database"
+	"shantaram/pkg/escpos"
+	"strings"
+	"unicode/utf8"
+)
+
+func (s *Service) newBuilder() *escpos.Builder {
+	return escpos.New(byte(*s.cfg.Printing.CodePage)) //nolint:gosec
+}
+
+func (s *Service) separator() string {
+	return strings.Repeat("-", s.cfg.Printing.LineWidth)
+}
+
+// columns renders left and right parts on a single line, padded to the line width
+func (s *Service) columns(left, right string) string {
+	padding := s.cfg.Printing.LineWidth - utf8.RuneCountInString(left) - utf8.RuneCountInString(right)
+	if padding < 1 {
+		return left + "\n" + strings.Repeat(" ", max(s.cfg.Printing.LineWidth-utf8.RuneCountInString(right), 0)) + right
+	}
+
+	return left + strings.Repeat(" ", padding) + right
+}
+
+func (s *Service) renderKitchenTicket(
+	order database.Order,
+	station database.KitchenStation,
+	tickets []database.KitchenTicket,
+	reprint bool,
+) []byte {
+	b := s.newBuilder()
+
+	b.Align(escpos.AlignCenter).
+		Bold(true).
+		Line(station.Title).
+		Bold(false)
+
+	if reprint {
+		b.Line("ПОВТОРНАЯ ПЕЧАТЬ")
+	}
+
+	b.Size(3, 3).
+		Line(fmt.Sprintf("#%d", order.Index)).
+		Size(1, 1).
+		Line(order.Created.In(s.cfg.Location).Format("02.01.2006 15:04")).
+		Align(escpos.AlignLeft).
+		Line(s.separator())
+
+	b.Size(1, 2).Bold(true)
+	for _, ticket := range tickets {
+		b.Line(fmt.Sprintf("%d x %s", ticket.Amount, ticket.Title))
+	}
+	b.Bold(false).Size(1, 1)
+
+	b.Line(s.separator()).
+		Line("Имя: " + order.ClientName)
+
+	if order.ClientComment != nil {
+		b.Line("Комментарий: " + *order.ClientComment)
+	}
+
+	return b.Feed(4).Cut().Bytes()
+}
+
+func (s *Service) renderReceipt(order database.Order, reprint bool) []byte {
+	b := s.newBuilder()
+
+	b.Align(escpos.AlignCenter).
+		Bold(true).
+		Line("Shantaram").
+		Bold(false)
+
+	if reprint {
+		b.Line("КОПИЯ")
+	}
+
+	b.Text("Заказ ").
+		Size(2, 2).
+		Line(fmt.Sprintf("#%d", order.Index)).
+		Size(1, 1).
+		Line(order.Created.In(s.cfg.Location).Format("02.01.2006 15:04")).
+		Align(escpos.AlignLeft).
+		Line(s.separator())
+
+	for _, item := range order.Items {
+		b.Line(s.columns(
+			fmt.Sprintf("%s x %d", item.Title, item.Amount),
+			item.Price.Times(item.Amount).String(),
+		))
+
+		if item.Components != nil {
+			for _, component := range *item.Components {
+				b.Line("  " + component.Title)
+			}
+		}
+	}
+
+	for _, discount := range order.Discounts {
+		b.Line(s.columns(discount.Title, "-"+discount.Amount.String()))
+	}
+
+	b.Line(s.separator()).
+		Bold(true).
+		Line(s.columns("ИТОГО, руб.", mapper.OrderTotal(order).String())).
+		Bold(false).
+		Line("Имя: " + order.ClientName)
+
+	if order.ClientComment != nil {
+		b.Line("Комментарий: " + *order.ClientComment)
+	}
+
+	return b.Feed(4).Cut().Bytes()
+}
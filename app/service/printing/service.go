@@ -0,0 +1,204 @@
+package printing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/telemetry"
+	"time"
+
+	"github.com/samber/do"
+)
+
+var serviceName = "printing"
+
+var defaultPort = "9100"
+var dialTimeout = 5 * time.Second
+var writeTimeout = 10 * time.Second
+var retryBaseDelay = 5 * time.Second
+var queueSize = 256
+
+type printJob struct {
+	address  string
+	title    string
+	data     []byte
+	attempts int
+}
+
+type Service struct {
+	appCtx  context.Context
+	cfg     *config.Config
+	queries *database.Queries
+	tracing *telemetry.Tracing
+	jobs    chan printJob
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		appCtx:  do.MustInvoke[context.Context](di),
+		cfg:     do.MustInvoke[*config.Config](di),
+		queries: do.MustInvoke[*database.Queries](di),
+		tracing: do.MustInvoke[*telemetry.Tracing](di),
+		jobs:    make(chan printJob, queueSize),
+	}, nil
+}
+
+// PrintOrder renders kitchen tickets for every station printer and the receipt, and queues them for printing
+func (s *Service) PrintOrder(ctx context.Context, order database.Order, reprint bool) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "print_order")
+	defer span.End()
+
+	tickets, err := s.queries.GetKitchenTicketsByOrder(ctx, order.ID)
+	if err != nil {
+		return s.tracing.Error(span, fmt.Errorf("GetKitchenTicketsByOrder: %w", err))
+	}
+
+	stationTickets := make(map[string][]database.KitchenTicket)
+	var stationIDs []string
+
+	for _, ticket := range tickets {
+		if _, ok := stationTickets[ticket.StationID]; !ok {
+			stationIDs = append(stationIDs, ticket.StationID)
+		}
+
+		stationTickets[ticket.StationID] = append(stationTickets[ticket.StationID], ticket)
+	}
+
+	for _, stationID := range stationIDs {
+		station, err := s.queries.GetKitchenStationByID(ctx, stationID)
+		if err != nil {
+			return s.tracing.Error(span, fmt.Errorf("GetKitchenStationByID %s: %w", stationID, err))
+		}
+
+		if station.PrinterAddress == nil || *station.PrinterAddress == "" {
+			continue
+		}
+
+		s.enqueue(printJob{
+			address:  *station.PrinterAddress,
+			title:    fmt.Sprintf("order #%d ticket for %s", order.Index, station.ID),
+			data:     s.renderKitchenTicket(order, station, stationTickets[stationID], reprint),
+			attempts: 0,
+		})
+	}
+
+	if s.cfg.Printing.ReceiptPrinter != "" {
+		s.enqueue(printJob{
+			address:  s.cfg.Printing.ReceiptPrinter,
+			title:    fmt.Sprintf("order #%d receipt", order.Index),
+			data:     s.renderReceipt(order, reprint),
+			attempts: 0,
+		})
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+// PrintOrderAsync is PrintOrder for callers that should not wait for or fail on printing
+func (s *Service) PrintOrderAsync(order database.Order) {
+	go func() {
+		if err := s.PrintOrder(s.appCtx, order, false); err != nil {
+			slog.Error("Failed to print order",
+				slog.Int64("index", order.Index),
+				slog.Any("error", err),
+			)
+		}
+	}()
+}
+
+func (s *Service) enqueue(job printJob) {
+	select {
+	case s.jobs <- job:
+	default:
+		slog.Error("Print queue is full, dropping job",
+			slog.String("title", job.title),
+			slog.String("address", job.address),
+		)
+	}
+}
+
+func (s *Service) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-s.jobs:
+			s.process(ctx, job)
+		}
+	}
+}
+
+func (s *Service) process(ctx context.Context, job printJob) {
+	job.attempts++
+
+	err := s.send(ctx, job.address, job.data)
+	if err == nil {
+		slog.Info("Printed",
+			slog.String("title", job.title),
+			slog.String("address", job.address),
+		)
+
+		return
+	}
+
+	if job.attempts >= s.cfg.Printing.MaxAttempts {
+		slog.Error("Failed to print, giving up",
+			slog.String("title", job.title),
+			slog.String("address", job.address),
+			slog.Int("attempts", job.attempts),
+			slog.Any("error", err),
+		)
+
+		return
+	}
+
+	delay := retryBaseDelay * time.Duration(1<<(job.attempts-1))
+
+	slog.Warn("Failed to print, retrying",
+		slog.String("title", job.title),
+		slog.String("address", job.address),
+		slog.Int("attempts", job.attempts),
+		slog.Duration("delay", delay),
+		slog.Any("error", err),
+	)
+
+	time.AfterFunc(delay, func() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.enqueue(job)
+	})
+}
+
+// send writes raw bytes to the printer over TCP, which is what port 9100 (JetDirect) printers expect
+func (s *Service) send(ctx context.Context, address string, data []byte) error {
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, defaultPort)
+	}
+
+	dialer := net.Dialer{ //nolint:exhaustruct
+		Timeout: dialTimeout,
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("dial: %w", err)
+	}
+	defer conn.Close()
+
+	if err = conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return fmt.Errorf("SetWriteDeadline: %w", err)
+	}
+
+	if _, err = conn.Write(data); err != nil {
+		return fmt.Errorf("write: %w", err)
+	}
+
+	return nil
+}
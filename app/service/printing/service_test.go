@@ -0,0 +1,268 @@
+package printing
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"shantaram/app/api"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/telemetry"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rofleksey/meg"
+	"go.opentelemetry.io/otel/trace/noop"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// emptyDB answers every query with no rows, the order has no kitchen tickets then
+type emptyDB struct{}
+
+func (emptyDB) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, nil
+}
+
+func (emptyDB) Query(context.Context, string, ...any) (pgx.Rows, error) {
+	return &emptyRows{}, nil
+}
+
+func (emptyDB) QueryRow(context.Context, string, ...any) pgx.Row {
+	return &emptyRows{}
+}
+
+type emptyRows struct{}
+
+func (*emptyRows) Close()                                       {}
+func (*emptyRows) Err() error                                   { return nil }
+func (*emptyRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (*emptyRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (*emptyRows) Next() bool                                   { return false }
+func (*emptyRows) Scan(...any) error                            { return pgx.ErrNoRows }
+func (*emptyRows) Values() ([]any, error)                       { return nil, nil }
+func (*emptyRows) RawValues() [][]byte                          { return nil }
+func (*emptyRows) Conn() *pgx.Conn                              { return nil }
+
+func newTestService(receiptPrinter string) *Service {
+	cfg := &config.Config{} //nolint:exhaustruct
+	cfg.Location = time.UTC
+	cfg.Printing.ReceiptPrinter = receiptPrinter
+	cfg.Printing.CodePage = meg.ToPtr(17)
+	cfg.Printing.LineWidth = 32
+	cfg.Printing.MaxAttempts = 3
+
+	return &Service{
+		appCtx:  context.Background(),
+		cfg:     cfg,
+		queries: database.New(emptyDB{}),
+		tracing: telemetry.NewTracing(cfg, noop.NewTracerProvider().Tracer("test")),
+		jobs:    make(chan printJob, queueSize),
+	}
+}
+
+// standInPrinter accepts connections and hands over everything written to each of them
+func standInPrinter(t *testing.T, ln net.Listener) <-chan []byte {
+	t.Helper()
+
+	received := make(chan []byte, 8)
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+
+			data, _ := io.ReadAll(conn)
+			_ = conn.Close()
+			received <- data
+		}
+	}()
+
+	return received
+}
+
+func waitData(t *testing.T, received <-chan []byte) []byte {
+	t.Helper()
+
+	select {
+	case data := <-received:
+		return data
+	case <-time.After(5 * time.Second):
+		t.Fatal("the printer received nothing")
+
+		return nil
+	}
+}
+
+func cp866(t *testing.T, s string) []byte {
+	t.Helper()
+
+	encoded, err := charmap.CodePage866.NewEncoder().Bytes([]byte(s))
+	if err != nil {
+		t.Fatalf("encode %q: %v", s, err)
+	}
+
+	return encoded
+}
+
+func testOrder() database.Order {
+	return database.Order{ //nolint:exhaustruct
+		ID:         uuid.New(),
+		Index:      42,
+		ClientName: "Иван",
+		Created:    time.Date(2026, 10, 19, 12, 30, 0, 0, time.UTC),
+		Items: []api.OrderItem{
+			{
+				Id:     uuid.New(),
+				Title:  "Чай масала",
+				Price:  25000,
+				Amount: 2,
+			},
+		},
+		Discounts: []api.OrderDiscount{},
+	}
+}
+
+func TestPrintOrderSendsReceipt(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	defer ln.Close()
+
+	received := standInPrinter(t, ln)
+	s := newTestService(ln.Addr().String())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go s.Run(ctx)
+
+	if err = s.PrintOrder(ctx, testOrder(), false); err != nil {
+		t.Fatalf("PrintOrder: %v", err)
+	}
+
+	data := waitData(t, received)
+
+	if init := []byte{0x1B, '@', 0x1B, 't', 17}; !bytes.HasPrefix(data, init) {
+		t.Fatalf("data starts with % x, want printer init and code page % x", data[:min(len(data), 5)], init)
+	}
+
+	if cut := []byte{0x1D, 'V', 66, 0}; !bytes.HasSuffix(data, cut) {
+		t.Fatalf("data does not end with the cut command % x", cut)
+	}
+
+	for _, text := range []string{"Чай масала x 2", "500.00", "ИТОГО, руб.", "Имя: Иван", "#42"} {
+		if !bytes.Contains(data, cp866(t, text)) {
+			t.Errorf("data has no CP866 encoded %q", text)
+		}
+	}
+
+	if bytes.Contains(data, []byte("Чай")) {
+		t.Error("text was sent as UTF-8")
+	}
+}
+
+func TestPrintOrderWithoutPrinters(t *testing.T) {
+	s := newTestService("")
+
+	if err := s.PrintOrder(context.Background(), testOrder(), false); err != nil {
+		t.Fatalf("PrintOrder: %v", err)
+	}
+
+	if len(s.jobs) != 0 {
+		t.Fatalf("queued %d jobs, want none", len(s.jobs))
+	}
+}
+
+func TestSendRetriesWhenPrinterIsDown(t *testing.T) {
+	defaultDelay := retryBaseDelay
+	retryBaseDelay = 50 * time.Millisecond
+	defer func() { retryBaseDelay = defaultDelay }()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+
+	address := ln.Addr().String()
+
+	// the printer is offline for the first attempt
+	_ = ln.Close()
+
+	s := newTestService(address)
+	ctx := context.Background()
+
+	job := printJob{
+		address:  address,
+		title:    "order #42 receipt",
+		data:     s.renderReceipt(testOrder(), false),
+		attempts: 0,
+	}
+
+	if err = s.send(ctx, address, job.data); err == nil {
+		t.Fatal("send to a closed listener succeeded")
+	}
+
+	s.process(ctx, job)
+
+	ln, err = net.Listen("tcp", address)
+	if err != nil {
+		t.Skipf("port %s was taken in between: %v", address, err)
+	}
+	defer ln.Close()
+
+	received := standInPrinter(t, ln)
+
+	var retried printJob
+
+	select {
+	case retried = <-s.jobs:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the failed job was not queued again")
+	}
+
+	if retried.attempts != 1 {
+		t.Fatalf("attempts = %d, want 1", retried.attempts)
+	}
+
+	s.process(ctx, retried)
+
+	if data := waitData(t, received); !bytes.Equal(data, job.data) {
+		t.Fatalf("the retry sent %d bytes, want %d", len(data), len(job.data))
+	}
+}
+
+func TestProcessGivesUpAfterMaxAttempts(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+
+	address := ln.Addr().String()
+	_ = ln.Close()
+
+	s := newTestService(address)
+
+	defaultDelay := retryBaseDelay
+	retryBaseDelay = time.Millisecond
+	defer func() { retryBaseDelay = defaultDelay }()
+
+	s.process(context.Background(), printJob{
+		address:  address,
+		title:    "order #42 receipt",
+		data:     []byte("x"),
+		attempts: s.cfg.Printing.MaxAttempts - 1,
+	})
+
+	time.Sleep(50 * time.Millisecond)
+
+	if len(s.jobs) != 0 {
+		t.Fatal("the job was queued again after the last attempt")
+	}
+}
@@ -1,20 +1,34 @@
 package pubsub
 
 import (
+	"context"
+	"log/slog"
 	"shantaram/app/api"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/samber/do"
 	"github.com/simonfxr/pubsub"
 )
 
+var historySize = 128
+
+type historyEntry struct {
+	channel string
+	message api.IdMessage
+}
+
 type Service struct {
 	bus *pubsub.Bus
+
+	historyMu sync.Mutex
+	history   []historyEntry
 }
 
 func New(_ *do.Injector) (*Service, error) {
 	return &Service{
-		bus: pubsub.NewBus(),
+		bus:     pubsub.NewBus(),
+		history: make([]historyEntry, 0, historySize),
 	}, nil
 }
 
@@ -22,11 +36,80 @@ func (s *Service) Subscribe(channel string, callback func(message any)) *pubsub.
 	return s.bus.Subscribe(channel, callback)
 }
 
+// SubscribeMessages is like Subscribe, but only passes through api.IdMessage values
+func (s *Service) SubscribeMessages(channel string, callback func(message api.IdMessage)) *pubsub.Subscription {
+	return s.bus.Subscribe(channel, func(data any) {
+		defer func() {
+			if err := recover(); err != nil {
+				slog.Warn("Panic in subscription handler", slog.Any("error", err))
+			}
+		}()
+
+		idMsg, ok := data.(api.IdMessage)
+		if !ok {
+			slog.LogAttrs(context.Background(), slog.LevelError, "Failed to cast pubsub message to IdMessage",
+				slog.Any("data", data),
+			)
+			return
+		}
+
+		callback(idMsg)
+	})
+}
+
 func (s *Service) Unsubscribe(sub *pubsub.Subscription) {
 	s.bus.Unsubscribe(sub)
 }
 
+// Since returns messages published to any of the channels after the message with lastID.
+// The second value is false if lastID is no longer present in the history.
+func (s *Service) Since(channels []string, lastID string) ([]api.IdMessage, bool) {
+	s.historyMu.Lock()
+	defer s.historyMu.Unlock()
+
+	start := -1
+	for i, entry := range s.history {
+		if entry.message.GetId() == lastID {
+			start = i + 1
+			break
+		}
+	}
+
+	if start < 0 {
+		return nil, false
+	}
+
+	var result []api.IdMessage
+
+	for _, entry := range s.history[start:] {
+		for _, channel := range channels {
+			if entry.channel == channel {
+				result = append(result, entry.message)
+				break
+			}
+		}
+	}
+
+	return result, true
+}
+
+func (s *Service) remember(channel string, message api.IdMessage) {
+	s.historyMu.Lock()
+	defer s.historyMu.Unlock()
+
+	if len(s.history) == historySize {
+		copy(s.history, s.history[1:])
+		s.history = s.history[:historySize-1]
+	}
+
+	s.history = append(s.history, historyEntry{
+		channel: channel,
+		message: message,
+	})
+}
+
 func (s *Service) doPublish(channel string, message api.IdMessage) {
+	s.remember(channel, message)
 	s.bus.Publish(channel, message)
 }
 
@@ -43,3 +126,24 @@ func (s *Service) NotifyMenuChanged() {
 		Event: api.WsMenuChangedMessageEventMenuChanged,
 	})
 }
+
+func (s *Service) NotifyKitchenChanged(stationID string) {
+	s.doPublish("admin", &api.WsKitchenChangedMessage{
+		Id:        uuid.New().String(),
+		Event:     api.WsKitchenChangedMessageEventKitchenChanged,
+		StationId: stationID,
+	})
+}
+
+// NotifyAnnouncementsChanged publishes the same message to every channel,
+// clients subscribed to several of them receive it once thanks to deduplication
+func (s *Service) NotifyAnnouncementsChanged(channels ...string) {
+	msg := &api.WsAnnouncementsChangedMessage{
+		Id:    uuid.New().String(),
+		Event: api.WsAnnouncementsChangedMessageEventAnnouncementsChanged,
+	}
+
+	for _, channel := range channels {
+		s.doPublish(channel, msg)
+	}
+}
@@ -0,0 +1,293 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/service/email"
+	"shantaram/app/service/telegram"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/money"
+	"shantaram/pkg/telemetry"
+	"time"
+
+	"github.com/samber/do"
+	"github.com/samber/oops"
+)
+
+var serviceName = "report"
+
+const defaultLimit = 10
+
+// documentSender is the telegram service, tests pass a stand-in
+type documentSender interface {
+	ChatIDs() []string
+	NotifyDocument(ctx context.Context, chatID, msg, filename string, data []byte) error
+}
+
+// mailSender is the email service, tests pass a stand-in
+type mailSender interface {
+	Enabled() bool
+	Send(ctx context.Context, subject, body string, attachments ...email.Attachment) error
+}
+
+type Service struct {
+	cfg             *config.Config
+	queries         *database.Queries
+	telegramService documentSender
+	emailService    mailSender
+	tracing         *telemetry.Tracing
+}
+
+func New(di *do.Injector) (*Service, error) {
+	return &Service{
+		cfg:             do.MustInvoke[*config.Config](di),
+		queries:         do.MustInvoke[*database.Queries](di),
+		telegramService: do.MustInvoke[*telegram.Service](di),
+		emailService:    do.MustInvoke[*email.Service](di),
+		tracing:         do.MustInvoke[*telemetry.Tracing](di),
+	}, nil
+}
+
+func checkRange(from, to time.Time) error {
+	if !to.After(from) {
+		return oops.With("status_code", http.StatusBadRequest).New("to must be after from")
+	}
+
+	return nil
+}
+
+func granularity(value *api.ReportGranularity) (string, error) {
+	if value == nil {
+		return string(api.ReportGranularityDay), nil
+	}
+
+	switch *value {
+	case api.ReportGranularityHour, api.ReportGranularityDay, api.ReportGranularityWeek:
+		return string(*value), nil
+	default:
+		return "", oops.With("status_code", http.StatusBadRequest).Errorf("invalid granularity %q", *value)
+	}
+}
+
+func limit(value *int) int32 {
+	if value == nil {
+		return defaultLimit
+	}
+
+	return int32(min(max(*value, 1), 100)) //nolint:gosec
+}
+
+// local attaches the configured timezone to wall clock time computed by the database
+func (s *Service) local(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), s.cfg.Location)
+}
+
+func (s *Service) Sales(ctx context.Context, params api.GetSalesReportParams) ([]api.SalesReportRow, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "sales")
+	defer span.End()
+
+	if err := checkRange(params.From, params.To); err != nil {
+		return nil, s.tracing.Error(span, err)
+	}
+
+	trunc, err := granularity(params.Granularity)
+	if err != nil {
+		return nil, s.tracing.Error(span, err)
+	}
+
+	rows, err := s.queries.GetSalesReport(ctx, database.GetSalesReportParams{
+		Granularity: trunc,
+		Tz:          s.cfg.Timezone,
+		Since:       params.From.UTC(),
+		Until:       params.To.UTC(),
+	})
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetSalesReport: %w", err))
+	}
+
+	result := make([]api.SalesReportRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, api.SalesReportRow{
+			Period:       s.local(row.Period),
+			Orders:       int(row.Orders),
+			Revenue:      money.Kopecks(row.Revenue),
+			AverageCheck: money.Kopecks(row.Revenue / int64(max(row.Orders, 1))),
+		})
+	}
+
+	s.tracing.Success(span)
+
+	return result, nil
+}
+
+func (s *Service) TopProducts(ctx context.Context, params api.GetTopProductsReportParams) ([]api.TopProductRow, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "top_products")
+	defer span.End()
+
+	if err := checkRange(params.From, params.To); err != nil {
+		return nil, s.tracing.Error(span, err)
+	}
+
+	rows, err := s.queries.GetTopProductsReport(ctx, database.GetTopProductsReportParams{
+		Since:    params.From.UTC(),
+		Until:    params.To.UTC(),
+		RowLimit: limit(params.Limit),
+	})
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetTopProductsReport: %w", err))
+	}
+
+	result := make([]api.TopProductRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, api.TopProductRow{
+			ProductId: row.ProductID,
+			Title:     row.Title,
+			Quantity:  int(row.Quantity),
+			Revenue:   money.Kopecks(row.Revenue),
+		})
+	}
+
+	s.tracing.Success(span)
+
+	return result, nil
+}
+
+// TopGroups skips products deleted since the order, their group is unknown
+func (s *Service) TopGroups(ctx context.Context, params api.GetTopGroupsReportParams) ([]api.TopGroupRow, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "top_groups")
+	defer span.End()
+
+	if err := checkRange(params.From, params.To); err != nil {
+		return nil, s.tracing.Error(span, err)
+	}
+
+	rows, err := s.queries.GetTopGroupsReport(ctx, database.GetTopGroupsReportParams{
+		Since:    params.From.UTC(),
+		Until:    params.To.UTC(),
+		RowLimit: limit(params.Limit),
+	})
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetTopGroupsReport: %w", err))
+	}
+
+	result := make([]api.TopGroupRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, api.TopGroupRow{
+			GroupId:  row.GroupID,
+			Title:    row.Title,
+			Quantity: int(row.Quantity),
+			Revenue:  money.Kopecks(row.Revenue),
+		})
+	}
+
+	s.tracing.Success(span)
+
+	return result, nil
+}
+
+func (s *Service) Cancellations(ctx context.Context, params api.GetCancellationReportParams) ([]api.CancellationReportRow, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "cancellations")
+	defer span.End()
+
+	if err := checkRange(params.From, params.To); err != nil {
+		return nil, s.tracing.Error(span, err)
+	}
+
+	trunc, err := granularity(params.Granularity)
+	if err != nil {
+		return nil, s.tracing.Error(span, err)
+	}
+
+	rows, err := s.queries.GetCancellationReport(ctx, database.GetCancellationReportParams{
+		Granularity: trunc,
+		Tz:          s.cfg.Timezone,
+		Since:       params.From.UTC(),
+		Until:       params.To.UTC(),
+	})
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetCancellationReport: %w", err))
+	}
+
+	result := make([]api.CancellationReportRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, api.CancellationReportRow{
+			Period:    s.local(row.Period),
+			Orders:    int(row.Orders),
+			Cancelled: int(row.Cancelled),
+			Rate:      float64(row.Cancelled) / float64(max(row.Orders, 1)),
+		})
+	}
+
+	s.tracing.Success(span)
+
+	return result, nil
+}
+
+func (s *Service) Heatmap(ctx context.Context, params api.GetHeatmapReportParams) ([]api.HeatmapCell, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "heatmap")
+	defer span.End()
+
+	if err := checkRange(params.From, params.To); err != nil {
+		return nil, s.tracing.Error(span, err)
+	}
+
+	rows, err := s.queries.GetHeatmapReport(ctx, database.GetHeatmapReportParams{
+		Tz:    s.cfg.Timezone,
+		Since: params.From.UTC(),
+		Until: params.To.UTC(),
+	})
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetHeatmapReport: %w", err))
+	}
+
+	result := make([]api.HeatmapCell, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, api.HeatmapCell{
+			Weekday: int(row.Weekday),
+			Hour:    int(row.Hour),
+			Orders:  int(row.Orders),
+			Revenue: money.Kopecks(row.Revenue),
+		})
+	}
+
+	s.tracing.Success(span)
+
+	return result, nil
+}
+
+// StatusDurations measures how long orders stay in a status until the next one,
+// the current status of an order has no duration yet and is skipped
+func (s *Service) StatusDurations(ctx context.Context, params api.GetStatusDurationReportParams) ([]api.StatusDurationRow, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "status_durations")
+	defer span.End()
+
+	if err := checkRange(params.From, params.To); err != nil {
+		return nil, s.tracing.Error(span, err)
+	}
+
+	rows, err := s.queries.GetStatusDurationReport(ctx, database.GetStatusDurationReportParams{
+		Since: params.From.UTC(),
+		Until: params.To.UTC(),
+	})
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetStatusDurationReport: %w", err))
+	}
+
+	result := make([]api.StatusDurationRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, api.StatusDurationRow{
+			Status:      api.OrderStatus(row.Status),
+			Transitions: int(row.Transitions),
+			P50:         row.P50,
+			P90:         row.P90,
+			P95:         row.P95,
+		})
+	}
+
+	s.tracing.Success(span)
+
+	return result, nil
+}
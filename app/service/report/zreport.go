@@ -0,0 +1,378 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"shantaram/app/api"
+	"shantaram/app/mapper"
+	"shantaram/app/service/email"
+	"shantaram/pkg/database"
+	"shantaram/pkg/money"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rofleksey/meg"
+	"github.com/samber/oops"
+)
+
+// maxBacklogDays limits how many missed business days are closed at once
+const maxBacklogDays = 7
+
+const zReportTopItems = 10
+
+// zReportClaim is how long an instance may take to send a report before another one
+// sends it again, it is well above zReportSendTimeout times the number of targets
+const zReportClaim = 15 * time.Minute
+
+const zReportSendTimeout = time.Minute
+
+const (
+	emailTarget    = "email"
+	telegramTarget = "telegram:"
+)
+
+// cutoff is the configured end of a business day as an offset from midnight
+func (s *Service) cutoff() time.Duration {
+	t, _ := time.Parse("15:04", s.cfg.ZReport.Cutoff)
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+}
+
+// ZReportCron runs the close job right after the business day ends in the configured timezone
+func (s *Service) ZReportCron() string {
+	cutoff := s.cutoff()
+
+	return fmt.Sprintf("CRON_TZ=%s %d %d * * *", s.cfg.Timezone, int(cutoff.Minutes())%60, int(cutoff.Hours()))
+}
+
+// businessDay returns the date of the business day t belongs to, as UTC midnight like DATE columns
+func (s *Service) businessDay(t time.Time) time.Time {
+	local := t.In(s.cfg.Location).Add(-s.cutoff())
+
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func (s *Service) dayBounds(day time.Time) (time.Time, time.Time) {
+	starts := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, s.cfg.Location).Add(s.cutoff())
+	ends := time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, s.cfg.Location).Add(s.cutoff())
+
+	return starts, ends
+}
+
+// CloseDays stores reports of finished business days that have none yet and sends them
+func (s *Service) CloseDays(ctx context.Context) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "close_days")
+	defer span.End()
+
+	yesterday := s.businessDay(time.Now()).AddDate(0, 0, -1)
+	from := yesterday
+
+	last, err := s.queries.GetLastZReportDay(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return s.tracing.Error(span, fmt.Errorf("GetLastZReportDay: %w", err))
+	}
+
+	if err == nil {
+		from = last.AddDate(0, 0, 1)
+		if earliest := yesterday.AddDate(0, 0, 1-maxBacklogDays); from.Before(earliest) {
+			from = earliest
+		}
+	}
+
+	var errs []error
+
+	for day := from; !day.After(yesterday); day = day.AddDate(0, 0, 1) {
+		if err = s.closeDay(ctx, day); err != nil {
+			errs = append(errs, fmt.Errorf("closeDay %s: %w", day.Format(time.DateOnly), err))
+		}
+	}
+
+	if err = s.deliverPending(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("deliverPending: %w", err))
+	}
+
+	if err = errors.Join(errs...); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) closeDay(ctx context.Context, day time.Time) error {
+	starts, ends := s.dayBounds(day)
+
+	summary, err := s.buildSummary(ctx, starts, ends)
+	if err != nil {
+		return err
+	}
+
+	affected, err := s.queries.CreateZReport(ctx, database.CreateZReportParams{
+		Day:     day,
+		Starts:  starts.UTC(),
+		Ends:    ends.UTC(),
+		Summary: summary,
+	})
+	if err != nil {
+		return fmt.Errorf("CreateZReport: %w", err)
+	}
+
+	// another instance has closed the day already
+	if affected == 0 {
+		return nil
+	}
+
+	slog.InfoContext(ctx, "Business day closed",
+		slog.String("day", day.Format(time.DateOnly)),
+		slog.Int("orders", summary.Orders),
+	)
+
+	return nil
+}
+
+// DeliverZReports retries reports that some channel failed to get
+func (s *Service) DeliverZReports(ctx context.Context) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "deliver_z_reports")
+	defer span.End()
+
+	if err := s.deliverPending(ctx); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+// zReportTargets lists every configured telegram chat and email, unless it is disabled.
+// Each target is marked delivered on its own, so that a retry resends to the failed ones only.
+func (s *Service) zReportTargets() []string {
+	chatIDs := s.telegramService.ChatIDs()
+
+	targets := make([]string, 0, len(chatIDs)+1)
+	for _, chatID := range chatIDs {
+		targets = append(targets, telegramTarget+chatID)
+	}
+
+	if s.emailService.Enabled() {
+		targets = append(targets, emailTarget)
+	}
+
+	return targets
+}
+
+// deliverPending sends reports of the last maxBacklogDays days to the targets that haven't got them yet
+func (s *Service) deliverPending(ctx context.Context) error {
+	targets := s.zReportTargets()
+	if len(targets) == 0 {
+		return nil
+	}
+
+	days, err := s.queries.GetUndeliveredZReportDays(ctx, database.GetUndeliveredZReportDaysParams{
+		Since:    s.businessDay(time.Now()).AddDate(0, 0, -maxBacklogDays),
+		Channels: targets,
+	})
+	if err != nil {
+		return fmt.Errorf("GetUndeliveredZReportDays: %w", err)
+	}
+
+	var errs []error
+
+	for _, day := range days {
+		if err = s.deliverDay(ctx, day, targets); err != nil {
+			errs = append(errs, fmt.Errorf("deliverDay %s: %w", day.Format(time.DateOnly), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// deliverDay claims the day so that instances don't send it twice, then sends it with no
+// transaction open. Targets that got the report are marked even when another one fails.
+func (s *Service) deliverDay(ctx context.Context, day time.Time, targets []string) error {
+	now := time.Now().UTC()
+
+	claimed, err := s.queries.ClaimZReport(ctx, database.ClaimZReportParams{
+		Day:     day,
+		Expires: now.Add(zReportClaim),
+		Now:     now,
+	})
+	if err != nil {
+		return fmt.Errorf("ClaimZReport: %w", err)
+	}
+
+	// another instance is sending the report right now
+	if claimed == 0 {
+		return nil
+	}
+
+	defer func() {
+		// released even on shutdown, so that the next start doesn't wait for the claim to expire
+		if err := s.queries.ReleaseZReport(context.WithoutCancel(ctx), day); err != nil {
+			slog.ErrorContext(ctx, "Failed to release Z-report claim",
+				slog.String("day", day.Format(time.DateOnly)),
+				slog.Any("error", err),
+			)
+		}
+	}()
+
+	report, err := s.queries.GetZReport(ctx, day)
+	if err != nil {
+		return fmt.Errorf("GetZReport: %w", err)
+	}
+
+	delivered, err := s.queries.GetZReportDeliveredChannels(ctx, day)
+	if err != nil {
+		return fmt.Errorf("GetZReportDeliveredChannels: %w", err)
+	}
+
+	var errs []error
+
+	for _, target := range targets {
+		if slices.Contains(delivered, target) {
+			continue
+		}
+
+		if err = s.deliver(ctx, report, target); err != nil {
+			errs = append(errs, fmt.Errorf("deliver %s: %w", target, err))
+
+			continue
+		}
+
+		if err = s.queries.CreateZReportDelivery(ctx, database.CreateZReportDeliveryParams{
+			Day:     day,
+			Channel: target,
+		}); err != nil {
+			return fmt.Errorf("CreateZReportDelivery: %w", err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Service) buildSummary(ctx context.Context, starts, ends time.Time) (api.ZReportSummary, error) {
+	statuses, err := s.queries.GetZReportStatuses(ctx, database.GetZReportStatusesParams{
+		Since: starts.UTC(),
+		Until: ends.UTC(),
+	})
+	if err != nil {
+		return api.ZReportSummary{}, fmt.Errorf("GetZReportStatuses: %w", err)
+	}
+
+	top, err := s.TopProducts(ctx, api.GetTopProductsReportParams{
+		From:   starts,
+		To:     ends,
+		Limit:  meg.ToPtr(zReportTopItems),
+		Format: nil,
+	})
+	if err != nil {
+		return api.ZReportSummary{}, fmt.Errorf("TopProducts: %w", err)
+	}
+
+	summary := api.ZReportSummary{
+		Statuses:     make([]api.ZReportStatusRow, 0, len(statuses)),
+		Orders:       0,
+		Gross:        0,
+		Discount:     0,
+		Points:       0,
+		Revenue:      0,
+		AverageCheck: 0,
+		TopItems:     top,
+	}
+
+	for _, row := range statuses {
+		summary.Statuses = append(summary.Statuses, api.ZReportStatusRow{
+			Status:   row.Status,
+			Orders:   int(row.Orders),
+			Gross:    money.Kopecks(row.Gross),
+			Discount: money.Kopecks(row.Discount),
+			Points:   money.Kopecks(row.Points),
+		})
+
+		if row.Status == api.OrderStatusCancelled {
+			continue
+		}
+
+		summary.Orders += int(row.Orders)
+		summary.Gross += money.Kopecks(row.Gross)
+		summary.Discount += money.Kopecks(row.Discount)
+		summary.Points += money.Kopecks(row.Points)
+	}
+
+	summary.Revenue = summary.Gross - summary.Discount - summary.Points
+	summary.AverageCheck = summary.Revenue / money.Kopecks(max(summary.Orders, 1))
+
+	return summary, nil
+}
+
+// deliver sends the report to a single target
+func (s *Service) deliver(ctx context.Context, report database.ZReport, target string) error {
+	ctx, cancel := context.WithTimeout(ctx, zReportSendTimeout)
+	defer cancel()
+
+	text := mapper.ZReportToText(report, s.cfg.Location)
+	filename := fmt.Sprintf("z-report-%s.csv", report.Day.Format(time.DateOnly))
+
+	data, err := mapper.EncodeCSV(mapper.ZReportCSV(report, s.cfg.Location))
+	if err != nil {
+		return fmt.Errorf("EncodeCSV: %w", err)
+	}
+
+	switch {
+	case strings.HasPrefix(target, telegramTarget):
+		if err = s.telegramService.NotifyDocument(ctx, strings.TrimPrefix(target, telegramTarget), text, filename, data); err != nil {
+			return fmt.Errorf("NotifyDocument: %w", err)
+		}
+	case target == emailTarget:
+		subject := "Z-отчёт за " + report.Day.Format("02.01.2006")
+		if err = s.emailService.Send(ctx, subject, text, email.Attachment{
+			Filename:    filename,
+			ContentType: "text/csv; charset=utf-8",
+			Data:        data,
+		}); err != nil {
+			return fmt.Errorf("Send: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown target %q", target)
+	}
+
+	return nil
+}
+
+func (s *Service) GetZReports(ctx context.Context, limit int) ([]database.ZReport, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_z_reports")
+	defer span.End()
+
+	reports, err := s.queries.GetZReports(ctx, int32(limit)) //nolint:gosec
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetZReports: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return reports, nil
+}
+
+func (s *Service) GetZReport(ctx context.Context, day time.Time) (database.ZReport, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "get_z_report")
+	defer span.End()
+
+	report, err := s.queries.GetZReport(ctx, day)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return database.ZReport{}, s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).Errorf("report not found"))
+		}
+
+		return database.ZReport{}, s.tracing.Error(span, fmt.Errorf("GetZReport: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return report, nil
+}
@@ -0,0 +1,269 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"shantaram/app/api"
+	"shantaram/app/service/email"
+	"shantaram/pkg/config"
+	"shantaram/pkg/database"
+	"shantaram/pkg/database/dbtest"
+	"shantaram/pkg/telemetry"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// channel counts sent reports per target and fails for targets in down
+type channel struct {
+	mu   sync.Mutex
+	sent map[string][]string
+	down map[string]bool
+}
+
+func newChannel() channel {
+	return channel{mu: sync.Mutex{}, sent: make(map[string][]string), down: make(map[string]bool)}
+}
+
+func (c *channel) send(target, filename string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.down[target] {
+		return errors.New("connection refused")
+	}
+
+	c.sent[target] = append(c.sent[target], filename)
+
+	return nil
+}
+
+type telegramChannel struct {
+	channel
+
+	chats []string
+}
+
+func (c *telegramChannel) ChatIDs() []string {
+	return c.chats
+}
+
+func (c *telegramChannel) NotifyDocument(_ context.Context, chatID, _, filename string, _ []byte) error {
+	return c.send(chatID, filename)
+}
+
+type emailChannel struct {
+	channel
+
+	enabled bool
+}
+
+func (c *emailChannel) Enabled() bool {
+	return c.enabled
+}
+
+func (c *emailChannel) Send(_ context.Context, _, _ string, attachments ...email.Attachment) error {
+	return c.send(emailTarget, attachments[0].Filename)
+}
+
+type zReports struct {
+	reports    map[time.Time]database.ZReport
+	deliveries map[time.Time][]string
+	claims     map[time.Time]time.Time
+}
+
+func newZReportService(t *testing.T) (*Service, *zReports, *telegramChannel, *emailChannel) {
+	t.Helper()
+
+	z := &zReports{
+		reports:    make(map[time.Time]database.ZReport),
+		deliveries: make(map[time.Time][]string),
+		claims:     make(map[time.Time]time.Time),
+	}
+
+	db := dbtest.New()
+	db.Handle("GetUndeliveredZReportDays", func(args []any) ([][]any, error) {
+		var rows [][]any
+
+		for day := range z.reports {
+			if day.Before(args[0].(time.Time)) {
+				continue
+			}
+
+			for _, target := range args[1].([]string) {
+				if !slices.Contains(z.deliveries[day], target) {
+					rows = append(rows, []any{day})
+
+					break
+				}
+			}
+		}
+
+		return rows, nil
+	})
+	db.Handle("ClaimZReport", func(args []any) ([][]any, error) {
+		day, expires, now := args[0].(time.Time), args[1].(time.Time), args[2].(time.Time)
+
+		if claim, ok := z.claims[day]; ok && !claim.Before(now) {
+			return nil, nil
+		}
+
+		z.claims[day] = expires
+
+		return [][]any{{}}, nil
+	})
+	db.Handle("ReleaseZReport", func(args []any) ([][]any, error) {
+		delete(z.claims, args[0].(time.Time))
+
+		return [][]any{{}}, nil
+	})
+	db.Handle("GetZReport", func(args []any) ([][]any, error) {
+		return [][]any{dbtest.Fields(z.reports[args[0].(time.Time)])}, nil
+	})
+	db.Handle("GetZReportDeliveredChannels", func(args []any) ([][]any, error) {
+		var rows [][]any
+		for _, target := range z.deliveries[args[0].(time.Time)] {
+			rows = append(rows, []any{target})
+		}
+
+		return rows, nil
+	})
+	db.Handle("CreateZReportDelivery", func(args []any) ([][]any, error) {
+		day := args[0].(time.Time)
+		z.deliveries[day] = append(z.deliveries[day], args[1].(string))
+
+		return [][]any{{}}, nil
+	})
+
+	cfg := &config.Config{} //nolint:exhaustruct
+	cfg.Location = time.UTC
+	cfg.ZReport.Cutoff = "04:00"
+
+	telegram := &telegramChannel{channel: newChannel(), chats: []string{"-1001", "-1002"}}
+	mail := &emailChannel{channel: newChannel(), enabled: true}
+
+	return &Service{
+		cfg:             cfg,
+		queries:         database.New(db),
+		telegramService: telegram,
+		emailService:    mail,
+		tracing:         telemetry.NewTracing(cfg, noop.NewTracerProvider().Tracer("test")),
+	}, z, telegram, mail
+}
+
+func (z *zReports) add(day time.Time) {
+	z.reports[day] = database.ZReport{
+		Day:     day,
+		Starts:  day.Add(4 * time.Hour),
+		Ends:    day.Add(28 * time.Hour),
+		Summary: api.ZReportSummary{Statuses: []api.ZReportStatusRow{}, TopItems: []api.TopProductRow{}}, //nolint:exhaustruct
+		Created: day.Add(28 * time.Hour),
+	}
+}
+
+func TestDeliverZReportsRetriesFailedTargets(t *testing.T) {
+	s, z, telegram, mail := newZReportService(t)
+	ctx := context.Background()
+
+	yesterday := s.businessDay(time.Now()).AddDate(0, 0, -1)
+	z.add(yesterday)
+
+	telegram.down["-1002"] = true
+	mail.down[emailTarget] = true
+
+	if err := s.DeliverZReports(ctx); err == nil {
+		t.Fatal("DeliverZReports succeeded with a chat and email down")
+	}
+
+	if !slices.Equal(z.deliveries[yesterday], []string{"telegram:-1001"}) {
+		t.Fatalf("delivered %v, want the first chat only", z.deliveries[yesterday])
+	}
+
+	telegram.down["-1002"] = false
+	mail.down[emailTarget] = false
+
+	for range 2 {
+		if err := s.DeliverZReports(ctx); err != nil {
+			t.Fatalf("DeliverZReports: %v", err)
+		}
+	}
+
+	for _, sent := range [][]string{telegram.sent["-1001"], telegram.sent["-1002"], mail.sent[emailTarget]} {
+		if len(sent) != 1 {
+			t.Fatalf("telegram %v, email %v, want one report per target", telegram.sent, mail.sent)
+		}
+	}
+
+	if len(z.deliveries[yesterday]) != 3 || len(z.claims) != 0 {
+		t.Fatalf("delivered %v, claims %v, want 3 deliveries and no claims", z.deliveries[yesterday], z.claims)
+	}
+}
+
+func TestDeliverZReportsSkipsDisabledEmail(t *testing.T) {
+	s, z, telegram, mail := newZReportService(t)
+
+	yesterday := s.businessDay(time.Now()).AddDate(0, 0, -1)
+	z.add(yesterday)
+
+	mail.enabled = false
+
+	if err := s.DeliverZReports(context.Background()); err != nil {
+		t.Fatalf("DeliverZReports: %v", err)
+	}
+
+	if len(mail.sent) != 0 || len(telegram.sent) != 2 {
+		t.Fatalf("telegram %v, email %v, want both chats and no email", telegram.sent, mail.sent)
+	}
+
+	// enabling email later still delivers the report there
+	if slices.Contains(z.deliveries[yesterday], emailTarget) {
+		t.Fatalf("delivered %v, disabled email is marked", z.deliveries[yesterday])
+	}
+}
+
+func TestDeliverZReportsSkipsClaimedDays(t *testing.T) {
+	s, z, telegram, _ := newZReportService(t)
+	ctx := context.Background()
+
+	yesterday := s.businessDay(time.Now()).AddDate(0, 0, -1)
+	z.add(yesterday)
+
+	z.claims[yesterday] = time.Now().UTC().Add(time.Minute)
+
+	if err := s.DeliverZReports(ctx); err != nil {
+		t.Fatalf("DeliverZReports: %v", err)
+	}
+
+	if len(telegram.sent) != 0 {
+		t.Fatalf("telegram %v, want nothing sent while another instance holds the claim", telegram.sent)
+	}
+
+	// the instance holding the claim died
+	z.claims[yesterday] = time.Now().UTC().Add(-time.Minute)
+
+	if err := s.DeliverZReports(ctx); err != nil {
+		t.Fatalf("DeliverZReports: %v", err)
+	}
+
+	if len(telegram.sent) != 2 {
+		t.Fatalf("telegram %v, want the report sent once the claim expired", telegram.sent)
+	}
+}
+
+func TestDeliverZReportsSkipsOldReports(t *testing.T) {
+	s, z, telegram, mail := newZReportService(t)
+
+	old := s.businessDay(time.Now()).AddDate(0, 0, -maxBacklogDays-1)
+	z.add(old)
+
+	if err := s.DeliverZReports(context.Background()); err != nil {
+		t.Fatalf("DeliverZReports: %v", err)
+	}
+
+	if len(telegram.sent) != 0 || len(mail.sent) != 0 {
+		t.Fatalf("telegram %v, email %v, want nothing sent", telegram.sent, mail.sent)
+	}
+}
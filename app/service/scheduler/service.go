@@ -0,0 +1,394 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"shantaram/pkg/database"
+	"shantaram/pkg/telemetry"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/robfig/cron/v3"
+	"github.com/rofleksey/meg"
+	"github.com/samber/do"
+	"github.com/samber/oops"
+)
+
+var serviceName = "scheduler"
+
+// pollInterval is how often due jobs are checked
+var pollInterval = 5 * time.Second
+
+var defaultTimeout = 5 * time.Minute
+
+// Job is a named task run by exactly one instance at a time
+type Job struct {
+	Name string
+	// Interval and Cron are mutually exclusive
+	Interval time.Duration `exhaustruct:"optional"`
+	Cron     string        `exhaustruct:"optional"`
+	Timeout  time.Duration `exhaustruct:"optional"`
+	Run      func(ctx context.Context) error
+
+	schedule cron.Schedule
+}
+
+func (j *Job) next(last time.Time) time.Time {
+	if j.schedule != nil {
+		return j.schedule.Next(last)
+	}
+
+	return last.Add(j.Interval)
+}
+
+func (j *Job) describe() string {
+	if j.Cron != "" {
+		return j.Cron
+	}
+
+	return "@every " + j.Interval.String()
+}
+
+// Status combines job definition with its persisted state
+type Status struct {
+	Name     string
+	Schedule string
+	State    database.Job
+	NextRun  time.Time
+	Running  bool
+}
+
+type Service struct {
+	dbConn   *pgxpool.Pool
+	queries  *database.Queries
+	tracing  *telemetry.Tracing
+	instance string
+
+	mu      sync.Mutex
+	jobs    []*Job
+	nextRun map[string]time.Time
+	running map[string]bool
+}
+
+func New(di *do.Injector) (*Service, error) {
+	instance, err := os.Hostname()
+	if err != nil {
+		instance = "unknown"
+	}
+
+	return &Service{
+		dbConn:   do.MustInvoke[*pgxpool.Pool](di),
+		queries:  do.MustInvoke[*database.Queries](di),
+		tracing:  do.MustInvoke[*telemetry.Tracing](di),
+		instance: fmt.Sprintf("%s:%d", instance, os.Getpid()),
+		jobs:     nil,
+		nextRun:  make(map[string]time.Time),
+		running:  make(map[string]bool),
+	}, nil
+}
+
+// Register adds a job, it must be called before Run
+func (s *Service) Register(job Job) error {
+	if job.Name == "" || job.Run == nil {
+		return fmt.Errorf("job name and run func are required")
+	}
+
+	if (job.Interval > 0) == (job.Cron != "") {
+		return fmt.Errorf("job %s: exactly one of interval or cron must be set", job.Name)
+	}
+
+	if job.Cron != "" {
+		schedule, err := cron.ParseStandard(job.Cron)
+		if err != nil {
+			return fmt.Errorf("job %s: invalid cron expression: %w", job.Name, err)
+		}
+
+		job.schedule = schedule
+	}
+
+	if job.Timeout == 0 {
+		job.Timeout = defaultTimeout
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.jobs {
+		if existing.Name == job.Name {
+			return fmt.Errorf("job %s is already registered", job.Name)
+		}
+	}
+
+	s.jobs = append(s.jobs, &job)
+
+	return nil
+}
+
+func (s *Service) getJob(name string) (*Job, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, job := range s.jobs {
+		if job.Name == name {
+			return job, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Service) Run(ctx context.Context) {
+	meg.RunTicker(ctx, pollInterval, func() {
+		now := time.Now()
+
+		s.mu.Lock()
+		var due []*Job
+		for _, job := range s.jobs {
+			if !s.running[job.Name] && !now.Before(s.nextRun[job.Name]) {
+				s.running[job.Name] = true
+				due = append(due, job)
+			}
+		}
+		s.mu.Unlock()
+
+		for _, job := range due {
+			go func() {
+				if _, err := s.execute(ctx, job, false); err != nil {
+					slog.Error("Job failed",
+						slog.String("job", job.Name),
+						slog.Any("error", err),
+					)
+				}
+			}()
+		}
+	})
+}
+
+// Trigger runs the job immediately regardless of its schedule
+func (s *Service) Trigger(ctx context.Context, name string) (Status, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "trigger")
+	defer span.End()
+
+	job, ok := s.getJob(name)
+	if !ok {
+		return Status{}, s.tracing.Error(span, oops.With("status_code", http.StatusNotFound).Errorf("job not found"))
+	}
+
+	s.mu.Lock()
+	if s.running[name] {
+		s.mu.Unlock()
+		return Status{}, s.tracing.Error(span, oops.With("status_code", http.StatusConflict).Errorf("job is already running"))
+	}
+	s.running[name] = true
+	s.mu.Unlock()
+
+	executed, err := s.execute(ctx, job, true)
+	if !executed {
+		if err != nil {
+			return Status{}, s.tracing.Error(span, fmt.Errorf("execute: %w", err))
+		}
+
+		return Status{}, s.tracing.Error(span, oops.With("status_code", http.StatusConflict).Errorf("job is running on another instance"))
+	}
+
+	// the job error is persisted and returned as part of the status
+	if err != nil {
+		slog.Warn("Manually triggered job failed",
+			slog.String("job", name),
+			slog.Any("error", err),
+		)
+	}
+
+	status, err := s.getStatus(ctx, job)
+	if err != nil {
+		return Status{}, s.tracing.Error(span, fmt.Errorf("getStatus: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return status, nil
+}
+
+// execute runs the job under an advisory lock, the lock is bound to a single
+// connection, so that connection is held for the whole run
+func (s *Service) execute(ctx context.Context, job *Job, force bool) (bool, error) {
+	defer func() {
+		s.mu.Lock()
+		s.running[job.Name] = false
+		s.mu.Unlock()
+	}()
+
+	conn, err := s.dbConn.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("Acquire: %w", err)
+	}
+	defer conn.Release()
+
+	queries := database.New(conn)
+
+	locked, err := queries.TryJobLock(ctx, job.Name)
+	if err != nil {
+		return false, fmt.Errorf("TryJobLock: %w", err)
+	}
+
+	if !locked {
+		// another instance is running it right now
+		s.setNextRun(job, time.Now())
+		return false, nil
+	}
+
+	defer func() {
+		if err := queries.UnlockJob(context.WithoutCancel(ctx), job.Name); err != nil {
+			slog.Error("Failed to unlock job, dropping connection",
+				slog.String("job", job.Name),
+				slog.Any("error", err),
+			)
+
+			_ = conn.Conn().Close(context.WithoutCancel(ctx))
+		}
+	}()
+
+	state, err := queries.GetJob(ctx, job.Name)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return false, fmt.Errorf("GetJob: %w", err)
+	}
+
+	// the schedule is shared between instances through the persisted last run
+	if !force && state.LastStarted != nil {
+		next := job.next(*state.LastStarted)
+		if time.Now().Before(next) {
+			s.setNextRun(job, *state.LastStarted)
+			return false, nil
+		}
+	}
+
+	started := time.Now().UTC()
+
+	if err = queries.StartJobRun(ctx, database.StartJobRunParams{
+		Name:         job.Name,
+		LastStarted:  &started,
+		LastInstance: &s.instance,
+	}); err != nil {
+		return false, fmt.Errorf("StartJobRun: %w", err)
+	}
+
+	s.setNextRun(job, started)
+
+	runErr := s.runJob(ctx, job)
+
+	finished := time.Now().UTC()
+
+	var lastError *string
+	if runErr != nil {
+		lastError = meg.ToPtr(runErr.Error())
+	}
+
+	if err = queries.FinishJobRun(context.WithoutCancel(ctx), database.FinishJobRunParams{
+		Name:           job.Name,
+		LastFinished:   &finished,
+		LastError:      lastError,
+		LastDurationMs: meg.ToPtr(finished.Sub(started).Milliseconds()),
+	}); err != nil {
+		return true, fmt.Errorf("FinishJobRun: %w", err)
+	}
+
+	if runErr != nil {
+		return true, fmt.Errorf("job %s: %w", job.Name, runErr)
+	}
+
+	return true, nil
+}
+
+func (s *Service) runJob(ctx context.Context, job *Job) (err error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, job.Name)
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, job.Timeout)
+	defer cancel()
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("panic: %v", rec)
+		}
+	}()
+
+	if err = job.Run(ctx); err != nil {
+		return s.tracing.Error(span, err)
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
+
+func (s *Service) setNextRun(job *Job, last time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	next := job.next(last)
+	if earliest := time.Now().Add(pollInterval); next.Before(earliest) {
+		next = earliest
+	}
+
+	s.nextRun[job.Name] = next
+}
+
+func (s *Service) getStatus(ctx context.Context, job *Job) (Status, error) {
+	state, err := s.queries.GetJob(ctx, job.Name)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return Status{}, fmt.Errorf("GetJob: %w", err)
+	}
+
+	return s.toStatus(job, state), nil
+}
+
+func (s *Service) toStatus(job *Job, state database.Job) Status {
+	status := Status{
+		Name:     job.Name,
+		Schedule: job.describe(),
+		State:    state,
+		NextRun:  time.Now(),
+		Running:  false,
+	}
+
+	if state.LastStarted != nil {
+		status.NextRun = job.next(*state.LastStarted)
+		status.Running = state.LastFinished == nil || state.LastFinished.Before(*state.LastStarted)
+	}
+
+	return status
+}
+
+func (s *Service) List(ctx context.Context) ([]Status, error) {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "list")
+	defer span.End()
+
+	states, err := s.queries.GetJobs(ctx)
+	if err != nil {
+		return nil, s.tracing.Error(span, fmt.Errorf("GetJobs: %w", err))
+	}
+
+	stateMap := make(map[string]database.Job, len(states))
+	for _, state := range states {
+		stateMap[state.Name] = state
+	}
+
+	s.mu.Lock()
+	jobs := append([]*Job(nil), s.jobs...)
+	s.mu.Unlock()
+
+	result := make([]Status, 0, len(jobs))
+	for _, job := range jobs {
+		result = append(result, s.toStatus(job, stateMap[job.Name]))
+	}
+
+	s.tracing.Success(span)
+
+	return result, nil
+}
@@ -0,0 +1,44 @@
+package sms
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// LogProvider writes messages to the log instead of sending them
+// and remembers the last message for every phone
+type LogProvider struct {
+	mu   sync.Mutex
+	last map[string]string
+}
+
+func NewLogProvider() *LogProvider {
+	return &LogProvider{
+		mu:   sync.Mutex{},
+		last: make(map[string]string),
+	}
+}
+
+func (p *LogProvider) Send(ctx context.Context, phone, text string) error {
+	p.mu.Lock()
+	p.last[phone] = text
+	p.mu.Unlock()
+
+	slog.InfoContext(ctx, "SMS message",
+		slog.String("phone", phone),
+		slog.String("text", text),
+	)
+
+	return nil
+}
+
+// LastMessage returns the last message sent to the phone
+func (p *LogProvider) LastMessage(phone string) (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	text, ok := p.last[phone]
+
+	return text, ok
+}
@@ -0,0 +1,61 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"shantaram/pkg/config"
+	"shantaram/pkg/telemetry"
+
+	"github.com/samber/do"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var serviceName = "sms"
+
+// Provider delivers a text message to a phone in E.164 format
+type Provider interface {
+	Send(ctx context.Context, phone, text string) error
+}
+
+type Service struct {
+	provider Provider
+	tracing  *telemetry.Tracing
+}
+
+func New(di *do.Injector) (*Service, error) {
+	cfg := do.MustInvoke[*config.Config](di)
+
+	var provider Provider
+
+	switch cfg.SMS.Provider {
+	case "smsru":
+		provider = NewSmsRuProvider(cfg.SMS.APIKey, cfg.SMS.Sender)
+	default:
+		provider = NewLogProvider()
+	}
+
+	return &Service{
+		provider: provider,
+		tracing:  do.MustInvoke[*telemetry.Tracing](di),
+	}, nil
+}
+
+// Provider is exposed so that the log stand-in can be inspected locally
+func (s *Service) Provider() Provider {
+	return s.provider
+}
+
+func (s *Service) Send(ctx context.Context, phone, text string) error {
+	ctx, span := s.tracing.StartServiceSpan(ctx, serviceName, "send")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("phone", phone))
+
+	if err := s.provider.Send(ctx, phone, text); err != nil {
+		return s.tracing.Error(span, fmt.Errorf("Send: %w", err))
+	}
+
+	s.tracing.Success(span)
+
+	return nil
+}
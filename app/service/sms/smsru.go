@@ -0,0 +1,85 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const smsRuURL = "https://sms.ru/sms/send"
+
+// SmsRuProvider sends messages through the sms.ru HTTP API
+type SmsRuProvider struct {
+	apiKey string
+	sender string
+	client *http.Client
+}
+
+func NewSmsRuProvider(apiKey, sender string) *SmsRuProvider {
+	return &SmsRuProvider{
+		apiKey: apiKey,
+		sender: sender,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type smsRuStatus struct {
+	Status     string `json:"status"`
+	StatusCode int    `json:"status_code"`
+	StatusText string `json:"status_text"`
+}
+
+type smsRuResponse struct {
+	smsRuStatus
+	SMS map[string]smsRuStatus `json:"sms"`
+}
+
+func (p *SmsRuProvider) Send(ctx context.Context, phone, text string) error {
+	form := url.Values{
+		"api_id": {p.apiKey},
+		"to":     {strings.TrimPrefix(phone, "+")},
+		"msg":    {text},
+		"json":   {"1"},
+	}
+	if p.sender != "" {
+		form.Set("from", p.sender)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, smsRuURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("NewRequest: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	var result smsRuResponse
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("Decode: %w", err)
+	}
+
+	if result.Status != "OK" {
+		return fmt.Errorf("sms.ru error %d: %s", result.StatusCode, result.StatusText)
+	}
+
+	// the request may succeed while the message to a particular number is rejected
+	for _, status := range result.SMS {
+		if status.Status != "OK" {
+			return fmt.Errorf("sms.ru error %d: %s", status.StatusCode, status.StatusText)
+		}
+	}
+
+	return nil
+}
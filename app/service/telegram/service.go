@@ -1,6 +1,7 @@
 package telegram
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"shantaram/pkg/config"
@@ -8,17 +9,18 @@ import (
 	"shantaram/pkg/telemetry"
 
 	tgBot "github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
 	"github.com/samber/do"
 )
 
 var serviceName = "telegram"
 
 type Service struct {
-	appCtx  context.Context
-	cfg     *config.Config
-	queries *database.Queries
-	tracing *telemetry.Tracing
-	bot     *tgBot.Bot
+	appCtx         context.Context
+	configReloader *config.Reloader
+	queries        *database.Queries
+	tracing        *telemetry.Tracing
+	bot            *tgBot.Bot
 }
 
 func New(di *do.Injector) (*Service, error) {
@@ -30,19 +32,47 @@ func New(di *do.Injector) (*Service, error) {
 	}
 
 	return &Service{
-		appCtx:  do.MustInvoke[context.Context](di),
-		cfg:     cfg,
-		queries: do.MustInvoke[*database.Queries](di),
-		tracing: do.MustInvoke[*telemetry.Tracing](di),
-		bot:     bot,
+		appCtx:         do.MustInvoke[context.Context](di),
+		configReloader: do.MustInvoke[*config.Reloader](di),
+		queries:        do.MustInvoke[*database.Queries](di),
+		tracing:        do.MustInvoke[*telemetry.Tracing](di),
+		bot:            bot,
 	}, nil
 }
 
+// Notify sends the message to the chats configured at the moment of the call
 func (s *Service) Notify(msg string) {
-	for _, id := range s.cfg.Telegram.ChatIds {
+	for _, id := range s.configReloader.Runtime().TelegramChatIds {
 		_, _ = s.bot.SendMessage(s.appCtx, &tgBot.SendMessageParams{
 			ChatID: id,
 			Text:   msg,
 		})
 	}
 }
+
+// ChatIDs returns the chats configured at the moment of the call
+func (s *Service) ChatIDs() []string {
+	return s.configReloader.Runtime().TelegramChatIds
+}
+
+// NotifyDocument sends the message followed by the file to a single chat, unlike Notify it reports failures
+func (s *Service) NotifyDocument(ctx context.Context, chatID, msg, filename string, data []byte) error {
+	if _, err := s.bot.SendMessage(ctx, &tgBot.SendMessageParams{
+		ChatID: chatID,
+		Text:   msg,
+	}); err != nil {
+		return fmt.Errorf("SendMessage: %w", err)
+	}
+
+	if _, err := s.bot.SendDocument(ctx, &tgBot.SendDocumentParams{
+		ChatID: chatID,
+		Document: &models.InputFileUpload{
+			Filename: filename,
+			Data:     bytes.NewReader(data),
+		},
+	}); err != nil {
+		return fmt.Errorf("SendDocument: %w", err)
+	}
+
+	return nil
+}
@@ -2,11 +2,21 @@ package config
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	_ "time/tzdata"
 
 	"github.com/getsentry/sentry-go"
 	"github.com/go-playground/validator/v10"
+	"github.com/rofleksey/meg"
 	"gopkg.in/yaml.v3"
 )
 
@@ -16,6 +26,9 @@ type Config struct {
 	BaseFrontURL    string `yaml:"base_front_url" validate:"required"`
 	BaseWWWFrontURL string `yaml:"base_www_front_url" validate:"required"`
 	BaseAdminURL    string `yaml:"base_admin_url" validate:"required"`
+	Timezone        string `yaml:"timezone" validate:"required"`
+
+	Location *time.Location `yaml:"-"`
 
 	Sentry struct {
 		DSN string `yaml:"dsn"`
@@ -41,30 +54,121 @@ type Config struct {
 
 	JWT struct {
 		Secret string `yaml:"secret" validate:"required"`
+		// CustomerSecret signs customer tokens, so that they never pass as admin ones.
+		// It is derived from Secret by default.
+		CustomerSecret string `yaml:"customer_secret" validate:"required,nefield=Secret"`
 	} `yaml:"jwt"`
 
 	Admin struct {
-		Password string `yaml:"password" validate:"required"`
+		Password string `yaml:"password"`
 	} `yaml:"admin"`
 
 	Telegram struct {
 		Token   string   `yaml:"token" validate:"required"`
 		ChatIds []string `yaml:"chat_ids" validate:"required"`
 	} `yaml:"telegram"`
+
+	CORS struct {
+		// Origins are allowed in addition to the base urls
+		Origins []string `yaml:"origins"`
+	} `yaml:"cors"`
+
+	Limits LimitsConfig `yaml:"limits"`
+
+	ETA ETAConfig `yaml:"eta"`
+
+	Printing struct {
+		Trigger        string `yaml:"trigger" validate:"required,oneof=created seen"`
+		ReceiptPrinter string `yaml:"receipt_printer"`
+		// CodePage is 17 (CP866) when not set, 0 is a valid code page (PC437)
+		CodePage  *int `yaml:"code_page" validate:"omitnil,min=0,max=255"`
+		LineWidth int  `yaml:"line_width" validate:"required,min=16"`
+		// MaxAttempts bounds retries of a failed print job. The retry queue is in memory,
+		// jobs still waiting in it are lost on restart.
+		MaxAttempts int `yaml:"max_attempts" validate:"required,min=1"`
+	} `yaml:"printing"`
+
+	Email struct {
+		// Host enables email delivery, STARTTLS is used when the server supports it
+		Host     string   `yaml:"host"`
+		Port     int      `yaml:"port" validate:"min=0,max=65535"`
+		Username string   `yaml:"username"`
+		Password string   `yaml:"password"`
+		From     string   `yaml:"from" validate:"required_with=Host"`
+		To       []string `yaml:"to"`
+	} `yaml:"email"`
+
+	SMS struct {
+		// Provider log only writes messages, login codes included, to the log and stands in
+		// for a real provider locally. There is no default so that it is never chosen by accident.
+		Provider string `yaml:"provider" validate:"required,oneof=log smsru"`
+		APIKey   string `yaml:"api_key" validate:"required_if=Provider smsru"`
+		Sender   string `yaml:"sender"`
+	} `yaml:"sms"`
+
+	ZReport struct {
+		// Cutoff is the local time on the next calendar day when a business day ends
+		Cutoff string `yaml:"cutoff" validate:"required,datetime=15:04"`
+	} `yaml:"z_report"`
+
+	WS struct {
+		QueueSize          int    `yaml:"queue_size" validate:"required,min=1"`
+		SlowConsumerPolicy string `yaml:"slow_consumer_policy" validate:"required,oneof=drop disconnect"`
+
+		AdminAlert AdminAlertConfig `yaml:"admin_alert"`
+	} `yaml:"ws"`
+}
+
+type LimitsConfig struct {
+	CreateOrderPerMinute int `yaml:"create_order_per_minute" validate:"required,min=1"`
+	LoginPerMinute       int `yaml:"login_per_minute" validate:"required,min=1"`
+	OTPPerMinute         int `yaml:"otp_per_minute" validate:"required,min=1"`
+}
+
+type ETAConfig struct {
+	DefaultPrepMinutes int  `yaml:"default_prep_minutes" validate:"required,min=1"`
+	PerItemMinutes     int  `yaml:"per_item_minutes" validate:"min=0"`
+	Parallel           int  `yaml:"parallel" validate:"required,min=1"`
+	Calibrate          bool `yaml:"calibrate"`
 }
 
-func Load() (*Config, error) {
+// AdminAlertConfig warns when no admin is connected for After while ordering is open
+type AdminAlertConfig struct {
+	After time.Duration `yaml:"after"`
+}
+
+// DefaultPath is used when no config file is given explicitly, it may be missing
+// when the whole config comes from the environment
+const DefaultPath = "config.yaml"
+
+// Load reads config files in order, later files override earlier ones.
+// Each file may have an environment specific overlay next to it, e.g. config.production.yaml
+// for SHANTARAM_ENV=production. Environment variables are applied last.
+func Load(paths ...string) (*Config, error) {
 	span := sentry.StartSpan(context.Background(), "config.load")
 	defer span.Finish()
 
-	data, err := os.ReadFile("config.yaml")
-	if err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
+	explicit := len(paths) > 0
+	if !explicit {
+		paths = []string{DefaultPath}
 	}
 
 	var result Config
-	if err := yaml.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse YAML config: %w", err)
+
+	for _, path := range paths {
+		if err := loadFile(&result, path, explicit); err != nil {
+			return nil, err
+		}
+
+		if env := os.Getenv(EnvPrefix + "ENV"); env != "" {
+			if err := loadFile(&result, overlayPath(path, env), false); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := applyEnv(&result, os.LookupEnv); err != nil {
+		return nil, fmt.Errorf("failed to apply environment: %w", err)
 	}
 
 	if result.ServiceName == "" {
@@ -83,6 +187,9 @@ func Load() (*Config, error) {
 	if result.BaseAdminURL == "" {
 		result.BaseAdminURL = "https://admin.shantaram-spb.ru"
 	}
+	if result.Timezone == "" {
+		result.Timezone = "Europe/Moscow"
+	}
 
 	if result.DB.User == "" {
 		result.DB.User = "postgres"
@@ -97,10 +204,91 @@ func Load() (*Config, error) {
 		result.DB.Database = "shantaram"
 	}
 
+	if result.Limits.CreateOrderPerMinute == 0 {
+		result.Limits.CreateOrderPerMinute = 5
+	}
+	if result.Limits.LoginPerMinute == 0 {
+		result.Limits.LoginPerMinute = 5
+	}
+	if result.Limits.OTPPerMinute == 0 {
+		result.Limits.OTPPerMinute = 3
+	}
+	if result.ETA.DefaultPrepMinutes == 0 {
+		result.ETA.DefaultPrepMinutes = 15
+	}
+	if result.ETA.Parallel == 0 {
+		result.ETA.Parallel = 3
+	}
+	if result.Printing.Trigger == "" {
+		result.Printing.Trigger = "created"
+	}
+	if result.Printing.CodePage == nil {
+		result.Printing.CodePage = meg.ToPtr(17)
+	}
+	if result.Printing.LineWidth == 0 {
+		result.Printing.LineWidth = 48
+	}
+	if result.Printing.MaxAttempts == 0 {
+		result.Printing.MaxAttempts = 5
+	}
+	if result.JWT.CustomerSecret == "" && result.JWT.Secret != "" {
+		result.JWT.CustomerSecret = deriveSecret(result.JWT.Secret, "customer")
+	}
+	if result.Email.Port == 0 {
+		result.Email.Port = 587
+	}
+	if result.ZReport.Cutoff == "" {
+		result.ZReport.Cutoff = "04:00"
+	}
+	if result.WS.QueueSize == 0 {
+		result.WS.QueueSize = 64
+	}
+	if result.WS.SlowConsumerPolicy == "" {
+		result.WS.SlowConsumerPolicy = "disconnect"
+	}
+
 	validate := validator.New(validator.WithRequiredStructEnabled())
 	if err := validate.Struct(result); err != nil {
 		return nil, fmt.Errorf("failed to validate config: %w", err)
 	}
 
+	location, err := time.LoadLocation(result.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load timezone: %w", err)
+	}
+	result.Location = location
+
 	return &result, nil
 }
+
+func deriveSecret(secret, purpose string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(purpose))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// loadFile merges the file into cfg, fields missing from the file are kept
+func loadFile(cfg *Config, path string, required bool) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !required && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	if err = yaml.Unmarshal(data, cfg); err != nil {
+		return fmt.Errorf("failed to parse YAML config %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// overlayPath turns config.yaml into config.<env>.yaml
+func overlayPath(path, env string) string {
+	ext := filepath.Ext(path)
+
+	return strings.TrimSuffix(path, ext) + "." + env + ext
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// minimalConfig holds the fields without defaults
+const minimalConfig = `
+jwt:
+  secret: secret
+telegram:
+  token: token
+  chat_ids: ["1"]
+sms:
+  provider: log
+`
+
+func TestLoadCodePage(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		want    int
+		wantErr bool
+	}{
+		{name: "default", yaml: "", want: 17},
+		{name: "PC437", yaml: "printing:\n  code_page: 0\n", want: 0},
+		{name: "explicit", yaml: "printing:\n  code_page: 46\n", want: 46},
+		{name: "out of range", yaml: "printing:\n  code_page: 256\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(path, []byte(minimalConfig+tt.yaml), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := Load(path)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got code page %d", *cfg.Printing.CodePage)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if *cfg.Printing.CodePage != tt.want {
+				t.Errorf("code page = %d, want %d", *cfg.Printing.CodePage, tt.want)
+			}
+		})
+	}
+}
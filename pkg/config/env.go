@@ -0,0 +1,144 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EnvPrefix is prepended to every environment override, e.g. SHANTARAM_DB_PASS
+const EnvPrefix = "SHANTARAM_"
+
+// fileSuffix marks variables holding a path to the value, used with docker secrets
+const fileSuffix = "_FILE"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// applyEnv overrides config fields from environment variables named after
+// their yaml path, nested keys are joined with an underscore
+func applyEnv(cfg *Config, lookup func(string) (string, bool)) error {
+	return applyEnvStruct(reflect.ValueOf(cfg).Elem(), strings.TrimSuffix(EnvPrefix, "_"), lookup)
+}
+
+func applyEnvStruct(value reflect.Value, prefix string, lookup func(string) (string, bool)) error {
+	valueType := value.Type()
+
+	for i := range valueType.NumField() {
+		field := valueType.Field(i)
+
+		tag, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if tag == "-" || !field.IsExported() {
+			continue
+		}
+		if tag == "" {
+			tag = field.Name
+		}
+
+		name := prefix + "_" + strings.ToUpper(tag)
+		fieldValue := value.Field(i)
+
+		if field.Type.Kind() == reflect.Struct {
+			if err := applyEnvStruct(fieldValue, name, lookup); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		raw, ok, err := lookupEnv(name, lookup)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		if err = setField(fieldValue, raw); err != nil {
+			return fmt.Errorf("invalid value of %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// lookupEnv resolves NAME or NAME_FILE, setting both is an error
+func lookupEnv(name string, lookup func(string) (string, bool)) (string, bool, error) {
+	raw, ok := lookup(name)
+
+	path, fileOk := lookup(name + fileSuffix)
+	if !fileOk {
+		return raw, ok, nil
+	}
+
+	if ok {
+		return "", false, fmt.Errorf("both %s and %s%s are set", name, name, fileSuffix)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read %s%s: %w", name, fileSuffix, err)
+	}
+
+	return strings.TrimRight(string(data), "\r\n"), true, nil
+}
+
+func setField(field reflect.Value, raw string) error {
+	if field.Type() == durationType {
+		duration, err := time.ParseDuration(raw)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		field.SetInt(int64(duration))
+
+		return nil
+	}
+
+	switch field.Kind() { //nolint:exhaustive
+	case reflect.Pointer:
+		// a set variable allocates the value, an unset one keeps nil
+		elem := reflect.New(field.Type().Elem())
+		if err := setField(elem.Elem(), raw); err != nil {
+			return err
+		}
+
+		field.Set(elem)
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		field.SetBool(parsed)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(raw, 10, field.Type().Bits())
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		field.SetInt(parsed)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported slice type %s", field.Type())
+		}
+
+		// comma separated, an empty value clears the list
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+
+		field.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+
+	return nil
+}
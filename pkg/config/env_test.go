@@ -0,0 +1,198 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type envTestConfig struct {
+	Name    string         `yaml:"name"`
+	Enabled bool           `yaml:"enabled"`
+	Port    int            `yaml:"port"`
+	Small   int32          `yaml:"small"`
+	Timeout time.Duration  `yaml:"timeout"`
+	Origins []string       `yaml:"origins"`
+	Limit   *int           `yaml:"limit"`
+	Delay   *time.Duration `yaml:"delay"`
+	Skipped string         `yaml:"-"`
+	NoTag   string
+
+	Nested struct {
+		Token string `yaml:"token,omitempty"`
+
+		Deeper struct {
+			Count int `yaml:"count"`
+		} `yaml:"deeper"`
+	} `yaml:"nested"`
+}
+
+func lookupMap(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+}
+
+func TestApplyEnvStruct(t *testing.T) {
+	limit := 7
+	delay := 1500 * time.Millisecond
+
+	tests := []struct {
+		name    string
+		env     map[string]string
+		initial func(*envTestConfig)
+		want    func(*envTestConfig)
+		wantErr bool
+	}{
+		{
+			name: "nothing set keeps the file values",
+			env:  map[string]string{},
+			initial: func(c *envTestConfig) {
+				c.Name = "from file"
+				c.Origins = []string{"a"}
+			},
+			want: func(c *envTestConfig) {
+				c.Name = "from file"
+				c.Origins = []string{"a"}
+			},
+		},
+		{
+			name: "scalars",
+			env: map[string]string{
+				"APP_NAME":    "shantaram",
+				"APP_ENABLED": "true",
+				"APP_PORT":    "8080",
+				"APP_SMALL":   "-12",
+			},
+			want: func(c *envTestConfig) {
+				c.Name = "shantaram"
+				c.Enabled = true
+				c.Port = 8080
+				c.Small = -12
+			},
+		},
+		{
+			name: "nested structs join the path with underscores",
+			env: map[string]string{
+				"APP_NESTED_TOKEN":        "secret",
+				"APP_NESTED_DEEPER_COUNT": "3",
+			},
+			want: func(c *envTestConfig) {
+				c.Nested.Token = "secret"
+				c.Nested.Deeper.Count = 3
+			},
+		},
+		{
+			name: "duration",
+			env:  map[string]string{"APP_TIMEOUT": "2m30s"},
+			want: func(c *envTestConfig) { c.Timeout = 150 * time.Second },
+		},
+		{
+			name: "slice is comma separated and trimmed",
+			env:  map[string]string{"APP_ORIGINS": " https://a.ru, ,https://b.ru "},
+			want: func(c *envTestConfig) { c.Origins = []string{"https://a.ru", "https://b.ru"} },
+		},
+		{
+			name:    "empty slice clears the file value",
+			env:     map[string]string{"APP_ORIGINS": ""},
+			initial: func(c *envTestConfig) { c.Origins = []string{"a"} },
+			want:    func(c *envTestConfig) { c.Origins = nil },
+		},
+		{
+			name: "pointers are allocated",
+			env: map[string]string{
+				"APP_LIMIT": "7",
+				"APP_DELAY": "1.5s",
+			},
+			want: func(c *envTestConfig) {
+				c.Limit = &limit
+				c.Delay = &delay
+			},
+		},
+		{
+			name: "ignored and untagged fields",
+			env: map[string]string{
+				"APP_SKIPPED": "x",
+				"APP_NOTAG":   "y",
+			},
+			want: func(c *envTestConfig) { c.NoTag = "y" },
+		},
+		{name: "invalid bool", env: map[string]string{"APP_ENABLED": "yes please"}, wantErr: true},
+		{name: "invalid int", env: map[string]string{"APP_PORT": "80a"}, wantErr: true},
+		{name: "int overflow", env: map[string]string{"APP_SMALL": "3000000000"}, wantErr: true},
+		{name: "invalid duration", env: map[string]string{"APP_TIMEOUT": "5"}, wantErr: true},
+		{name: "invalid pointer value", env: map[string]string{"APP_LIMIT": "many"}, wantErr: true},
+		{name: "invalid nested value", env: map[string]string{"APP_NESTED_DEEPER_COUNT": "-"}, wantErr: true},
+		{
+			name:    "value and file together",
+			env:     map[string]string{"APP_NAME": "a", "APP_NAME_FILE": "/dev/null"},
+			wantErr: true,
+		},
+		{name: "missing file", env: map[string]string{"APP_NAME_FILE": "/nonexistent/secret"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got, want envTestConfig
+			if tt.initial != nil {
+				tt.initial(&got)
+			}
+
+			err := applyEnvStruct(reflect.ValueOf(&got).Elem(), "APP", lookupMap(tt.env))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tt.want(&want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestApplyEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "db_pass")
+	if err := os.WriteFile(path, []byte("s3cret\r\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var cfg Config
+	cfg.DB.Pass = "from file"
+
+	err := applyEnv(&cfg, lookupMap(map[string]string{
+		"SHANTARAM_DB_PASS_FILE":            path,
+		"SHANTARAM_WS_ADMIN_ALERT_AFTER":    "10m",
+		"SHANTARAM_TELEGRAM_CHAT_IDS":       "1,2",
+		"SHANTARAM_LIMITS_LOGIN_PER_MINUTE": "9",
+		"SHANTARAM_LOCATION":                "Europe/Berlin",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.DB.Pass != "s3cret" {
+		t.Errorf("DB.Pass = %q, want the trimmed file contents", cfg.DB.Pass)
+	}
+	if cfg.WS.AdminAlert.After != 10*time.Minute {
+		t.Errorf("WS.AdminAlert.After = %s", cfg.WS.AdminAlert.After)
+	}
+	if !reflect.DeepEqual(cfg.Telegram.ChatIds, []string{"1", "2"}) {
+		t.Errorf("Telegram.ChatIds = %v", cfg.Telegram.ChatIds)
+	}
+	if cfg.Limits.LoginPerMinute != 9 {
+		t.Errorf("Limits.LoginPerMinute = %d", cfg.Limits.LoginPerMinute)
+	}
+	if cfg.Location != nil {
+		t.Errorf("Location is not configurable, got %s", cfg.Location)
+	}
+}
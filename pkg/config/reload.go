@@ -0,0 +1,190 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// watchInterval is how often config files are checked for changes
+var watchInterval = 5 * time.Second
+
+// Runtime is the part of the config applied without a restart,
+// everything else in Config is read once at startup
+type Runtime struct {
+	CORSOrigins     []string
+	TelegramChatIds []string
+	Limits          LimitsConfig
+	ETA             ETAConfig
+	AdminAlert      AdminAlertConfig
+}
+
+func (c *Config) Runtime() *Runtime {
+	return &Runtime{
+		CORSOrigins:     c.CORS.Origins,
+		TelegramChatIds: c.Telegram.ChatIds,
+		Limits:          c.Limits,
+		ETA:             c.ETA,
+		AdminAlert:      c.WS.AdminAlert,
+	}
+}
+
+// static returns a copy with all runtime fields cleared, used to detect
+// changes that need a restart
+func (c *Config) static() Config {
+	result := *c
+	result.Location = nil
+	result.CORS.Origins = nil
+	result.Telegram.ChatIds = nil
+	result.Limits = LimitsConfig{}
+	result.ETA = ETAConfig{}
+	result.WS.AdminAlert = AdminAlertConfig{}
+
+	return result
+}
+
+// Reloader re-reads config files on SIGHUP or when they change and swaps the runtime part atomically
+type Reloader struct {
+	paths   []string
+	static  Config
+	current atomic.Pointer[Runtime]
+
+	mu          sync.Mutex
+	subscribers []func(runtime *Runtime)
+	modTimes    map[string]time.Time
+}
+
+func NewReloader(cfg *Config, paths []string) *Reloader {
+	r := &Reloader{
+		paths:       paths,
+		static:      cfg.static(),
+		subscribers: nil,
+		modTimes:    make(map[string]time.Time),
+	}
+
+	r.current.Store(cfg.Runtime())
+	r.modTimes = r.statFiles()
+
+	return r
+}
+
+// Runtime returns the latest valid runtime config, it must not be modified
+func (r *Reloader) Runtime() *Runtime {
+	return r.current.Load()
+}
+
+// Subscribe registers a callback called after every successful reload
+func (r *Reloader) Subscribe(fn func(runtime *Runtime)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.subscribers = append(r.subscribers, fn)
+}
+
+// Reload loads and validates the config, the current one is kept on error
+func (r *Reloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.modTimes = r.statFiles()
+
+	cfg, err := Load(r.paths...)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(cfg.static(), r.static) {
+		slog.Warn("Config changes outside of the runtime section require a restart")
+	}
+
+	runtime := cfg.Runtime()
+	r.current.Store(runtime)
+
+	for _, fn := range r.subscribers {
+		fn(runtime)
+	}
+
+	return nil
+}
+
+func (r *Reloader) files() []string {
+	paths := r.paths
+	if len(paths) == 0 {
+		paths = []string{DefaultPath}
+	}
+
+	env := os.Getenv(EnvPrefix + "ENV")
+
+	var result []string
+	for _, path := range paths {
+		result = append(result, path)
+
+		if env != "" {
+			result = append(result, overlayPath(path, env))
+		}
+	}
+
+	return result
+}
+
+// statFiles returns modification times of existing config files
+func (r *Reloader) statFiles() map[string]time.Time {
+	result := make(map[string]time.Time)
+
+	for _, path := range r.files() {
+		if info, err := os.Stat(path); err == nil {
+			result[path] = info.ModTime()
+		}
+	}
+
+	return result
+}
+
+func (r *Reloader) changed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return !reflect.DeepEqual(r.statFiles(), r.modTimes)
+}
+
+func (r *Reloader) reload(reason string) {
+	if err := r.Reload(); err != nil {
+		slog.Error("Config reload failed, keeping the current config",
+			slog.String("reason", reason),
+			slog.Any("error", err),
+		)
+
+		return
+	}
+
+	slog.Info("Config reloaded", slog.String("reason", reason))
+}
+
+// Run reloads the config on SIGHUP and on file changes until ctx is done
+func (r *Reloader) Run(ctx context.Context) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sighup:
+			r.reload("SIGHUP")
+		case <-ticker.C:
+			if r.changed() {
+				r.reload("file changed")
+			}
+		}
+	}
+}
@@ -0,0 +1,204 @@
+// Package dbtest is an in-memory stand-in for the database in service tests.
+// Queries are told apart by their sqlc name, handlers answer them with rows of
+// column values in the order sqlc scans them.
+package dbtest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Handler answers a single query, :exec and :execrows queries report the number of rows as affected
+type Handler func(args []any) ([][]any, error)
+
+// DB implements database.DBTX. Handlers run one at a time, and a transaction
+// holds the whole database until it ends, which stands in for row locks.
+type DB struct {
+	txMu     sync.Mutex
+	mu       sync.Mutex
+	handlers map[string]Handler
+}
+
+func New() *DB {
+	return &DB{
+		txMu:     sync.Mutex{},
+		mu:       sync.Mutex{},
+		handlers: make(map[string]Handler),
+	}
+}
+
+func (db *DB) Handle(name string, handler Handler) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.handlers[name] = handler
+}
+
+// Fields returns the fields of a struct in order, the way sqlc scans SELECT * rows
+func Fields(v any) []any {
+	value := reflect.ValueOf(v)
+	fields := make([]any, value.NumField())
+
+	for i := range fields {
+		fields[i] = value.Field(i).Interface()
+	}
+
+	return fields
+}
+
+func queryName(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) < 3 || fields[0] != "--" || fields[1] != "name:" {
+		return ""
+	}
+
+	return fields[2]
+}
+
+func (db *DB) call(sql string, args []any) ([][]any, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	name := queryName(sql)
+
+	handler, ok := db.handlers[name]
+	if !ok {
+		return nil, fmt.Errorf("dbtest: unexpected query %q", name)
+	}
+
+	return handler(args)
+}
+
+func (db *DB) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	rows, err := db.call(sql, args)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+
+	return pgconn.NewCommandTag(fmt.Sprintf("UPDATE %d", len(rows))), nil
+}
+
+func (db *DB) Query(_ context.Context, sql string, args ...any) (pgx.Rows, error) {
+	rows, err := db.call(sql, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rows{rows: rows, index: -1}, nil
+}
+
+func (db *DB) QueryRow(_ context.Context, sql string, args ...any) pgx.Row {
+	rows, err := db.call(sql, args)
+	if err != nil {
+		return &Rows{err: err, index: -1}
+	}
+
+	if len(rows) == 0 {
+		return &Rows{err: pgx.ErrNoRows, index: -1}
+	}
+
+	return &Rows{rows: rows[:1], index: 0}
+}
+
+func (db *DB) Begin(context.Context) (pgx.Tx, error) {
+	db.txMu.Lock()
+
+	return &Tx{db: db, once: sync.Once{}}, nil
+}
+
+// Tx applies writes right away, rollback only releases the database
+type Tx struct {
+	pgx.Tx
+
+	db   *DB
+	once sync.Once
+}
+
+func (tx *Tx) end() {
+	tx.once.Do(tx.db.txMu.Unlock)
+}
+
+func (tx *Tx) Commit(context.Context) error {
+	tx.end()
+
+	return nil
+}
+
+func (tx *Tx) Rollback(context.Context) error {
+	tx.end()
+
+	return nil
+}
+
+func (tx *Tx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return tx.db.Exec(ctx, sql, args...)
+}
+
+func (tx *Tx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return tx.db.Query(ctx, sql, args...)
+}
+
+func (tx *Tx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return tx.db.QueryRow(ctx, sql, args...)
+}
+
+// Rows implements pgx.Rows and pgx.Row over handler results
+type Rows struct {
+	rows  [][]any
+	index int
+	err   error
+}
+
+func (r *Rows) Close()                                       {}
+func (r *Rows) Err() error                                   { return nil }
+func (r *Rows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *Rows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *Rows) Values() ([]any, error)                       { return r.rows[r.index], nil }
+func (r *Rows) RawValues() [][]byte                          { return nil }
+func (r *Rows) Conn() *pgx.Conn                              { return nil }
+
+func (r *Rows) Next() bool {
+	r.index++
+
+	return r.index < len(r.rows)
+}
+
+func (r *Rows) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	row := r.rows[r.index]
+	if len(row) != len(dest) {
+		return fmt.Errorf("dbtest: row has %d columns, scanning into %d", len(row), len(dest))
+	}
+
+	for i, d := range dest {
+		target := reflect.ValueOf(d).Elem()
+
+		if row[i] == nil {
+			target.SetZero()
+
+			continue
+		}
+
+		value := reflect.ValueOf(row[i])
+		if !value.Type().AssignableTo(target.Type()) {
+			if !value.Type().ConvertibleTo(target.Type()) {
+				return fmt.Errorf("dbtest: column %d is %s, scanning into %s", i, value.Type(), target.Type())
+			}
+
+			value = value.Convert(target.Type())
+		}
+
+		target.Set(value)
+	}
+
+	return nil
+}
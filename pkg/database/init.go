@@ -1,20 +1,11 @@
 package database
 
 import (
-	"context"
-	_ "embed"
-	"fmt"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"embed"
 )
 
-//go:embed schema.sql
-var schema string
-
-func InitSchema(ctx context.Context, dbConn *pgxpool.Pool) error {
-	_, err := dbConn.Exec(ctx, schema)
-	if err != nil {
-		return fmt.Errorf("sql exec error: %w", err)
-	}
-
-	return nil
-}
+// Migrations holds versioned schema migrations named <version>_<name>.up.sql
+// with optional <version>_<name>.down.sql counterparts
+//
+//go:embed migrations/*.sql
+var Migrations embed.FS
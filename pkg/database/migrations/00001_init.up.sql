@@ -0,0 +1,190 @@
+-- Baseline schema. Every statement is idempotent so that databases
+-- bootstrapped before versioned migrations can adopt it as is.
+
+CREATE TABLE IF NOT EXISTS orders
+(
+  id             UUID PRIMARY KEY,
+  index          BIGSERIAL    NOT NULL,
+  table_id       VARCHAR(255),
+  created        TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated        TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  status         VARCHAR(64)  NOT NULL,
+  client_name    VARCHAR(255) NOT NULL,
+  client_comment TEXT,
+  seen           BOOLEAN      NOT NULL DEFAULT false,
+  items          JSONB        NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_orders_created ON orders (index DESC);
+
+ALTER TABLE orders
+  ADD COLUMN IF NOT EXISTS eta_minutes INTEGER;
+ALTER TABLE orders
+  ADD COLUMN IF NOT EXISTS ready_at TIMESTAMP;
+-- the initial estimate split into its parts, eta_minutes includes the calibration factor and the queue.
+-- prep_minutes is the raw preparation estimate, queue_minutes the expected wait for a free kitchen slot
+ALTER TABLE orders
+  ADD COLUMN IF NOT EXISTS prep_minutes INTEGER;
+ALTER TABLE orders
+  ADD COLUMN IF NOT EXISTS queue_minutes INTEGER;
+ALTER TABLE orders
+  ADD COLUMN IF NOT EXISTS pickup_at TIMESTAMP;
+CREATE INDEX IF NOT EXISTS idx_orders_pickup_at ON orders (pickup_at);
+
+CREATE TABLE IF NOT EXISTS order_status_history
+(
+  id       BIGSERIAL PRIMARY KEY,
+  order_id UUID        NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+  status   VARCHAR(64) NOT NULL,
+  created  TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_order_status_history_order ON order_status_history (order_id, created);
+
+CREATE TABLE IF NOT EXISTS menu
+(
+  id      VARCHAR(64) PRIMARY KEY,
+  title   VARCHAR(255) NOT NULL,
+  created TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS product_groups
+(
+  id      UUID PRIMARY KEY,
+  menu_id VARCHAR(64)  NOT NULL REFERENCES menu (id) ON DELETE CASCADE,
+  index   INTEGER      NOT NULL CHECK (index >= 0),
+  title   VARCHAR(255) NOT NULL,
+  created TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT product_groups_order UNIQUE (menu_id, index) DEFERRABLE INITIALLY DEFERRED
+);
+
+CREATE TABLE IF NOT EXISTS products
+(
+  id          UUID PRIMARY KEY,
+  group_id    UUID             NOT NULL REFERENCES product_groups (id) ON DELETE CASCADE,
+  index       INTEGER          NOT NULL CHECK (index >= 0),
+  title       VARCHAR(255)     NOT NULL,
+  description TEXT             NOT NULL,
+  price       DOUBLE PRECISION NOT NULL CHECK (price >= 0.0),
+  available   BOOLEAN          NOT NULL DEFAULT true,
+  created     TIMESTAMP        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated     TIMESTAMP        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT products_order UNIQUE (group_id, index) DEFERRABLE INITIALLY DEFERRED
+);
+
+CREATE TABLE IF NOT EXISTS kitchen_stations
+(
+  id      VARCHAR(64) PRIMARY KEY,
+  title   VARCHAR(255) NOT NULL,
+  created TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE product_groups
+  ADD COLUMN IF NOT EXISTS prep_minutes INTEGER CHECK (prep_minutes > 0);
+ALTER TABLE products
+  ADD COLUMN IF NOT EXISTS prep_minutes INTEGER CHECK (prep_minutes > 0);
+
+ALTER TABLE kitchen_stations
+  ADD COLUMN IF NOT EXISTS printer_address VARCHAR(255);
+
+ALTER TABLE product_groups
+  ADD COLUMN IF NOT EXISTS station_id VARCHAR(64) REFERENCES kitchen_stations (id) ON DELETE SET NULL;
+
+CREATE TABLE IF NOT EXISTS kitchen_tickets
+(
+  id         UUID PRIMARY KEY,
+  order_id   UUID         NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+  station_id VARCHAR(64)  NOT NULL REFERENCES kitchen_stations (id) ON DELETE CASCADE,
+  product_id UUID         NOT NULL,
+  title      VARCHAR(255) NOT NULL,
+  amount     INTEGER      NOT NULL,
+  done       BOOLEAN      NOT NULL DEFAULT false,
+  created    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  bumped     TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_kitchen_tickets_station ON kitchen_tickets (station_id, created) WHERE NOT done;
+CREATE INDEX IF NOT EXISTS idx_kitchen_tickets_order ON kitchen_tickets (order_id);
+
+CREATE TABLE IF NOT EXISTS params
+(
+  id              INTEGER PRIMARY KEY CHECK (id = 1),
+  header_text     TEXT,
+  header_deadline TIMESTAMP
+);
+INSERT INTO params (id)
+VALUES (1)
+ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE params
+  ADD COLUMN IF NOT EXISTS ordering_paused BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE params
+  ADD COLUMN IF NOT EXISTS slot_minutes INTEGER NOT NULL DEFAULT 15 CHECK (slot_minutes > 0);
+ALTER TABLE params
+  ADD COLUMN IF NOT EXISTS slot_max_orders INTEGER CHECK (slot_max_orders > 0);
+ALTER TABLE params
+  ADD COLUMN IF NOT EXISTS slot_max_items INTEGER CHECK (slot_max_items > 0);
+ALTER TABLE params
+  ADD COLUMN IF NOT EXISTS closed_until TIMESTAMP;
+ALTER TABLE params
+  ADD COLUMN IF NOT EXISTS closed_reason TEXT;
+
+-- minutes since local midnight, closes <= opens means the day passes midnight
+CREATE TABLE IF NOT EXISTS opening_hours
+(
+  weekday INTEGER PRIMARY KEY CHECK (weekday BETWEEN 0 AND 6),
+  opens   INTEGER NOT NULL CHECK (opens BETWEEN 0 AND 1439),
+  closes  INTEGER NOT NULL CHECK (closes BETWEEN 0 AND 1440)
+);
+
+-- holidays and other dates with non-standard hours, opens IS NULL means closed all day
+CREATE TABLE IF NOT EXISTS opening_exceptions
+(
+  day    DATE PRIMARY KEY,
+  opens  INTEGER CHECK (opens BETWEEN 0 AND 1439),
+  closes INTEGER CHECK (closes BETWEEN 0 AND 1440),
+  title  VARCHAR(255)
+);
+
+CREATE TABLE IF NOT EXISTS announcements
+(
+  id       UUID PRIMARY KEY,
+  text     TEXT        NOT NULL,
+  starts   TIMESTAMP,
+  ends     TIMESTAMP,
+  priority INTEGER     NOT NULL DEFAULT 0,
+  severity VARCHAR(16) NOT NULL DEFAULT 'info',
+  target   VARCHAR(16) NOT NULL DEFAULT 'public',
+  menu_id  VARCHAR(64) REFERENCES menu (id) ON DELETE CASCADE,
+  created  TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated  TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- the header banner is the announcement with this id since announcements were added,
+-- a header set before is carried over once
+INSERT INTO announcements (id, text, ends)
+SELECT '00000000-0000-0000-0000-000000000001', header_text, header_deadline
+FROM params
+WHERE btrim(header_text) <> ''
+ON CONFLICT (id) DO NOTHING;
+UPDATE params
+SET header_text     = NULL,
+    header_deadline = NULL
+WHERE header_text IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS jobs
+(
+  name             VARCHAR(64) PRIMARY KEY,
+  last_started     TIMESTAMP,
+  last_finished    TIMESTAMP,
+  last_error       TEXT,
+  last_duration_ms BIGINT,
+  last_instance    VARCHAR(255),
+  runs             INTEGER NOT NULL DEFAULT 0,
+  failures         INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS migration
+(
+  id       VARCHAR(255) PRIMARY KEY,
+  applied  TIMESTAMP NOT NULL,
+  checksum VARCHAR(64)
+);
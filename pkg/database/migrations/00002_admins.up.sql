@@ -0,0 +1,7 @@
+CREATE TABLE admins
+(
+  username      VARCHAR(64) PRIMARY KEY,
+  password_hash TEXT      NOT NULL,
+  created       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
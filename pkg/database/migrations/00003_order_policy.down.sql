@@ -0,0 +1,11 @@
+ALTER TABLE products
+  DROP COLUMN max_quantity;
+
+ALTER TABLE params
+  DROP COLUMN max_order_total;
+ALTER TABLE params
+  DROP COLUMN min_order_total;
+ALTER TABLE params
+  DROP COLUMN max_line_quantity;
+ALTER TABLE params
+  DROP COLUMN max_lines;
@@ -0,0 +1,11 @@
+ALTER TABLE params
+  ADD COLUMN max_lines INTEGER NOT NULL DEFAULT 10 CHECK (max_lines > 0);
+ALTER TABLE params
+  ADD COLUMN max_line_quantity INTEGER NOT NULL DEFAULT 10 CHECK (max_line_quantity > 0);
+ALTER TABLE params
+  ADD COLUMN min_order_total DOUBLE PRECISION CHECK (min_order_total >= 0.0);
+ALTER TABLE params
+  ADD COLUMN max_order_total DOUBLE PRECISION DEFAULT 99999.0 CHECK (max_order_total > 0.0);
+
+ALTER TABLE products
+  ADD COLUMN max_quantity INTEGER CHECK (max_quantity > 0);
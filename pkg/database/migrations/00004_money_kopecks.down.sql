@@ -0,0 +1,16 @@
+UPDATE orders
+SET items = (SELECT COALESCE(jsonb_agg(item || jsonb_build_object('price', (item ->> 'price')::NUMERIC / 100)
+                                       ORDER BY position), '[]'::JSONB)
+             FROM jsonb_array_elements(orders.items) WITH ORDINALITY AS elements(item, position));
+
+ALTER TABLE params
+  ALTER COLUMN max_order_total DROP DEFAULT;
+ALTER TABLE params
+  ALTER COLUMN min_order_total TYPE DOUBLE PRECISION USING min_order_total / 100.0;
+ALTER TABLE params
+  ALTER COLUMN max_order_total TYPE DOUBLE PRECISION USING max_order_total / 100.0;
+ALTER TABLE params
+  ALTER COLUMN max_order_total SET DEFAULT 99999.0;
+
+ALTER TABLE products
+  ALTER COLUMN price TYPE DOUBLE PRECISION USING price / 100.0;
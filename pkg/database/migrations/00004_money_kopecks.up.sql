@@ -0,0 +1,19 @@
+-- prices and totals are stored in kopecks, values are rounded through NUMERIC
+-- so that 0.1 + 0.2 style float artifacts don't shift any amount
+
+ALTER TABLE products
+  ALTER COLUMN price TYPE BIGINT USING ROUND(price::NUMERIC * 100)::BIGINT;
+
+ALTER TABLE params
+  ALTER COLUMN max_order_total DROP DEFAULT;
+ALTER TABLE params
+  ALTER COLUMN min_order_total TYPE BIGINT USING ROUND(min_order_total::NUMERIC * 100)::BIGINT;
+ALTER TABLE params
+  ALTER COLUMN max_order_total TYPE BIGINT USING ROUND(max_order_total::NUMERIC * 100)::BIGINT;
+ALTER TABLE params
+  ALTER COLUMN max_order_total SET DEFAULT 9999900;
+
+UPDATE orders
+SET items = (SELECT COALESCE(jsonb_agg(item || jsonb_build_object('price', ROUND((item ->> 'price')::NUMERIC * 100)::BIGINT)
+                                       ORDER BY position), '[]'::JSONB)
+             FROM jsonb_array_elements(orders.items) WITH ORDINALITY AS elements(item, position));
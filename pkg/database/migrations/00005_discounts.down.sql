@@ -0,0 +1,7 @@
+DROP TABLE discount_usages;
+
+ALTER TABLE orders
+  DROP COLUMN discounts;
+
+DROP TABLE discount_rules;
+DROP TABLE promo_codes;
@@ -0,0 +1,52 @@
+-- value is a percentage for percent discounts and kopecks for fixed ones
+CREATE TABLE promo_codes
+(
+  id                    UUID PRIMARY KEY,
+  code                  VARCHAR(64) NOT NULL UNIQUE,
+  kind                  VARCHAR(16) NOT NULL,
+  value                 BIGINT      NOT NULL CHECK (value > 0),
+  min_order_total       BIGINT CHECK (min_order_total >= 0),
+  max_uses              INTEGER CHECK (max_uses > 0),
+  max_uses_per_customer INTEGER CHECK (max_uses_per_customer > 0),
+  starts                TIMESTAMP,
+  ends                  TIMESTAMP,
+  active                BOOLEAN     NOT NULL DEFAULT true,
+  created               TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated               TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- rules without a group apply to the whole order, from/to are minutes since local midnight
+CREATE TABLE discount_rules
+(
+  id          UUID PRIMARY KEY,
+  title       VARCHAR(255) NOT NULL,
+  kind        VARCHAR(16)  NOT NULL,
+  value       BIGINT       NOT NULL CHECK (value > 0),
+  group_id    UUID REFERENCES product_groups (id) ON DELETE CASCADE,
+  from_minute INTEGER CHECK (from_minute BETWEEN 0 AND 1439),
+  to_minute   INTEGER CHECK (to_minute BETWEEN 0 AND 1440),
+  starts      TIMESTAMP,
+  ends        TIMESTAMP,
+  active      BOOLEAN      NOT NULL DEFAULT true,
+  created     TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated     TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE orders
+  ADD COLUMN discounts JSONB NOT NULL DEFAULT '[]';
+
+CREATE TABLE discount_usages
+(
+  id            BIGSERIAL PRIMARY KEY,
+  order_id      UUID         NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+  promo_code_id UUID REFERENCES promo_codes (id) ON DELETE SET NULL,
+  rule_id       UUID REFERENCES discount_rules (id) ON DELETE SET NULL,
+  customer      VARCHAR(255) NOT NULL,
+  title         VARCHAR(255) NOT NULL,
+  amount        BIGINT       NOT NULL,
+  -- usages of cancelled orders don't count against promo code limits
+  voided        BOOLEAN      NOT NULL DEFAULT false,
+  created       TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_discount_usages_promo ON discount_usages (promo_code_id, customer);
+CREATE INDEX idx_discount_usages_created ON discount_usages (created);
@@ -0,0 +1,2 @@
+DROP TABLE combo_slot_options;
+DROP TABLE combo_slots;
@@ -0,0 +1,20 @@
+CREATE TABLE combo_slots
+(
+  id         UUID PRIMARY KEY,
+  product_id UUID         NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+  index      INTEGER      NOT NULL CHECK (index >= 0),
+  title      VARCHAR(255) NOT NULL,
+  created    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT combo_slots_order UNIQUE (product_id, index)
+);
+
+CREATE TABLE combo_slot_options
+(
+  id         BIGSERIAL PRIMARY KEY,
+  slot_id    UUID   NOT NULL REFERENCES combo_slots (id) ON DELETE CASCADE,
+  product_id UUID REFERENCES products (id) ON DELETE CASCADE,
+  group_id   UUID REFERENCES product_groups (id) ON DELETE CASCADE,
+  surcharge  BIGINT NOT NULL DEFAULT 0 CHECK (surcharge >= 0),
+  CONSTRAINT combo_slot_options_target CHECK ((product_id IS NULL) <> (group_id IS NULL))
+);
+CREATE INDEX idx_combo_slot_options_slot ON combo_slot_options (slot_id);
@@ -0,0 +1,11 @@
+DROP TABLE order_ingredient_stock;
+DROP TABLE order_product_stock;
+DROP TABLE product_ingredients;
+DROP TABLE ingredients;
+
+ALTER TABLE products
+  DROP COLUMN auto_stopped;
+ALTER TABLE products
+  DROP COLUMN low_stock;
+ALTER TABLE products
+  DROP COLUMN stock;
@@ -0,0 +1,43 @@
+ALTER TABLE products
+  ADD COLUMN stock INTEGER CHECK (stock >= 0);
+ALTER TABLE products
+  ADD COLUMN low_stock INTEGER CHECK (low_stock >= 0);
+ALTER TABLE products
+  ADD COLUMN auto_stopped BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE ingredients
+(
+  id        UUID PRIMARY KEY,
+  title     VARCHAR(255) NOT NULL,
+  unit      VARCHAR(32)  NOT NULL,
+  stock     INTEGER      NOT NULL CHECK (stock >= 0),
+  low_stock INTEGER CHECK (low_stock >= 0),
+  created   TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated   TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE product_ingredients
+(
+  product_id    UUID    NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+  ingredient_id UUID    NOT NULL REFERENCES ingredients (id) ON DELETE CASCADE,
+  quantity      INTEGER NOT NULL CHECK (quantity > 0),
+  PRIMARY KEY (product_id, ingredient_id)
+);
+CREATE INDEX idx_product_ingredients_ingredient ON product_ingredients (ingredient_id);
+
+-- stock taken by an order, returned when it is cancelled even if recipes have changed since
+CREATE TABLE order_product_stock
+(
+  order_id   UUID    NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+  product_id UUID    NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+  amount     INTEGER NOT NULL CHECK (amount > 0),
+  PRIMARY KEY (order_id, product_id)
+);
+
+CREATE TABLE order_ingredient_stock
+(
+  order_id      UUID    NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+  ingredient_id UUID    NOT NULL REFERENCES ingredients (id) ON DELETE CASCADE,
+  amount        INTEGER NOT NULL CHECK (amount > 0),
+  PRIMARY KEY (order_id, ingredient_id)
+);
@@ -0,0 +1,4 @@
+DROP TABLE z_report_claims;
+DROP TABLE z_report_deliveries;
+DROP TABLE z_reports;
+DROP FUNCTION z_reports_immutable();
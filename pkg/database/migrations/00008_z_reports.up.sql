@@ -0,0 +1,38 @@
+CREATE TABLE z_reports
+(
+  day     DATE PRIMARY KEY,
+  starts  TIMESTAMP NOT NULL,
+  ends    TIMESTAMP NOT NULL,
+  summary JSONB     NOT NULL,
+  created TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE FUNCTION z_reports_immutable() RETURNS TRIGGER AS
+$$
+BEGIN
+  RAISE EXCEPTION 'z_reports rows are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER z_reports_immutable
+  BEFORE UPDATE OR DELETE
+  ON z_reports
+  FOR EACH ROW
+EXECUTE FUNCTION z_reports_immutable();
+
+-- z_reports rows are immutable, so deliveries are marked here, one row per target
+-- that got the report: "email" or "telegram:<chat id>"
+CREATE TABLE z_report_deliveries
+(
+  day       DATE        NOT NULL REFERENCES z_reports (day),
+  channel   VARCHAR(64) NOT NULL,
+  delivered TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (day, channel)
+);
+
+-- an instance claims a day before sending it, others skip the day until the claim expires
+CREATE TABLE z_report_claims
+(
+  day     DATE PRIMARY KEY REFERENCES z_reports (day),
+  expires TIMESTAMP NOT NULL
+);
@@ -0,0 +1,13 @@
+DROP INDEX idx_orders_client_comment;
+DROP INDEX idx_orders_client_name;
+DROP INDEX idx_orders_items;
+DROP INDEX idx_orders_total;
+DROP INDEX idx_orders_created_at;
+DROP INDEX idx_orders_unseen;
+DROP INDEX idx_orders_table;
+DROP INDEX idx_orders_status;
+
+ALTER TABLE orders
+  DROP COLUMN total;
+
+DROP FUNCTION order_total(JSONB, JSONB);
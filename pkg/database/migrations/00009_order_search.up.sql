@@ -0,0 +1,24 @@
+-- total mirrors mapper.OrderTotal so that orders can be filtered and sorted by it
+CREATE FUNCTION order_total(items JSONB, discounts JSONB) RETURNS BIGINT
+  LANGUAGE SQL
+  IMMUTABLE AS
+$$
+SELECT (SELECT COALESCE(SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT), 0)
+        FROM jsonb_array_elements(items) AS item) -
+       (SELECT COALESCE(SUM((discount ->> 'amount')::BIGINT), 0)
+        FROM jsonb_array_elements(discounts) AS discount)
+$$;
+
+ALTER TABLE orders
+  ADD COLUMN total BIGINT NOT NULL GENERATED ALWAYS AS (order_total(items, discounts)) STORED;
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_orders_status ON orders (status, index DESC);
+CREATE INDEX idx_orders_table ON orders (table_id, index DESC) WHERE table_id IS NOT NULL;
+CREATE INDEX idx_orders_unseen ON orders (index DESC) WHERE NOT seen;
+CREATE INDEX idx_orders_created_at ON orders (created);
+CREATE INDEX idx_orders_total ON orders (total, index);
+CREATE INDEX idx_orders_items ON orders USING GIN (items jsonb_path_ops);
+CREATE INDEX idx_orders_client_name ON orders USING GIN (client_name gin_trgm_ops);
+CREATE INDEX idx_orders_client_comment ON orders USING GIN (client_comment gin_trgm_ops);
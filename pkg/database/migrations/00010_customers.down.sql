@@ -0,0 +1,7 @@
+DROP INDEX idx_orders_customer;
+
+ALTER TABLE orders
+  DROP COLUMN customer_id;
+
+DROP TABLE customer_otps;
+DROP TABLE customers;
@@ -0,0 +1,22 @@
+CREATE TABLE customers
+(
+  id      UUID PRIMARY KEY,
+  phone   VARCHAR(32) NOT NULL UNIQUE,
+  name    VARCHAR(255),
+  created TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- one pending code per phone, only its HMAC is stored
+CREATE TABLE customer_otps
+(
+  phone     VARCHAR(32) PRIMARY KEY,
+  code_hash VARCHAR(64) NOT NULL,
+  attempts  INTEGER     NOT NULL DEFAULT 0,
+  expires   TIMESTAMP   NOT NULL,
+  created   TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE orders
+  ADD COLUMN customer_id UUID REFERENCES customers (id) ON DELETE SET NULL;
+CREATE INDEX idx_orders_customer ON orders (customer_id, index DESC) WHERE customer_id IS NOT NULL;
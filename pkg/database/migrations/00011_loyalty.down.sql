@@ -0,0 +1,8 @@
+DROP TABLE loyalty_entries;
+
+ALTER TABLE params
+  DROP COLUMN loyalty_expire_days;
+ALTER TABLE params
+  DROP COLUMN loyalty_max_redeem_percent;
+ALTER TABLE params
+  DROP COLUMN loyalty_earn_percent;
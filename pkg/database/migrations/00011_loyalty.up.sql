@@ -0,0 +1,23 @@
+ALTER TABLE params
+  ADD COLUMN loyalty_earn_percent INTEGER NOT NULL DEFAULT 0 CHECK (loyalty_earn_percent BETWEEN 0 AND 100);
+ALTER TABLE params
+  ADD COLUMN loyalty_max_redeem_percent INTEGER NOT NULL DEFAULT 50 CHECK (loyalty_max_redeem_percent BETWEEN 0 AND 100);
+ALTER TABLE params
+  ADD COLUMN loyalty_expire_days INTEGER CHECK (loyalty_expire_days > 0);
+
+-- points ledger, the balance of a customer is the sum of their entries.
+-- earn and redeem entries of an order are corrected with entries of the same kind
+-- when the order is cancelled or its status changes back
+CREATE TABLE loyalty_entries
+(
+  id          BIGSERIAL PRIMARY KEY,
+  customer_id UUID        NOT NULL REFERENCES customers (id) ON DELETE CASCADE,
+  order_id    UUID REFERENCES orders (id) ON DELETE SET NULL,
+  kind        VARCHAR(16) NOT NULL CHECK (kind IN ('earn', 'redeem', 'expire', 'adjust')),
+  points      INTEGER     NOT NULL CHECK (points <> 0),
+  expires     TIMESTAMP,
+  comment     TEXT,
+  created     TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_loyalty_entries_customer ON loyalty_entries (customer_id, id DESC);
+CREATE INDEX idx_loyalty_entries_order ON loyalty_entries (order_id) WHERE order_id IS NOT NULL;
@@ -9,8 +9,139 @@ import (
 
 	"github.com/google/uuid"
 	"shantaram/app/api"
+	"shantaram/pkg/money"
 )
 
+type Admin struct {
+	Username     string
+	PasswordHash string
+	Created      time.Time
+	Updated      time.Time
+}
+
+type Announcement struct {
+	ID       uuid.UUID
+	Text     string
+	Starts   *time.Time
+	Ends     *time.Time
+	Priority int32
+	Severity api.AnnouncementSeverity
+	Target   api.AnnouncementTarget
+	MenuID   *string
+	Created  time.Time
+	Updated  time.Time
+}
+
+type ComboSlot struct {
+	ID        uuid.UUID
+	ProductID uuid.UUID
+	Index     int32
+	Title     string
+	Created   time.Time
+}
+
+type ComboSlotOption struct {
+	ID        int64
+	SlotID    uuid.UUID
+	ProductID *uuid.UUID
+	GroupID   *uuid.UUID
+	Surcharge money.Kopecks
+}
+
+type Customer struct {
+	ID      uuid.UUID
+	Phone   string
+	Name    *string
+	Created time.Time
+	Updated time.Time
+}
+
+type CustomerOtp struct {
+	Phone    string
+	CodeHash string
+	Attempts int32
+	Expires  time.Time
+	Created  time.Time
+}
+
+type DiscountRule struct {
+	ID         uuid.UUID
+	Title      string
+	Kind       api.DiscountKind
+	Value      int64
+	GroupID    *uuid.UUID
+	FromMinute *int32
+	ToMinute   *int32
+	Starts     *time.Time
+	Ends       *time.Time
+	Active     bool
+	Created    time.Time
+	Updated    time.Time
+}
+
+type DiscountUsage struct {
+	ID          int64
+	OrderID     uuid.UUID
+	PromoCodeID *uuid.UUID
+	RuleID      *uuid.UUID
+	Customer    string
+	Title       string
+	Amount      money.Kopecks
+	Voided      bool
+	Created     time.Time
+}
+
+type Ingredient struct {
+	ID       uuid.UUID
+	Title    string
+	Unit     string
+	Stock    int32
+	LowStock *int32
+	Created  time.Time
+	Updated  time.Time
+}
+
+type Job struct {
+	Name           string
+	LastStarted    *time.Time
+	LastFinished   *time.Time
+	LastError      *string
+	LastDurationMs *int64
+	LastInstance   *string
+	Runs           int32
+	Failures       int32
+}
+
+type KitchenStation struct {
+	ID             string
+	Title          string
+	Created        time.Time
+	PrinterAddress *string
+}
+
+type KitchenTicket struct {
+	ID        uuid.UUID
+	OrderID   uuid.UUID
+	StationID string
+	ProductID uuid.UUID
+	Title     string
+	Amount    int32
+	Done      bool
+	Created   time.Time
+	Bumped    *time.Time
+}
+
+type LoyaltyEntry struct {
+	ID         int64
+	CustomerID uuid.UUID
+	OrderID    *uuid.UUID
+	Kind       api.LoyaltyEntryKind
+	Points     int32
+	Expires    *time.Time
+	Comment    *string
+	Created    time.Time
+}
+
 type Menu struct {
 	ID      string
 	Title   string
@@ -18,8 +149,22 @@ type Menu struct {
 }
 
 type Migration struct {
-	ID      string
-	Applied time.Time
+	ID       string
+	Applied  time.Time
+	Checksum *string
+}
+
+type OpeningException struct {
+	Day    time.Time
+	Opens  *int32
+	Closes *int32
+	Title  *string
+}
+
+type OpeningHour struct {
+	Weekday int32
+	Opens   int32
+	Closes  int32
 }
 
 type Order struct {
@@ -33,12 +178,52 @@ type Order struct {
 	ClientComment *string
 	Seen          bool
 	Items         []api.OrderItem
+	EtaMinutes    *int32
+	ReadyAt       *time.Time
+	PrepMinutes   *int32
+	QueueMinutes  *int32
+	PickupAt      *time.Time
+	Discounts     []api.OrderDiscount
+	Total         money.Kopecks
+	CustomerID    *uuid.UUID
+}
+
+type OrderIngredientStock struct {
+	OrderID      uuid.UUID
+	IngredientID uuid.UUID
+	Amount       int32
+}
+
+type OrderProductStock struct {
+	OrderID   uuid.UUID
+	ProductID uuid.UUID
+	Amount    int32
+}
+
+type OrderStatusHistory struct {
+	ID      int64
+	OrderID uuid.UUID
+	Status  api.OrderStatus
+	Created time.Time
 }
 
 type Param struct {
-	ID             int32
-	HeaderText     *string
-	HeaderDeadline *time.Time
+	ID                      int32
+	HeaderText              *string
+	HeaderDeadline          *time.Time
+	OrderingPaused          bool
+	SlotMinutes             int32
+	SlotMaxOrders           *int32
+	SlotMaxItems            *int32
+	ClosedUntil             *time.Time
+	ClosedReason            *string
+	MaxLines                int32
+	MaxLineQuantity         int32
+	MinOrderTotal           *money.Kopecks
+	MaxOrderTotal           *money.Kopecks
+	LoyaltyEarnPercent      int32
+	LoyaltyMaxRedeemPercent int32
+	LoyaltyExpireDays       *int32
 }
 
 type Product struct {
@@ -47,17 +232,64 @@ type Product struct {
 	Index       int32
 	Title       string
 	Description string
-	Price       float64
+	Price       money.Kopecks
 	Available   bool
 	Created     time.Time
 	Updated     time.Time
+	PrepMinutes *int32
+	MaxQuantity *int32
+	Stock       *int32
+	LowStock    *int32
+	AutoStopped bool
 }
 
 type ProductGroup struct {
-	ID      uuid.UUID
-	MenuID  string
-	Index   int32
-	Title   string
+	ID          uuid.UUID
+	MenuID      string
+	Index       int32
+	Title       string
+	Created     time.Time
+	Updated     time.Time
+	PrepMinutes *int32
+	StationID   *string
+}
+
+type ProductIngredient struct {
+	ProductID    uuid.UUID
+	IngredientID uuid.UUID
+	Quantity     int32
+}
+
+type PromoCode struct {
+	ID                 uuid.UUID
+	Code               string
+	Kind               api.DiscountKind
+	Value              int64
+	MinOrderTotal      *money.Kopecks
+	MaxUses            *int32
+	MaxUsesPerCustomer *int32
+	Starts             *time.Time
+	Ends               *time.Time
+	Active             bool
+	Created            time.Time
+	Updated            time.Time
+}
+
+type ZReport struct {
+	Day     time.Time
+	Starts  time.Time
+	Ends    time.Time
+	Summary api.ZReportSummary
 	Created time.Time
-	Updated time.Time
+}
+
+type ZReportClaim struct {
+	Day     time.Time
+	Expires time.Time
+}
+
+type ZReportDelivery struct {
+	Day       time.Time
+	Channel   string
+	Delivered time.Time
 }
@@ -6,31 +6,160 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
-	//CountOrders
+	//BumpKitchenTicket
+	//
+	//  UPDATE kitchen_tickets
+	//  SET done   = true,
+	//      bumped = CURRENT_TIMESTAMP
+	//  WHERE id = $1
+	//  RETURNING id, order_id, station_id, product_id, title, amount, done, created, bumped
+	BumpKitchenTicket(ctx context.Context, id uuid.UUID) (KitchenTicket, error)
+	// affects no rows while another instance holds an unexpired claim
+	//
+	//  INSERT INTO z_report_claims (day, expires)
+	//  VALUES ($1, $2)
+	//  ON CONFLICT (day) DO UPDATE SET expires = excluded.expires
+	//  WHERE z_report_claims.expires < $3::TIMESTAMP
+	ClaimZReport(ctx context.Context, arg ClaimZReportParams) (int64, error)
+	//ConsumeIngredientStock
+	//
+	//  UPDATE ingredients
+	//  SET stock   = stock - $1::INTEGER,
+	//      updated = CURRENT_TIMESTAMP
+	//  WHERE id = $2
+	//    AND stock >= $1::INTEGER
+	//  RETURNING id, title, unit, stock, low_stock, created, updated
+	ConsumeIngredientStock(ctx context.Context, arg ConsumeIngredientStockParams) (Ingredient, error)
+	//ConsumeProductStock
+	//
+	//  UPDATE products
+	//  SET stock   = stock - $1::INTEGER,
+	//      updated = CURRENT_TIMESTAMP
+	//  WHERE id = $2
+	//    AND stock >= $1::INTEGER
+	//  RETURNING stock::INTEGER AS stock, low_stock
+	ConsumeProductStock(ctx context.Context, arg ConsumeProductStockParams) (ConsumeProductStockRow, error)
+	//CountPendingKitchenTickets
+	//
+	//  SELECT COUNT(*)
+	//  FROM kitchen_tickets
+	//  WHERE order_id = $1
+	//    AND NOT done
+	CountPendingKitchenTickets(ctx context.Context, orderID uuid.UUID) (int64, error)
+	//CountPromoCodeUses
+	//
+	//  SELECT COUNT(*)::INTEGER                                 AS total,
+	//         (COUNT(*) FILTER (WHERE customer = $1))::INTEGER AS customer
+	//  FROM discount_usages
+	//  WHERE promo_code_id = $2
+	//    AND NOT voided
+	CountPromoCodeUses(ctx context.Context, arg CountPromoCodeUsesParams) (CountPromoCodeUsesRow, error)
+	//CountSearchOrders
 	//
 	//  SELECT COUNT(*)
 	//  FROM orders
-	CountOrders(ctx context.Context) (int64, error)
+	//  WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+	//    AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+	//    AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+	//    AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+	//    AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+	//    AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+	//         client_comment ILIKE $6::TEXT)
+	//    AND ($7::TEXT IS NULL OR
+	//         items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+	//         items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+	//           jsonb_build_object('id', $7::TEXT)))))
+	//    AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+	//    AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+	//    AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+	CountSearchOrders(ctx context.Context, arg CountSearchOrdersParams) (int64, error)
+	//CreateAdmin
+	//
+	//  INSERT INTO admins (username, password_hash)
+	//  VALUES ($1, $2)
+	CreateAdmin(ctx context.Context, arg CreateAdminParams) error
+	//CreateAnnouncement
+	//
+	//  INSERT INTO announcements (id, text, starts, ends, priority, severity, target, menu_id)
+	//  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	CreateAnnouncement(ctx context.Context, arg CreateAnnouncementParams) error
+	//CreateComboSlot
+	//
+	//  INSERT INTO combo_slots (id, product_id, index, title)
+	//  VALUES ($1, $2, $3, $4)
+	CreateComboSlot(ctx context.Context, arg CreateComboSlotParams) error
+	//CreateComboSlotOption
+	//
+	//  INSERT INTO combo_slot_options (slot_id, product_id, group_id, surcharge)
+	//  VALUES ($1, $2, $3, $4)
+	CreateComboSlotOption(ctx context.Context, arg CreateComboSlotOptionParams) error
+	//CreateDiscountRule
+	//
+	//  INSERT INTO discount_rules (id, title, kind, value, group_id, from_minute, to_minute, starts, ends, active)
+	//  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	CreateDiscountRule(ctx context.Context, arg CreateDiscountRuleParams) error
+	//CreateDiscountUsage
+	//
+	//  INSERT INTO discount_usages (order_id, promo_code_id, rule_id, customer, title, amount)
+	//  VALUES ($1, $2, $3, $4, $5, $6)
+	CreateDiscountUsage(ctx context.Context, arg CreateDiscountUsageParams) error
+	//CreateIngredient
+	//
+	//  INSERT INTO ingredients (id, title, unit, stock, low_stock)
+	//  VALUES ($1, $2, $3, $4, $5)
+	CreateIngredient(ctx context.Context, arg CreateIngredientParams) error
+	//CreateKitchenTicket
+	//
+	//  INSERT INTO kitchen_tickets (id, order_id, station_id, product_id, title, amount)
+	//  VALUES ($1, $2, $3, $4, $5, $6)
+	CreateKitchenTicket(ctx context.Context, arg CreateKitchenTicketParams) error
+	//CreateLoyaltyEntry
+	//
+	//  INSERT INTO loyalty_entries (customer_id, order_id, kind, points, expires, comment)
+	//  VALUES ($1, $2, $3, $4, $5, $6)
+	//  RETURNING id, customer_id, order_id, kind, points, expires, comment, created
+	CreateLoyaltyEntry(ctx context.Context, arg CreateLoyaltyEntryParams) (LoyaltyEntry, error)
 	//CreateMigration
 	//
-	//  INSERT INTO migration (id, applied)
-	//  VALUES ($1, $2) RETURNING id
+	//  INSERT INTO migration (id, applied, checksum)
+	//  VALUES ($1, $2, $3) RETURNING id
 	CreateMigration(ctx context.Context, arg CreateMigrationParams) (string, error)
+	//CreateOpeningHours
+	//
+	//  INSERT INTO opening_hours (weekday, opens, closes)
+	//  VALUES ($1, $2, $3)
+	CreateOpeningHours(ctx context.Context, arg CreateOpeningHoursParams) error
 	//CreateOrder
 	//
-	//  INSERT INTO orders (id, table_id, client_name, client_comment, status, seen, items)
-	//  VALUES ($1, $2, $3, $4, $5, $6, $7)
-	//  RETURNING id, index, table_id, created, updated, status, client_name, client_comment, seen, items
+	//  INSERT INTO orders (id, table_id, client_name, client_comment, status, seen, items, pickup_at, discounts, customer_id)
+	//  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	//  RETURNING id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
 	CreateOrder(ctx context.Context, arg CreateOrderParams) (Order, error)
+	//CreateOrderIngredientStock
+	//
+	//  INSERT INTO order_ingredient_stock (order_id, ingredient_id, amount)
+	//  VALUES ($1, $2, $3)
+	CreateOrderIngredientStock(ctx context.Context, arg CreateOrderIngredientStockParams) error
+	//CreateOrderProductStock
+	//
+	//  INSERT INTO order_product_stock (order_id, product_id, amount)
+	//  VALUES ($1, $2, $3)
+	CreateOrderProductStock(ctx context.Context, arg CreateOrderProductStockParams) error
+	//CreateOrderStatusHistory
+	//
+	//  INSERT INTO order_status_history (order_id, status)
+	//  VALUES ($1, $2)
+	CreateOrderStatusHistory(ctx context.Context, arg CreateOrderStatusHistoryParams) error
 	//CreateProduct
 	//
-	//  INSERT INTO products (id, group_id, title, description, price, index)
-	//  VALUES ($1, $2::UUID, $3, $4, $5,
+	//  INSERT INTO products (id, group_id, title, description, price, prep_minutes, max_quantity, index)
+	//  VALUES ($1, $2::UUID, $3, $4, $5, $6, $7,
 	//          (SELECT COALESCE(MAX(index), 0) + 1 FROM products WHERE group_id = $2::UUID) )
 	CreateProduct(ctx context.Context, arg CreateProductParams) error
 	//CreateProductGroup
@@ -39,12 +168,108 @@ type Querier interface {
 	//  VALUES ($1, $2::VARCHAR(255), $3,
 	//          (SELECT COALESCE(MAX(index), 0) + 1 FROM product_groups WHERE menu_id = $2:: VARCHAR (255)) )
 	CreateProductGroup(ctx context.Context, arg CreateProductGroupParams) error
+	//CreatePromoCode
+	//
+	//  INSERT INTO promo_codes (id, code, kind, value, min_order_total, max_uses, max_uses_per_customer, starts, ends, active)
+	//  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	CreatePromoCode(ctx context.Context, arg CreatePromoCodeParams) error
+	//CreateRecipeItem
+	//
+	//  INSERT INTO product_ingredients (product_id, ingredient_id, quantity)
+	//  VALUES ($1, $2, $3)
+	CreateRecipeItem(ctx context.Context, arg CreateRecipeItemParams) error
+	//CreateZReport
+	//
+	//  INSERT INTO z_reports (day, starts, ends, summary)
+	//  VALUES ($1, $2, $3, $4)
+	//  ON CONFLICT (day) DO NOTHING
+	CreateZReport(ctx context.Context, arg CreateZReportParams) (int64, error)
+	//CreateZReportDelivery
+	//
+	//  INSERT INTO z_report_deliveries (day, channel)
+	//  VALUES ($1, $2)
+	//  ON CONFLICT (day, channel) DO NOTHING
+	CreateZReportDelivery(ctx context.Context, arg CreateZReportDeliveryParams) error
+	//DeleteAnnouncement
+	//
+	//  DELETE
+	//  FROM announcements
+	//  WHERE id = $1
+	DeleteAnnouncement(ctx context.Context, id uuid.UUID) (int64, error)
+	//DeleteComboSlots
+	//
+	//  DELETE
+	//  FROM combo_slots
+	//  WHERE product_id = $1
+	DeleteComboSlots(ctx context.Context, productID uuid.UUID) error
+	//DeleteCustomerOTP
+	//
+	//  DELETE
+	//  FROM customer_otps
+	//  WHERE phone = $1
+	DeleteCustomerOTP(ctx context.Context, phone string) error
+	//DeleteDiscountRule
+	//
+	//  DELETE
+	//  FROM discount_rules
+	//  WHERE id = $1
+	DeleteDiscountRule(ctx context.Context, id uuid.UUID) (int64, error)
+	//DeleteExpiredAnnouncement
+	//
+	//  DELETE
+	//  FROM announcements
+	//  WHERE id = $1
+	//    AND ends <= $2::TIMESTAMP
+	DeleteExpiredAnnouncement(ctx context.Context, arg DeleteExpiredAnnouncementParams) (int64, error)
+	//DeleteIngredient
+	//
+	//  DELETE
+	//  FROM ingredients
+	//  WHERE id = $1
+	DeleteIngredient(ctx context.Context, id uuid.UUID) (int64, error)
+	//DeleteKitchenStation
+	//
+	//  DELETE
+	//  FROM kitchen_stations
+	//  WHERE id = $1
+	DeleteKitchenStation(ctx context.Context, id string) error
+	//DeleteMigration
+	//
+	//  DELETE
+	//  FROM migration
+	//  WHERE id = $1
+	DeleteMigration(ctx context.Context, id string) error
+	//DeleteOpeningException
+	//
+	//  DELETE
+	//  FROM opening_exceptions
+	//  WHERE day = $1
+	DeleteOpeningException(ctx context.Context, day time.Time) error
+	//DeleteOpeningHours
+	//
+	//  DELETE
+	//  FROM opening_hours
+	DeleteOpeningHours(ctx context.Context) error
 	//DeleteOrder
 	//
 	//  DELETE
 	//  FROM orders
 	//  WHERE id = $1
 	DeleteOrder(ctx context.Context, id uuid.UUID) error
+	//DeleteOrderIngredientStock
+	//
+	//  DELETE
+	//  FROM order_ingredient_stock
+	//  WHERE order_id = $1
+	//  RETURNING order_id, ingredient_id, amount
+	DeleteOrderIngredientStock(ctx context.Context, orderID uuid.UUID) ([]OrderIngredientStock, error)
+	//DeleteOrderProductStock
+	//
+	//  DELETE
+	//  FROM order_product_stock
+	//  WHERE order_id = $1
+	//  RETURNING order_id, product_id, amount
+	DeleteOrderProductStock(ctx context.Context, orderID uuid.UUID) ([]OrderProductStock, error)
 	//DeleteProduct
 	//
 	//  DELETE
@@ -57,18 +282,287 @@ type Querier interface {
 	//  FROM product_groups
 	//  WHERE id = $1
 	DeleteProductGroup(ctx context.Context, id uuid.UUID) error
+	//DeleteProductGroupsNotIn
+	//
+	//  DELETE
+	//  FROM product_groups
+	//  WHERE menu_id = $1
+	//    AND NOT (id = ANY ($2::UUID[]))
+	DeleteProductGroupsNotIn(ctx context.Context, arg DeleteProductGroupsNotInParams) error
+	//DeleteProductsNotIn
+	//
+	//  DELETE
+	//  FROM products
+	//  WHERE group_id = $1
+	//    AND NOT (id = ANY ($2::UUID[]))
+	DeleteProductsNotIn(ctx context.Context, arg DeleteProductsNotInParams) error
+	//DeletePromoCode
+	//
+	//  DELETE
+	//  FROM promo_codes
+	//  WHERE id = $1
+	DeletePromoCode(ctx context.Context, id uuid.UUID) (int64, error)
+	//DeleteRecipe
+	//
+	//  DELETE
+	//  FROM product_ingredients
+	//  WHERE product_id = $1
+	DeleteRecipe(ctx context.Context, productID uuid.UUID) error
+	//ExpireLoyaltyPoints
+	//
+	//  INSERT INTO loyalty_entries (customer_id, kind, points)
+	//  SELECT customer_id, 'expire', -due
+	//  FROM (SELECT customer_id,
+	//               COALESCE(SUM(points) FILTER (WHERE kind = 'earn' AND points > 0 AND expires <= $1::TIMESTAMP), 0) +
+	//               COALESCE(SUM(points) FILTER (WHERE kind IN ('redeem', 'expire') OR (kind IN ('earn', 'adjust') AND points < 0)), 0) AS due
+	//        FROM loyalty_entries
+	//        WHERE loyalty_entries.customer_id = $2::UUID
+	//        GROUP BY customer_id) AS balances
+	//  WHERE due > 0
+	ExpireLoyaltyPoints(ctx context.Context, arg ExpireLoyaltyPointsParams) (int64, error)
+	//FinishJobRun
+	//
+	//  UPDATE jobs
+	//  SET last_finished    = $2,
+	//      last_error       = $3,
+	//      last_duration_ms = $4,
+	//      runs             = runs + 1,
+	//      failures         = failures + CASE WHEN $3::TEXT IS NULL THEN 0 ELSE 1 END
+	//  WHERE name = $1
+	FinishJobRun(ctx context.Context, arg FinishJobRunParams) error
+	//GetActiveAnnouncements
+	//
+	//  SELECT id, text, starts, ends, priority, severity, target, menu_id, created, updated
+	//  FROM announcements
+	//  WHERE (starts IS NULL OR starts <= $1::TIMESTAMP)
+	//    AND (ends IS NULL OR ends > $1::TIMESTAMP)
+	//  ORDER BY priority DESC, created DESC
+	GetActiveAnnouncements(ctx context.Context, now time.Time) ([]Announcement, error)
+	//GetActiveDiscountRules
+	//
+	//  SELECT id, title, kind, value, group_id, from_minute, to_minute, starts, ends, active, created, updated
+	//  FROM discount_rules
+	//  WHERE active
+	//    AND (starts IS NULL OR starts <= $1::TIMESTAMP)
+	//    AND (ends IS NULL OR ends > $1::TIMESTAMP)
+	//  ORDER BY created
+	GetActiveDiscountRules(ctx context.Context, now time.Time) ([]DiscountRule, error)
+	//GetAdminByUsername
+	//
+	//  SELECT username, password_hash, created, updated
+	//  FROM admins
+	//  WHERE username = $1
+	GetAdminByUsername(ctx context.Context, username string) (Admin, error)
+	//GetAllComboSlotOptions
+	//
+	//  SELECT id, slot_id, product_id, group_id, surcharge
+	//  FROM combo_slot_options
+	//  ORDER BY id
+	GetAllComboSlotOptions(ctx context.Context) ([]ComboSlotOption, error)
+	//GetAllComboSlots
+	//
+	//  SELECT id, product_id, index, title, created
+	//  FROM combo_slots
+	//  ORDER BY product_id, index
+	GetAllComboSlots(ctx context.Context) ([]ComboSlot, error)
 	//GetAllProductGroups
 	//
-	//  SELECT id, menu_id, index, title, created, updated
+	//  SELECT id, menu_id, index, title, created, updated, prep_minutes, station_id
 	//  FROM product_groups
 	//  ORDER BY index
 	GetAllProductGroups(ctx context.Context) ([]ProductGroup, error)
 	//GetAllProducts
 	//
-	//  SELECT id, group_id, index, title, description, price, available, created, updated
+	//  SELECT id, group_id, index, title, description, price, available, created, updated, prep_minutes, max_quantity, stock, low_stock, auto_stopped
 	//  FROM products
 	//  ORDER BY available DESC, index, group_id
 	GetAllProducts(ctx context.Context) ([]Product, error)
+	//GetAnnouncementByID
+	//
+	//  SELECT id, text, starts, ends, priority, severity, target, menu_id, created, updated
+	//  FROM announcements
+	//  WHERE id = $1
+	GetAnnouncementByID(ctx context.Context, id uuid.UUID) (Announcement, error)
+	//GetAnnouncements
+	//
+	//  SELECT id, text, starts, ends, priority, severity, target, menu_id, created, updated
+	//  FROM announcements
+	//  ORDER BY priority DESC, created DESC
+	GetAnnouncements(ctx context.Context) ([]Announcement, error)
+	//GetCancellationReport
+	//
+	//  SELECT date_trunc($1::TEXT, (orders.created AT TIME ZONE 'UTC') AT TIME ZONE $2::TEXT)::TIMESTAMP AS period,
+	//         COUNT(*)::INTEGER                                                                                     AS orders,
+	//         (COUNT(*) FILTER (WHERE orders.status = 'cancelled'))::INTEGER                                        AS cancelled
+	//  FROM orders
+	//  WHERE orders.created >= $3::TIMESTAMP
+	//    AND orders.created < $4::TIMESTAMP
+	//  GROUP BY period
+	//  ORDER BY period
+	GetCancellationReport(ctx context.Context, arg GetCancellationReportParams) ([]GetCancellationReportRow, error)
+	//GetComboSlotOptionsByProduct
+	//
+	//  SELECT combo_slot_options.id, combo_slot_options.slot_id, combo_slot_options.product_id, combo_slot_options.group_id, combo_slot_options.surcharge
+	//  FROM combo_slot_options
+	//         JOIN combo_slots ON combo_slots.id = combo_slot_options.slot_id
+	//  WHERE combo_slots.product_id = $1
+	//  ORDER BY combo_slot_options.id
+	GetComboSlotOptionsByProduct(ctx context.Context, productID uuid.UUID) ([]ComboSlotOption, error)
+	//GetComboSlotsByProduct
+	//
+	//  SELECT id, product_id, index, title, created
+	//  FROM combo_slots
+	//  WHERE product_id = $1
+	//  ORDER BY index
+	GetComboSlotsByProduct(ctx context.Context, productID uuid.UUID) ([]ComboSlot, error)
+	//GetCustomerByID
+	//
+	//  SELECT id, phone, name, created, updated
+	//  FROM customers
+	//  WHERE id = $1
+	GetCustomerByID(ctx context.Context, id uuid.UUID) (Customer, error)
+	//GetCustomerByIDForUpdate
+	//
+	//  SELECT id, phone, name, created, updated
+	//  FROM customers
+	//  WHERE id = $1
+	//  FOR UPDATE
+	GetCustomerByIDForUpdate(ctx context.Context, id uuid.UUID) (Customer, error)
+	//GetCustomerOTP
+	//
+	//  SELECT phone, code_hash, attempts, expires, created
+	//  FROM customer_otps
+	//  WHERE phone = $1
+	GetCustomerOTP(ctx context.Context, phone string) (CustomerOtp, error)
+	//GetDiscountReport
+	//
+	//  SELECT discount_usages.title,
+	//         discount_usages.promo_code_id,
+	//         discount_usages.rule_id,
+	//         COUNT(*)::INTEGER                     AS orders,
+	//         SUM(discount_usages.amount)::BIGINT AS amount
+	//  FROM discount_usages
+	//  WHERE discount_usages.created >= $1::TIMESTAMP
+	//    AND discount_usages.created < $2::TIMESTAMP
+	//    AND NOT discount_usages.voided
+	//  GROUP BY discount_usages.title, discount_usages.promo_code_id, discount_usages.rule_id
+	//  ORDER BY amount DESC
+	GetDiscountReport(ctx context.Context, arg GetDiscountReportParams) ([]GetDiscountReportRow, error)
+	//GetDiscountRules
+	//
+	//  SELECT id, title, kind, value, group_id, from_minute, to_minute, starts, ends, active, created, updated
+	//  FROM discount_rules
+	//  ORDER BY created DESC
+	GetDiscountRules(ctx context.Context) ([]DiscountRule, error)
+	//GetDiscountUsagesByOrder
+	//
+	//  SELECT id, order_id, promo_code_id, rule_id, customer, title, amount, voided, created
+	//  FROM discount_usages
+	//  WHERE order_id = $1
+	//  ORDER BY id
+	GetDiscountUsagesByOrder(ctx context.Context, orderID uuid.UUID) ([]DiscountUsage, error)
+	//GetHeatmapReport
+	//
+	//  WITH totals AS (SELECT (orders.created AT TIME ZONE 'UTC') AT TIME ZONE $1::TEXT AS local_created,
+	//                         (SELECT COALESCE(SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT), 0)
+	//                          FROM jsonb_array_elements(orders.items) AS item) -
+	//                         (SELECT COALESCE(SUM((discount ->> 'amount')::BIGINT), 0)
+	//                          FROM jsonb_array_elements(orders.discounts) AS discount) AS total
+	//                  FROM orders
+	//                  WHERE orders.created >= $2::TIMESTAMP
+	//                    AND orders.created < $3::TIMESTAMP
+	//                    AND orders.status <> 'cancelled')
+	//  SELECT EXTRACT(ISODOW FROM local_created)::INTEGER AS weekday,
+	//         EXTRACT(HOUR FROM local_created)::INTEGER   AS hour,
+	//         COUNT(*)::INTEGER                           AS orders,
+	//         COALESCE(SUM(total), 0)::BIGINT             AS revenue
+	//  FROM totals
+	//  GROUP BY weekday, hour
+	//  ORDER BY weekday, hour
+	GetHeatmapReport(ctx context.Context, arg GetHeatmapReportParams) ([]GetHeatmapReportRow, error)
+	//GetIngredients
+	//
+	//  SELECT id, title, unit, stock, low_stock, created, updated
+	//  FROM ingredients
+	//  ORDER BY title
+	GetIngredients(ctx context.Context) ([]Ingredient, error)
+	//GetJob
+	//
+	//  SELECT name, last_started, last_finished, last_error, last_duration_ms, last_instance, runs, failures
+	//  FROM jobs
+	//  WHERE name = $1
+	GetJob(ctx context.Context, name string) (Job, error)
+	//GetJobs
+	//
+	//  SELECT name, last_started, last_finished, last_error, last_duration_ms, last_instance, runs, failures
+	//  FROM jobs
+	//  ORDER BY name
+	GetJobs(ctx context.Context) ([]Job, error)
+	//GetKitchenQueue
+	//
+	//  SELECT kitchen_tickets.id, kitchen_tickets.order_id, kitchen_tickets.station_id, kitchen_tickets.product_id, kitchen_tickets.title, kitchen_tickets.amount, kitchen_tickets.done, kitchen_tickets.created, kitchen_tickets.bumped, orders.index AS order_index, orders.client_name, orders.client_comment
+	//  FROM kitchen_tickets
+	//         JOIN orders ON orders.id = kitchen_tickets.order_id
+	//  WHERE kitchen_tickets.station_id = $1
+	//    AND NOT kitchen_tickets.done
+	//    AND orders.status = 'open'
+	//  ORDER BY kitchen_tickets.created, kitchen_tickets.title
+	GetKitchenQueue(ctx context.Context, stationID string) ([]GetKitchenQueueRow, error)
+	//GetKitchenStationByID
+	//
+	//  SELECT id, title, created, printer_address
+	//  FROM kitchen_stations
+	//  WHERE id = $1
+	GetKitchenStationByID(ctx context.Context, id string) (KitchenStation, error)
+	//GetKitchenStations
+	//
+	//  SELECT id, title, created, printer_address
+	//  FROM kitchen_stations
+	//  ORDER BY created
+	GetKitchenStations(ctx context.Context) ([]KitchenStation, error)
+	//GetKitchenTicketOrderID
+	//
+	//  SELECT order_id
+	//  FROM kitchen_tickets
+	//  WHERE id = $1
+	GetKitchenTicketOrderID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	//GetKitchenTicketsByOrder
+	//
+	//  SELECT id, order_id, station_id, product_id, title, amount, done, created, bumped
+	//  FROM kitchen_tickets
+	//  WHERE order_id = $1
+	//  ORDER BY station_id, created, title
+	GetKitchenTicketsByOrder(ctx context.Context, orderID uuid.UUID) ([]KitchenTicket, error)
+	//GetLastZReportDay
+	//
+	//  SELECT day
+	//  FROM z_reports
+	//  ORDER BY day DESC
+	//  LIMIT 1
+	GetLastZReportDay(ctx context.Context) (time.Time, error)
+	//GetLoyaltyBalance
+	//
+	//  SELECT COALESCE(SUM(points), 0)::INTEGER
+	//  FROM loyalty_entries
+	//  WHERE customer_id = $1
+	GetLoyaltyBalance(ctx context.Context, customerID uuid.UUID) (int32, error)
+	//GetLoyaltyEntries
+	//
+	//  SELECT id, customer_id, order_id, kind, points, expires, comment, created
+	//  FROM loyalty_entries
+	//  WHERE customer_id = $1
+	//  ORDER BY id DESC
+	//  LIMIT $2
+	GetLoyaltyEntries(ctx context.Context, arg GetLoyaltyEntriesParams) ([]LoyaltyEntry, error)
+	// spending takes expiring points first, so the points left to expire are the earned ones
+	// past their date minus everything spent, expired or taken back so far
+	//
+	//  SELECT customer_id
+	//  FROM loyalty_entries
+	//  GROUP BY customer_id
+	//  HAVING COALESCE(SUM(points) FILTER (WHERE kind = 'earn' AND points > 0 AND expires <= $1::TIMESTAMP), 0) +
+	//         COALESCE(SUM(points) FILTER (WHERE kind IN ('redeem', 'expire') OR (kind IN ('earn', 'adjust') AND points < 0)), 0) > 0
+	GetLoyaltyExpiringCustomers(ctx context.Context, now time.Time) ([]uuid.UUID, error)
 	//GetMenus
 	//
 	//  SELECT id, title, created
@@ -77,56 +571,457 @@ type Querier interface {
 	GetMenus(ctx context.Context) ([]Menu, error)
 	//GetMigrations
 	//
-	//  SELECT id, applied
+	//  SELECT id, applied, checksum
 	//  FROM migration
 	//  ORDER BY id
 	GetMigrations(ctx context.Context) ([]Migration, error)
+	//GetOpenOrders
+	//
+	//  SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+	//  FROM orders
+	//  WHERE status = 'open'
+	//  ORDER BY index
+	GetOpenOrders(ctx context.Context) ([]Order, error)
+	//GetOpeningExceptions
+	//
+	//  SELECT day, opens, closes, title
+	//  FROM opening_exceptions
+	//  WHERE day >= $1::DATE
+	//  ORDER BY day
+	GetOpeningExceptions(ctx context.Context, since time.Time) ([]OpeningException, error)
+	//GetOpeningHours
+	//
+	//  SELECT weekday, opens, closes
+	//  FROM opening_hours
+	//  ORDER BY weekday
+	GetOpeningHours(ctx context.Context) ([]OpeningHour, error)
 	//GetOrderByID
 	//
-	//  SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items
+	//  SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
 	//  FROM orders
 	//  WHERE id = $1
 	GetOrderByID(ctx context.Context, id uuid.UUID) (Order, error)
-	//GetOrdersPaginated
+	//GetOrderByIDForUpdate
 	//
-	//  SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items
+	//  SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
 	//  FROM orders
-	//  ORDER BY index DESC
-	//  OFFSET $1 LIMIT $2
-	GetOrdersPaginated(ctx context.Context, arg GetOrdersPaginatedParams) ([]Order, error)
+	//  WHERE id = $1
+	//    FOR UPDATE
+	GetOrderByIDForUpdate(ctx context.Context, id uuid.UUID) (Order, error)
+	//GetOrderLoyaltyPoints
+	//
+	//  SELECT COALESCE(SUM(points) FILTER (WHERE kind = 'earn'), 0)::INTEGER   AS earned,
+	//         COALESCE(SUM(points) FILTER (WHERE kind = 'redeem'), 0)::INTEGER AS redeemed
+	//  FROM loyalty_entries
+	//  WHERE order_id = $1
+	GetOrderLoyaltyPoints(ctx context.Context, orderID *uuid.UUID) (GetOrderLoyaltyPointsRow, error)
+	// only orders that went to the kitchen right away, the time they took is all preparation
+	//
+	//  SELECT orders.created, orders.prep_minutes::INTEGER AS prep_minutes, MIN(order_status_history.created)::TIMESTAMP AS done
+	//  FROM orders
+	//         JOIN order_status_history ON order_status_history.order_id = orders.id
+	//  WHERE orders.created >= $1::TIMESTAMP
+	//    AND orders.prep_minutes IS NOT NULL
+	//    AND orders.queue_minutes = 0
+	//    AND order_status_history.status IN ('ready', 'closed')
+	//  GROUP BY orders.id
+	GetOrderPrepTimings(ctx context.Context, since time.Time) ([]GetOrderPrepTimingsRow, error)
 	//GetParams
 	//
-	//  SELECT id, header_text, header_deadline
+	//  SELECT id, header_text, header_deadline, ordering_paused, slot_minutes, slot_max_orders, slot_max_items, closed_until, closed_reason, max_lines, max_line_quantity, min_order_total, max_order_total, loyalty_earn_percent, loyalty_max_redeem_percent, loyalty_expire_days
 	//  FROM params
 	//  WHERE id = 1
 	GetParams(ctx context.Context) (Param, error)
+	// points are paid with the order discount line, they are kept out of discount_usages
+	//
+	//  SELECT COUNT(*)::INTEGER                             AS orders,
+	//         COALESCE(SUM(discount.amount), 0)::BIGINT    AS amount,
+	//         COALESCE(SUM(discount.points), 0)::INTEGER   AS points
+	//  FROM orders,
+	//       LATERAL (SELECT SUM((line ->> 'amount')::BIGINT) AS amount,
+	//                       SUM((line ->> 'points')::INTEGER) AS points
+	//                FROM jsonb_array_elements(orders.discounts) AS line
+	//                WHERE line ->> 'points' IS NOT NULL) AS discount
+	//  WHERE orders.created >= $1::TIMESTAMP
+	//    AND orders.created < $2::TIMESTAMP
+	//    AND orders.status <> 'cancelled'
+	//    AND discount.points IS NOT NULL
+	GetPointsReport(ctx context.Context, arg GetPointsReportParams) (GetPointsReportRow, error)
 	//GetProductByID
 	//
-	//  SELECT id, group_id, index, title, description, price, available, created, updated
+	//  SELECT id, group_id, index, title, description, price, available, created, updated, prep_minutes, max_quantity, stock, low_stock, auto_stopped
 	//  FROM products
 	//  WHERE id = $1
 	GetProductByID(ctx context.Context, id uuid.UUID) (Product, error)
 	//GetProductGroupByID
 	//
-	//  SELECT id, menu_id, index, title, created, updated
+	//  SELECT id, menu_id, index, title, created, updated, prep_minutes, station_id
 	//  FROM product_groups
 	//  WHERE id = $1
 	GetProductGroupByID(ctx context.Context, id uuid.UUID) (ProductGroup, error)
+	//GetProductPrepTimes
+	//
+	//  SELECT products.id, COALESCE(products.prep_minutes, product_groups.prep_minutes, 0)::INTEGER AS prep_minutes
+	//  FROM products
+	//         JOIN product_groups ON product_groups.id = products.group_id
+	GetProductPrepTimes(ctx context.Context) ([]GetProductPrepTimesRow, error)
 	//GetProductsByGroup
 	//
-	//  SELECT id, group_id, index, title, description, price, available, created, updated
+	//  SELECT id, group_id, index, title, description, price, available, created, updated, prep_minutes, max_quantity, stock, low_stock, auto_stopped
 	//  FROM products
 	//  WHERE group_id = $1
 	//  ORDER BY index
 	GetProductsByGroup(ctx context.Context, groupID uuid.UUID) ([]Product, error)
+	//GetPromoCodeByCode
+	//
+	//  SELECT id, code, kind, value, min_order_total, max_uses, max_uses_per_customer, starts, ends, active, created, updated
+	//  FROM promo_codes
+	//  WHERE code = $1
+	GetPromoCodeByCode(ctx context.Context, code string) (PromoCode, error)
+	//GetPromoCodeByID
+	//
+	//  SELECT id, code, kind, value, min_order_total, max_uses, max_uses_per_customer, starts, ends, active, created, updated
+	//  FROM promo_codes
+	//  WHERE id = $1
+	GetPromoCodeByID(ctx context.Context, id uuid.UUID) (PromoCode, error)
+	//GetPromoCodes
+	//
+	//  SELECT promo_codes.id, promo_codes.code, promo_codes.kind, promo_codes.value, promo_codes.min_order_total, promo_codes.max_uses, promo_codes.max_uses_per_customer, promo_codes.starts, promo_codes.ends, promo_codes.active, promo_codes.created, promo_codes.updated,
+	//         (SELECT COUNT(*)
+	//          FROM discount_usages
+	//          WHERE discount_usages.promo_code_id = promo_codes.id
+	//            AND NOT discount_usages.voided)::INTEGER AS uses
+	//  FROM promo_codes
+	//  ORDER BY created DESC
+	GetPromoCodes(ctx context.Context) ([]GetPromoCodesRow, error)
+	//GetRecipes
+	//
+	//  SELECT product_id, ingredient_id, quantity
+	//  FROM product_ingredients
+	//  ORDER BY product_id, ingredient_id
+	GetRecipes(ctx context.Context) ([]ProductIngredient, error)
+	//GetRecipesByProducts
+	//
+	//  SELECT product_id, ingredient_id, quantity
+	//  FROM product_ingredients
+	//  WHERE product_id = ANY ($1::UUID[])
+	GetRecipesByProducts(ctx context.Context, productIds []uuid.UUID) ([]ProductIngredient, error)
+	//GetSalesReport
+	//
+	//  WITH totals AS (SELECT (orders.created AT TIME ZONE 'UTC') AT TIME ZONE $2::TEXT AS local_created,
+	//                         (SELECT COALESCE(SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT), 0)
+	//                          FROM jsonb_array_elements(orders.items) AS item) -
+	//                         (SELECT COALESCE(SUM((discount ->> 'amount')::BIGINT), 0)
+	//                          FROM jsonb_array_elements(orders.discounts) AS discount) AS total
+	//                  FROM orders
+	//                  WHERE orders.created >= $3::TIMESTAMP
+	//                    AND orders.created < $4::TIMESTAMP
+	//                    AND orders.status <> 'cancelled')
+	//  SELECT date_trunc($1::TEXT, local_created)::TIMESTAMP AS period,
+	//         COUNT(*)::INTEGER                                      AS orders,
+	//         COALESCE(SUM(total), 0)::BIGINT                        AS revenue
+	//  FROM totals
+	//  GROUP BY period
+	//  ORDER BY period
+	GetSalesReport(ctx context.Context, arg GetSalesReportParams) ([]GetSalesReportRow, error)
+	//GetSlotLoad
+	//
+	//  SELECT orders.pickup_at::TIMESTAMP AS pickup_at,
+	//         COUNT(*)::INTEGER           AS orders,
+	//         COALESCE(SUM((SELECT SUM((item ->> 'amount')::INTEGER)
+	//                       FROM jsonb_array_elements(orders.items) AS item)), 0)::INTEGER AS items
+	//  FROM orders
+	//  WHERE orders.pickup_at >= $1::TIMESTAMP
+	//    AND orders.pickup_at < $2::TIMESTAMP
+	//    AND orders.status <> 'cancelled'
+	//  GROUP BY orders.pickup_at
+	GetSlotLoad(ctx context.Context, arg GetSlotLoadParams) ([]GetSlotLoadRow, error)
+	//GetStatusDurationReport
+	//
+	//  WITH durations AS (SELECT order_status_history.status,
+	//                            EXTRACT(EPOCH FROM LEAD(order_status_history.created)
+	//                                               OVER (PARTITION BY order_status_history.order_id ORDER BY order_status_history.created, order_status_history.id) -
+	//                                               order_status_history.created) AS seconds
+	//                     FROM order_status_history
+	//                            JOIN orders ON orders.id = order_status_history.order_id
+	//                     WHERE orders.created >= $1::TIMESTAMP
+	//                       AND orders.created < $2::TIMESTAMP)
+	//  SELECT status::TEXT                                                             AS status,
+	//         COUNT(*)::INTEGER                                                        AS transitions,
+	//         (percentile_cont(0.5) WITHIN GROUP (ORDER BY seconds))::DOUBLE PRECISION  AS p50,
+	//         (percentile_cont(0.9) WITHIN GROUP (ORDER BY seconds))::DOUBLE PRECISION  AS p90,
+	//         (percentile_cont(0.95) WITHIN GROUP (ORDER BY seconds))::DOUBLE PRECISION AS p95
+	//  FROM durations
+	//  WHERE seconds IS NOT NULL
+	//  GROUP BY status
+	//  ORDER BY status
+	GetStatusDurationReport(ctx context.Context, arg GetStatusDurationReportParams) ([]GetStatusDurationReportRow, error)
+	//GetTopGroupsReport
+	//
+	//  SELECT product_groups.id                                                      AS group_id,
+	//         product_groups.title,
+	//         SUM((item ->> 'amount')::BIGINT)::BIGINT                               AS quantity,
+	//         SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT)::BIGINT AS revenue
+	//  FROM orders
+	//         CROSS JOIN LATERAL jsonb_array_elements(orders.items) AS item
+	//         JOIN products ON products.id = (item ->> 'id')::UUID
+	//         JOIN product_groups ON product_groups.id = products.group_id
+	//  WHERE orders.created >= $1::TIMESTAMP
+	//    AND orders.created < $2::TIMESTAMP
+	//    AND orders.status <> 'cancelled'
+	//  GROUP BY product_groups.id, product_groups.title
+	//  ORDER BY revenue DESC, quantity DESC
+	//  LIMIT $3
+	GetTopGroupsReport(ctx context.Context, arg GetTopGroupsReportParams) ([]GetTopGroupsReportRow, error)
+	//GetTopProductsReport
+	//
+	//  SELECT (item ->> 'id')::UUID                                                  AS product_id,
+	//         MAX(item ->> 'title')::TEXT                                            AS title,
+	//         SUM((item ->> 'amount')::BIGINT)::BIGINT                               AS quantity,
+	//         SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT)::BIGINT AS revenue
+	//  FROM orders
+	//         CROSS JOIN LATERAL jsonb_array_elements(orders.items) AS item
+	//  WHERE orders.created >= $1::TIMESTAMP
+	//    AND orders.created < $2::TIMESTAMP
+	//    AND orders.status <> 'cancelled'
+	//  GROUP BY product_id
+	//  ORDER BY revenue DESC, quantity DESC
+	//  LIMIT $3
+	GetTopProductsReport(ctx context.Context, arg GetTopProductsReportParams) ([]GetTopProductsReportRow, error)
+	//GetUndeliveredZReportDays
+	//
+	//  SELECT day
+	//  FROM z_reports
+	//  WHERE day >= $1::DATE
+	//    AND EXISTS (SELECT 1
+	//                FROM UNNEST($2::TEXT[]) AS target (channel)
+	//                WHERE NOT EXISTS (SELECT 1
+	//                                  FROM z_report_deliveries
+	//                                  WHERE z_report_deliveries.day = z_reports.day
+	//                                    AND z_report_deliveries.channel = target.channel))
+	//  ORDER BY day
+	GetUndeliveredZReportDays(ctx context.Context, arg GetUndeliveredZReportDaysParams) ([]time.Time, error)
+	//GetZReport
+	//
+	//  SELECT day, starts, ends, summary, created
+	//  FROM z_reports
+	//  WHERE day = $1
+	GetZReport(ctx context.Context, day time.Time) (ZReport, error)
+	//GetZReportDeliveredChannels
+	//
+	//  SELECT channel
+	//  FROM z_report_deliveries
+	//  WHERE day = $1
+	GetZReportDeliveredChannels(ctx context.Context, day time.Time) ([]string, error)
+	//GetZReportStatuses
+	//
+	//  SELECT orders.status,
+	//         COUNT(*)::INTEGER                                                       AS orders,
+	//         COALESCE(SUM((SELECT SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT)
+	//                       FROM jsonb_array_elements(orders.items) AS item)), 0)::BIGINT AS gross,
+	//         COALESCE(SUM((SELECT SUM((discount ->> 'amount')::BIGINT)
+	//                       FROM jsonb_array_elements(orders.discounts) AS discount
+	//                       WHERE discount ->> 'points' IS NULL)), 0)::BIGINT AS discount,
+	//         COALESCE(SUM((SELECT SUM((discount ->> 'amount')::BIGINT)
+	//                       FROM jsonb_array_elements(orders.discounts) AS discount
+	//                       WHERE discount ->> 'points' IS NOT NULL)), 0)::BIGINT AS points
+	//  FROM orders
+	//  WHERE orders.created >= $1::TIMESTAMP
+	//    AND orders.created < $2::TIMESTAMP
+	//  GROUP BY orders.status
+	//  ORDER BY orders.status
+	GetZReportStatuses(ctx context.Context, arg GetZReportStatusesParams) ([]GetZReportStatusesRow, error)
+	//GetZReports
+	//
+	//  SELECT day, starts, ends, summary, created
+	//  FROM z_reports
+	//  ORDER BY day DESC
+	//  LIMIT $1
+	GetZReports(ctx context.Context, limit int32) ([]ZReport, error)
+	//LockMigrations
+	//
+	//  SELECT pg_advisory_lock(hashtext('migrations'))
+	LockMigrations(ctx context.Context) error
+	//LockPromoCode
+	//
+	//  SELECT id
+	//  FROM promo_codes
+	//  WHERE id = $1
+	//    FOR UPDATE
+	LockPromoCode(ctx context.Context, id uuid.UUID) error
+	//LockSlots
+	//
+	//  SELECT pg_advisory_xact_lock(hashtext('order_slots'))
+	LockSlots(ctx context.Context) error
+	//ReleaseZReport
+	//
+	//  DELETE
+	//  FROM z_report_claims
+	//  WHERE day = $1
+	ReleaseZReport(ctx context.Context, day time.Time) error
+	//RestoreIngredientStock
+	//
+	//  UPDATE ingredients
+	//  SET stock   = stock + $1::INTEGER,
+	//      updated = CURRENT_TIMESTAMP
+	//  WHERE id = $2
+	RestoreIngredientStock(ctx context.Context, arg RestoreIngredientStockParams) error
+	//RestoreProductStock
+	//
+	//  UPDATE products
+	//  SET stock   = stock + $1::INTEGER,
+	//      updated = CURRENT_TIMESTAMP
+	//  WHERE id = $2
+	//    AND stock IS NOT NULL
+	RestoreProductStock(ctx context.Context, arg RestoreProductStockParams) error
+	//ResumeRestockedProducts
+	//
+	//  UPDATE products
+	//  SET available    = true,
+	//      auto_stopped = false,
+	//      updated      = CURRENT_TIMESTAMP
+	//  WHERE auto_stopped
+	//    AND (stock IS NULL OR stock > 0)
+	//    AND NOT EXISTS (SELECT 1
+	//                    FROM product_ingredients
+	//                           JOIN ingredients ON ingredients.id = product_ingredients.ingredient_id
+	//                    WHERE product_ingredients.product_id = products.id
+	//                      AND ingredients.stock < product_ingredients.quantity)
+	//  RETURNING title
+	ResumeRestockedProducts(ctx context.Context) ([]string, error)
+	// SearchOrders* differ in the sort only. Each has a literal ORDER BY and a cursor condition
+	// on the same columns, so the matching index is walked from the cursor. The first page
+	// starts from a cursor before every row
+	//
+	//  SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+	//  FROM orders
+	//  WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+	//    AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+	//    AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+	//    AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+	//    AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+	//    AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+	//         client_comment ILIKE $6::TEXT)
+	//    AND ($7::TEXT IS NULL OR
+	//         items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+	//         items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+	//           jsonb_build_object('id', $7::TEXT)))))
+	//    AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+	//    AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+	//    AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+	//    AND index < $11::BIGINT
+	//  ORDER BY index DESC
+	//  LIMIT $12
+	SearchOrdersNewest(ctx context.Context, arg SearchOrdersNewestParams) ([]Order, error)
+	//SearchOrdersOldest
+	//
+	//  SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+	//  FROM orders
+	//  WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+	//    AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+	//    AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+	//    AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+	//    AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+	//    AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+	//         client_comment ILIKE $6::TEXT)
+	//    AND ($7::TEXT IS NULL OR
+	//         items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+	//         items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+	//           jsonb_build_object('id', $7::TEXT)))))
+	//    AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+	//    AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+	//    AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+	//    AND index > $11::BIGINT
+	//  ORDER BY index
+	//  LIMIT $12
+	SearchOrdersOldest(ctx context.Context, arg SearchOrdersOldestParams) ([]Order, error)
+	//SearchOrdersTotalAsc
+	//
+	//  SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+	//  FROM orders
+	//  WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+	//    AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+	//    AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+	//    AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+	//    AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+	//    AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+	//         client_comment ILIKE $6::TEXT)
+	//    AND ($7::TEXT IS NULL OR
+	//         items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+	//         items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+	//           jsonb_build_object('id', $7::TEXT)))))
+	//    AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+	//    AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+	//    AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+	//    AND (total, index) > ($11::BIGINT, $12::BIGINT)
+	//  ORDER BY total, index
+	//  LIMIT $13
+	SearchOrdersTotalAsc(ctx context.Context, arg SearchOrdersTotalAscParams) ([]Order, error)
+	//SearchOrdersTotalDesc
+	//
+	//  SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+	//  FROM orders
+	//  WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+	//    AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+	//    AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+	//    AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+	//    AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+	//    AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+	//         client_comment ILIKE $6::TEXT)
+	//    AND ($7::TEXT IS NULL OR
+	//         items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+	//         items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+	//           jsonb_build_object('id', $7::TEXT)))))
+	//    AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+	//    AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+	//    AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+	//    AND (total, index) < ($11::BIGINT, $12::BIGINT)
+	//  ORDER BY total DESC, index DESC
+	//  LIMIT $13
+	SearchOrdersTotalDesc(ctx context.Context, arg SearchOrdersTotalDescParams) ([]Order, error)
 	//SearchProducts
 	//
-	//  SELECT id, group_id, index, title, description, price, available, created, updated
+	//  SELECT id, group_id, index, title, description, price, available, created, updated, prep_minutes, max_quantity, stock, low_stock, auto_stopped
 	//  FROM products
 	//  WHERE (title ILIKE '%' || $1 || '%' OR description ILIKE '%' || $1 || '%')
 	//    AND available = true
 	//  ORDER BY title
 	SearchProducts(ctx context.Context, dollar_1 *string) ([]Product, error)
+	//SetDiscountUsagesVoided
+	//
+	//  UPDATE discount_usages
+	//  SET voided = $1
+	//  WHERE order_id = $2
+	SetDiscountUsagesVoided(ctx context.Context, arg SetDiscountUsagesVoidedParams) error
+	// the header banner is a public info announcement
+	//
+	//  INSERT INTO announcements (id, text, ends)
+	//  VALUES ($1, $2, $3)
+	//  ON CONFLICT (id) DO UPDATE SET text    = excluded.text,
+	//                                 ends    = excluded.ends,
+	//                                 updated = CURRENT_TIMESTAMP
+	SetHeaderAnnouncement(ctx context.Context, arg SetHeaderAnnouncementParams) error
+	//SetMigrationChecksum
+	//
+	//  UPDATE migration
+	//  SET checksum = $2
+	//  WHERE id = $1
+	SetMigrationChecksum(ctx context.Context, arg SetMigrationChecksumParams) error
+	//SetOrderEta
+	//
+	//  UPDATE orders
+	//  SET eta_minutes   = $2,
+	//      ready_at      = $3,
+	//      prep_minutes  = $4,
+	//      queue_minutes = $5
+	//  WHERE id = $1
+	SetOrderEta(ctx context.Context, arg SetOrderEtaParams) error
+	//SetOrderReadyAt
+	//
+	//  UPDATE orders
+	//  SET ready_at = $2
+	//  WHERE id = $1
+	SetOrderReadyAt(ctx context.Context, arg SetOrderReadyAtParams) error
 	//SetOrderSeen
 	//
 	//  UPDATE orders
@@ -134,20 +1029,153 @@ type Querier interface {
 	//      updated = CURRENT_TIMESTAMP
 	//  WHERE id = $1
 	SetOrderSeen(ctx context.Context, id uuid.UUID) error
-	//SetParamsHeader
+	//SetParamsCapacity
+	//
+	//  UPDATE params
+	//  SET slot_minutes    = $1,
+	//      slot_max_orders = $2,
+	//      slot_max_items  = $3
+	//  WHERE id = 1
+	SetParamsCapacity(ctx context.Context, arg SetParamsCapacityParams) error
+	//SetParamsClosedUntil
+	//
+	//  UPDATE params
+	//  SET closed_until  = $1,
+	//      closed_reason = $2
+	//  WHERE id = 1
+	SetParamsClosedUntil(ctx context.Context, arg SetParamsClosedUntilParams) error
+	//SetParamsLoyaltyPolicy
+	//
+	//  UPDATE params
+	//  SET loyalty_earn_percent       = $1,
+	//      loyalty_max_redeem_percent = $2,
+	//      loyalty_expire_days        = $3
+	//  WHERE id = 1
+	SetParamsLoyaltyPolicy(ctx context.Context, arg SetParamsLoyaltyPolicyParams) error
+	//SetParamsOrderPolicy
 	//
 	//  UPDATE params
-	//  SET header_text = $1,
-	//      header_deadline = $2
+	//  SET max_lines         = $1,
+	//      max_line_quantity = $2,
+	//      min_order_total   = $3,
+	//      max_order_total   = $4
 	//  WHERE id = 1
-	SetParamsHeader(ctx context.Context, arg SetParamsHeaderParams) error
+	SetParamsOrderPolicy(ctx context.Context, arg SetParamsOrderPolicyParams) error
+	//SetParamsOrderingPaused
+	//
+	//  UPDATE params
+	//  SET ordering_paused = $1
+	//  WHERE id = 1
+	SetParamsOrderingPaused(ctx context.Context, orderingPaused bool) error
 	//SetProductAvailability
 	//
 	//  UPDATE products
-	//  SET available = $2,
-	//      updated   = CURRENT_TIMESTAMP
+	//  SET available    = $2,
+	//      auto_stopped = false,
+	//      updated      = CURRENT_TIMESTAMP
 	//  WHERE id = $1
 	SetProductAvailability(ctx context.Context, arg SetProductAvailabilityParams) error
+	//SetProductGroupStation
+	//
+	//  UPDATE product_groups
+	//  SET station_id = $2,
+	//      updated    = CURRENT_TIMESTAMP
+	//  WHERE id = $1
+	SetProductGroupStation(ctx context.Context, arg SetProductGroupStationParams) error
+	//SetProductStock
+	//
+	//  UPDATE products
+	//  SET stock     = $2,
+	//      low_stock = $3,
+	//      updated   = CURRENT_TIMESTAMP
+	//  WHERE id = $1
+	SetProductStock(ctx context.Context, arg SetProductStockParams) (int64, error)
+	//StartJobRun
+	//
+	//  INSERT INTO jobs (name, last_started, last_instance)
+	//  VALUES ($1, $2, $3)
+	//  ON CONFLICT (name) DO UPDATE SET last_started  = EXCLUDED.last_started,
+	//                                   last_instance = EXCLUDED.last_instance
+	StartJobRun(ctx context.Context, arg StartJobRunParams) error
+	//StopOutOfStockProducts
+	//
+	//  UPDATE products
+	//  SET available    = false,
+	//      auto_stopped = true,
+	//      updated      = CURRENT_TIMESTAMP
+	//  WHERE available
+	//    AND (stock = 0 OR EXISTS (SELECT 1
+	//                              FROM product_ingredients
+	//                                     JOIN ingredients ON ingredients.id = product_ingredients.ingredient_id
+	//                              WHERE product_ingredients.product_id = products.id
+	//                                AND ingredients.stock < product_ingredients.quantity))
+	//  RETURNING title
+	StopOutOfStockProducts(ctx context.Context) ([]string, error)
+	//TryJobLock
+	//
+	//  SELECT pg_try_advisory_lock(hashtext('job:' || $1::TEXT))::BOOLEAN AS locked
+	TryJobLock(ctx context.Context, name string) (bool, error)
+	//UnlockJob
+	//
+	//  SELECT pg_advisory_unlock(hashtext('job:' || $1::TEXT))
+	UnlockJob(ctx context.Context, name string) error
+	//UnlockMigrations
+	//
+	//  SELECT pg_advisory_unlock(hashtext('migrations'))
+	UnlockMigrations(ctx context.Context) error
+	//UpdateAdminPassword
+	//
+	//  UPDATE admins
+	//  SET password_hash = $2,
+	//      updated       = CURRENT_TIMESTAMP
+	//  WHERE username = $1
+	UpdateAdminPassword(ctx context.Context, arg UpdateAdminPasswordParams) (int64, error)
+	//UpdateAnnouncement
+	//
+	//  UPDATE announcements
+	//  SET text     = $2,
+	//      starts   = $3,
+	//      ends     = $4,
+	//      priority = $5,
+	//      severity = $6,
+	//      target   = $7,
+	//      menu_id  = $8,
+	//      updated  = CURRENT_TIMESTAMP
+	//  WHERE id = $1
+	UpdateAnnouncement(ctx context.Context, arg UpdateAnnouncementParams) (int64, error)
+	//UpdateCustomerName
+	//
+	//  UPDATE customers
+	//  SET name    = $2,
+	//      updated = CURRENT_TIMESTAMP
+	//  WHERE id = $1
+	//  RETURNING id, phone, name, created, updated
+	UpdateCustomerName(ctx context.Context, arg UpdateCustomerNameParams) (Customer, error)
+	//UpdateDiscountRule
+	//
+	//  UPDATE discount_rules
+	//  SET title       = $2,
+	//      kind        = $3,
+	//      value       = $4,
+	//      group_id    = $5,
+	//      from_minute = $6,
+	//      to_minute   = $7,
+	//      starts      = $8,
+	//      ends        = $9,
+	//      active      = $10,
+	//      updated     = CURRENT_TIMESTAMP
+	//  WHERE id = $1
+	UpdateDiscountRule(ctx context.Context, arg UpdateDiscountRuleParams) (int64, error)
+	//UpdateIngredient
+	//
+	//  UPDATE ingredients
+	//  SET title     = $2,
+	//      unit      = $3,
+	//      stock     = $4,
+	//      low_stock = $5,
+	//      updated   = CURRENT_TIMESTAMP
+	//  WHERE id = $1
+	UpdateIngredient(ctx context.Context, arg UpdateIngredientParams) (int64, error)
 	//UpdateOrderStatus
 	//
 	//  UPDATE orders
@@ -158,18 +1186,22 @@ type Querier interface {
 	//UpdateProduct
 	//
 	//  UPDATE products
-	//  SET title       = $2,
-	//      description = $3,
-	//      price       = $4,
-	//      available   = $5,
-	//      updated     = CURRENT_TIMESTAMP
+	//  SET title        = $2,
+	//      description  = $3,
+	//      price        = $4,
+	//      available    = $5,
+	//      prep_minutes = $6,
+	//      max_quantity = $7,
+	//      auto_stopped = false,
+	//      updated      = CURRENT_TIMESTAMP
 	//  WHERE id = $1
 	UpdateProduct(ctx context.Context, arg UpdateProductParams) error
 	//UpdateProductGroup
 	//
 	//  UPDATE product_groups
-	//  SET title   = $2,
-	//      updated = CURRENT_TIMESTAMP
+	//  SET title        = $2,
+	//      prep_minutes = $3,
+	//      updated      = CURRENT_TIMESTAMP
 	//  WHERE id = $1
 	UpdateProductGroup(ctx context.Context, arg UpdateProductGroupParams) error
 	//UpdateProductGroupIndex
@@ -186,6 +1218,92 @@ type Querier interface {
 	//      updated = CURRENT_TIMESTAMP
 	//  WHERE id = $1
 	UpdateProductIndex(ctx context.Context, arg UpdateProductIndexParams) error
+	//UpdatePromoCode
+	//
+	//  UPDATE promo_codes
+	//  SET code                  = $2,
+	//      kind                  = $3,
+	//      value                 = $4,
+	//      min_order_total       = $5,
+	//      max_uses              = $6,
+	//      max_uses_per_customer = $7,
+	//      starts                = $8,
+	//      ends                  = $9,
+	//      active                = $10,
+	//      updated               = CURRENT_TIMESTAMP
+	//  WHERE id = $1
+	UpdatePromoCode(ctx context.Context, arg UpdatePromoCodeParams) (int64, error)
+	//UpsertCustomerByPhone
+	//
+	//  INSERT INTO customers (id, phone)
+	//  VALUES ($1, $2)
+	//  ON CONFLICT (phone) DO UPDATE SET updated = CURRENT_TIMESTAMP
+	//  RETURNING id, phone, name, created, updated
+	UpsertCustomerByPhone(ctx context.Context, arg UpsertCustomerByPhoneParams) (Customer, error)
+	//UpsertCustomerOTP
+	//
+	//  INSERT INTO customer_otps (phone, code_hash, expires)
+	//  VALUES ($1, $2, $3)
+	//  ON CONFLICT (phone) DO UPDATE SET code_hash = excluded.code_hash,
+	//                                    attempts  = 0,
+	//                                    expires   = excluded.expires,
+	//                                    created   = CURRENT_TIMESTAMP
+	UpsertCustomerOTP(ctx context.Context, arg UpsertCustomerOTPParams) error
+	//UpsertKitchenStation
+	//
+	//  INSERT INTO kitchen_stations (id, title, printer_address)
+	//  VALUES ($1, $2, $3)
+	//  ON CONFLICT (id) DO UPDATE SET title           = excluded.title,
+	//                                 printer_address = excluded.printer_address
+	UpsertKitchenStation(ctx context.Context, arg UpsertKitchenStationParams) error
+	//UpsertMenu
+	//
+	//  INSERT INTO menu (id, title)
+	//  VALUES ($1, $2)
+	//  ON CONFLICT (id) DO UPDATE SET title = excluded.title
+	UpsertMenu(ctx context.Context, arg UpsertMenuParams) error
+	//UpsertOpeningException
+	//
+	//  INSERT INTO opening_exceptions (day, opens, closes, title)
+	//  VALUES ($1, $2, $3, $4)
+	//  ON CONFLICT (day) DO UPDATE SET opens  = EXCLUDED.opens,
+	//                                  closes = EXCLUDED.closes,
+	//                                  title  = EXCLUDED.title
+	UpsertOpeningException(ctx context.Context, arg UpsertOpeningExceptionParams) error
+	//UpsertProduct
+	//
+	//  INSERT INTO products (id, group_id, title, description, price, available, index, prep_minutes, max_quantity)
+	//  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	//  ON CONFLICT (id) DO UPDATE SET group_id     = excluded.group_id,
+	//                                 title        = excluded.title,
+	//                                 description  = excluded.description,
+	//                                 price        = excluded.price,
+	//                                 available    = excluded.available,
+	//                                 index        = excluded.index,
+	//                                 prep_minutes = excluded.prep_minutes,
+	//                                 max_quantity = excluded.max_quantity,
+	//                                 auto_stopped = false,
+	//                                 updated      = CURRENT_TIMESTAMP
+	UpsertProduct(ctx context.Context, arg UpsertProductParams) error
+	//UpsertProductGroup
+	//
+	//  INSERT INTO product_groups (id, menu_id, title, index, prep_minutes)
+	//  VALUES ($1, $2, $3, $4, $5)
+	//  ON CONFLICT (id) DO UPDATE SET menu_id      = excluded.menu_id,
+	//                                 title        = excluded.title,
+	//                                 index        = excluded.index,
+	//                                 prep_minutes = excluded.prep_minutes,
+	//                                 updated      = CURRENT_TIMESTAMP
+	UpsertProductGroup(ctx context.Context, arg UpsertProductGroupParams) error
+	// the attempt is counted before the code is compared, so parallel guesses can't exceed the limit
+	//
+	//  UPDATE customer_otps
+	//  SET attempts = attempts + 1
+	//  WHERE phone = $1
+	//    AND attempts < $2
+	//    AND expires > $3::TIMESTAMP
+	//  RETURNING code_hash
+	UseCustomerOTPAttempt(ctx context.Context, arg UseCustomerOTPAttemptParams) (string, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -1,6 +1,6 @@
 -- name: CreateOrder :one
-INSERT INTO orders (id, table_id, client_name, client_comment, status, seen, items)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO orders (id, table_id, client_name, client_comment, status, seen, items, pickup_at, discounts, customer_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 RETURNING *;
 
 -- name: GetOrderByID :one
@@ -8,15 +8,116 @@ SELECT *
 FROM orders
 WHERE id = $1;
 
--- name: GetOrdersPaginated :many
+-- name: GetOrderByIDForUpdate :one
 SELECT *
 FROM orders
+WHERE id = $1
+  FOR UPDATE;
+
+-- name: SearchOrdersNewest :many
+-- SearchOrders* differ in the sort only. Each has a literal ORDER BY and a cursor condition
+-- on the same columns, so the matching index is walked from the cursor. The first page
+-- starts from a cursor before every row
+SELECT *
+FROM orders
+WHERE (sqlc.narg(statuses)::TEXT[] IS NULL OR status = ANY (sqlc.narg(statuses)::TEXT[]))
+  AND (sqlc.narg(seen)::BOOLEAN IS NULL OR seen = sqlc.narg(seen)::BOOLEAN)
+  AND (sqlc.narg(since)::TIMESTAMP IS NULL OR created >= sqlc.narg(since)::TIMESTAMP)
+  AND (sqlc.narg(until)::TIMESTAMP IS NULL OR created < sqlc.narg(until)::TIMESTAMP)
+  AND (sqlc.narg(table_id)::TEXT IS NULL OR table_id = sqlc.narg(table_id)::TEXT)
+  AND (sqlc.narg(pattern)::TEXT IS NULL OR client_name ILIKE sqlc.narg(pattern)::TEXT OR
+       client_comment ILIKE sqlc.narg(pattern)::TEXT)
+  AND (sqlc.narg(product_id)::TEXT IS NULL OR
+       items @> jsonb_build_array(jsonb_build_object('id', sqlc.narg(product_id)::TEXT)) OR
+       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+         jsonb_build_object('id', sqlc.narg(product_id)::TEXT)))))
+  AND (sqlc.narg(min_total)::BIGINT IS NULL OR total >= sqlc.narg(min_total)::BIGINT)
+  AND (sqlc.narg(max_total)::BIGINT IS NULL OR total <= sqlc.narg(max_total)::BIGINT)
+  AND (sqlc.narg(customer_id)::UUID IS NULL OR customer_id = sqlc.narg(customer_id)::UUID)
+  AND index < @cursor_index::BIGINT
 ORDER BY index DESC
-OFFSET $1 LIMIT $2;
+LIMIT @row_limit;
+
+-- name: SearchOrdersOldest :many
+SELECT *
+FROM orders
+WHERE (sqlc.narg(statuses)::TEXT[] IS NULL OR status = ANY (sqlc.narg(statuses)::TEXT[]))
+  AND (sqlc.narg(seen)::BOOLEAN IS NULL OR seen = sqlc.narg(seen)::BOOLEAN)
+  AND (sqlc.narg(since)::TIMESTAMP IS NULL OR created >= sqlc.narg(since)::TIMESTAMP)
+  AND (sqlc.narg(until)::TIMESTAMP IS NULL OR created < sqlc.narg(until)::TIMESTAMP)
+  AND (sqlc.narg(table_id)::TEXT IS NULL OR table_id = sqlc.narg(table_id)::TEXT)
+  AND (sqlc.narg(pattern)::TEXT IS NULL OR client_name ILIKE sqlc.narg(pattern)::TEXT OR
+       client_comment ILIKE sqlc.narg(pattern)::TEXT)
+  AND (sqlc.narg(product_id)::TEXT IS NULL OR
+       items @> jsonb_build_array(jsonb_build_object('id', sqlc.narg(product_id)::TEXT)) OR
+       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+         jsonb_build_object('id', sqlc.narg(product_id)::TEXT)))))
+  AND (sqlc.narg(min_total)::BIGINT IS NULL OR total >= sqlc.narg(min_total)::BIGINT)
+  AND (sqlc.narg(max_total)::BIGINT IS NULL OR total <= sqlc.narg(max_total)::BIGINT)
+  AND (sqlc.narg(customer_id)::UUID IS NULL OR customer_id = sqlc.narg(customer_id)::UUID)
+  AND index > @cursor_index::BIGINT
+ORDER BY index
+LIMIT @row_limit;
+
+-- name: SearchOrdersTotalDesc :many
+SELECT *
+FROM orders
+WHERE (sqlc.narg(statuses)::TEXT[] IS NULL OR status = ANY (sqlc.narg(statuses)::TEXT[]))
+  AND (sqlc.narg(seen)::BOOLEAN IS NULL OR seen = sqlc.narg(seen)::BOOLEAN)
+  AND (sqlc.narg(since)::TIMESTAMP IS NULL OR created >= sqlc.narg(since)::TIMESTAMP)
+  AND (sqlc.narg(until)::TIMESTAMP IS NULL OR created < sqlc.narg(until)::TIMESTAMP)
+  AND (sqlc.narg(table_id)::TEXT IS NULL OR table_id = sqlc.narg(table_id)::TEXT)
+  AND (sqlc.narg(pattern)::TEXT IS NULL OR client_name ILIKE sqlc.narg(pattern)::TEXT OR
+       client_comment ILIKE sqlc.narg(pattern)::TEXT)
+  AND (sqlc.narg(product_id)::TEXT IS NULL OR
+       items @> jsonb_build_array(jsonb_build_object('id', sqlc.narg(product_id)::TEXT)) OR
+       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+         jsonb_build_object('id', sqlc.narg(product_id)::TEXT)))))
+  AND (sqlc.narg(min_total)::BIGINT IS NULL OR total >= sqlc.narg(min_total)::BIGINT)
+  AND (sqlc.narg(max_total)::BIGINT IS NULL OR total <= sqlc.narg(max_total)::BIGINT)
+  AND (sqlc.narg(customer_id)::UUID IS NULL OR customer_id = sqlc.narg(customer_id)::UUID)
+  AND (total, index) < (@cursor_total::BIGINT, @cursor_index::BIGINT)
+ORDER BY total DESC, index DESC
+LIMIT @row_limit;
 
--- name: CountOrders :one
+-- name: SearchOrdersTotalAsc :many
+SELECT *
+FROM orders
+WHERE (sqlc.narg(statuses)::TEXT[] IS NULL OR status = ANY (sqlc.narg(statuses)::TEXT[]))
+  AND (sqlc.narg(seen)::BOOLEAN IS NULL OR seen = sqlc.narg(seen)::BOOLEAN)
+  AND (sqlc.narg(since)::TIMESTAMP IS NULL OR created >= sqlc.narg(since)::TIMESTAMP)
+  AND (sqlc.narg(until)::TIMESTAMP IS NULL OR created < sqlc.narg(until)::TIMESTAMP)
+  AND (sqlc.narg(table_id)::TEXT IS NULL OR table_id = sqlc.narg(table_id)::TEXT)
+  AND (sqlc.narg(pattern)::TEXT IS NULL OR client_name ILIKE sqlc.narg(pattern)::TEXT OR
+       client_comment ILIKE sqlc.narg(pattern)::TEXT)
+  AND (sqlc.narg(product_id)::TEXT IS NULL OR
+       items @> jsonb_build_array(jsonb_build_object('id', sqlc.narg(product_id)::TEXT)) OR
+       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+         jsonb_build_object('id', sqlc.narg(product_id)::TEXT)))))
+  AND (sqlc.narg(min_total)::BIGINT IS NULL OR total >= sqlc.narg(min_total)::BIGINT)
+  AND (sqlc.narg(max_total)::BIGINT IS NULL OR total <= sqlc.narg(max_total)::BIGINT)
+  AND (sqlc.narg(customer_id)::UUID IS NULL OR customer_id = sqlc.narg(customer_id)::UUID)
+  AND (total, index) > (@cursor_total::BIGINT, @cursor_index::BIGINT)
+ORDER BY total, index
+LIMIT @row_limit;
+
+-- name: CountSearchOrders :one
 SELECT COUNT(*)
-FROM orders;
+FROM orders
+WHERE (sqlc.narg(statuses)::TEXT[] IS NULL OR status = ANY (sqlc.narg(statuses)::TEXT[]))
+  AND (sqlc.narg(seen)::BOOLEAN IS NULL OR seen = sqlc.narg(seen)::BOOLEAN)
+  AND (sqlc.narg(since)::TIMESTAMP IS NULL OR created >= sqlc.narg(since)::TIMESTAMP)
+  AND (sqlc.narg(until)::TIMESTAMP IS NULL OR created < sqlc.narg(until)::TIMESTAMP)
+  AND (sqlc.narg(table_id)::TEXT IS NULL OR table_id = sqlc.narg(table_id)::TEXT)
+  AND (sqlc.narg(pattern)::TEXT IS NULL OR client_name ILIKE sqlc.narg(pattern)::TEXT OR
+       client_comment ILIKE sqlc.narg(pattern)::TEXT)
+  AND (sqlc.narg(product_id)::TEXT IS NULL OR
+       items @> jsonb_build_array(jsonb_build_object('id', sqlc.narg(product_id)::TEXT)) OR
+       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+         jsonb_build_object('id', sqlc.narg(product_id)::TEXT)))))
+  AND (sqlc.narg(min_total)::BIGINT IS NULL OR total >= sqlc.narg(min_total)::BIGINT)
+  AND (sqlc.narg(max_total)::BIGINT IS NULL OR total <= sqlc.narg(max_total)::BIGINT)
+  AND (sqlc.narg(customer_id)::UUID IS NULL OR customer_id = sqlc.narg(customer_id)::UUID);
 
 -- name: UpdateOrderStatus :exec
 UPDATE orders
@@ -30,6 +131,40 @@ SET seen    = true,
     updated = CURRENT_TIMESTAMP
 WHERE id = $1;
 
+-- name: CreateOrderStatusHistory :exec
+INSERT INTO order_status_history (order_id, status)
+VALUES ($1, $2);
+
+-- name: GetOpenOrders :many
+SELECT *
+FROM orders
+WHERE status = 'open'
+ORDER BY index;
+
+-- name: SetOrderReadyAt :exec
+UPDATE orders
+SET ready_at = $2
+WHERE id = $1;
+
+-- name: SetOrderEta :exec
+UPDATE orders
+SET eta_minutes   = $2,
+    ready_at      = $3,
+    prep_minutes  = $4,
+    queue_minutes = $5
+WHERE id = $1;
+
+-- name: GetOrderPrepTimings :many
+-- only orders that went to the kitchen right away, the time they took is all preparation
+SELECT orders.created, orders.prep_minutes::INTEGER AS prep_minutes, MIN(order_status_history.created)::TIMESTAMP AS done
+FROM orders
+       JOIN order_status_history ON order_status_history.order_id = orders.id
+WHERE orders.created >= @since::TIMESTAMP
+  AND orders.prep_minutes IS NOT NULL
+  AND orders.queue_minutes = 0
+  AND order_status_history.status IN ('ready', 'closed')
+GROUP BY orders.id;
+
 -- name: DeleteOrder :exec
 DELETE
 FROM orders
@@ -57,8 +192,9 @@ ORDER BY index;
 
 -- name: UpdateProductGroup :exec
 UPDATE product_groups
-SET title   = $2,
-    updated = CURRENT_TIMESTAMP
+SET title        = $2,
+    prep_minutes = $3,
+    updated      = CURRENT_TIMESTAMP
 WHERE id = $1;
 
 -- name: UpdateProductGroupIndex :exec
@@ -73,8 +209,8 @@ FROM product_groups
 WHERE id = $1;
 
 -- name: CreateProduct :exec
-INSERT INTO products (id, group_id, title, description, price, index)
-VALUES (@id, @group_id::UUID, @title, @description, @price,
+INSERT INTO products (id, group_id, title, description, price, prep_minutes, max_quantity, index)
+VALUES (@id, @group_id::UUID, @title, @description, @price, @prep_minutes, @max_quantity,
         (SELECT COALESCE(MAX(index), 0) + 1 FROM products WHERE group_id = @group_id::UUID) );
 
 -- name: GetProductByID :one
@@ -95,13 +231,21 @@ ORDER BY available DESC, index, group_id;
 
 -- name: UpdateProduct :exec
 UPDATE products
-SET title       = $2,
-    description = $3,
-    price       = $4,
-    available   = $5,
-    updated     = CURRENT_TIMESTAMP
+SET title        = $2,
+    description  = $3,
+    price        = $4,
+    available    = $5,
+    prep_minutes = $6,
+    max_quantity = $7,
+    auto_stopped = false,
+    updated      = CURRENT_TIMESTAMP
 WHERE id = $1;
 
+-- name: GetProductPrepTimes :many
+SELECT products.id, COALESCE(products.prep_minutes, product_groups.prep_minutes, 0)::INTEGER AS prep_minutes
+FROM products
+       JOIN product_groups ON product_groups.id = products.group_id;
+
 -- name: UpdateProductIndex :exec
 UPDATE products
 SET index   = $2,
@@ -115,8 +259,9 @@ WHERE id = $1;
 
 -- name: SetProductAvailability :exec
 UPDATE products
-SET available = $2,
-    updated   = CURRENT_TIMESTAMP
+SET available    = $2,
+    auto_stopped = false,
+    updated      = CURRENT_TIMESTAMP
 WHERE id = $1;
 
 -- name: SearchProducts :many
@@ -126,22 +271,868 @@ WHERE (title ILIKE '%' || $1 || '%' OR description ILIKE '%' || $1 || '%')
   AND available = true
 ORDER BY title;
 
+-- name: SetProductGroupStation :exec
+UPDATE product_groups
+SET station_id = $2,
+    updated    = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: GetKitchenStations :many
+SELECT *
+FROM kitchen_stations
+ORDER BY created;
+
+-- name: GetKitchenStationByID :one
+SELECT *
+FROM kitchen_stations
+WHERE id = $1;
+
+-- name: UpsertKitchenStation :exec
+INSERT INTO kitchen_stations (id, title, printer_address)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO UPDATE SET title           = excluded.title,
+                               printer_address = excluded.printer_address;
+
+-- name: DeleteKitchenStation :exec
+DELETE
+FROM kitchen_stations
+WHERE id = $1;
+
+-- name: CreateKitchenTicket :exec
+INSERT INTO kitchen_tickets (id, order_id, station_id, product_id, title, amount)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetKitchenQueue :many
+SELECT sqlc.embed(kitchen_tickets), orders.index AS order_index, orders.client_name, orders.client_comment
+FROM kitchen_tickets
+       JOIN orders ON orders.id = kitchen_tickets.order_id
+WHERE kitchen_tickets.station_id = $1
+  AND NOT kitchen_tickets.done
+  AND orders.status = 'open'
+ORDER BY kitchen_tickets.created, kitchen_tickets.title;
+
+-- name: GetKitchenTicketsByOrder :many
+SELECT *
+FROM kitchen_tickets
+WHERE order_id = $1
+ORDER BY station_id, created, title;
+
+-- name: GetKitchenTicketOrderID :one
+SELECT order_id
+FROM kitchen_tickets
+WHERE id = $1;
+
+-- name: BumpKitchenTicket :one
+UPDATE kitchen_tickets
+SET done   = true,
+    bumped = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: CountPendingKitchenTickets :one
+SELECT COUNT(*)
+FROM kitchen_tickets
+WHERE order_id = $1
+  AND NOT done;
+
 -- name: GetParams :one
 SELECT *
 FROM params
 WHERE id = 1;
 
--- name: SetParamsHeader :exec
+-- name: SetParamsOrderingPaused :exec
+UPDATE params
+SET ordering_paused = $1
+WHERE id = 1;
+
+-- name: SetParamsCapacity :exec
+UPDATE params
+SET slot_minutes    = $1,
+    slot_max_orders = $2,
+    slot_max_items  = $3
+WHERE id = 1;
+
+-- name: SetParamsOrderPolicy :exec
+UPDATE params
+SET max_lines         = $1,
+    max_line_quantity = $2,
+    min_order_total   = $3,
+    max_order_total   = $4
+WHERE id = 1;
+
+-- name: LockSlots :exec
+SELECT pg_advisory_xact_lock(hashtext('order_slots'));
+
+-- name: GetSlotLoad :many
+SELECT orders.pickup_at::TIMESTAMP AS pickup_at,
+       COUNT(*)::INTEGER           AS orders,
+       COALESCE(SUM((SELECT SUM((item ->> 'amount')::INTEGER)
+                     FROM jsonb_array_elements(orders.items) AS item)), 0)::INTEGER AS items
+FROM orders
+WHERE orders.pickup_at >= @since::TIMESTAMP
+  AND orders.pickup_at < @until::TIMESTAMP
+  AND orders.status <> 'cancelled'
+GROUP BY orders.pickup_at;
+
+-- name: SetParamsLoyaltyPolicy :exec
+UPDATE params
+SET loyalty_earn_percent       = $1,
+    loyalty_max_redeem_percent = $2,
+    loyalty_expire_days        = $3
+WHERE id = 1;
+
+-- name: SetParamsClosedUntil :exec
 UPDATE params
-SET header_text = $1,
-    header_deadline = $2
+SET closed_until  = $1,
+    closed_reason = $2
 WHERE id = 1;
 
+-- name: GetOpeningHours :many
+SELECT *
+FROM opening_hours
+ORDER BY weekday;
+
+-- name: DeleteOpeningHours :exec
+DELETE
+FROM opening_hours;
+
+-- name: CreateOpeningHours :exec
+INSERT INTO opening_hours (weekday, opens, closes)
+VALUES ($1, $2, $3);
+
+-- name: GetOpeningExceptions :many
+SELECT *
+FROM opening_exceptions
+WHERE day >= @since::DATE
+ORDER BY day;
+
+-- name: UpsertOpeningException :exec
+INSERT INTO opening_exceptions (day, opens, closes, title)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (day) DO UPDATE SET opens  = EXCLUDED.opens,
+                                closes = EXCLUDED.closes,
+                                title  = EXCLUDED.title;
+
+-- name: DeleteOpeningException :exec
+DELETE
+FROM opening_exceptions
+WHERE day = $1;
+
+-- name: GetAnnouncements :many
+SELECT *
+FROM announcements
+ORDER BY priority DESC, created DESC;
+
+-- name: GetActiveAnnouncements :many
+SELECT *
+FROM announcements
+WHERE (starts IS NULL OR starts <= @now::TIMESTAMP)
+  AND (ends IS NULL OR ends > @now::TIMESTAMP)
+ORDER BY priority DESC, created DESC;
+
+-- name: CreateAnnouncement :exec
+INSERT INTO announcements (id, text, starts, ends, priority, severity, target, menu_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: UpdateAnnouncement :execrows
+UPDATE announcements
+SET text     = $2,
+    starts   = $3,
+    ends     = $4,
+    priority = $5,
+    severity = $6,
+    target   = $7,
+    menu_id  = $8,
+    updated  = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: DeleteAnnouncement :execrows
+DELETE
+FROM announcements
+WHERE id = $1;
+
+-- name: GetAnnouncementByID :one
+SELECT *
+FROM announcements
+WHERE id = $1;
+
+-- name: SetHeaderAnnouncement :exec
+-- the header banner is a public info announcement
+INSERT INTO announcements (id, text, ends)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO UPDATE SET text    = excluded.text,
+                               ends    = excluded.ends,
+                               updated = CURRENT_TIMESTAMP;
+
+-- name: DeleteExpiredAnnouncement :execrows
+DELETE
+FROM announcements
+WHERE id = @id
+  AND ends <= @now::TIMESTAMP;
+
+-- name: TryJobLock :one
+SELECT pg_try_advisory_lock(hashtext('job:' || @name::TEXT))::BOOLEAN AS locked;
+
+-- name: UnlockJob :exec
+SELECT pg_advisory_unlock(hashtext('job:' || @name::TEXT));
+
+-- name: GetJobs :many
+SELECT *
+FROM jobs
+ORDER BY name;
+
+-- name: GetJob :one
+SELECT *
+FROM jobs
+WHERE name = $1;
+
+-- name: StartJobRun :exec
+INSERT INTO jobs (name, last_started, last_instance)
+VALUES ($1, $2, $3)
+ON CONFLICT (name) DO UPDATE SET last_started  = EXCLUDED.last_started,
+                                 last_instance = EXCLUDED.last_instance;
+
+-- name: FinishJobRun :exec
+UPDATE jobs
+SET last_finished    = $2,
+    last_error       = $3,
+    last_duration_ms = $4,
+    runs             = runs + 1,
+    failures         = failures + CASE WHEN $3::TEXT IS NULL THEN 0 ELSE 1 END
+WHERE name = $1;
+
+-- name: GetAdminByUsername :one
+SELECT *
+FROM admins
+WHERE username = $1;
+
+-- name: CreateAdmin :exec
+INSERT INTO admins (username, password_hash)
+VALUES ($1, $2);
+
+-- name: UpdateAdminPassword :execrows
+UPDATE admins
+SET password_hash = $2,
+    updated       = CURRENT_TIMESTAMP
+WHERE username = $1;
+
+-- name: UpsertMenu :exec
+INSERT INTO menu (id, title)
+VALUES ($1, $2)
+ON CONFLICT (id) DO UPDATE SET title = excluded.title;
+
+-- name: UpsertProductGroup :exec
+INSERT INTO product_groups (id, menu_id, title, index, prep_minutes)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE SET menu_id      = excluded.menu_id,
+                               title        = excluded.title,
+                               index        = excluded.index,
+                               prep_minutes = excluded.prep_minutes,
+                               updated      = CURRENT_TIMESTAMP;
+
+-- name: UpsertProduct :exec
+INSERT INTO products (id, group_id, title, description, price, available, index, prep_minutes, max_quantity)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (id) DO UPDATE SET group_id     = excluded.group_id,
+                               title        = excluded.title,
+                               description  = excluded.description,
+                               price        = excluded.price,
+                               available    = excluded.available,
+                               index        = excluded.index,
+                               prep_minutes = excluded.prep_minutes,
+                               max_quantity = excluded.max_quantity,
+                               auto_stopped = false,
+                               updated      = CURRENT_TIMESTAMP;
+
+-- name: DeleteProductGroupsNotIn :exec
+DELETE
+FROM product_groups
+WHERE menu_id = @menu_id
+  AND NOT (id = ANY (@ids::UUID[]));
+
+-- name: DeleteProductsNotIn :exec
+DELETE
+FROM products
+WHERE group_id = @group_id
+  AND NOT (id = ANY (@ids::UUID[]));
+
 -- name: GetMigrations :many
 SELECT *
 FROM migration
 ORDER BY id;
 
 -- name: CreateMigration :one
-INSERT INTO migration (id, applied)
-VALUES ($1, $2) RETURNING id;
+INSERT INTO migration (id, applied, checksum)
+VALUES ($1, $2, $3) RETURNING id;
+
+-- name: SetMigrationChecksum :exec
+UPDATE migration
+SET checksum = $2
+WHERE id = $1;
+
+-- name: DeleteMigration :exec
+DELETE
+FROM migration
+WHERE id = $1;
+
+-- name: LockMigrations :exec
+SELECT pg_advisory_lock(hashtext('migrations'));
+
+-- name: UnlockMigrations :exec
+SELECT pg_advisory_unlock(hashtext('migrations'));
+
+-- name: GetPromoCodes :many
+SELECT promo_codes.*,
+       (SELECT COUNT(*)
+        FROM discount_usages
+        WHERE discount_usages.promo_code_id = promo_codes.id
+          AND NOT discount_usages.voided)::INTEGER AS uses
+FROM promo_codes
+ORDER BY created DESC;
+
+-- name: GetPromoCodeByCode :one
+SELECT *
+FROM promo_codes
+WHERE code = $1;
+
+-- name: GetPromoCodeByID :one
+SELECT *
+FROM promo_codes
+WHERE id = $1;
+
+-- name: LockPromoCode :exec
+SELECT id
+FROM promo_codes
+WHERE id = $1
+  FOR UPDATE;
+
+-- name: CountPromoCodeUses :one
+SELECT COUNT(*)::INTEGER                                 AS total,
+       (COUNT(*) FILTER (WHERE customer = @customer))::INTEGER AS customer
+FROM discount_usages
+WHERE promo_code_id = @promo_code_id
+  AND NOT voided;
+
+-- name: CreatePromoCode :exec
+INSERT INTO promo_codes (id, code, kind, value, min_order_total, max_uses, max_uses_per_customer, starts, ends, active)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: UpdatePromoCode :execrows
+UPDATE promo_codes
+SET code                  = $2,
+    kind                  = $3,
+    value                 = $4,
+    min_order_total       = $5,
+    max_uses              = $6,
+    max_uses_per_customer = $7,
+    starts                = $8,
+    ends                  = $9,
+    active                = $10,
+    updated               = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: DeletePromoCode :execrows
+DELETE
+FROM promo_codes
+WHERE id = $1;
+
+-- name: GetDiscountRules :many
+SELECT *
+FROM discount_rules
+ORDER BY created DESC;
+
+-- name: GetActiveDiscountRules :many
+SELECT *
+FROM discount_rules
+WHERE active
+  AND (starts IS NULL OR starts <= @now::TIMESTAMP)
+  AND (ends IS NULL OR ends > @now::TIMESTAMP)
+ORDER BY created;
+
+-- name: CreateDiscountRule :exec
+INSERT INTO discount_rules (id, title, kind, value, group_id, from_minute, to_minute, starts, ends, active)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: UpdateDiscountRule :execrows
+UPDATE discount_rules
+SET title       = $2,
+    kind        = $3,
+    value       = $4,
+    group_id    = $5,
+    from_minute = $6,
+    to_minute   = $7,
+    starts      = $8,
+    ends        = $9,
+    active      = $10,
+    updated     = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: DeleteDiscountRule :execrows
+DELETE
+FROM discount_rules
+WHERE id = $1;
+
+-- name: CreateDiscountUsage :exec
+INSERT INTO discount_usages (order_id, promo_code_id, rule_id, customer, title, amount)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetDiscountUsagesByOrder :many
+SELECT *
+FROM discount_usages
+WHERE order_id = $1
+ORDER BY id;
+
+-- name: SetDiscountUsagesVoided :exec
+UPDATE discount_usages
+SET voided = @voided
+WHERE order_id = @order_id;
+
+-- name: GetDiscountReport :many
+SELECT discount_usages.title,
+       discount_usages.promo_code_id,
+       discount_usages.rule_id,
+       COUNT(*)::INTEGER                     AS orders,
+       SUM(discount_usages.amount)::BIGINT AS amount
+FROM discount_usages
+WHERE discount_usages.created >= @since::TIMESTAMP
+  AND discount_usages.created < @until::TIMESTAMP
+  AND NOT discount_usages.voided
+GROUP BY discount_usages.title, discount_usages.promo_code_id, discount_usages.rule_id
+ORDER BY amount DESC;
+
+-- name: GetPointsReport :one
+-- points are paid with the order discount line, they are kept out of discount_usages
+SELECT COUNT(*)::INTEGER                             AS orders,
+       COALESCE(SUM(discount.amount), 0)::BIGINT    AS amount,
+       COALESCE(SUM(discount.points), 0)::INTEGER   AS points
+FROM orders,
+     LATERAL (SELECT SUM((line ->> 'amount')::BIGINT) AS amount,
+                     SUM((line ->> 'points')::INTEGER) AS points
+              FROM jsonb_array_elements(orders.discounts) AS line
+              WHERE line ->> 'points' IS NOT NULL) AS discount
+WHERE orders.created >= @since::TIMESTAMP
+  AND orders.created < @until::TIMESTAMP
+  AND orders.status <> 'cancelled'
+  AND discount.points IS NOT NULL;
+
+-- name: GetAllComboSlots :many
+SELECT *
+FROM combo_slots
+ORDER BY product_id, index;
+
+-- name: GetAllComboSlotOptions :many
+SELECT *
+FROM combo_slot_options
+ORDER BY id;
+
+-- name: GetComboSlotsByProduct :many
+SELECT *
+FROM combo_slots
+WHERE product_id = $1
+ORDER BY index;
+
+-- name: GetComboSlotOptionsByProduct :many
+SELECT combo_slot_options.*
+FROM combo_slot_options
+       JOIN combo_slots ON combo_slots.id = combo_slot_options.slot_id
+WHERE combo_slots.product_id = $1
+ORDER BY combo_slot_options.id;
+
+-- name: DeleteComboSlots :exec
+DELETE
+FROM combo_slots
+WHERE product_id = $1;
+
+-- name: CreateComboSlot :exec
+INSERT INTO combo_slots (id, product_id, index, title)
+VALUES ($1, $2, $3, $4);
+
+-- name: CreateComboSlotOption :exec
+INSERT INTO combo_slot_options (slot_id, product_id, group_id, surcharge)
+VALUES ($1, $2, $3, $4);
+
+-- name: SetProductStock :execrows
+UPDATE products
+SET stock     = $2,
+    low_stock = $3,
+    updated   = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: ConsumeProductStock :one
+UPDATE products
+SET stock   = stock - @amount::INTEGER,
+    updated = CURRENT_TIMESTAMP
+WHERE id = @id
+  AND stock >= @amount::INTEGER
+RETURNING stock::INTEGER AS stock, low_stock;
+
+-- name: RestoreProductStock :exec
+UPDATE products
+SET stock   = stock + @amount::INTEGER,
+    updated = CURRENT_TIMESTAMP
+WHERE id = @id
+  AND stock IS NOT NULL;
+
+-- name: GetIngredients :many
+SELECT *
+FROM ingredients
+ORDER BY title;
+
+-- name: CreateIngredient :exec
+INSERT INTO ingredients (id, title, unit, stock, low_stock)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: UpdateIngredient :execrows
+UPDATE ingredients
+SET title     = $2,
+    unit      = $3,
+    stock     = $4,
+    low_stock = $5,
+    updated   = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: DeleteIngredient :execrows
+DELETE
+FROM ingredients
+WHERE id = $1;
+
+-- name: ConsumeIngredientStock :one
+UPDATE ingredients
+SET stock   = stock - @amount::INTEGER,
+    updated = CURRENT_TIMESTAMP
+WHERE id = @id
+  AND stock >= @amount::INTEGER
+RETURNING *;
+
+-- name: RestoreIngredientStock :exec
+UPDATE ingredients
+SET stock   = stock + @amount::INTEGER,
+    updated = CURRENT_TIMESTAMP
+WHERE id = @id;
+
+-- name: CreateOrderProductStock :exec
+INSERT INTO order_product_stock (order_id, product_id, amount)
+VALUES ($1, $2, $3);
+
+-- name: CreateOrderIngredientStock :exec
+INSERT INTO order_ingredient_stock (order_id, ingredient_id, amount)
+VALUES ($1, $2, $3);
+
+-- name: DeleteOrderProductStock :many
+DELETE
+FROM order_product_stock
+WHERE order_id = $1
+RETURNING *;
+
+-- name: DeleteOrderIngredientStock :many
+DELETE
+FROM order_ingredient_stock
+WHERE order_id = $1
+RETURNING *;
+
+-- name: GetRecipes :many
+SELECT *
+FROM product_ingredients
+ORDER BY product_id, ingredient_id;
+
+-- name: GetRecipesByProducts :many
+SELECT *
+FROM product_ingredients
+WHERE product_id = ANY (@product_ids::UUID[]);
+
+-- name: DeleteRecipe :exec
+DELETE
+FROM product_ingredients
+WHERE product_id = $1;
+
+-- name: CreateRecipeItem :exec
+INSERT INTO product_ingredients (product_id, ingredient_id, quantity)
+VALUES ($1, $2, $3);
+
+-- name: StopOutOfStockProducts :many
+UPDATE products
+SET available    = false,
+    auto_stopped = true,
+    updated      = CURRENT_TIMESTAMP
+WHERE available
+  AND (stock = 0 OR EXISTS (SELECT 1
+                            FROM product_ingredients
+                                   JOIN ingredients ON ingredients.id = product_ingredients.ingredient_id
+                            WHERE product_ingredients.product_id = products.id
+                              AND ingredients.stock < product_ingredients.quantity))
+RETURNING title;
+
+-- name: ResumeRestockedProducts :many
+UPDATE products
+SET available    = true,
+    auto_stopped = false,
+    updated      = CURRENT_TIMESTAMP
+WHERE auto_stopped
+  AND (stock IS NULL OR stock > 0)
+  AND NOT EXISTS (SELECT 1
+                  FROM product_ingredients
+                         JOIN ingredients ON ingredients.id = product_ingredients.ingredient_id
+                  WHERE product_ingredients.product_id = products.id
+                    AND ingredients.stock < product_ingredients.quantity)
+RETURNING title;
+
+-- name: GetSalesReport :many
+WITH totals AS (SELECT (orders.created AT TIME ZONE 'UTC') AT TIME ZONE @tz::TEXT AS local_created,
+                       (SELECT COALESCE(SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT), 0)
+                        FROM jsonb_array_elements(orders.items) AS item) -
+                       (SELECT COALESCE(SUM((discount ->> 'amount')::BIGINT), 0)
+                        FROM jsonb_array_elements(orders.discounts) AS discount) AS total
+                FROM orders
+                WHERE orders.created >= @since::TIMESTAMP
+                  AND orders.created < @until::TIMESTAMP
+                  AND orders.status <> 'cancelled')
+SELECT date_trunc(@granularity::TEXT, local_created)::TIMESTAMP AS period,
+       COUNT(*)::INTEGER                                      AS orders,
+       COALESCE(SUM(total), 0)::BIGINT                        AS revenue
+FROM totals
+GROUP BY period
+ORDER BY period;
+
+-- name: GetTopProductsReport :many
+SELECT (item ->> 'id')::UUID                                                  AS product_id,
+       MAX(item ->> 'title')::TEXT                                            AS title,
+       SUM((item ->> 'amount')::BIGINT)::BIGINT                               AS quantity,
+       SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT)::BIGINT AS revenue
+FROM orders
+       CROSS JOIN LATERAL jsonb_array_elements(orders.items) AS item
+WHERE orders.created >= @since::TIMESTAMP
+  AND orders.created < @until::TIMESTAMP
+  AND orders.status <> 'cancelled'
+GROUP BY product_id
+ORDER BY revenue DESC, quantity DESC
+LIMIT @row_limit;
+
+-- name: GetTopGroupsReport :many
+SELECT product_groups.id                                                      AS group_id,
+       product_groups.title,
+       SUM((item ->> 'amount')::BIGINT)::BIGINT                               AS quantity,
+       SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT)::BIGINT AS revenue
+FROM orders
+       CROSS JOIN LATERAL jsonb_array_elements(orders.items) AS item
+       JOIN products ON products.id = (item ->> 'id')::UUID
+       JOIN product_groups ON product_groups.id = products.group_id
+WHERE orders.created >= @since::TIMESTAMP
+  AND orders.created < @until::TIMESTAMP
+  AND orders.status <> 'cancelled'
+GROUP BY product_groups.id, product_groups.title
+ORDER BY revenue DESC, quantity DESC
+LIMIT @row_limit;
+
+-- name: GetCancellationReport :many
+SELECT date_trunc(@granularity::TEXT, (orders.created AT TIME ZONE 'UTC') AT TIME ZONE @tz::TEXT)::TIMESTAMP AS period,
+       COUNT(*)::INTEGER                                                                                     AS orders,
+       (COUNT(*) FILTER (WHERE orders.status = 'cancelled'))::INTEGER                                        AS cancelled
+FROM orders
+WHERE orders.created >= @since::TIMESTAMP
+  AND orders.created < @until::TIMESTAMP
+GROUP BY period
+ORDER BY period;
+
+-- name: GetHeatmapReport :many
+WITH totals AS (SELECT (orders.created AT TIME ZONE 'UTC') AT TIME ZONE @tz::TEXT AS local_created,
+                       (SELECT COALESCE(SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT), 0)
+                        FROM jsonb_array_elements(orders.items) AS item) -
+                       (SELECT COALESCE(SUM((discount ->> 'amount')::BIGINT), 0)
+                        FROM jsonb_array_elements(orders.discounts) AS discount) AS total
+                FROM orders
+                WHERE orders.created >= @since::TIMESTAMP
+                  AND orders.created < @until::TIMESTAMP
+                  AND orders.status <> 'cancelled')
+SELECT EXTRACT(ISODOW FROM local_created)::INTEGER AS weekday,
+       EXTRACT(HOUR FROM local_created)::INTEGER   AS hour,
+       COUNT(*)::INTEGER                           AS orders,
+       COALESCE(SUM(total), 0)::BIGINT             AS revenue
+FROM totals
+GROUP BY weekday, hour
+ORDER BY weekday, hour;
+
+-- name: GetStatusDurationReport :many
+WITH durations AS (SELECT order_status_history.status,
+                          EXTRACT(EPOCH FROM LEAD(order_status_history.created)
+                                             OVER (PARTITION BY order_status_history.order_id ORDER BY order_status_history.created, order_status_history.id) -
+                                             order_status_history.created) AS seconds
+                   FROM order_status_history
+                          JOIN orders ON orders.id = order_status_history.order_id
+                   WHERE orders.created >= @since::TIMESTAMP
+                     AND orders.created < @until::TIMESTAMP)
+SELECT status::TEXT                                                             AS status,
+       COUNT(*)::INTEGER                                                        AS transitions,
+       (percentile_cont(0.5) WITHIN GROUP (ORDER BY seconds))::DOUBLE PRECISION  AS p50,
+       (percentile_cont(0.9) WITHIN GROUP (ORDER BY seconds))::DOUBLE PRECISION  AS p90,
+       (percentile_cont(0.95) WITHIN GROUP (ORDER BY seconds))::DOUBLE PRECISION AS p95
+FROM durations
+WHERE seconds IS NOT NULL
+GROUP BY status
+ORDER BY status;
+
+-- name: GetZReportStatuses :many
+SELECT orders.status,
+       COUNT(*)::INTEGER                                                       AS orders,
+       COALESCE(SUM((SELECT SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT)
+                     FROM jsonb_array_elements(orders.items) AS item)), 0)::BIGINT AS gross,
+       COALESCE(SUM((SELECT SUM((discount ->> 'amount')::BIGINT)
+                     FROM jsonb_array_elements(orders.discounts) AS discount
+                     WHERE discount ->> 'points' IS NULL)), 0)::BIGINT AS discount,
+       COALESCE(SUM((SELECT SUM((discount ->> 'amount')::BIGINT)
+                     FROM jsonb_array_elements(orders.discounts) AS discount
+                     WHERE discount ->> 'points' IS NOT NULL)), 0)::BIGINT AS points
+FROM orders
+WHERE orders.created >= @since::TIMESTAMP
+  AND orders.created < @until::TIMESTAMP
+GROUP BY orders.status
+ORDER BY orders.status;
+
+-- name: CreateZReport :execrows
+INSERT INTO z_reports (day, starts, ends, summary)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (day) DO NOTHING;
+
+-- name: GetUndeliveredZReportDays :many
+SELECT day
+FROM z_reports
+WHERE day >= @since::DATE
+  AND EXISTS (SELECT 1
+              FROM UNNEST(@channels::TEXT[]) AS target (channel)
+              WHERE NOT EXISTS (SELECT 1
+                                FROM z_report_deliveries
+                                WHERE z_report_deliveries.day = z_reports.day
+                                  AND z_report_deliveries.channel = target.channel))
+ORDER BY day;
+
+-- name: ClaimZReport :execrows
+-- affects no rows while another instance holds an unexpired claim
+INSERT INTO z_report_claims (day, expires)
+VALUES (@day, @expires)
+ON CONFLICT (day) DO UPDATE SET expires = excluded.expires
+WHERE z_report_claims.expires < @now::TIMESTAMP;
+
+-- name: ReleaseZReport :exec
+DELETE
+FROM z_report_claims
+WHERE day = $1;
+
+-- name: GetZReportDeliveredChannels :many
+SELECT channel
+FROM z_report_deliveries
+WHERE day = $1;
+
+-- name: CreateZReportDelivery :exec
+INSERT INTO z_report_deliveries (day, channel)
+VALUES ($1, $2)
+ON CONFLICT (day, channel) DO NOTHING;
+
+-- name: GetLastZReportDay :one
+SELECT day
+FROM z_reports
+ORDER BY day DESC
+LIMIT 1;
+
+-- name: GetZReports :many
+SELECT *
+FROM z_reports
+ORDER BY day DESC
+LIMIT $1;
+
+-- name: GetZReport :one
+SELECT *
+FROM z_reports
+WHERE day = $1;
+
+-- name: GetCustomerByID :one
+SELECT *
+FROM customers
+WHERE id = $1;
+
+-- name: GetCustomerByIDForUpdate :one
+SELECT *
+FROM customers
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpsertCustomerByPhone :one
+INSERT INTO customers (id, phone)
+VALUES ($1, $2)
+ON CONFLICT (phone) DO UPDATE SET updated = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: UpdateCustomerName :one
+UPDATE customers
+SET name    = $2,
+    updated = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: GetCustomerOTP :one
+SELECT *
+FROM customer_otps
+WHERE phone = $1;
+
+-- name: UpsertCustomerOTP :exec
+INSERT INTO customer_otps (phone, code_hash, expires)
+VALUES ($1, $2, $3)
+ON CONFLICT (phone) DO UPDATE SET code_hash = excluded.code_hash,
+                                  attempts  = 0,
+                                  expires   = excluded.expires,
+                                  created   = CURRENT_TIMESTAMP;
+
+-- name: UseCustomerOTPAttempt :one
+-- the attempt is counted before the code is compared, so parallel guesses can't exceed the limit
+UPDATE customer_otps
+SET attempts = attempts + 1
+WHERE phone = @phone
+  AND attempts < @max_attempts
+  AND expires > @now::TIMESTAMP
+RETURNING code_hash;
+
+-- name: DeleteCustomerOTP :exec
+DELETE
+FROM customer_otps
+WHERE phone = $1;
+
+-- name: GetLoyaltyBalance :one
+SELECT COALESCE(SUM(points), 0)::INTEGER
+FROM loyalty_entries
+WHERE customer_id = $1;
+
+-- name: GetLoyaltyEntries :many
+SELECT *
+FROM loyalty_entries
+WHERE customer_id = $1
+ORDER BY id DESC
+LIMIT $2;
+
+-- name: GetOrderLoyaltyPoints :one
+SELECT COALESCE(SUM(points) FILTER (WHERE kind = 'earn'), 0)::INTEGER   AS earned,
+       COALESCE(SUM(points) FILTER (WHERE kind = 'redeem'), 0)::INTEGER AS redeemed
+FROM loyalty_entries
+WHERE order_id = $1;
+
+-- name: CreateLoyaltyEntry :one
+INSERT INTO loyalty_entries (customer_id, order_id, kind, points, expires, comment)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetLoyaltyExpiringCustomers :many
+-- spending takes expiring points first, so the points left to expire are the earned ones
+-- past their date minus everything spent, expired or taken back so far
+SELECT customer_id
+FROM loyalty_entries
+GROUP BY customer_id
+HAVING COALESCE(SUM(points) FILTER (WHERE kind = 'earn' AND points > 0 AND expires <= @now::TIMESTAMP), 0) +
+       COALESCE(SUM(points) FILTER (WHERE kind IN ('redeem', 'expire') OR (kind IN ('earn', 'adjust') AND points < 0)), 0) > 0;
+
+-- name: ExpireLoyaltyPoints :execrows
+INSERT INTO loyalty_entries (customer_id, kind, points)
+SELECT customer_id, 'expire', -due
+FROM (SELECT customer_id,
+             COALESCE(SUM(points) FILTER (WHERE kind = 'earn' AND points > 0 AND expires <= @now::TIMESTAMP), 0) +
+             COALESCE(SUM(points) FILTER (WHERE kind IN ('redeem', 'expire') OR (kind IN ('earn', 'adjust') AND points < 0)), 0) AS due
+      FROM loyalty_entries
+      WHERE loyalty_entries.customer_id = @customer_id::UUID
+      GROUP BY customer_id) AS balances
+WHERE due > 0;
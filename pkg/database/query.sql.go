@@ -11,49 +11,579 @@ import (
 
 	"github.com/google/uuid"
 	"shantaram/app/api"
+	"shantaram/pkg/money"
 )
 
-const countOrders = `-- name: CountOrders :one
+const bumpKitchenTicket = `-- name: BumpKitchenTicket :one
+UPDATE kitchen_tickets
+SET done   = true,
+    bumped = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING id, order_id, station_id, product_id, title, amount, done, created, bumped
+`
+
+// BumpKitchenTicket
+//
+//	UPDATE kitchen_tickets
+//	SET done   = true,
+//	    bumped = CURRENT_TIMESTAMP
+//	WHERE id = $1
+//	RETURNING id, order_id, station_id, product_id, title, amount, done, created, bumped
+func (q *Queries) BumpKitchenTicket(ctx context.Context, id uuid.UUID) (KitchenTicket, error) {
+	row := q.db.QueryRow(ctx, bumpKitchenTicket, id)
+	var i KitchenTicket
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.StationID,
+		&i.ProductID,
+		&i.Title,
+		&i.Amount,
+		&i.Done,
+		&i.Created,
+		&i.Bumped,
+	)
+	return i, err
+}
+
+const claimZReport = `-- name: ClaimZReport :execrows
+INSERT INTO z_report_claims (day, expires)
+VALUES ($1, $2)
+ON CONFLICT (day) DO UPDATE SET expires = excluded.expires
+WHERE z_report_claims.expires < $3::TIMESTAMP
+`
+
+type ClaimZReportParams struct {
+	Day     time.Time
+	Expires time.Time
+	Now     time.Time
+}
+
+// affects no rows while another instance holds an unexpired claim
+//
+//	INSERT INTO z_report_claims (day, expires)
+//	VALUES ($1, $2)
+//	ON CONFLICT (day) DO UPDATE SET expires = excluded.expires
+//	WHERE z_report_claims.expires < $3::TIMESTAMP
+func (q *Queries) ClaimZReport(ctx context.Context, arg ClaimZReportParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimZReport, arg.Day, arg.Expires, arg.Now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const consumeIngredientStock = `-- name: ConsumeIngredientStock :one
+UPDATE ingredients
+SET stock   = stock - $1::INTEGER,
+    updated = CURRENT_TIMESTAMP
+WHERE id = $2
+  AND stock >= $1::INTEGER
+RETURNING id, title, unit, stock, low_stock, created, updated
+`
+
+type ConsumeIngredientStockParams struct {
+	Amount int32
+	ID     uuid.UUID
+}
+
+// ConsumeIngredientStock
+//
+//	UPDATE ingredients
+//	SET stock   = stock - $1::INTEGER,
+//	    updated = CURRENT_TIMESTAMP
+//	WHERE id = $2
+//	  AND stock >= $1::INTEGER
+//	RETURNING id, title, unit, stock, low_stock, created, updated
+func (q *Queries) ConsumeIngredientStock(ctx context.Context, arg ConsumeIngredientStockParams) (Ingredient, error) {
+	row := q.db.QueryRow(ctx, consumeIngredientStock, arg.Amount, arg.ID)
+	var i Ingredient
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Unit,
+		&i.Stock,
+		&i.LowStock,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const consumeProductStock = `-- name: ConsumeProductStock :one
+UPDATE products
+SET stock   = stock - $1::INTEGER,
+    updated = CURRENT_TIMESTAMP
+WHERE id = $2
+  AND stock >= $1::INTEGER
+RETURNING stock::INTEGER AS stock, low_stock
+`
+
+type ConsumeProductStockParams struct {
+	Amount int32
+	ID     uuid.UUID
+}
+
+type ConsumeProductStockRow struct {
+	Stock    int32
+	LowStock *int32
+}
+
+// ConsumeProductStock
+//
+//	UPDATE products
+//	SET stock   = stock - $1::INTEGER,
+//	    updated = CURRENT_TIMESTAMP
+//	WHERE id = $2
+//	  AND stock >= $1::INTEGER
+//	RETURNING stock::INTEGER AS stock, low_stock
+func (q *Queries) ConsumeProductStock(ctx context.Context, arg ConsumeProductStockParams) (ConsumeProductStockRow, error) {
+	row := q.db.QueryRow(ctx, consumeProductStock, arg.Amount, arg.ID)
+	var i ConsumeProductStockRow
+	err := row.Scan(&i.Stock, &i.LowStock)
+	return i, err
+}
+
+const countPendingKitchenTickets = `-- name: CountPendingKitchenTickets :one
+SELECT COUNT(*)
+FROM kitchen_tickets
+WHERE order_id = $1
+  AND NOT done
+`
+
+// CountPendingKitchenTickets
+//
+//	SELECT COUNT(*)
+//	FROM kitchen_tickets
+//	WHERE order_id = $1
+//	  AND NOT done
+func (q *Queries) CountPendingKitchenTickets(ctx context.Context, orderID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countPendingKitchenTickets, orderID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPromoCodeUses = `-- name: CountPromoCodeUses :one
+SELECT COUNT(*)::INTEGER                                 AS total,
+       (COUNT(*) FILTER (WHERE customer = $1))::INTEGER AS customer
+FROM discount_usages
+WHERE promo_code_id = $2
+  AND NOT voided
+`
+
+type CountPromoCodeUsesParams struct {
+	Customer    string
+	PromoCodeID *uuid.UUID
+}
+
+type CountPromoCodeUsesRow struct {
+	Total    int32
+	Customer int32
+}
+
+// CountPromoCodeUses
+//
+//	SELECT COUNT(*)::INTEGER                                 AS total,
+//	       (COUNT(*) FILTER (WHERE customer = $1))::INTEGER AS customer
+//	FROM discount_usages
+//	WHERE promo_code_id = $2
+//	  AND NOT voided
+func (q *Queries) CountPromoCodeUses(ctx context.Context, arg CountPromoCodeUsesParams) (CountPromoCodeUsesRow, error) {
+	row := q.db.QueryRow(ctx, countPromoCodeUses, arg.Customer, arg.PromoCodeID)
+	var i CountPromoCodeUsesRow
+	err := row.Scan(&i.Total, &i.Customer)
+	return i, err
+}
+
+const countSearchOrders = `-- name: CountSearchOrders :one
 SELECT COUNT(*)
 FROM orders
+WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+  AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+  AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+  AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+  AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+  AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+       client_comment ILIKE $6::TEXT)
+  AND ($7::TEXT IS NULL OR
+       items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+         jsonb_build_object('id', $7::TEXT)))))
+  AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+  AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+  AND ($10::UUID IS NULL OR customer_id = $10::UUID)
 `
 
-// CountOrders
+type CountSearchOrdersParams struct {
+	Statuses   []string
+	Seen       *bool
+	Since      *time.Time
+	Until      *time.Time
+	TableID    *string
+	Pattern    *string
+	ProductID  *string
+	MinTotal   *int64
+	MaxTotal   *int64
+	CustomerID *uuid.UUID
+}
+
+// CountSearchOrders
 //
 //	SELECT COUNT(*)
 //	FROM orders
-func (q *Queries) CountOrders(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countOrders)
+//	WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+//	  AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+//	  AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+//	  AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+//	  AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+//	  AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+//	       client_comment ILIKE $6::TEXT)
+//	  AND ($7::TEXT IS NULL OR
+//	       items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+//	       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+//	         jsonb_build_object('id', $7::TEXT)))))
+//	  AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+//	  AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+//	  AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+func (q *Queries) CountSearchOrders(ctx context.Context, arg CountSearchOrdersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSearchOrders,
+		arg.Statuses,
+		arg.Seen,
+		arg.Since,
+		arg.Until,
+		arg.TableID,
+		arg.Pattern,
+		arg.ProductID,
+		arg.MinTotal,
+		arg.MaxTotal,
+		arg.CustomerID,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
+const createAdmin = `-- name: CreateAdmin :exec
+INSERT INTO admins (username, password_hash)
+VALUES ($1, $2)
+`
+
+type CreateAdminParams struct {
+	Username     string
+	PasswordHash string
+}
+
+// CreateAdmin
+//
+//	INSERT INTO admins (username, password_hash)
+//	VALUES ($1, $2)
+func (q *Queries) CreateAdmin(ctx context.Context, arg CreateAdminParams) error {
+	_, err := q.db.Exec(ctx, createAdmin, arg.Username, arg.PasswordHash)
+	return err
+}
+
+const createAnnouncement = `-- name: CreateAnnouncement :exec
+INSERT INTO announcements (id, text, starts, ends, priority, severity, target, menu_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateAnnouncementParams struct {
+	ID       uuid.UUID
+	Text     string
+	Starts   *time.Time
+	Ends     *time.Time
+	Priority int32
+	Severity api.AnnouncementSeverity
+	Target   api.AnnouncementTarget
+	MenuID   *string
+}
+
+// CreateAnnouncement
+//
+//	INSERT INTO announcements (id, text, starts, ends, priority, severity, target, menu_id)
+//	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+func (q *Queries) CreateAnnouncement(ctx context.Context, arg CreateAnnouncementParams) error {
+	_, err := q.db.Exec(ctx, createAnnouncement,
+		arg.ID,
+		arg.Text,
+		arg.Starts,
+		arg.Ends,
+		arg.Priority,
+		arg.Severity,
+		arg.Target,
+		arg.MenuID,
+	)
+	return err
+}
+
+const createComboSlot = `-- name: CreateComboSlot :exec
+INSERT INTO combo_slots (id, product_id, index, title)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateComboSlotParams struct {
+	ID        uuid.UUID
+	ProductID uuid.UUID
+	Index     int32
+	Title     string
+}
+
+// CreateComboSlot
+//
+//	INSERT INTO combo_slots (id, product_id, index, title)
+//	VALUES ($1, $2, $3, $4)
+func (q *Queries) CreateComboSlot(ctx context.Context, arg CreateComboSlotParams) error {
+	_, err := q.db.Exec(ctx, createComboSlot,
+		arg.ID,
+		arg.ProductID,
+		arg.Index,
+		arg.Title,
+	)
+	return err
+}
+
+const createComboSlotOption = `-- name: CreateComboSlotOption :exec
+INSERT INTO combo_slot_options (slot_id, product_id, group_id, surcharge)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateComboSlotOptionParams struct {
+	SlotID    uuid.UUID
+	ProductID *uuid.UUID
+	GroupID   *uuid.UUID
+	Surcharge money.Kopecks
+}
+
+// CreateComboSlotOption
+//
+//	INSERT INTO combo_slot_options (slot_id, product_id, group_id, surcharge)
+//	VALUES ($1, $2, $3, $4)
+func (q *Queries) CreateComboSlotOption(ctx context.Context, arg CreateComboSlotOptionParams) error {
+	_, err := q.db.Exec(ctx, createComboSlotOption,
+		arg.SlotID,
+		arg.ProductID,
+		arg.GroupID,
+		arg.Surcharge,
+	)
+	return err
+}
+
+const createDiscountRule = `-- name: CreateDiscountRule :exec
+INSERT INTO discount_rules (id, title, kind, value, group_id, from_minute, to_minute, starts, ends, active)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+type CreateDiscountRuleParams struct {
+	ID         uuid.UUID
+	Title      string
+	Kind       api.DiscountKind
+	Value      int64
+	GroupID    *uuid.UUID
+	FromMinute *int32
+	ToMinute   *int32
+	Starts     *time.Time
+	Ends       *time.Time
+	Active     bool
+}
+
+// CreateDiscountRule
+//
+//	INSERT INTO discount_rules (id, title, kind, value, group_id, from_minute, to_minute, starts, ends, active)
+//	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+func (q *Queries) CreateDiscountRule(ctx context.Context, arg CreateDiscountRuleParams) error {
+	_, err := q.db.Exec(ctx, createDiscountRule,
+		arg.ID,
+		arg.Title,
+		arg.Kind,
+		arg.Value,
+		arg.GroupID,
+		arg.FromMinute,
+		arg.ToMinute,
+		arg.Starts,
+		arg.Ends,
+		arg.Active,
+	)
+	return err
+}
+
+const createDiscountUsage = `-- name: CreateDiscountUsage :exec
+INSERT INTO discount_usages (order_id, promo_code_id, rule_id, customer, title, amount)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateDiscountUsageParams struct {
+	OrderID     uuid.UUID
+	PromoCodeID *uuid.UUID
+	RuleID      *uuid.UUID
+	Customer    string
+	Title       string
+	Amount      money.Kopecks
+}
+
+// CreateDiscountUsage
+//
+//	INSERT INTO discount_usages (order_id, promo_code_id, rule_id, customer, title, amount)
+//	VALUES ($1, $2, $3, $4, $5, $6)
+func (q *Queries) CreateDiscountUsage(ctx context.Context, arg CreateDiscountUsageParams) error {
+	_, err := q.db.Exec(ctx, createDiscountUsage,
+		arg.OrderID,
+		arg.PromoCodeID,
+		arg.RuleID,
+		arg.Customer,
+		arg.Title,
+		arg.Amount,
+	)
+	return err
+}
+
+const createIngredient = `-- name: CreateIngredient :exec
+INSERT INTO ingredients (id, title, unit, stock, low_stock)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type CreateIngredientParams struct {
+	ID       uuid.UUID
+	Title    string
+	Unit     string
+	Stock    int32
+	LowStock *int32
+}
+
+// CreateIngredient
+//
+//	INSERT INTO ingredients (id, title, unit, stock, low_stock)
+//	VALUES ($1, $2, $3, $4, $5)
+func (q *Queries) CreateIngredient(ctx context.Context, arg CreateIngredientParams) error {
+	_, err := q.db.Exec(ctx, createIngredient,
+		arg.ID,
+		arg.Title,
+		arg.Unit,
+		arg.Stock,
+		arg.LowStock,
+	)
+	return err
+}
+
+const createKitchenTicket = `-- name: CreateKitchenTicket :exec
+INSERT INTO kitchen_tickets (id, order_id, station_id, product_id, title, amount)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateKitchenTicketParams struct {
+	ID        uuid.UUID
+	OrderID   uuid.UUID
+	StationID string
+	ProductID uuid.UUID
+	Title     string
+	Amount    int32
+}
+
+// CreateKitchenTicket
+//
+//	INSERT INTO kitchen_tickets (id, order_id, station_id, product_id, title, amount)
+//	VALUES ($1, $2, $3, $4, $5, $6)
+func (q *Queries) CreateKitchenTicket(ctx context.Context, arg CreateKitchenTicketParams) error {
+	_, err := q.db.Exec(ctx, createKitchenTicket,
+		arg.ID,
+		arg.OrderID,
+		arg.StationID,
+		arg.ProductID,
+		arg.Title,
+		arg.Amount,
+	)
+	return err
+}
+
+const createLoyaltyEntry = `-- name: CreateLoyaltyEntry :one
+INSERT INTO loyalty_entries (customer_id, order_id, kind, points, expires, comment)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, customer_id, order_id, kind, points, expires, comment, created
+`
+
+type CreateLoyaltyEntryParams struct {
+	CustomerID uuid.UUID
+	OrderID    *uuid.UUID
+	Kind       api.LoyaltyEntryKind
+	Points     int32
+	Expires    *time.Time
+	Comment    *string
+}
+
+// CreateLoyaltyEntry
+//
+//	INSERT INTO loyalty_entries (customer_id, order_id, kind, points, expires, comment)
+//	VALUES ($1, $2, $3, $4, $5, $6)
+//	RETURNING id, customer_id, order_id, kind, points, expires, comment, created
+func (q *Queries) CreateLoyaltyEntry(ctx context.Context, arg CreateLoyaltyEntryParams) (LoyaltyEntry, error) {
+	row := q.db.QueryRow(ctx, createLoyaltyEntry,
+		arg.CustomerID,
+		arg.OrderID,
+		arg.Kind,
+		arg.Points,
+		arg.Expires,
+		arg.Comment,
+	)
+	var i LoyaltyEntry
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.OrderID,
+		&i.Kind,
+		&i.Points,
+		&i.Expires,
+		&i.Comment,
+		&i.Created,
+	)
+	return i, err
+}
+
 const createMigration = `-- name: CreateMigration :one
-INSERT INTO migration (id, applied)
-VALUES ($1, $2) RETURNING id
+INSERT INTO migration (id, applied, checksum)
+VALUES ($1, $2, $3) RETURNING id
 `
 
 type CreateMigrationParams struct {
-	ID      string
-	Applied time.Time
+	ID       string
+	Applied  time.Time
+	Checksum *string
 }
 
 // CreateMigration
 //
-//	INSERT INTO migration (id, applied)
-//	VALUES ($1, $2) RETURNING id
+//	INSERT INTO migration (id, applied, checksum)
+//	VALUES ($1, $2, $3) RETURNING id
 func (q *Queries) CreateMigration(ctx context.Context, arg CreateMigrationParams) (string, error) {
-	row := q.db.QueryRow(ctx, createMigration, arg.ID, arg.Applied)
+	row := q.db.QueryRow(ctx, createMigration, arg.ID, arg.Applied, arg.Checksum)
 	var id string
 	err := row.Scan(&id)
 	return id, err
 }
 
+const createOpeningHours = `-- name: CreateOpeningHours :exec
+INSERT INTO opening_hours (weekday, opens, closes)
+VALUES ($1, $2, $3)
+`
+
+type CreateOpeningHoursParams struct {
+	Weekday int32
+	Opens   int32
+	Closes  int32
+}
+
+// CreateOpeningHours
+//
+//	INSERT INTO opening_hours (weekday, opens, closes)
+//	VALUES ($1, $2, $3)
+func (q *Queries) CreateOpeningHours(ctx context.Context, arg CreateOpeningHoursParams) error {
+	_, err := q.db.Exec(ctx, createOpeningHours, arg.Weekday, arg.Opens, arg.Closes)
+	return err
+}
+
 const createOrder = `-- name: CreateOrder :one
-INSERT INTO orders (id, table_id, client_name, client_comment, status, seen, items)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, index, table_id, created, updated, status, client_name, client_comment, seen, items
+INSERT INTO orders (id, table_id, client_name, client_comment, status, seen, items, pickup_at, discounts, customer_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
 `
 
 type CreateOrderParams struct {
@@ -64,13 +594,16 @@ type CreateOrderParams struct {
 	Status        api.OrderStatus
 	Seen          bool
 	Items         []api.OrderItem
+	PickupAt      *time.Time
+	Discounts     []api.OrderDiscount
+	CustomerID    *uuid.UUID
 }
 
 // CreateOrder
 //
-//	INSERT INTO orders (id, table_id, client_name, client_comment, status, seen, items)
-//	VALUES ($1, $2, $3, $4, $5, $6, $7)
-//	RETURNING id, index, table_id, created, updated, status, client_name, client_comment, seen, items
+//	INSERT INTO orders (id, table_id, client_name, client_comment, status, seen, items, pickup_at, discounts, customer_id)
+//	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+//	RETURNING id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
 func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order, error) {
 	row := q.db.QueryRow(ctx, createOrder,
 		arg.ID,
@@ -80,6 +613,9 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		arg.Status,
 		arg.Seen,
 		arg.Items,
+		arg.PickupAt,
+		arg.Discounts,
+		arg.CustomerID,
 	)
 	var i Order
 	err := row.Scan(
@@ -93,13 +629,80 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		&i.ClientComment,
 		&i.Seen,
 		&i.Items,
+		&i.EtaMinutes,
+		&i.ReadyAt,
+		&i.PrepMinutes,
+		&i.QueueMinutes,
+		&i.PickupAt,
+		&i.Discounts,
+		&i.Total,
+		&i.CustomerID,
 	)
 	return i, err
 }
 
+const createOrderIngredientStock = `-- name: CreateOrderIngredientStock :exec
+INSERT INTO order_ingredient_stock (order_id, ingredient_id, amount)
+VALUES ($1, $2, $3)
+`
+
+type CreateOrderIngredientStockParams struct {
+	OrderID      uuid.UUID
+	IngredientID uuid.UUID
+	Amount       int32
+}
+
+// CreateOrderIngredientStock
+//
+//	INSERT INTO order_ingredient_stock (order_id, ingredient_id, amount)
+//	VALUES ($1, $2, $3)
+func (q *Queries) CreateOrderIngredientStock(ctx context.Context, arg CreateOrderIngredientStockParams) error {
+	_, err := q.db.Exec(ctx, createOrderIngredientStock, arg.OrderID, arg.IngredientID, arg.Amount)
+	return err
+}
+
+const createOrderProductStock = `-- name: CreateOrderProductStock :exec
+INSERT INTO order_product_stock (order_id, product_id, amount)
+VALUES ($1, $2, $3)
+`
+
+type CreateOrderProductStockParams struct {
+	OrderID   uuid.UUID
+	ProductID uuid.UUID
+	Amount    int32
+}
+
+// CreateOrderProductStock
+//
+//	INSERT INTO order_product_stock (order_id, product_id, amount)
+//	VALUES ($1, $2, $3)
+func (q *Queries) CreateOrderProductStock(ctx context.Context, arg CreateOrderProductStockParams) error {
+	_, err := q.db.Exec(ctx, createOrderProductStock, arg.OrderID, arg.ProductID, arg.Amount)
+	return err
+}
+
+const createOrderStatusHistory = `-- name: CreateOrderStatusHistory :exec
+INSERT INTO order_status_history (order_id, status)
+VALUES ($1, $2)
+`
+
+type CreateOrderStatusHistoryParams struct {
+	OrderID uuid.UUID
+	Status  api.OrderStatus
+}
+
+// CreateOrderStatusHistory
+//
+//	INSERT INTO order_status_history (order_id, status)
+//	VALUES ($1, $2)
+func (q *Queries) CreateOrderStatusHistory(ctx context.Context, arg CreateOrderStatusHistoryParams) error {
+	_, err := q.db.Exec(ctx, createOrderStatusHistory, arg.OrderID, arg.Status)
+	return err
+}
+
 const createProduct = `-- name: CreateProduct :exec
-INSERT INTO products (id, group_id, title, description, price, index)
-VALUES ($1, $2::UUID, $3, $4, $5,
+INSERT INTO products (id, group_id, title, description, price, prep_minutes, max_quantity, index)
+VALUES ($1, $2::UUID, $3, $4, $5, $6, $7,
         (SELECT COALESCE(MAX(index), 0) + 1 FROM products WHERE group_id = $2::UUID) )
 `
 
@@ -108,13 +711,15 @@ type CreateProductParams struct {
 	GroupID     uuid.UUID
 	Title       string
 	Description string
-	Price       float64
+	Price       money.Kopecks
+	PrepMinutes *int32
+	MaxQuantity *int32
 }
 
 // CreateProduct
 //
-//	INSERT INTO products (id, group_id, title, description, price, index)
-//	VALUES ($1, $2::UUID, $3, $4, $5,
+//	INSERT INTO products (id, group_id, title, description, price, prep_minutes, max_quantity, index)
+//	VALUES ($1, $2::UUID, $3, $4, $5, $6, $7,
 //	        (SELECT COALESCE(MAX(index), 0) + 1 FROM products WHERE group_id = $2::UUID) )
 func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) error {
 	_, err := q.db.Exec(ctx, createProduct,
@@ -123,6 +728,8 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) er
 		arg.Title,
 		arg.Description,
 		arg.Price,
+		arg.PrepMinutes,
+		arg.MaxQuantity,
 	)
 	return err
 }
@@ -149,263 +756,332 @@ func (q *Queries) CreateProductGroup(ctx context.Context, arg CreateProductGroup
 	return err
 }
 
-const deleteOrder = `-- name: DeleteOrder :exec
-DELETE
-FROM orders
-WHERE id = $1
+const createPromoCode = `-- name: CreatePromoCode :exec
+INSERT INTO promo_codes (id, code, kind, value, min_order_total, max_uses, max_uses_per_customer, starts, ends, active)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 `
 
-// DeleteOrder
+type CreatePromoCodeParams struct {
+	ID                 uuid.UUID
+	Code               string
+	Kind               api.DiscountKind
+	Value              int64
+	MinOrderTotal      *money.Kopecks
+	MaxUses            *int32
+	MaxUsesPerCustomer *int32
+	Starts             *time.Time
+	Ends               *time.Time
+	Active             bool
+}
+
+// CreatePromoCode
 //
-//	DELETE
-//	FROM orders
-//	WHERE id = $1
-func (q *Queries) DeleteOrder(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteOrder, id)
+//	INSERT INTO promo_codes (id, code, kind, value, min_order_total, max_uses, max_uses_per_customer, starts, ends, active)
+//	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+func (q *Queries) CreatePromoCode(ctx context.Context, arg CreatePromoCodeParams) error {
+	_, err := q.db.Exec(ctx, createPromoCode,
+		arg.ID,
+		arg.Code,
+		arg.Kind,
+		arg.Value,
+		arg.MinOrderTotal,
+		arg.MaxUses,
+		arg.MaxUsesPerCustomer,
+		arg.Starts,
+		arg.Ends,
+		arg.Active,
+	)
 	return err
 }
 
-const deleteProduct = `-- name: DeleteProduct :exec
-DELETE
-FROM products
-WHERE id = $1
+const createRecipeItem = `-- name: CreateRecipeItem :exec
+INSERT INTO product_ingredients (product_id, ingredient_id, quantity)
+VALUES ($1, $2, $3)
 `
 
-// DeleteProduct
+type CreateRecipeItemParams struct {
+	ProductID    uuid.UUID
+	IngredientID uuid.UUID
+	Quantity     int32
+}
+
+// CreateRecipeItem
 //
-//	DELETE
-//	FROM products
-//	WHERE id = $1
-func (q *Queries) DeleteProduct(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteProduct, id)
+//	INSERT INTO product_ingredients (product_id, ingredient_id, quantity)
+//	VALUES ($1, $2, $3)
+func (q *Queries) CreateRecipeItem(ctx context.Context, arg CreateRecipeItemParams) error {
+	_, err := q.db.Exec(ctx, createRecipeItem, arg.ProductID, arg.IngredientID, arg.Quantity)
 	return err
 }
 
-const deleteProductGroup = `-- name: DeleteProductGroup :exec
-DELETE
-FROM product_groups
-WHERE id = $1
+const createZReport = `-- name: CreateZReport :execrows
+INSERT INTO z_reports (day, starts, ends, summary)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (day) DO NOTHING
 `
 
-// DeleteProductGroup
+type CreateZReportParams struct {
+	Day     time.Time
+	Starts  time.Time
+	Ends    time.Time
+	Summary api.ZReportSummary
+}
+
+// CreateZReport
 //
-//	DELETE
-//	FROM product_groups
-//	WHERE id = $1
-func (q *Queries) DeleteProductGroup(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteProductGroup, id)
-	return err
+//	INSERT INTO z_reports (day, starts, ends, summary)
+//	VALUES ($1, $2, $3, $4)
+//	ON CONFLICT (day) DO NOTHING
+func (q *Queries) CreateZReport(ctx context.Context, arg CreateZReportParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createZReport,
+		arg.Day,
+		arg.Starts,
+		arg.Ends,
+		arg.Summary,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const getAllProductGroups = `-- name: GetAllProductGroups :many
-SELECT id, menu_id, index, title, created, updated
-FROM product_groups
-ORDER BY index
+const createZReportDelivery = `-- name: CreateZReportDelivery :exec
+INSERT INTO z_report_deliveries (day, channel)
+VALUES ($1, $2)
+ON CONFLICT (day, channel) DO NOTHING
 `
 
-// GetAllProductGroups
+type CreateZReportDeliveryParams struct {
+	Day     time.Time
+	Channel string
+}
+
+// CreateZReportDelivery
 //
-//	SELECT id, menu_id, index, title, created, updated
-//	FROM product_groups
-//	ORDER BY index
-func (q *Queries) GetAllProductGroups(ctx context.Context) ([]ProductGroup, error) {
-	rows, err := q.db.Query(ctx, getAllProductGroups)
+//	INSERT INTO z_report_deliveries (day, channel)
+//	VALUES ($1, $2)
+//	ON CONFLICT (day, channel) DO NOTHING
+func (q *Queries) CreateZReportDelivery(ctx context.Context, arg CreateZReportDeliveryParams) error {
+	_, err := q.db.Exec(ctx, createZReportDelivery, arg.Day, arg.Channel)
+	return err
+}
+
+const deleteAnnouncement = `-- name: DeleteAnnouncement :execrows
+DELETE
+FROM announcements
+WHERE id = $1
+`
+
+// DeleteAnnouncement
+//
+//	DELETE
+//	FROM announcements
+//	WHERE id = $1
+func (q *Queries) DeleteAnnouncement(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAnnouncement, id)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []ProductGroup{}
-	for rows.Next() {
-		var i ProductGroup
-		if err := rows.Scan(
-			&i.ID,
-			&i.MenuID,
-			&i.Index,
-			&i.Title,
-			&i.Created,
-			&i.Updated,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const getAllProducts = `-- name: GetAllProducts :many
-SELECT id, group_id, index, title, description, price, available, created, updated
-FROM products
-ORDER BY available DESC, index, group_id
+const deleteComboSlots = `-- name: DeleteComboSlots :exec
+DELETE
+FROM combo_slots
+WHERE product_id = $1
 `
 
-// GetAllProducts
+// DeleteComboSlots
 //
-//	SELECT id, group_id, index, title, description, price, available, created, updated
-//	FROM products
-//	ORDER BY available DESC, index, group_id
-func (q *Queries) GetAllProducts(ctx context.Context) ([]Product, error) {
-	rows, err := q.db.Query(ctx, getAllProducts)
+//	DELETE
+//	FROM combo_slots
+//	WHERE product_id = $1
+func (q *Queries) DeleteComboSlots(ctx context.Context, productID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteComboSlots, productID)
+	return err
+}
+
+const deleteCustomerOTP = `-- name: DeleteCustomerOTP :exec
+DELETE
+FROM customer_otps
+WHERE phone = $1
+`
+
+// DeleteCustomerOTP
+//
+//	DELETE
+//	FROM customer_otps
+//	WHERE phone = $1
+func (q *Queries) DeleteCustomerOTP(ctx context.Context, phone string) error {
+	_, err := q.db.Exec(ctx, deleteCustomerOTP, phone)
+	return err
+}
+
+const deleteDiscountRule = `-- name: DeleteDiscountRule :execrows
+DELETE
+FROM discount_rules
+WHERE id = $1
+`
+
+// DeleteDiscountRule
+//
+//	DELETE
+//	FROM discount_rules
+//	WHERE id = $1
+func (q *Queries) DeleteDiscountRule(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteDiscountRule, id)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []Product{}
-	for rows.Next() {
-		var i Product
-		if err := rows.Scan(
-			&i.ID,
-			&i.GroupID,
-			&i.Index,
-			&i.Title,
-			&i.Description,
-			&i.Price,
-			&i.Available,
-			&i.Created,
-			&i.Updated,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const getMenus = `-- name: GetMenus :many
-SELECT id, title, created
-FROM menu
-ORDER BY created
+const deleteExpiredAnnouncement = `-- name: DeleteExpiredAnnouncement :execrows
+DELETE
+FROM announcements
+WHERE id = $1
+  AND ends <= $2::TIMESTAMP
 `
 
-// GetMenus
+type DeleteExpiredAnnouncementParams struct {
+	ID  uuid.UUID
+	Now time.Time
+}
+
+// DeleteExpiredAnnouncement
 //
-//	SELECT id, title, created
-//	FROM menu
-//	ORDER BY created
-func (q *Queries) GetMenus(ctx context.Context) ([]Menu, error) {
-	rows, err := q.db.Query(ctx, getMenus)
+//	DELETE
+//	FROM announcements
+//	WHERE id = $1
+//	  AND ends <= $2::TIMESTAMP
+func (q *Queries) DeleteExpiredAnnouncement(ctx context.Context, arg DeleteExpiredAnnouncementParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredAnnouncement, arg.ID, arg.Now)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []Menu{}
-	for rows.Next() {
-		var i Menu
-		if err := rows.Scan(&i.ID, &i.Title, &i.Created); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const getMigrations = `-- name: GetMigrations :many
-SELECT id, applied
-FROM migration
-ORDER BY id
+const deleteIngredient = `-- name: DeleteIngredient :execrows
+DELETE
+FROM ingredients
+WHERE id = $1
 `
 
-// GetMigrations
+// DeleteIngredient
 //
-//	SELECT id, applied
-//	FROM migration
-//	ORDER BY id
-func (q *Queries) GetMigrations(ctx context.Context) ([]Migration, error) {
-	rows, err := q.db.Query(ctx, getMigrations)
+//	DELETE
+//	FROM ingredients
+//	WHERE id = $1
+func (q *Queries) DeleteIngredient(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteIngredient, id)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []Migration{}
-	for rows.Next() {
-		var i Migration
-		if err := rows.Scan(&i.ID, &i.Applied); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
+		return 0, err
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const getOrderByID = `-- name: GetOrderByID :one
-SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items
-FROM orders
+const deleteKitchenStation = `-- name: DeleteKitchenStation :exec
+DELETE
+FROM kitchen_stations
 WHERE id = $1
 `
 
-// GetOrderByID
+// DeleteKitchenStation
 //
-//	SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items
-//	FROM orders
+//	DELETE
+//	FROM kitchen_stations
 //	WHERE id = $1
-func (q *Queries) GetOrderByID(ctx context.Context, id uuid.UUID) (Order, error) {
-	row := q.db.QueryRow(ctx, getOrderByID, id)
-	var i Order
-	err := row.Scan(
-		&i.ID,
-		&i.Index,
-		&i.TableID,
-		&i.Created,
-		&i.Updated,
-		&i.Status,
-		&i.ClientName,
-		&i.ClientComment,
-		&i.Seen,
-		&i.Items,
-	)
-	return i, err
+func (q *Queries) DeleteKitchenStation(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteKitchenStation, id)
+	return err
 }
 
-const getOrdersPaginated = `-- name: GetOrdersPaginated :many
-SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items
-FROM orders
-ORDER BY index DESC
-OFFSET $1 LIMIT $2
+const deleteMigration = `-- name: DeleteMigration :exec
+DELETE
+FROM migration
+WHERE id = $1
+`
+
+// DeleteMigration
+//
+//	DELETE
+//	FROM migration
+//	WHERE id = $1
+func (q *Queries) DeleteMigration(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteMigration, id)
+	return err
+}
+
+const deleteOpeningException = `-- name: DeleteOpeningException :exec
+DELETE
+FROM opening_exceptions
+WHERE day = $1
+`
+
+// DeleteOpeningException
+//
+//	DELETE
+//	FROM opening_exceptions
+//	WHERE day = $1
+func (q *Queries) DeleteOpeningException(ctx context.Context, day time.Time) error {
+	_, err := q.db.Exec(ctx, deleteOpeningException, day)
+	return err
+}
+
+const deleteOpeningHours = `-- name: DeleteOpeningHours :exec
+DELETE
+FROM opening_hours
 `
 
-type GetOrdersPaginatedParams struct {
-	Offset int64
-	Limit  int64
+// DeleteOpeningHours
+//
+//	DELETE
+//	FROM opening_hours
+func (q *Queries) DeleteOpeningHours(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteOpeningHours)
+	return err
 }
 
-// GetOrdersPaginated
+const deleteOrder = `-- name: DeleteOrder :exec
+DELETE
+FROM orders
+WHERE id = $1
+`
+
+// DeleteOrder
 //
-//	SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items
+//	DELETE
 //	FROM orders
-//	ORDER BY index DESC
-//	OFFSET $1 LIMIT $2
-func (q *Queries) GetOrdersPaginated(ctx context.Context, arg GetOrdersPaginatedParams) ([]Order, error) {
-	rows, err := q.db.Query(ctx, getOrdersPaginated, arg.Offset, arg.Limit)
+//	WHERE id = $1
+func (q *Queries) DeleteOrder(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteOrder, id)
+	return err
+}
+
+const deleteOrderIngredientStock = `-- name: DeleteOrderIngredientStock :many
+DELETE
+FROM order_ingredient_stock
+WHERE order_id = $1
+RETURNING order_id, ingredient_id, amount
+`
+
+// DeleteOrderIngredientStock
+//
+//	DELETE
+//	FROM order_ingredient_stock
+//	WHERE order_id = $1
+//	RETURNING order_id, ingredient_id, amount
+func (q *Queries) DeleteOrderIngredientStock(ctx context.Context, orderID uuid.UUID) ([]OrderIngredientStock, error) {
+	rows, err := q.db.Query(ctx, deleteOrderIngredientStock, orderID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Order{}
+	items := []OrderIngredientStock{}
 	for rows.Next() {
-		var i Order
-		if err := rows.Scan(
-			&i.ID,
-			&i.Index,
-			&i.TableID,
-			&i.Created,
-			&i.Updated,
-			&i.Status,
-			&i.ClientName,
-			&i.ClientComment,
-			&i.Seen,
-			&i.Items,
-		); err != nil {
+		var i OrderIngredientStock
+		if err := rows.Scan(&i.OrderID, &i.IngredientID, &i.Amount); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -416,113 +1092,3794 @@ func (q *Queries) GetOrdersPaginated(ctx context.Context, arg GetOrdersPaginated
 	return items, nil
 }
 
-const getParams = `-- name: GetParams :one
-SELECT id, header_text, header_deadline
-FROM params
-WHERE id = 1
+const deleteOrderProductStock = `-- name: DeleteOrderProductStock :many
+DELETE
+FROM order_product_stock
+WHERE order_id = $1
+RETURNING order_id, product_id, amount
 `
 
-// GetParams
+// DeleteOrderProductStock
 //
-//	SELECT id, header_text, header_deadline
-//	FROM params
-//	WHERE id = 1
-func (q *Queries) GetParams(ctx context.Context) (Param, error) {
-	row := q.db.QueryRow(ctx, getParams)
-	var i Param
-	err := row.Scan(&i.ID, &i.HeaderText, &i.HeaderDeadline)
-	return i, err
+//	DELETE
+//	FROM order_product_stock
+//	WHERE order_id = $1
+//	RETURNING order_id, product_id, amount
+func (q *Queries) DeleteOrderProductStock(ctx context.Context, orderID uuid.UUID) ([]OrderProductStock, error) {
+	rows, err := q.db.Query(ctx, deleteOrderProductStock, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrderProductStock{}
+	for rows.Next() {
+		var i OrderProductStock
+		if err := rows.Scan(&i.OrderID, &i.ProductID, &i.Amount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getProductByID = `-- name: GetProductByID :one
-SELECT id, group_id, index, title, description, price, available, created, updated
+const deleteProduct = `-- name: DeleteProduct :exec
+DELETE
 FROM products
 WHERE id = $1
 `
 
-// GetProductByID
+// DeleteProduct
 //
-//	SELECT id, group_id, index, title, description, price, available, created, updated
+//	DELETE
 //	FROM products
 //	WHERE id = $1
-func (q *Queries) GetProductByID(ctx context.Context, id uuid.UUID) (Product, error) {
-	row := q.db.QueryRow(ctx, getProductByID, id)
-	var i Product
-	err := row.Scan(
-		&i.ID,
-		&i.GroupID,
-		&i.Index,
-		&i.Title,
-		&i.Description,
-		&i.Price,
-		&i.Available,
-		&i.Created,
-		&i.Updated,
-	)
-	return i, err
+func (q *Queries) DeleteProduct(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteProduct, id)
+	return err
 }
 
-const getProductGroupByID = `-- name: GetProductGroupByID :one
-SELECT id, menu_id, index, title, created, updated
+const deleteProductGroup = `-- name: DeleteProductGroup :exec
+DELETE
 FROM product_groups
 WHERE id = $1
 `
 
-// GetProductGroupByID
+// DeleteProductGroup
 //
-//	SELECT id, menu_id, index, title, created, updated
+//	DELETE
 //	FROM product_groups
 //	WHERE id = $1
-func (q *Queries) GetProductGroupByID(ctx context.Context, id uuid.UUID) (ProductGroup, error) {
-	row := q.db.QueryRow(ctx, getProductGroupByID, id)
-	var i ProductGroup
+func (q *Queries) DeleteProductGroup(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteProductGroup, id)
+	return err
+}
+
+const deleteProductGroupsNotIn = `-- name: DeleteProductGroupsNotIn :exec
+DELETE
+FROM product_groups
+WHERE menu_id = $1
+  AND NOT (id = ANY ($2::UUID[]))
+`
+
+type DeleteProductGroupsNotInParams struct {
+	MenuID string
+	Ids    []uuid.UUID
+}
+
+// DeleteProductGroupsNotIn
+//
+//	DELETE
+//	FROM product_groups
+//	WHERE menu_id = $1
+//	  AND NOT (id = ANY ($2::UUID[]))
+func (q *Queries) DeleteProductGroupsNotIn(ctx context.Context, arg DeleteProductGroupsNotInParams) error {
+	_, err := q.db.Exec(ctx, deleteProductGroupsNotIn, arg.MenuID, arg.Ids)
+	return err
+}
+
+const deleteProductsNotIn = `-- name: DeleteProductsNotIn :exec
+DELETE
+FROM products
+WHERE group_id = $1
+  AND NOT (id = ANY ($2::UUID[]))
+`
+
+type DeleteProductsNotInParams struct {
+	GroupID uuid.UUID
+	Ids     []uuid.UUID
+}
+
+// DeleteProductsNotIn
+//
+//	DELETE
+//	FROM products
+//	WHERE group_id = $1
+//	  AND NOT (id = ANY ($2::UUID[]))
+func (q *Queries) DeleteProductsNotIn(ctx context.Context, arg DeleteProductsNotInParams) error {
+	_, err := q.db.Exec(ctx, deleteProductsNotIn, arg.GroupID, arg.Ids)
+	return err
+}
+
+const deletePromoCode = `-- name: DeletePromoCode :execrows
+DELETE
+FROM promo_codes
+WHERE id = $1
+`
+
+// DeletePromoCode
+//
+//	DELETE
+//	FROM promo_codes
+//	WHERE id = $1
+func (q *Queries) DeletePromoCode(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePromoCode, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteRecipe = `-- name: DeleteRecipe :exec
+DELETE
+FROM product_ingredients
+WHERE product_id = $1
+`
+
+// DeleteRecipe
+//
+//	DELETE
+//	FROM product_ingredients
+//	WHERE product_id = $1
+func (q *Queries) DeleteRecipe(ctx context.Context, productID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRecipe, productID)
+	return err
+}
+
+const expireLoyaltyPoints = `-- name: ExpireLoyaltyPoints :execrows
+INSERT INTO loyalty_entries (customer_id, kind, points)
+SELECT customer_id, 'expire', -due
+FROM (SELECT customer_id,
+             COALESCE(SUM(points) FILTER (WHERE kind = 'earn' AND points > 0 AND expires <= $1::TIMESTAMP), 0) +
+             COALESCE(SUM(points) FILTER (WHERE kind IN ('redeem', 'expire') OR (kind IN ('earn', 'adjust') AND points < 0)), 0) AS due
+      FROM loyalty_entries
+      WHERE loyalty_entries.customer_id = $2::UUID
+      GROUP BY customer_id) AS balances
+WHERE due > 0
+`
+
+type ExpireLoyaltyPointsParams struct {
+	Now        time.Time
+	CustomerID uuid.UUID
+}
+
+// ExpireLoyaltyPoints
+//
+//	INSERT INTO loyalty_entries (customer_id, kind, points)
+//	SELECT customer_id, 'expire', -due
+//	FROM (SELECT customer_id,
+//	             COALESCE(SUM(points) FILTER (WHERE kind = 'earn' AND points > 0 AND expires <= $1::TIMESTAMP), 0) +
+//	             COALESCE(SUM(points) FILTER (WHERE kind IN ('redeem', 'expire') OR (kind IN ('earn', 'adjust') AND points < 0)), 0) AS due
+//	      FROM loyalty_entries
+//	      WHERE loyalty_entries.customer_id = $2::UUID
+//	      GROUP BY customer_id) AS balances
+//	WHERE due > 0
+func (q *Queries) ExpireLoyaltyPoints(ctx context.Context, arg ExpireLoyaltyPointsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, expireLoyaltyPoints, arg.Now, arg.CustomerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const finishJobRun = `-- name: FinishJobRun :exec
+UPDATE jobs
+SET last_finished    = $2,
+    last_error       = $3,
+    last_duration_ms = $4,
+    runs             = runs + 1,
+    failures         = failures + CASE WHEN $3::TEXT IS NULL THEN 0 ELSE 1 END
+WHERE name = $1
+`
+
+type FinishJobRunParams struct {
+	Name           string
+	LastFinished   *time.Time
+	LastError      *string
+	LastDurationMs *int64
+}
+
+// FinishJobRun
+//
+//	UPDATE jobs
+//	SET last_finished    = $2,
+//	    last_error       = $3,
+//	    last_duration_ms = $4,
+//	    runs             = runs + 1,
+//	    failures         = failures + CASE WHEN $3::TEXT IS NULL THEN 0 ELSE 1 END
+//	WHERE name = $1
+func (q *Queries) FinishJobRun(ctx context.Context, arg FinishJobRunParams) error {
+	_, err := q.db.Exec(ctx, finishJobRun,
+		arg.Name,
+		arg.LastFinished,
+		arg.LastError,
+		arg.LastDurationMs,
+	)
+	return err
+}
+
+const getActiveAnnouncements = `-- name: GetActiveAnnouncements :many
+SELECT id, text, starts, ends, priority, severity, target, menu_id, created, updated
+FROM announcements
+WHERE (starts IS NULL OR starts <= $1::TIMESTAMP)
+  AND (ends IS NULL OR ends > $1::TIMESTAMP)
+ORDER BY priority DESC, created DESC
+`
+
+// GetActiveAnnouncements
+//
+//	SELECT id, text, starts, ends, priority, severity, target, menu_id, created, updated
+//	FROM announcements
+//	WHERE (starts IS NULL OR starts <= $1::TIMESTAMP)
+//	  AND (ends IS NULL OR ends > $1::TIMESTAMP)
+//	ORDER BY priority DESC, created DESC
+func (q *Queries) GetActiveAnnouncements(ctx context.Context, now time.Time) ([]Announcement, error) {
+	rows, err := q.db.Query(ctx, getActiveAnnouncements, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Announcement{}
+	for rows.Next() {
+		var i Announcement
+		if err := rows.Scan(
+			&i.ID,
+			&i.Text,
+			&i.Starts,
+			&i.Ends,
+			&i.Priority,
+			&i.Severity,
+			&i.Target,
+			&i.MenuID,
+			&i.Created,
+			&i.Updated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActiveDiscountRules = `-- name: GetActiveDiscountRules :many
+SELECT id, title, kind, value, group_id, from_minute, to_minute, starts, ends, active, created, updated
+FROM discount_rules
+WHERE active
+  AND (starts IS NULL OR starts <= $1::TIMESTAMP)
+  AND (ends IS NULL OR ends > $1::TIMESTAMP)
+ORDER BY created
+`
+
+// GetActiveDiscountRules
+//
+//	SELECT id, title, kind, value, group_id, from_minute, to_minute, starts, ends, active, created, updated
+//	FROM discount_rules
+//	WHERE active
+//	  AND (starts IS NULL OR starts <= $1::TIMESTAMP)
+//	  AND (ends IS NULL OR ends > $1::TIMESTAMP)
+//	ORDER BY created
+func (q *Queries) GetActiveDiscountRules(ctx context.Context, now time.Time) ([]DiscountRule, error) {
+	rows, err := q.db.Query(ctx, getActiveDiscountRules, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DiscountRule{}
+	for rows.Next() {
+		var i DiscountRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Kind,
+			&i.Value,
+			&i.GroupID,
+			&i.FromMinute,
+			&i.ToMinute,
+			&i.Starts,
+			&i.Ends,
+			&i.Active,
+			&i.Created,
+			&i.Updated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAdminByUsername = `-- name: GetAdminByUsername :one
+SELECT username, password_hash, created, updated
+FROM admins
+WHERE username = $1
+`
+
+// GetAdminByUsername
+//
+//	SELECT username, password_hash, created, updated
+//	FROM admins
+//	WHERE username = $1
+func (q *Queries) GetAdminByUsername(ctx context.Context, username string) (Admin, error) {
+	row := q.db.QueryRow(ctx, getAdminByUsername, username)
+	var i Admin
 	err := row.Scan(
-		&i.ID,
-		&i.MenuID,
-		&i.Index,
-		&i.Title,
+		&i.Username,
+		&i.PasswordHash,
 		&i.Created,
 		&i.Updated,
 	)
 	return i, err
 }
 
-const getProductsByGroup = `-- name: GetProductsByGroup :many
-SELECT id, group_id, index, title, description, price, available, created, updated
-FROM products
-WHERE group_id = $1
-ORDER BY index
+const getAllComboSlotOptions = `-- name: GetAllComboSlotOptions :many
+SELECT id, slot_id, product_id, group_id, surcharge
+FROM combo_slot_options
+ORDER BY id
+`
+
+// GetAllComboSlotOptions
+//
+//	SELECT id, slot_id, product_id, group_id, surcharge
+//	FROM combo_slot_options
+//	ORDER BY id
+func (q *Queries) GetAllComboSlotOptions(ctx context.Context) ([]ComboSlotOption, error) {
+	rows, err := q.db.Query(ctx, getAllComboSlotOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ComboSlotOption{}
+	for rows.Next() {
+		var i ComboSlotOption
+		if err := rows.Scan(
+			&i.ID,
+			&i.SlotID,
+			&i.ProductID,
+			&i.GroupID,
+			&i.Surcharge,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllComboSlots = `-- name: GetAllComboSlots :many
+SELECT id, product_id, index, title, created
+FROM combo_slots
+ORDER BY product_id, index
+`
+
+// GetAllComboSlots
+//
+//	SELECT id, product_id, index, title, created
+//	FROM combo_slots
+//	ORDER BY product_id, index
+func (q *Queries) GetAllComboSlots(ctx context.Context) ([]ComboSlot, error) {
+	rows, err := q.db.Query(ctx, getAllComboSlots)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ComboSlot{}
+	for rows.Next() {
+		var i ComboSlot
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProductID,
+			&i.Index,
+			&i.Title,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllProductGroups = `-- name: GetAllProductGroups :many
+SELECT id, menu_id, index, title, created, updated, prep_minutes, station_id
+FROM product_groups
+ORDER BY index
+`
+
+// GetAllProductGroups
+//
+//	SELECT id, menu_id, index, title, created, updated, prep_minutes, station_id
+//	FROM product_groups
+//	ORDER BY index
+func (q *Queries) GetAllProductGroups(ctx context.Context) ([]ProductGroup, error) {
+	rows, err := q.db.Query(ctx, getAllProductGroups)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductGroup{}
+	for rows.Next() {
+		var i ProductGroup
+		if err := rows.Scan(
+			&i.ID,
+			&i.MenuID,
+			&i.Index,
+			&i.Title,
+			&i.Created,
+			&i.Updated,
+			&i.PrepMinutes,
+			&i.StationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllProducts = `-- name: GetAllProducts :many
+SELECT id, group_id, index, title, description, price, available, created, updated, prep_minutes, max_quantity, stock, low_stock, auto_stopped
+FROM products
+ORDER BY available DESC, index, group_id
+`
+
+// GetAllProducts
+//
+//	SELECT id, group_id, index, title, description, price, available, created, updated, prep_minutes, max_quantity, stock, low_stock, auto_stopped
+//	FROM products
+//	ORDER BY available DESC, index, group_id
+func (q *Queries) GetAllProducts(ctx context.Context) ([]Product, error) {
+	rows, err := q.db.Query(ctx, getAllProducts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.GroupID,
+			&i.Index,
+			&i.Title,
+			&i.Description,
+			&i.Price,
+			&i.Available,
+			&i.Created,
+			&i.Updated,
+			&i.PrepMinutes,
+			&i.MaxQuantity,
+			&i.Stock,
+			&i.LowStock,
+			&i.AutoStopped,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAnnouncementByID = `-- name: GetAnnouncementByID :one
+SELECT id, text, starts, ends, priority, severity, target, menu_id, created, updated
+FROM announcements
+WHERE id = $1
+`
+
+// GetAnnouncementByID
+//
+//	SELECT id, text, starts, ends, priority, severity, target, menu_id, created, updated
+//	FROM announcements
+//	WHERE id = $1
+func (q *Queries) GetAnnouncementByID(ctx context.Context, id uuid.UUID) (Announcement, error) {
+	row := q.db.QueryRow(ctx, getAnnouncementByID, id)
+	var i Announcement
+	err := row.Scan(
+		&i.ID,
+		&i.Text,
+		&i.Starts,
+		&i.Ends,
+		&i.Priority,
+		&i.Severity,
+		&i.Target,
+		&i.MenuID,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const getAnnouncements = `-- name: GetAnnouncements :many
+SELECT id, text, starts, ends, priority, severity, target, menu_id, created, updated
+FROM announcements
+ORDER BY priority DESC, created DESC
+`
+
+// GetAnnouncements
+//
+//	SELECT id, text, starts, ends, priority, severity, target, menu_id, created, updated
+//	FROM announcements
+//	ORDER BY priority DESC, created DESC
+func (q *Queries) GetAnnouncements(ctx context.Context) ([]Announcement, error) {
+	rows, err := q.db.Query(ctx, getAnnouncements)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Announcement{}
+	for rows.Next() {
+		var i Announcement
+		if err := rows.Scan(
+			&i.ID,
+			&i.Text,
+			&i.Starts,
+			&i.Ends,
+			&i.Priority,
+			&i.Severity,
+			&i.Target,
+			&i.MenuID,
+			&i.Created,
+			&i.Updated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCancellationReport = `-- name: GetCancellationReport :many
+SELECT date_trunc($1::TEXT, (orders.created AT TIME ZONE 'UTC') AT TIME ZONE $2::TEXT)::TIMESTAMP AS period,
+       COUNT(*)::INTEGER                                                                                     AS orders,
+       (COUNT(*) FILTER (WHERE orders.status = 'cancelled'))::INTEGER                                        AS cancelled
+FROM orders
+WHERE orders.created >= $3::TIMESTAMP
+  AND orders.created < $4::TIMESTAMP
+GROUP BY period
+ORDER BY period
+`
+
+type GetCancellationReportParams struct {
+	Granularity string
+	Tz          string
+	Since       time.Time
+	Until       time.Time
+}
+
+type GetCancellationReportRow struct {
+	Period    time.Time
+	Orders    int32
+	Cancelled int32
+}
+
+// GetCancellationReport
+//
+//	SELECT date_trunc($1::TEXT, (orders.created AT TIME ZONE 'UTC') AT TIME ZONE $2::TEXT)::TIMESTAMP AS period,
+//	       COUNT(*)::INTEGER                                                                                     AS orders,
+//	       (COUNT(*) FILTER (WHERE orders.status = 'cancelled'))::INTEGER                                        AS cancelled
+//	FROM orders
+//	WHERE orders.created >= $3::TIMESTAMP
+//	  AND orders.created < $4::TIMESTAMP
+//	GROUP BY period
+//	ORDER BY period
+func (q *Queries) GetCancellationReport(ctx context.Context, arg GetCancellationReportParams) ([]GetCancellationReportRow, error) {
+	rows, err := q.db.Query(ctx, getCancellationReport,
+		arg.Granularity,
+		arg.Tz,
+		arg.Since,
+		arg.Until,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetCancellationReportRow{}
+	for rows.Next() {
+		var i GetCancellationReportRow
+		if err := rows.Scan(&i.Period, &i.Orders, &i.Cancelled); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getComboSlotOptionsByProduct = `-- name: GetComboSlotOptionsByProduct :many
+SELECT combo_slot_options.id, combo_slot_options.slot_id, combo_slot_options.product_id, combo_slot_options.group_id, combo_slot_options.surcharge
+FROM combo_slot_options
+       JOIN combo_slots ON combo_slots.id = combo_slot_options.slot_id
+WHERE combo_slots.product_id = $1
+ORDER BY combo_slot_options.id
+`
+
+// GetComboSlotOptionsByProduct
+//
+//	SELECT combo_slot_options.id, combo_slot_options.slot_id, combo_slot_options.product_id, combo_slot_options.group_id, combo_slot_options.surcharge
+//	FROM combo_slot_options
+//	       JOIN combo_slots ON combo_slots.id = combo_slot_options.slot_id
+//	WHERE combo_slots.product_id = $1
+//	ORDER BY combo_slot_options.id
+func (q *Queries) GetComboSlotOptionsByProduct(ctx context.Context, productID uuid.UUID) ([]ComboSlotOption, error) {
+	rows, err := q.db.Query(ctx, getComboSlotOptionsByProduct, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ComboSlotOption{}
+	for rows.Next() {
+		var i ComboSlotOption
+		if err := rows.Scan(
+			&i.ID,
+			&i.SlotID,
+			&i.ProductID,
+			&i.GroupID,
+			&i.Surcharge,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getComboSlotsByProduct = `-- name: GetComboSlotsByProduct :many
+SELECT id, product_id, index, title, created
+FROM combo_slots
+WHERE product_id = $1
+ORDER BY index
+`
+
+// GetComboSlotsByProduct
+//
+//	SELECT id, product_id, index, title, created
+//	FROM combo_slots
+//	WHERE product_id = $1
+//	ORDER BY index
+func (q *Queries) GetComboSlotsByProduct(ctx context.Context, productID uuid.UUID) ([]ComboSlot, error) {
+	rows, err := q.db.Query(ctx, getComboSlotsByProduct, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ComboSlot{}
+	for rows.Next() {
+		var i ComboSlot
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProductID,
+			&i.Index,
+			&i.Title,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomerByID = `-- name: GetCustomerByID :one
+SELECT id, phone, name, created, updated
+FROM customers
+WHERE id = $1
+`
+
+// GetCustomerByID
+//
+//	SELECT id, phone, name, created, updated
+//	FROM customers
+//	WHERE id = $1
+func (q *Queries) GetCustomerByID(ctx context.Context, id uuid.UUID) (Customer, error) {
+	row := q.db.QueryRow(ctx, getCustomerByID, id)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.Phone,
+		&i.Name,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const getCustomerByIDForUpdate = `-- name: GetCustomerByIDForUpdate :one
+SELECT id, phone, name, created, updated
+FROM customers
+WHERE id = $1
+FOR UPDATE
+`
+
+// GetCustomerByIDForUpdate
+//
+//	SELECT id, phone, name, created, updated
+//	FROM customers
+//	WHERE id = $1
+//	FOR UPDATE
+func (q *Queries) GetCustomerByIDForUpdate(ctx context.Context, id uuid.UUID) (Customer, error) {
+	row := q.db.QueryRow(ctx, getCustomerByIDForUpdate, id)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.Phone,
+		&i.Name,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const getCustomerOTP = `-- name: GetCustomerOTP :one
+SELECT phone, code_hash, attempts, expires, created
+FROM customer_otps
+WHERE phone = $1
+`
+
+// GetCustomerOTP
+//
+//	SELECT phone, code_hash, attempts, expires, created
+//	FROM customer_otps
+//	WHERE phone = $1
+func (q *Queries) GetCustomerOTP(ctx context.Context, phone string) (CustomerOtp, error) {
+	row := q.db.QueryRow(ctx, getCustomerOTP, phone)
+	var i CustomerOtp
+	err := row.Scan(
+		&i.Phone,
+		&i.CodeHash,
+		&i.Attempts,
+		&i.Expires,
+		&i.Created,
+	)
+	return i, err
+}
+
+const getDiscountReport = `-- name: GetDiscountReport :many
+SELECT discount_usages.title,
+       discount_usages.promo_code_id,
+       discount_usages.rule_id,
+       COUNT(*)::INTEGER                     AS orders,
+       SUM(discount_usages.amount)::BIGINT AS amount
+FROM discount_usages
+WHERE discount_usages.created >= $1::TIMESTAMP
+  AND discount_usages.created < $2::TIMESTAMP
+  AND NOT discount_usages.voided
+GROUP BY discount_usages.title, discount_usages.promo_code_id, discount_usages.rule_id
+ORDER BY amount DESC
+`
+
+type GetDiscountReportParams struct {
+	Since time.Time
+	Until time.Time
+}
+
+type GetDiscountReportRow struct {
+	Title       string
+	PromoCodeID *uuid.UUID
+	RuleID      *uuid.UUID
+	Orders      int32
+	Amount      int64
+}
+
+// GetDiscountReport
+//
+//	SELECT discount_usages.title,
+//	       discount_usages.promo_code_id,
+//	       discount_usages.rule_id,
+//	       COUNT(*)::INTEGER                     AS orders,
+//	       SUM(discount_usages.amount)::BIGINT AS amount
+//	FROM discount_usages
+//	WHERE discount_usages.created >= $1::TIMESTAMP
+//	  AND discount_usages.created < $2::TIMESTAMP
+//	  AND NOT discount_usages.voided
+//	GROUP BY discount_usages.title, discount_usages.promo_code_id, discount_usages.rule_id
+//	ORDER BY amount DESC
+func (q *Queries) GetDiscountReport(ctx context.Context, arg GetDiscountReportParams) ([]GetDiscountReportRow, error) {
+	rows, err := q.db.Query(ctx, getDiscountReport, arg.Since, arg.Until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetDiscountReportRow{}
+	for rows.Next() {
+		var i GetDiscountReportRow
+		if err := rows.Scan(
+			&i.Title,
+			&i.PromoCodeID,
+			&i.RuleID,
+			&i.Orders,
+			&i.Amount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDiscountRules = `-- name: GetDiscountRules :many
+SELECT id, title, kind, value, group_id, from_minute, to_minute, starts, ends, active, created, updated
+FROM discount_rules
+ORDER BY created DESC
+`
+
+// GetDiscountRules
+//
+//	SELECT id, title, kind, value, group_id, from_minute, to_minute, starts, ends, active, created, updated
+//	FROM discount_rules
+//	ORDER BY created DESC
+func (q *Queries) GetDiscountRules(ctx context.Context) ([]DiscountRule, error) {
+	rows, err := q.db.Query(ctx, getDiscountRules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DiscountRule{}
+	for rows.Next() {
+		var i DiscountRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Kind,
+			&i.Value,
+			&i.GroupID,
+			&i.FromMinute,
+			&i.ToMinute,
+			&i.Starts,
+			&i.Ends,
+			&i.Active,
+			&i.Created,
+			&i.Updated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDiscountUsagesByOrder = `-- name: GetDiscountUsagesByOrder :many
+SELECT id, order_id, promo_code_id, rule_id, customer, title, amount, voided, created
+FROM discount_usages
+WHERE order_id = $1
+ORDER BY id
+`
+
+// GetDiscountUsagesByOrder
+//
+//	SELECT id, order_id, promo_code_id, rule_id, customer, title, amount, voided, created
+//	FROM discount_usages
+//	WHERE order_id = $1
+//	ORDER BY id
+func (q *Queries) GetDiscountUsagesByOrder(ctx context.Context, orderID uuid.UUID) ([]DiscountUsage, error) {
+	rows, err := q.db.Query(ctx, getDiscountUsagesByOrder, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DiscountUsage{}
+	for rows.Next() {
+		var i DiscountUsage
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderID,
+			&i.PromoCodeID,
+			&i.RuleID,
+			&i.Customer,
+			&i.Title,
+			&i.Amount,
+			&i.Voided,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getHeatmapReport = `-- name: GetHeatmapReport :many
+WITH totals AS (SELECT (orders.created AT TIME ZONE 'UTC') AT TIME ZONE $1::TEXT AS local_created,
+                       (SELECT COALESCE(SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT), 0)
+                        FROM jsonb_array_elements(orders.items) AS item) -
+                       (SELECT COALESCE(SUM((discount ->> 'amount')::BIGINT), 0)
+                        FROM jsonb_array_elements(orders.discounts) AS discount) AS total
+                FROM orders
+                WHERE orders.created >= $2::TIMESTAMP
+                  AND orders.created < $3::TIMESTAMP
+                  AND orders.status <> 'cancelled')
+SELECT EXTRACT(ISODOW FROM local_created)::INTEGER AS weekday,
+       EXTRACT(HOUR FROM local_created)::INTEGER   AS hour,
+       COUNT(*)::INTEGER                           AS orders,
+       COALESCE(SUM(total), 0)::BIGINT             AS revenue
+FROM totals
+GROUP BY weekday, hour
+ORDER BY weekday, hour
+`
+
+type GetHeatmapReportParams struct {
+	Tz    string
+	Since time.Time
+	Until time.Time
+}
+
+type GetHeatmapReportRow struct {
+	Weekday int32
+	Hour    int32
+	Orders  int32
+	Revenue int64
+}
+
+// GetHeatmapReport
+//
+//	WITH totals AS (SELECT (orders.created AT TIME ZONE 'UTC') AT TIME ZONE $1::TEXT AS local_created,
+//	                       (SELECT COALESCE(SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT), 0)
+//	                        FROM jsonb_array_elements(orders.items) AS item) -
+//	                       (SELECT COALESCE(SUM((discount ->> 'amount')::BIGINT), 0)
+//	                        FROM jsonb_array_elements(orders.discounts) AS discount) AS total
+//	                FROM orders
+//	                WHERE orders.created >= $2::TIMESTAMP
+//	                  AND orders.created < $3::TIMESTAMP
+//	                  AND orders.status <> 'cancelled')
+//	SELECT EXTRACT(ISODOW FROM local_created)::INTEGER AS weekday,
+//	       EXTRACT(HOUR FROM local_created)::INTEGER   AS hour,
+//	       COUNT(*)::INTEGER                           AS orders,
+//	       COALESCE(SUM(total), 0)::BIGINT             AS revenue
+//	FROM totals
+//	GROUP BY weekday, hour
+//	ORDER BY weekday, hour
+func (q *Queries) GetHeatmapReport(ctx context.Context, arg GetHeatmapReportParams) ([]GetHeatmapReportRow, error) {
+	rows, err := q.db.Query(ctx, getHeatmapReport, arg.Tz, arg.Since, arg.Until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetHeatmapReportRow{}
+	for rows.Next() {
+		var i GetHeatmapReportRow
+		if err := rows.Scan(
+			&i.Weekday,
+			&i.Hour,
+			&i.Orders,
+			&i.Revenue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIngredients = `-- name: GetIngredients :many
+SELECT id, title, unit, stock, low_stock, created, updated
+FROM ingredients
+ORDER BY title
+`
+
+// GetIngredients
+//
+//	SELECT id, title, unit, stock, low_stock, created, updated
+//	FROM ingredients
+//	ORDER BY title
+func (q *Queries) GetIngredients(ctx context.Context) ([]Ingredient, error) {
+	rows, err := q.db.Query(ctx, getIngredients)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Ingredient{}
+	for rows.Next() {
+		var i Ingredient
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Unit,
+			&i.Stock,
+			&i.LowStock,
+			&i.Created,
+			&i.Updated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJob = `-- name: GetJob :one
+SELECT name, last_started, last_finished, last_error, last_duration_ms, last_instance, runs, failures
+FROM jobs
+WHERE name = $1
+`
+
+// GetJob
+//
+//	SELECT name, last_started, last_finished, last_error, last_duration_ms, last_instance, runs, failures
+//	FROM jobs
+//	WHERE name = $1
+func (q *Queries) GetJob(ctx context.Context, name string) (Job, error) {
+	row := q.db.QueryRow(ctx, getJob, name)
+	var i Job
+	err := row.Scan(
+		&i.Name,
+		&i.LastStarted,
+		&i.LastFinished,
+		&i.LastError,
+		&i.LastDurationMs,
+		&i.LastInstance,
+		&i.Runs,
+		&i.Failures,
+	)
+	return i, err
+}
+
+const getJobs = `-- name: GetJobs :many
+SELECT name, last_started, last_finished, last_error, last_duration_ms, last_instance, runs, failures
+FROM jobs
+ORDER BY name
+`
+
+// GetJobs
+//
+//	SELECT name, last_started, last_finished, last_error, last_duration_ms, last_instance, runs, failures
+//	FROM jobs
+//	ORDER BY name
+func (q *Queries) GetJobs(ctx context.Context) ([]Job, error) {
+	rows, err := q.db.Query(ctx, getJobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Job{}
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.Name,
+			&i.LastStarted,
+			&i.LastFinished,
+			&i.LastError,
+			&i.LastDurationMs,
+			&i.LastInstance,
+			&i.Runs,
+			&i.Failures,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getKitchenQueue = `-- name: GetKitchenQueue :many
+SELECT kitchen_tickets.id, kitchen_tickets.order_id, kitchen_tickets.station_id, kitchen_tickets.product_id, kitchen_tickets.title, kitchen_tickets.amount, kitchen_tickets.done, kitchen_tickets.created, kitchen_tickets.bumped, orders.index AS order_index, orders.client_name, orders.client_comment
+FROM kitchen_tickets
+       JOIN orders ON orders.id = kitchen_tickets.order_id
+WHERE kitchen_tickets.station_id = $1
+  AND NOT kitchen_tickets.done
+  AND orders.status = 'open'
+ORDER BY kitchen_tickets.created, kitchen_tickets.title
+`
+
+type GetKitchenQueueRow struct {
+	KitchenTicket KitchenTicket
+	OrderIndex    int64
+	ClientName    string
+	ClientComment *string
+}
+
+// GetKitchenQueue
+//
+//	SELECT kitchen_tickets.id, kitchen_tickets.order_id, kitchen_tickets.station_id, kitchen_tickets.product_id, kitchen_tickets.title, kitchen_tickets.amount, kitchen_tickets.done, kitchen_tickets.created, kitchen_tickets.bumped, orders.index AS order_index, orders.client_name, orders.client_comment
+//	FROM kitchen_tickets
+//	       JOIN orders ON orders.id = kitchen_tickets.order_id
+//	WHERE kitchen_tickets.station_id = $1
+//	  AND NOT kitchen_tickets.done
+//	  AND orders.status = 'open'
+//	ORDER BY kitchen_tickets.created, kitchen_tickets.title
+func (q *Queries) GetKitchenQueue(ctx context.Context, stationID string) ([]GetKitchenQueueRow, error) {
+	rows, err := q.db.Query(ctx, getKitchenQueue, stationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetKitchenQueueRow{}
+	for rows.Next() {
+		var i GetKitchenQueueRow
+		if err := rows.Scan(
+			&i.KitchenTicket.ID,
+			&i.KitchenTicket.OrderID,
+			&i.KitchenTicket.StationID,
+			&i.KitchenTicket.ProductID,
+			&i.KitchenTicket.Title,
+			&i.KitchenTicket.Amount,
+			&i.KitchenTicket.Done,
+			&i.KitchenTicket.Created,
+			&i.KitchenTicket.Bumped,
+			&i.OrderIndex,
+			&i.ClientName,
+			&i.ClientComment,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getKitchenStationByID = `-- name: GetKitchenStationByID :one
+SELECT id, title, created, printer_address
+FROM kitchen_stations
+WHERE id = $1
+`
+
+// GetKitchenStationByID
+//
+//	SELECT id, title, created, printer_address
+//	FROM kitchen_stations
+//	WHERE id = $1
+func (q *Queries) GetKitchenStationByID(ctx context.Context, id string) (KitchenStation, error) {
+	row := q.db.QueryRow(ctx, getKitchenStationByID, id)
+	var i KitchenStation
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Created,
+		&i.PrinterAddress,
+	)
+	return i, err
+}
+
+const getKitchenStations = `-- name: GetKitchenStations :many
+SELECT id, title, created, printer_address
+FROM kitchen_stations
+ORDER BY created
+`
+
+// GetKitchenStations
+//
+//	SELECT id, title, created, printer_address
+//	FROM kitchen_stations
+//	ORDER BY created
+func (q *Queries) GetKitchenStations(ctx context.Context) ([]KitchenStation, error) {
+	rows, err := q.db.Query(ctx, getKitchenStations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []KitchenStation{}
+	for rows.Next() {
+		var i KitchenStation
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Created,
+			&i.PrinterAddress,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getKitchenTicketOrderID = `-- name: GetKitchenTicketOrderID :one
+SELECT order_id
+FROM kitchen_tickets
+WHERE id = $1
+`
+
+// GetKitchenTicketOrderID
+//
+//	SELECT order_id
+//	FROM kitchen_tickets
+//	WHERE id = $1
+func (q *Queries) GetKitchenTicketOrderID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getKitchenTicketOrderID, id)
+	var order_id uuid.UUID
+	err := row.Scan(&order_id)
+	return order_id, err
+}
+
+const getKitchenTicketsByOrder = `-- name: GetKitchenTicketsByOrder :many
+SELECT id, order_id, station_id, product_id, title, amount, done, created, bumped
+FROM kitchen_tickets
+WHERE order_id = $1
+ORDER BY station_id, created, title
+`
+
+// GetKitchenTicketsByOrder
+//
+//	SELECT id, order_id, station_id, product_id, title, amount, done, created, bumped
+//	FROM kitchen_tickets
+//	WHERE order_id = $1
+//	ORDER BY station_id, created, title
+func (q *Queries) GetKitchenTicketsByOrder(ctx context.Context, orderID uuid.UUID) ([]KitchenTicket, error) {
+	rows, err := q.db.Query(ctx, getKitchenTicketsByOrder, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []KitchenTicket{}
+	for rows.Next() {
+		var i KitchenTicket
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderID,
+			&i.StationID,
+			&i.ProductID,
+			&i.Title,
+			&i.Amount,
+			&i.Done,
+			&i.Created,
+			&i.Bumped,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLastZReportDay = `-- name: GetLastZReportDay :one
+SELECT day
+FROM z_reports
+ORDER BY day DESC
+LIMIT 1
+`
+
+// GetLastZReportDay
+//
+//	SELECT day
+//	FROM z_reports
+//	ORDER BY day DESC
+//	LIMIT 1
+func (q *Queries) GetLastZReportDay(ctx context.Context) (time.Time, error) {
+	row := q.db.QueryRow(ctx, getLastZReportDay)
+	var day time.Time
+	err := row.Scan(&day)
+	return day, err
+}
+
+const getLoyaltyBalance = `-- name: GetLoyaltyBalance :one
+SELECT COALESCE(SUM(points), 0)::INTEGER
+FROM loyalty_entries
+WHERE customer_id = $1
+`
+
+// GetLoyaltyBalance
+//
+//	SELECT COALESCE(SUM(points), 0)::INTEGER
+//	FROM loyalty_entries
+//	WHERE customer_id = $1
+func (q *Queries) GetLoyaltyBalance(ctx context.Context, customerID uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, getLoyaltyBalance, customerID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getLoyaltyEntries = `-- name: GetLoyaltyEntries :many
+SELECT id, customer_id, order_id, kind, points, expires, comment, created
+FROM loyalty_entries
+WHERE customer_id = $1
+ORDER BY id DESC
+LIMIT $2
+`
+
+type GetLoyaltyEntriesParams struct {
+	CustomerID uuid.UUID
+	Limit      int64
+}
+
+// GetLoyaltyEntries
+//
+//	SELECT id, customer_id, order_id, kind, points, expires, comment, created
+//	FROM loyalty_entries
+//	WHERE customer_id = $1
+//	ORDER BY id DESC
+//	LIMIT $2
+func (q *Queries) GetLoyaltyEntries(ctx context.Context, arg GetLoyaltyEntriesParams) ([]LoyaltyEntry, error) {
+	rows, err := q.db.Query(ctx, getLoyaltyEntries, arg.CustomerID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LoyaltyEntry{}
+	for rows.Next() {
+		var i LoyaltyEntry
+		if err := rows.Scan(
+			&i.ID,
+			&i.CustomerID,
+			&i.OrderID,
+			&i.Kind,
+			&i.Points,
+			&i.Expires,
+			&i.Comment,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLoyaltyExpiringCustomers = `-- name: GetLoyaltyExpiringCustomers :many
+SELECT customer_id
+FROM loyalty_entries
+GROUP BY customer_id
+HAVING COALESCE(SUM(points) FILTER (WHERE kind = 'earn' AND points > 0 AND expires <= $1::TIMESTAMP), 0) +
+       COALESCE(SUM(points) FILTER (WHERE kind IN ('redeem', 'expire') OR (kind IN ('earn', 'adjust') AND points < 0)), 0) > 0
+`
+
+// spending takes expiring points first, so the points left to expire are the earned ones
+// past their date minus everything spent, expired or taken back so far
+//
+//	SELECT customer_id
+//	FROM loyalty_entries
+//	GROUP BY customer_id
+//	HAVING COALESCE(SUM(points) FILTER (WHERE kind = 'earn' AND points > 0 AND expires <= $1::TIMESTAMP), 0) +
+//	       COALESCE(SUM(points) FILTER (WHERE kind IN ('redeem', 'expire') OR (kind IN ('earn', 'adjust') AND points < 0)), 0) > 0
+func (q *Queries) GetLoyaltyExpiringCustomers(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getLoyaltyExpiringCustomers, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var customer_id uuid.UUID
+		if err := rows.Scan(&customer_id); err != nil {
+			return nil, err
+		}
+		items = append(items, customer_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMenus = `-- name: GetMenus :many
+SELECT id, title, created
+FROM menu
+ORDER BY created
+`
+
+// GetMenus
+//
+//	SELECT id, title, created
+//	FROM menu
+//	ORDER BY created
+func (q *Queries) GetMenus(ctx context.Context) ([]Menu, error) {
+	rows, err := q.db.Query(ctx, getMenus)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Menu{}
+	for rows.Next() {
+		var i Menu
+		if err := rows.Scan(&i.ID, &i.Title, &i.Created); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMigrations = `-- name: GetMigrations :many
+SELECT id, applied, checksum
+FROM migration
+ORDER BY id
+`
+
+// GetMigrations
+//
+//	SELECT id, applied, checksum
+//	FROM migration
+//	ORDER BY id
+func (q *Queries) GetMigrations(ctx context.Context) ([]Migration, error) {
+	rows, err := q.db.Query(ctx, getMigrations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Migration{}
+	for rows.Next() {
+		var i Migration
+		if err := rows.Scan(&i.ID, &i.Applied, &i.Checksum); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOpenOrders = `-- name: GetOpenOrders :many
+SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+FROM orders
+WHERE status = 'open'
+ORDER BY index
+`
+
+// GetOpenOrders
+//
+//	SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+//	FROM orders
+//	WHERE status = 'open'
+//	ORDER BY index
+func (q *Queries) GetOpenOrders(ctx context.Context) ([]Order, error) {
+	rows, err := q.db.Query(ctx, getOpenOrders)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Order{}
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.Index,
+			&i.TableID,
+			&i.Created,
+			&i.Updated,
+			&i.Status,
+			&i.ClientName,
+			&i.ClientComment,
+			&i.Seen,
+			&i.Items,
+			&i.EtaMinutes,
+			&i.ReadyAt,
+			&i.PrepMinutes,
+			&i.QueueMinutes,
+			&i.PickupAt,
+			&i.Discounts,
+			&i.Total,
+			&i.CustomerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOpeningExceptions = `-- name: GetOpeningExceptions :many
+SELECT day, opens, closes, title
+FROM opening_exceptions
+WHERE day >= $1::DATE
+ORDER BY day
+`
+
+// GetOpeningExceptions
+//
+//	SELECT day, opens, closes, title
+//	FROM opening_exceptions
+//	WHERE day >= $1::DATE
+//	ORDER BY day
+func (q *Queries) GetOpeningExceptions(ctx context.Context, since time.Time) ([]OpeningException, error) {
+	rows, err := q.db.Query(ctx, getOpeningExceptions, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OpeningException{}
+	for rows.Next() {
+		var i OpeningException
+		if err := rows.Scan(
+			&i.Day,
+			&i.Opens,
+			&i.Closes,
+			&i.Title,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOpeningHours = `-- name: GetOpeningHours :many
+SELECT weekday, opens, closes
+FROM opening_hours
+ORDER BY weekday
+`
+
+// GetOpeningHours
+//
+//	SELECT weekday, opens, closes
+//	FROM opening_hours
+//	ORDER BY weekday
+func (q *Queries) GetOpeningHours(ctx context.Context) ([]OpeningHour, error) {
+	rows, err := q.db.Query(ctx, getOpeningHours)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OpeningHour{}
+	for rows.Next() {
+		var i OpeningHour
+		if err := rows.Scan(&i.Weekday, &i.Opens, &i.Closes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOrderByID = `-- name: GetOrderByID :one
+SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+FROM orders
+WHERE id = $1
+`
+
+// GetOrderByID
+//
+//	SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+//	FROM orders
+//	WHERE id = $1
+func (q *Queries) GetOrderByID(ctx context.Context, id uuid.UUID) (Order, error) {
+	row := q.db.QueryRow(ctx, getOrderByID, id)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.Index,
+		&i.TableID,
+		&i.Created,
+		&i.Updated,
+		&i.Status,
+		&i.ClientName,
+		&i.ClientComment,
+		&i.Seen,
+		&i.Items,
+		&i.EtaMinutes,
+		&i.ReadyAt,
+		&i.PrepMinutes,
+		&i.QueueMinutes,
+		&i.PickupAt,
+		&i.Discounts,
+		&i.Total,
+		&i.CustomerID,
+	)
+	return i, err
+}
+
+const getOrderByIDForUpdate = `-- name: GetOrderByIDForUpdate :one
+SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+FROM orders
+WHERE id = $1
+  FOR UPDATE
+`
+
+// GetOrderByIDForUpdate
+//
+//	SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+//	FROM orders
+//	WHERE id = $1
+//	  FOR UPDATE
+func (q *Queries) GetOrderByIDForUpdate(ctx context.Context, id uuid.UUID) (Order, error) {
+	row := q.db.QueryRow(ctx, getOrderByIDForUpdate, id)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.Index,
+		&i.TableID,
+		&i.Created,
+		&i.Updated,
+		&i.Status,
+		&i.ClientName,
+		&i.ClientComment,
+		&i.Seen,
+		&i.Items,
+		&i.EtaMinutes,
+		&i.ReadyAt,
+		&i.PrepMinutes,
+		&i.QueueMinutes,
+		&i.PickupAt,
+		&i.Discounts,
+		&i.Total,
+		&i.CustomerID,
+	)
+	return i, err
+}
+
+const getOrderLoyaltyPoints = `-- name: GetOrderLoyaltyPoints :one
+SELECT COALESCE(SUM(points) FILTER (WHERE kind = 'earn'), 0)::INTEGER   AS earned,
+       COALESCE(SUM(points) FILTER (WHERE kind = 'redeem'), 0)::INTEGER AS redeemed
+FROM loyalty_entries
+WHERE order_id = $1
+`
+
+type GetOrderLoyaltyPointsRow struct {
+	Earned   int32
+	Redeemed int32
+}
+
+// GetOrderLoyaltyPoints
+//
+//	SELECT COALESCE(SUM(points) FILTER (WHERE kind = 'earn'), 0)::INTEGER   AS earned,
+//	       COALESCE(SUM(points) FILTER (WHERE kind = 'redeem'), 0)::INTEGER AS redeemed
+//	FROM loyalty_entries
+//	WHERE order_id = $1
+func (q *Queries) GetOrderLoyaltyPoints(ctx context.Context, orderID *uuid.UUID) (GetOrderLoyaltyPointsRow, error) {
+	row := q.db.QueryRow(ctx, getOrderLoyaltyPoints, orderID)
+	var i GetOrderLoyaltyPointsRow
+	err := row.Scan(&i.Earned, &i.Redeemed)
+	return i, err
+}
+
+const getOrderPrepTimings = `-- name: GetOrderPrepTimings :many
+SELECT orders.created, orders.prep_minutes::INTEGER AS prep_minutes, MIN(order_status_history.created)::TIMESTAMP AS done
+FROM orders
+       JOIN order_status_history ON order_status_history.order_id = orders.id
+WHERE orders.created >= $1::TIMESTAMP
+  AND orders.prep_minutes IS NOT NULL
+  AND orders.queue_minutes = 0
+  AND order_status_history.status IN ('ready', 'closed')
+GROUP BY orders.id
+`
+
+type GetOrderPrepTimingsRow struct {
+	Created     time.Time
+	PrepMinutes int32
+	Done        time.Time
+}
+
+// only orders that went to the kitchen right away, the time they took is all preparation
+//
+//	SELECT orders.created, orders.prep_minutes::INTEGER AS prep_minutes, MIN(order_status_history.created)::TIMESTAMP AS done
+//	FROM orders
+//	       JOIN order_status_history ON order_status_history.order_id = orders.id
+//	WHERE orders.created >= $1::TIMESTAMP
+//	  AND orders.prep_minutes IS NOT NULL
+//	  AND orders.queue_minutes = 0
+//	  AND order_status_history.status IN ('ready', 'closed')
+//	GROUP BY orders.id
+func (q *Queries) GetOrderPrepTimings(ctx context.Context, since time.Time) ([]GetOrderPrepTimingsRow, error) {
+	rows, err := q.db.Query(ctx, getOrderPrepTimings, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetOrderPrepTimingsRow{}
+	for rows.Next() {
+		var i GetOrderPrepTimingsRow
+		if err := rows.Scan(&i.Created, &i.PrepMinutes, &i.Done); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParams = `-- name: GetParams :one
+SELECT id, header_text, header_deadline, ordering_paused, slot_minutes, slot_max_orders, slot_max_items, closed_until, closed_reason, max_lines, max_line_quantity, min_order_total, max_order_total, loyalty_earn_percent, loyalty_max_redeem_percent, loyalty_expire_days
+FROM params
+WHERE id = 1
+`
+
+// GetParams
+//
+//	SELECT id, header_text, header_deadline, ordering_paused, slot_minutes, slot_max_orders, slot_max_items, closed_until, closed_reason, max_lines, max_line_quantity, min_order_total, max_order_total, loyalty_earn_percent, loyalty_max_redeem_percent, loyalty_expire_days
+//	FROM params
+//	WHERE id = 1
+func (q *Queries) GetParams(ctx context.Context) (Param, error) {
+	row := q.db.QueryRow(ctx, getParams)
+	var i Param
+	err := row.Scan(
+		&i.ID,
+		&i.HeaderText,
+		&i.HeaderDeadline,
+		&i.OrderingPaused,
+		&i.SlotMinutes,
+		&i.SlotMaxOrders,
+		&i.SlotMaxItems,
+		&i.ClosedUntil,
+		&i.ClosedReason,
+		&i.MaxLines,
+		&i.MaxLineQuantity,
+		&i.MinOrderTotal,
+		&i.MaxOrderTotal,
+		&i.LoyaltyEarnPercent,
+		&i.LoyaltyMaxRedeemPercent,
+		&i.LoyaltyExpireDays,
+	)
+	return i, err
+}
+
+const getPointsReport = `-- name: GetPointsReport :one
+SELECT COUNT(*)::INTEGER                             AS orders,
+       COALESCE(SUM(discount.amount), 0)::BIGINT    AS amount,
+       COALESCE(SUM(discount.points), 0)::INTEGER   AS points
+FROM orders,
+     LATERAL (SELECT SUM((line ->> 'amount')::BIGINT) AS amount,
+                     SUM((line ->> 'points')::INTEGER) AS points
+              FROM jsonb_array_elements(orders.discounts) AS line
+              WHERE line ->> 'points' IS NOT NULL) AS discount
+WHERE orders.created >= $1::TIMESTAMP
+  AND orders.created < $2::TIMESTAMP
+  AND orders.status <> 'cancelled'
+  AND discount.points IS NOT NULL
+`
+
+type GetPointsReportParams struct {
+	Since time.Time
+	Until time.Time
+}
+
+type GetPointsReportRow struct {
+	Orders int32
+	Amount int64
+	Points int32
+}
+
+// points are paid with the order discount line, they are kept out of discount_usages
+//
+//	SELECT COUNT(*)::INTEGER                             AS orders,
+//	       COALESCE(SUM(discount.amount), 0)::BIGINT    AS amount,
+//	       COALESCE(SUM(discount.points), 0)::INTEGER   AS points
+//	FROM orders,
+//	     LATERAL (SELECT SUM((line ->> 'amount')::BIGINT) AS amount,
+//	                     SUM((line ->> 'points')::INTEGER) AS points
+//	              FROM jsonb_array_elements(orders.discounts) AS line
+//	              WHERE line ->> 'points' IS NOT NULL) AS discount
+//	WHERE orders.created >= $1::TIMESTAMP
+//	  AND orders.created < $2::TIMESTAMP
+//	  AND orders.status <> 'cancelled'
+//	  AND discount.points IS NOT NULL
+func (q *Queries) GetPointsReport(ctx context.Context, arg GetPointsReportParams) (GetPointsReportRow, error) {
+	row := q.db.QueryRow(ctx, getPointsReport, arg.Since, arg.Until)
+	var i GetPointsReportRow
+	err := row.Scan(&i.Orders, &i.Amount, &i.Points)
+	return i, err
+}
+
+const getProductByID = `-- name: GetProductByID :one
+SELECT id, group_id, index, title, description, price, available, created, updated, prep_minutes, max_quantity, stock, low_stock, auto_stopped
+FROM products
+WHERE id = $1
+`
+
+// GetProductByID
+//
+//	SELECT id, group_id, index, title, description, price, available, created, updated, prep_minutes, max_quantity, stock, low_stock, auto_stopped
+//	FROM products
+//	WHERE id = $1
+func (q *Queries) GetProductByID(ctx context.Context, id uuid.UUID) (Product, error) {
+	row := q.db.QueryRow(ctx, getProductByID, id)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.GroupID,
+		&i.Index,
+		&i.Title,
+		&i.Description,
+		&i.Price,
+		&i.Available,
+		&i.Created,
+		&i.Updated,
+		&i.PrepMinutes,
+		&i.MaxQuantity,
+		&i.Stock,
+		&i.LowStock,
+		&i.AutoStopped,
+	)
+	return i, err
+}
+
+const getProductGroupByID = `-- name: GetProductGroupByID :one
+SELECT id, menu_id, index, title, created, updated, prep_minutes, station_id
+FROM product_groups
+WHERE id = $1
+`
+
+// GetProductGroupByID
+//
+//	SELECT id, menu_id, index, title, created, updated, prep_minutes, station_id
+//	FROM product_groups
+//	WHERE id = $1
+func (q *Queries) GetProductGroupByID(ctx context.Context, id uuid.UUID) (ProductGroup, error) {
+	row := q.db.QueryRow(ctx, getProductGroupByID, id)
+	var i ProductGroup
+	err := row.Scan(
+		&i.ID,
+		&i.MenuID,
+		&i.Index,
+		&i.Title,
+		&i.Created,
+		&i.Updated,
+		&i.PrepMinutes,
+		&i.StationID,
+	)
+	return i, err
+}
+
+const getProductPrepTimes = `-- name: GetProductPrepTimes :many
+SELECT products.id, COALESCE(products.prep_minutes, product_groups.prep_minutes, 0)::INTEGER AS prep_minutes
+FROM products
+       JOIN product_groups ON product_groups.id = products.group_id
+`
+
+type GetProductPrepTimesRow struct {
+	ID          uuid.UUID
+	PrepMinutes int32
+}
+
+// GetProductPrepTimes
+//
+//	SELECT products.id, COALESCE(products.prep_minutes, product_groups.prep_minutes, 0)::INTEGER AS prep_minutes
+//	FROM products
+//	       JOIN product_groups ON product_groups.id = products.group_id
+func (q *Queries) GetProductPrepTimes(ctx context.Context) ([]GetProductPrepTimesRow, error) {
+	rows, err := q.db.Query(ctx, getProductPrepTimes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetProductPrepTimesRow{}
+	for rows.Next() {
+		var i GetProductPrepTimesRow
+		if err := rows.Scan(&i.ID, &i.PrepMinutes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProductsByGroup = `-- name: GetProductsByGroup :many
+SELECT id, group_id, index, title, description, price, available, created, updated, prep_minutes, max_quantity, stock, low_stock, auto_stopped
+FROM products
+WHERE group_id = $1
+ORDER BY index
+`
+
+// GetProductsByGroup
+//
+//	SELECT id, group_id, index, title, description, price, available, created, updated, prep_minutes, max_quantity, stock, low_stock, auto_stopped
+//	FROM products
+//	WHERE group_id = $1
+//	ORDER BY index
+func (q *Queries) GetProductsByGroup(ctx context.Context, groupID uuid.UUID) ([]Product, error) {
+	rows, err := q.db.Query(ctx, getProductsByGroup, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.GroupID,
+			&i.Index,
+			&i.Title,
+			&i.Description,
+			&i.Price,
+			&i.Available,
+			&i.Created,
+			&i.Updated,
+			&i.PrepMinutes,
+			&i.MaxQuantity,
+			&i.Stock,
+			&i.LowStock,
+			&i.AutoStopped,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPromoCodeByCode = `-- name: GetPromoCodeByCode :one
+SELECT id, code, kind, value, min_order_total, max_uses, max_uses_per_customer, starts, ends, active, created, updated
+FROM promo_codes
+WHERE code = $1
+`
+
+// GetPromoCodeByCode
+//
+//	SELECT id, code, kind, value, min_order_total, max_uses, max_uses_per_customer, starts, ends, active, created, updated
+//	FROM promo_codes
+//	WHERE code = $1
+func (q *Queries) GetPromoCodeByCode(ctx context.Context, code string) (PromoCode, error) {
+	row := q.db.QueryRow(ctx, getPromoCodeByCode, code)
+	var i PromoCode
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Kind,
+		&i.Value,
+		&i.MinOrderTotal,
+		&i.MaxUses,
+		&i.MaxUsesPerCustomer,
+		&i.Starts,
+		&i.Ends,
+		&i.Active,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const getPromoCodeByID = `-- name: GetPromoCodeByID :one
+SELECT id, code, kind, value, min_order_total, max_uses, max_uses_per_customer, starts, ends, active, created, updated
+FROM promo_codes
+WHERE id = $1
+`
+
+// GetPromoCodeByID
+//
+//	SELECT id, code, kind, value, min_order_total, max_uses, max_uses_per_customer, starts, ends, active, created, updated
+//	FROM promo_codes
+//	WHERE id = $1
+func (q *Queries) GetPromoCodeByID(ctx context.Context, id uuid.UUID) (PromoCode, error) {
+	row := q.db.QueryRow(ctx, getPromoCodeByID, id)
+	var i PromoCode
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Kind,
+		&i.Value,
+		&i.MinOrderTotal,
+		&i.MaxUses,
+		&i.MaxUsesPerCustomer,
+		&i.Starts,
+		&i.Ends,
+		&i.Active,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const getPromoCodes = `-- name: GetPromoCodes :many
+SELECT promo_codes.id, promo_codes.code, promo_codes.kind, promo_codes.value, promo_codes.min_order_total, promo_codes.max_uses, promo_codes.max_uses_per_customer, promo_codes.starts, promo_codes.ends, promo_codes.active, promo_codes.created, promo_codes.updated,
+       (SELECT COUNT(*)
+        FROM discount_usages
+        WHERE discount_usages.promo_code_id = promo_codes.id
+          AND NOT discount_usages.voided)::INTEGER AS uses
+FROM promo_codes
+ORDER BY created DESC
+`
+
+type GetPromoCodesRow struct {
+	ID                 uuid.UUID
+	Code               string
+	Kind               api.DiscountKind
+	Value              int64
+	MinOrderTotal      *money.Kopecks
+	MaxUses            *int32
+	MaxUsesPerCustomer *int32
+	Starts             *time.Time
+	Ends               *time.Time
+	Active             bool
+	Created            time.Time
+	Updated            time.Time
+	Uses               int32
+}
+
+// GetPromoCodes
+//
+//	SELECT promo_codes.id, promo_codes.code, promo_codes.kind, promo_codes.value, promo_codes.min_order_total, promo_codes.max_uses, promo_codes.max_uses_per_customer, promo_codes.starts, promo_codes.ends, promo_codes.active, promo_codes.created, promo_codes.updated,
+//	       (SELECT COUNT(*)
+//	        FROM discount_usages
+//	        WHERE discount_usages.promo_code_id = promo_codes.id
+//	          AND NOT discount_usages.voided)::INTEGER AS uses
+//	FROM promo_codes
+//	ORDER BY created DESC
+func (q *Queries) GetPromoCodes(ctx context.Context) ([]GetPromoCodesRow, error) {
+	rows, err := q.db.Query(ctx, getPromoCodes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPromoCodesRow{}
+	for rows.Next() {
+		var i GetPromoCodesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Kind,
+			&i.Value,
+			&i.MinOrderTotal,
+			&i.MaxUses,
+			&i.MaxUsesPerCustomer,
+			&i.Starts,
+			&i.Ends,
+			&i.Active,
+			&i.Created,
+			&i.Updated,
+			&i.Uses,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecipes = `-- name: GetRecipes :many
+SELECT product_id, ingredient_id, quantity
+FROM product_ingredients
+ORDER BY product_id, ingredient_id
+`
+
+// GetRecipes
+//
+//	SELECT product_id, ingredient_id, quantity
+//	FROM product_ingredients
+//	ORDER BY product_id, ingredient_id
+func (q *Queries) GetRecipes(ctx context.Context) ([]ProductIngredient, error) {
+	rows, err := q.db.Query(ctx, getRecipes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductIngredient{}
+	for rows.Next() {
+		var i ProductIngredient
+		if err := rows.Scan(&i.ProductID, &i.IngredientID, &i.Quantity); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecipesByProducts = `-- name: GetRecipesByProducts :many
+SELECT product_id, ingredient_id, quantity
+FROM product_ingredients
+WHERE product_id = ANY ($1::UUID[])
+`
+
+// GetRecipesByProducts
+//
+//	SELECT product_id, ingredient_id, quantity
+//	FROM product_ingredients
+//	WHERE product_id = ANY ($1::UUID[])
+func (q *Queries) GetRecipesByProducts(ctx context.Context, productIds []uuid.UUID) ([]ProductIngredient, error) {
+	rows, err := q.db.Query(ctx, getRecipesByProducts, productIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductIngredient{}
+	for rows.Next() {
+		var i ProductIngredient
+		if err := rows.Scan(&i.ProductID, &i.IngredientID, &i.Quantity); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSalesReport = `-- name: GetSalesReport :many
+WITH totals AS (SELECT (orders.created AT TIME ZONE 'UTC') AT TIME ZONE $2::TEXT AS local_created,
+                       (SELECT COALESCE(SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT), 0)
+                        FROM jsonb_array_elements(orders.items) AS item) -
+                       (SELECT COALESCE(SUM((discount ->> 'amount')::BIGINT), 0)
+                        FROM jsonb_array_elements(orders.discounts) AS discount) AS total
+                FROM orders
+                WHERE orders.created >= $3::TIMESTAMP
+                  AND orders.created < $4::TIMESTAMP
+                  AND orders.status <> 'cancelled')
+SELECT date_trunc($1::TEXT, local_created)::TIMESTAMP AS period,
+       COUNT(*)::INTEGER                                      AS orders,
+       COALESCE(SUM(total), 0)::BIGINT                        AS revenue
+FROM totals
+GROUP BY period
+ORDER BY period
+`
+
+type GetSalesReportParams struct {
+	Granularity string
+	Tz          string
+	Since       time.Time
+	Until       time.Time
+}
+
+type GetSalesReportRow struct {
+	Period  time.Time
+	Orders  int32
+	Revenue int64
+}
+
+// GetSalesReport
+//
+//	WITH totals AS (SELECT (orders.created AT TIME ZONE 'UTC') AT TIME ZONE $2::TEXT AS local_created,
+//	                       (SELECT COALESCE(SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT), 0)
+//	                        FROM jsonb_array_elements(orders.items) AS item) -
+//	                       (SELECT COALESCE(SUM((discount ->> 'amount')::BIGINT), 0)
+//	                        FROM jsonb_array_elements(orders.discounts) AS discount) AS total
+//	                FROM orders
+//	                WHERE orders.created >= $3::TIMESTAMP
+//	                  AND orders.created < $4::TIMESTAMP
+//	                  AND orders.status <> 'cancelled')
+//	SELECT date_trunc($1::TEXT, local_created)::TIMESTAMP AS period,
+//	       COUNT(*)::INTEGER                                      AS orders,
+//	       COALESCE(SUM(total), 0)::BIGINT                        AS revenue
+//	FROM totals
+//	GROUP BY period
+//	ORDER BY period
+func (q *Queries) GetSalesReport(ctx context.Context, arg GetSalesReportParams) ([]GetSalesReportRow, error) {
+	rows, err := q.db.Query(ctx, getSalesReport,
+		arg.Granularity,
+		arg.Tz,
+		arg.Since,
+		arg.Until,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSalesReportRow{}
+	for rows.Next() {
+		var i GetSalesReportRow
+		if err := rows.Scan(&i.Period, &i.Orders, &i.Revenue); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSlotLoad = `-- name: GetSlotLoad :many
+SELECT orders.pickup_at::TIMESTAMP AS pickup_at,
+       COUNT(*)::INTEGER           AS orders,
+       COALESCE(SUM((SELECT SUM((item ->> 'amount')::INTEGER)
+                     FROM jsonb_array_elements(orders.items) AS item)), 0)::INTEGER AS items
+FROM orders
+WHERE orders.pickup_at >= $1::TIMESTAMP
+  AND orders.pickup_at < $2::TIMESTAMP
+  AND orders.status <> 'cancelled'
+GROUP BY orders.pickup_at
+`
+
+type GetSlotLoadParams struct {
+	Since time.Time
+	Until time.Time
+}
+
+type GetSlotLoadRow struct {
+	PickupAt time.Time
+	Orders   int32
+	Items    int32
+}
+
+// GetSlotLoad
+//
+//	SELECT orders.pickup_at::TIMESTAMP AS pickup_at,
+//	       COUNT(*)::INTEGER           AS orders,
+//	       COALESCE(SUM((SELECT SUM((item ->> 'amount')::INTEGER)
+//	                     FROM jsonb_array_elements(orders.items) AS item)), 0)::INTEGER AS items
+//	FROM orders
+//	WHERE orders.pickup_at >= $1::TIMESTAMP
+//	  AND orders.pickup_at < $2::TIMESTAMP
+//	  AND orders.status <> 'cancelled'
+//	GROUP BY orders.pickup_at
+func (q *Queries) GetSlotLoad(ctx context.Context, arg GetSlotLoadParams) ([]GetSlotLoadRow, error) {
+	rows, err := q.db.Query(ctx, getSlotLoad, arg.Since, arg.Until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSlotLoadRow{}
+	for rows.Next() {
+		var i GetSlotLoadRow
+		if err := rows.Scan(&i.PickupAt, &i.Orders, &i.Items); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStatusDurationReport = `-- name: GetStatusDurationReport :many
+WITH durations AS (SELECT order_status_history.status,
+                          EXTRACT(EPOCH FROM LEAD(order_status_history.created)
+                                             OVER (PARTITION BY order_status_history.order_id ORDER BY order_status_history.created, order_status_history.id) -
+                                             order_status_history.created) AS seconds
+                   FROM order_status_history
+                          JOIN orders ON orders.id = order_status_history.order_id
+                   WHERE orders.created >= $1::TIMESTAMP
+                     AND orders.created < $2::TIMESTAMP)
+SELECT status::TEXT                                                             AS status,
+       COUNT(*)::INTEGER                                                        AS transitions,
+       (percentile_cont(0.5) WITHIN GROUP (ORDER BY seconds))::DOUBLE PRECISION  AS p50,
+       (percentile_cont(0.9) WITHIN GROUP (ORDER BY seconds))::DOUBLE PRECISION  AS p90,
+       (percentile_cont(0.95) WITHIN GROUP (ORDER BY seconds))::DOUBLE PRECISION AS p95
+FROM durations
+WHERE seconds IS NOT NULL
+GROUP BY status
+ORDER BY status
+`
+
+type GetStatusDurationReportParams struct {
+	Since time.Time
+	Until time.Time
+}
+
+type GetStatusDurationReportRow struct {
+	Status      string
+	Transitions int32
+	P50         float64
+	P90         float64
+	P95         float64
+}
+
+// GetStatusDurationReport
+//
+//	WITH durations AS (SELECT order_status_history.status,
+//	                          EXTRACT(EPOCH FROM LEAD(order_status_history.created)
+//	                                             OVER (PARTITION BY order_status_history.order_id ORDER BY order_status_history.created, order_status_history.id) -
+//	                                             order_status_history.created) AS seconds
+//	                   FROM order_status_history
+//	                          JOIN orders ON orders.id = order_status_history.order_id
+//	                   WHERE orders.created >= $1::TIMESTAMP
+//	                     AND orders.created < $2::TIMESTAMP)
+//	SELECT status::TEXT                                                             AS status,
+//	       COUNT(*)::INTEGER                                                        AS transitions,
+//	       (percentile_cont(0.5) WITHIN GROUP (ORDER BY seconds))::DOUBLE PRECISION  AS p50,
+//	       (percentile_cont(0.9) WITHIN GROUP (ORDER BY seconds))::DOUBLE PRECISION  AS p90,
+//	       (percentile_cont(0.95) WITHIN GROUP (ORDER BY seconds))::DOUBLE PRECISION AS p95
+//	FROM durations
+//	WHERE seconds IS NOT NULL
+//	GROUP BY status
+//	ORDER BY status
+func (q *Queries) GetStatusDurationReport(ctx context.Context, arg GetStatusDurationReportParams) ([]GetStatusDurationReportRow, error) {
+	rows, err := q.db.Query(ctx, getStatusDurationReport, arg.Since, arg.Until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetStatusDurationReportRow{}
+	for rows.Next() {
+		var i GetStatusDurationReportRow
+		if err := rows.Scan(
+			&i.Status,
+			&i.Transitions,
+			&i.P50,
+			&i.P90,
+			&i.P95,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTopGroupsReport = `-- name: GetTopGroupsReport :many
+SELECT product_groups.id                                                      AS group_id,
+       product_groups.title,
+       SUM((item ->> 'amount')::BIGINT)::BIGINT                               AS quantity,
+       SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT)::BIGINT AS revenue
+FROM orders
+       CROSS JOIN LATERAL jsonb_array_elements(orders.items) AS item
+       JOIN products ON products.id = (item ->> 'id')::UUID
+       JOIN product_groups ON product_groups.id = products.group_id
+WHERE orders.created >= $1::TIMESTAMP
+  AND orders.created < $2::TIMESTAMP
+  AND orders.status <> 'cancelled'
+GROUP BY product_groups.id, product_groups.title
+ORDER BY revenue DESC, quantity DESC
+LIMIT $3
+`
+
+type GetTopGroupsReportParams struct {
+	Since    time.Time
+	Until    time.Time
+	RowLimit int32
+}
+
+type GetTopGroupsReportRow struct {
+	GroupID  uuid.UUID
+	Title    string
+	Quantity int64
+	Revenue  int64
+}
+
+// GetTopGroupsReport
+//
+//	SELECT product_groups.id                                                      AS group_id,
+//	       product_groups.title,
+//	       SUM((item ->> 'amount')::BIGINT)::BIGINT                               AS quantity,
+//	       SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT)::BIGINT AS revenue
+//	FROM orders
+//	       CROSS JOIN LATERAL jsonb_array_elements(orders.items) AS item
+//	       JOIN products ON products.id = (item ->> 'id')::UUID
+//	       JOIN product_groups ON product_groups.id = products.group_id
+//	WHERE orders.created >= $1::TIMESTAMP
+//	  AND orders.created < $2::TIMESTAMP
+//	  AND orders.status <> 'cancelled'
+//	GROUP BY product_groups.id, product_groups.title
+//	ORDER BY revenue DESC, quantity DESC
+//	LIMIT $3
+func (q *Queries) GetTopGroupsReport(ctx context.Context, arg GetTopGroupsReportParams) ([]GetTopGroupsReportRow, error) {
+	rows, err := q.db.Query(ctx, getTopGroupsReport, arg.Since, arg.Until, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTopGroupsReportRow{}
+	for rows.Next() {
+		var i GetTopGroupsReportRow
+		if err := rows.Scan(
+			&i.GroupID,
+			&i.Title,
+			&i.Quantity,
+			&i.Revenue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTopProductsReport = `-- name: GetTopProductsReport :many
+SELECT (item ->> 'id')::UUID                                                  AS product_id,
+       MAX(item ->> 'title')::TEXT                                            AS title,
+       SUM((item ->> 'amount')::BIGINT)::BIGINT                               AS quantity,
+       SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT)::BIGINT AS revenue
+FROM orders
+       CROSS JOIN LATERAL jsonb_array_elements(orders.items) AS item
+WHERE orders.created >= $1::TIMESTAMP
+  AND orders.created < $2::TIMESTAMP
+  AND orders.status <> 'cancelled'
+GROUP BY product_id
+ORDER BY revenue DESC, quantity DESC
+LIMIT $3
+`
+
+type GetTopProductsReportParams struct {
+	Since    time.Time
+	Until    time.Time
+	RowLimit int32
+}
+
+type GetTopProductsReportRow struct {
+	ProductID uuid.UUID
+	Title     string
+	Quantity  int64
+	Revenue   int64
+}
+
+// GetTopProductsReport
+//
+//	SELECT (item ->> 'id')::UUID                                                  AS product_id,
+//	       MAX(item ->> 'title')::TEXT                                            AS title,
+//	       SUM((item ->> 'amount')::BIGINT)::BIGINT                               AS quantity,
+//	       SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT)::BIGINT AS revenue
+//	FROM orders
+//	       CROSS JOIN LATERAL jsonb_array_elements(orders.items) AS item
+//	WHERE orders.created >= $1::TIMESTAMP
+//	  AND orders.created < $2::TIMESTAMP
+//	  AND orders.status <> 'cancelled'
+//	GROUP BY product_id
+//	ORDER BY revenue DESC, quantity DESC
+//	LIMIT $3
+func (q *Queries) GetTopProductsReport(ctx context.Context, arg GetTopProductsReportParams) ([]GetTopProductsReportRow, error) {
+	rows, err := q.db.Query(ctx, getTopProductsReport, arg.Since, arg.Until, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTopProductsReportRow{}
+	for rows.Next() {
+		var i GetTopProductsReportRow
+		if err := rows.Scan(
+			&i.ProductID,
+			&i.Title,
+			&i.Quantity,
+			&i.Revenue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUndeliveredZReportDays = `-- name: GetUndeliveredZReportDays :many
+SELECT day
+FROM z_reports
+WHERE day >= $1::DATE
+  AND EXISTS (SELECT 1
+              FROM UNNEST($2::TEXT[]) AS target (channel)
+              WHERE NOT EXISTS (SELECT 1
+                                FROM z_report_deliveries
+                                WHERE z_report_deliveries.day = z_reports.day
+                                  AND z_report_deliveries.channel = target.channel))
+ORDER BY day
+`
+
+type GetUndeliveredZReportDaysParams struct {
+	Since    time.Time
+	Channels []string
+}
+
+// GetUndeliveredZReportDays
+//
+//	SELECT day
+//	FROM z_reports
+//	WHERE day >= $1::DATE
+//	  AND EXISTS (SELECT 1
+//	              FROM UNNEST($2::TEXT[]) AS target (channel)
+//	              WHERE NOT EXISTS (SELECT 1
+//	                                FROM z_report_deliveries
+//	                                WHERE z_report_deliveries.day = z_reports.day
+//	                                  AND z_report_deliveries.channel = target.channel))
+//	ORDER BY day
+func (q *Queries) GetUndeliveredZReportDays(ctx context.Context, arg GetUndeliveredZReportDaysParams) ([]time.Time, error) {
+	rows, err := q.db.Query(ctx, getUndeliveredZReportDays, arg.Since, arg.Channels)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []time.Time{}
+	for rows.Next() {
+		var day time.Time
+		if err := rows.Scan(&day); err != nil {
+			return nil, err
+		}
+		items = append(items, day)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getZReport = `-- name: GetZReport :one
+SELECT day, starts, ends, summary, created
+FROM z_reports
+WHERE day = $1
+`
+
+// GetZReport
+//
+//	SELECT day, starts, ends, summary, created
+//	FROM z_reports
+//	WHERE day = $1
+func (q *Queries) GetZReport(ctx context.Context, day time.Time) (ZReport, error) {
+	row := q.db.QueryRow(ctx, getZReport, day)
+	var i ZReport
+	err := row.Scan(
+		&i.Day,
+		&i.Starts,
+		&i.Ends,
+		&i.Summary,
+		&i.Created,
+	)
+	return i, err
+}
+
+const getZReportDeliveredChannels = `-- name: GetZReportDeliveredChannels :many
+SELECT channel
+FROM z_report_deliveries
+WHERE day = $1
+`
+
+// GetZReportDeliveredChannels
+//
+//	SELECT channel
+//	FROM z_report_deliveries
+//	WHERE day = $1
+func (q *Queries) GetZReportDeliveredChannels(ctx context.Context, day time.Time) ([]string, error) {
+	rows, err := q.db.Query(ctx, getZReportDeliveredChannels, day)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var channel string
+		if err := rows.Scan(&channel); err != nil {
+			return nil, err
+		}
+		items = append(items, channel)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getZReportStatuses = `-- name: GetZReportStatuses :many
+SELECT orders.status,
+       COUNT(*)::INTEGER                                                       AS orders,
+       COALESCE(SUM((SELECT SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT)
+                     FROM jsonb_array_elements(orders.items) AS item)), 0)::BIGINT AS gross,
+       COALESCE(SUM((SELECT SUM((discount ->> 'amount')::BIGINT)
+                     FROM jsonb_array_elements(orders.discounts) AS discount
+                     WHERE discount ->> 'points' IS NULL)), 0)::BIGINT AS discount,
+       COALESCE(SUM((SELECT SUM((discount ->> 'amount')::BIGINT)
+                     FROM jsonb_array_elements(orders.discounts) AS discount
+                     WHERE discount ->> 'points' IS NOT NULL)), 0)::BIGINT AS points
+FROM orders
+WHERE orders.created >= $1::TIMESTAMP
+  AND orders.created < $2::TIMESTAMP
+GROUP BY orders.status
+ORDER BY orders.status
+`
+
+type GetZReportStatusesParams struct {
+	Since time.Time
+	Until time.Time
+}
+
+type GetZReportStatusesRow struct {
+	Status   api.OrderStatus
+	Orders   int32
+	Gross    int64
+	Discount int64
+	Points   int64
+}
+
+// GetZReportStatuses
+//
+//	SELECT orders.status,
+//	       COUNT(*)::INTEGER                                                       AS orders,
+//	       COALESCE(SUM((SELECT SUM((item ->> 'price')::BIGINT * (item ->> 'amount')::BIGINT)
+//	                     FROM jsonb_array_elements(orders.items) AS item)), 0)::BIGINT AS gross,
+//	       COALESCE(SUM((SELECT SUM((discount ->> 'amount')::BIGINT)
+//	                     FROM jsonb_array_elements(orders.discounts) AS discount
+//	                     WHERE discount ->> 'points' IS NULL)), 0)::BIGINT AS discount,
+//	       COALESCE(SUM((SELECT SUM((discount ->> 'amount')::BIGINT)
+//	                     FROM jsonb_array_elements(orders.discounts) AS discount
+//	                     WHERE discount ->> 'points' IS NOT NULL)), 0)::BIGINT AS points
+//	FROM orders
+//	WHERE orders.created >= $1::TIMESTAMP
+//	  AND orders.created < $2::TIMESTAMP
+//	GROUP BY orders.status
+//	ORDER BY orders.status
+func (q *Queries) GetZReportStatuses(ctx context.Context, arg GetZReportStatusesParams) ([]GetZReportStatusesRow, error) {
+	rows, err := q.db.Query(ctx, getZReportStatuses, arg.Since, arg.Until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetZReportStatusesRow{}
+	for rows.Next() {
+		var i GetZReportStatusesRow
+		if err := rows.Scan(
+			&i.Status,
+			&i.Orders,
+			&i.Gross,
+			&i.Discount,
+			&i.Points,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getZReports = `-- name: GetZReports :many
+SELECT day, starts, ends, summary, created
+FROM z_reports
+ORDER BY day DESC
+LIMIT $1
+`
+
+// GetZReports
+//
+//	SELECT day, starts, ends, summary, created
+//	FROM z_reports
+//	ORDER BY day DESC
+//	LIMIT $1
+func (q *Queries) GetZReports(ctx context.Context, limit int32) ([]ZReport, error) {
+	rows, err := q.db.Query(ctx, getZReports, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ZReport{}
+	for rows.Next() {
+		var i ZReport
+		if err := rows.Scan(
+			&i.Day,
+			&i.Starts,
+			&i.Ends,
+			&i.Summary,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockMigrations = `-- name: LockMigrations :exec
+SELECT pg_advisory_lock(hashtext('migrations'))
+`
+
+// LockMigrations
+//
+//	SELECT pg_advisory_lock(hashtext('migrations'))
+func (q *Queries) LockMigrations(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockMigrations)
+	return err
+}
+
+const lockPromoCode = `-- name: LockPromoCode :exec
+SELECT id
+FROM promo_codes
+WHERE id = $1
+  FOR UPDATE
+`
+
+// LockPromoCode
+//
+//	SELECT id
+//	FROM promo_codes
+//	WHERE id = $1
+//	  FOR UPDATE
+func (q *Queries) LockPromoCode(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, lockPromoCode, id)
+	return err
+}
+
+const lockSlots = `-- name: LockSlots :exec
+SELECT pg_advisory_xact_lock(hashtext('order_slots'))
+`
+
+// LockSlots
+//
+//	SELECT pg_advisory_xact_lock(hashtext('order_slots'))
+func (q *Queries) LockSlots(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockSlots)
+	return err
+}
+
+const releaseZReport = `-- name: ReleaseZReport :exec
+DELETE
+FROM z_report_claims
+WHERE day = $1
+`
+
+// ReleaseZReport
+//
+//	DELETE
+//	FROM z_report_claims
+//	WHERE day = $1
+func (q *Queries) ReleaseZReport(ctx context.Context, day time.Time) error {
+	_, err := q.db.Exec(ctx, releaseZReport, day)
+	return err
+}
+
+const restoreIngredientStock = `-- name: RestoreIngredientStock :exec
+UPDATE ingredients
+SET stock   = stock + $1::INTEGER,
+    updated = CURRENT_TIMESTAMP
+WHERE id = $2
+`
+
+type RestoreIngredientStockParams struct {
+	Amount int32
+	ID     uuid.UUID
+}
+
+// RestoreIngredientStock
+//
+//	UPDATE ingredients
+//	SET stock   = stock + $1::INTEGER,
+//	    updated = CURRENT_TIMESTAMP
+//	WHERE id = $2
+func (q *Queries) RestoreIngredientStock(ctx context.Context, arg RestoreIngredientStockParams) error {
+	_, err := q.db.Exec(ctx, restoreIngredientStock, arg.Amount, arg.ID)
+	return err
+}
+
+const restoreProductStock = `-- name: RestoreProductStock :exec
+UPDATE products
+SET stock   = stock + $1::INTEGER,
+    updated = CURRENT_TIMESTAMP
+WHERE id = $2
+  AND stock IS NOT NULL
+`
+
+type RestoreProductStockParams struct {
+	Amount int32
+	ID     uuid.UUID
+}
+
+// RestoreProductStock
+//
+//	UPDATE products
+//	SET stock   = stock + $1::INTEGER,
+//	    updated = CURRENT_TIMESTAMP
+//	WHERE id = $2
+//	  AND stock IS NOT NULL
+func (q *Queries) RestoreProductStock(ctx context.Context, arg RestoreProductStockParams) error {
+	_, err := q.db.Exec(ctx, restoreProductStock, arg.Amount, arg.ID)
+	return err
+}
+
+const resumeRestockedProducts = `-- name: ResumeRestockedProducts :many
+UPDATE products
+SET available    = true,
+    auto_stopped = false,
+    updated      = CURRENT_TIMESTAMP
+WHERE auto_stopped
+  AND (stock IS NULL OR stock > 0)
+  AND NOT EXISTS (SELECT 1
+                  FROM product_ingredients
+                         JOIN ingredients ON ingredients.id = product_ingredients.ingredient_id
+                  WHERE product_ingredients.product_id = products.id
+                    AND ingredients.stock < product_ingredients.quantity)
+RETURNING title
+`
+
+// ResumeRestockedProducts
+//
+//	UPDATE products
+//	SET available    = true,
+//	    auto_stopped = false,
+//	    updated      = CURRENT_TIMESTAMP
+//	WHERE auto_stopped
+//	  AND (stock IS NULL OR stock > 0)
+//	  AND NOT EXISTS (SELECT 1
+//	                  FROM product_ingredients
+//	                         JOIN ingredients ON ingredients.id = product_ingredients.ingredient_id
+//	                  WHERE product_ingredients.product_id = products.id
+//	                    AND ingredients.stock < product_ingredients.quantity)
+//	RETURNING title
+func (q *Queries) ResumeRestockedProducts(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, resumeRestockedProducts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var title string
+		if err := rows.Scan(&title); err != nil {
+			return nil, err
+		}
+		items = append(items, title)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchOrdersNewest = `-- name: SearchOrdersNewest :many
+SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+FROM orders
+WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+  AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+  AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+  AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+  AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+  AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+       client_comment ILIKE $6::TEXT)
+  AND ($7::TEXT IS NULL OR
+       items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+         jsonb_build_object('id', $7::TEXT)))))
+  AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+  AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+  AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+  AND index < $11::BIGINT
+ORDER BY index DESC
+LIMIT $12
+`
+
+type SearchOrdersNewestParams struct {
+	Statuses    []string
+	Seen        *bool
+	Since       *time.Time
+	Until       *time.Time
+	TableID     *string
+	Pattern     *string
+	ProductID   *string
+	MinTotal    *int64
+	MaxTotal    *int64
+	CustomerID  *uuid.UUID
+	CursorIndex int64
+	RowLimit    int32
+}
+
+// SearchOrders* differ in the sort only. Each has a literal ORDER BY and a cursor condition
+// on the same columns, so the matching index is walked from the cursor. The first page
+// starts from a cursor before every row
+//
+//	SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+//	FROM orders
+//	WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+//	  AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+//	  AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+//	  AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+//	  AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+//	  AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+//	       client_comment ILIKE $6::TEXT)
+//	  AND ($7::TEXT IS NULL OR
+//	       items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+//	       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+//	         jsonb_build_object('id', $7::TEXT)))))
+//	  AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+//	  AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+//	  AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+//	  AND index < $11::BIGINT
+//	ORDER BY index DESC
+//	LIMIT $12
+func (q *Queries) SearchOrdersNewest(ctx context.Context, arg SearchOrdersNewestParams) ([]Order, error) {
+	rows, err := q.db.Query(ctx, searchOrdersNewest,
+		arg.Statuses,
+		arg.Seen,
+		arg.Since,
+		arg.Until,
+		arg.TableID,
+		arg.Pattern,
+		arg.ProductID,
+		arg.MinTotal,
+		arg.MaxTotal,
+		arg.CustomerID,
+		arg.CursorIndex,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Order{}
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.Index,
+			&i.TableID,
+			&i.Created,
+			&i.Updated,
+			&i.Status,
+			&i.ClientName,
+			&i.ClientComment,
+			&i.Seen,
+			&i.Items,
+			&i.EtaMinutes,
+			&i.ReadyAt,
+			&i.PrepMinutes,
+			&i.QueueMinutes,
+			&i.PickupAt,
+			&i.Discounts,
+			&i.Total,
+			&i.CustomerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchOrdersOldest = `-- name: SearchOrdersOldest :many
+SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+FROM orders
+WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+  AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+  AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+  AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+  AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+  AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+       client_comment ILIKE $6::TEXT)
+  AND ($7::TEXT IS NULL OR
+       items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+         jsonb_build_object('id', $7::TEXT)))))
+  AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+  AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+  AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+  AND index > $11::BIGINT
+ORDER BY index
+LIMIT $12
+`
+
+type SearchOrdersOldestParams struct {
+	Statuses    []string
+	Seen        *bool
+	Since       *time.Time
+	Until       *time.Time
+	TableID     *string
+	Pattern     *string
+	ProductID   *string
+	MinTotal    *int64
+	MaxTotal    *int64
+	CustomerID  *uuid.UUID
+	CursorIndex int64
+	RowLimit    int32
+}
+
+// SearchOrdersOldest
+//
+//	SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+//	FROM orders
+//	WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+//	  AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+//	  AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+//	  AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+//	  AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+//	  AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+//	       client_comment ILIKE $6::TEXT)
+//	  AND ($7::TEXT IS NULL OR
+//	       items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+//	       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+//	         jsonb_build_object('id', $7::TEXT)))))
+//	  AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+//	  AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+//	  AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+//	  AND index > $11::BIGINT
+//	ORDER BY index
+//	LIMIT $12
+func (q *Queries) SearchOrdersOldest(ctx context.Context, arg SearchOrdersOldestParams) ([]Order, error) {
+	rows, err := q.db.Query(ctx, searchOrdersOldest,
+		arg.Statuses,
+		arg.Seen,
+		arg.Since,
+		arg.Until,
+		arg.TableID,
+		arg.Pattern,
+		arg.ProductID,
+		arg.MinTotal,
+		arg.MaxTotal,
+		arg.CustomerID,
+		arg.CursorIndex,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Order{}
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.Index,
+			&i.TableID,
+			&i.Created,
+			&i.Updated,
+			&i.Status,
+			&i.ClientName,
+			&i.ClientComment,
+			&i.Seen,
+			&i.Items,
+			&i.EtaMinutes,
+			&i.ReadyAt,
+			&i.PrepMinutes,
+			&i.QueueMinutes,
+			&i.PickupAt,
+			&i.Discounts,
+			&i.Total,
+			&i.CustomerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchOrdersTotalAsc = `-- name: SearchOrdersTotalAsc :many
+SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+FROM orders
+WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+  AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+  AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+  AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+  AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+  AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+       client_comment ILIKE $6::TEXT)
+  AND ($7::TEXT IS NULL OR
+       items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+         jsonb_build_object('id', $7::TEXT)))))
+  AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+  AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+  AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+  AND (total, index) > ($11::BIGINT, $12::BIGINT)
+ORDER BY total, index
+LIMIT $13
+`
+
+type SearchOrdersTotalAscParams struct {
+	Statuses    []string
+	Seen        *bool
+	Since       *time.Time
+	Until       *time.Time
+	TableID     *string
+	Pattern     *string
+	ProductID   *string
+	MinTotal    *int64
+	MaxTotal    *int64
+	CustomerID  *uuid.UUID
+	CursorTotal int64
+	CursorIndex int64
+	RowLimit    int32
+}
+
+// SearchOrdersTotalAsc
+//
+//	SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+//	FROM orders
+//	WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+//	  AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+//	  AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+//	  AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+//	  AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+//	  AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+//	       client_comment ILIKE $6::TEXT)
+//	  AND ($7::TEXT IS NULL OR
+//	       items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+//	       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+//	         jsonb_build_object('id', $7::TEXT)))))
+//	  AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+//	  AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+//	  AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+//	  AND (total, index) > ($11::BIGINT, $12::BIGINT)
+//	ORDER BY total, index
+//	LIMIT $13
+func (q *Queries) SearchOrdersTotalAsc(ctx context.Context, arg SearchOrdersTotalAscParams) ([]Order, error) {
+	rows, err := q.db.Query(ctx, searchOrdersTotalAsc,
+		arg.Statuses,
+		arg.Seen,
+		arg.Since,
+		arg.Until,
+		arg.TableID,
+		arg.Pattern,
+		arg.ProductID,
+		arg.MinTotal,
+		arg.MaxTotal,
+		arg.CustomerID,
+		arg.CursorTotal,
+		arg.CursorIndex,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Order{}
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.Index,
+			&i.TableID,
+			&i.Created,
+			&i.Updated,
+			&i.Status,
+			&i.ClientName,
+			&i.ClientComment,
+			&i.Seen,
+			&i.Items,
+			&i.EtaMinutes,
+			&i.ReadyAt,
+			&i.PrepMinutes,
+			&i.QueueMinutes,
+			&i.PickupAt,
+			&i.Discounts,
+			&i.Total,
+			&i.CustomerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchOrdersTotalDesc = `-- name: SearchOrdersTotalDesc :many
+SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+FROM orders
+WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+  AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+  AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+  AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+  AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+  AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+       client_comment ILIKE $6::TEXT)
+  AND ($7::TEXT IS NULL OR
+       items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+         jsonb_build_object('id', $7::TEXT)))))
+  AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+  AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+  AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+  AND (total, index) < ($11::BIGINT, $12::BIGINT)
+ORDER BY total DESC, index DESC
+LIMIT $13
+`
+
+type SearchOrdersTotalDescParams struct {
+	Statuses    []string
+	Seen        *bool
+	Since       *time.Time
+	Until       *time.Time
+	TableID     *string
+	Pattern     *string
+	ProductID   *string
+	MinTotal    *int64
+	MaxTotal    *int64
+	CustomerID  *uuid.UUID
+	CursorTotal int64
+	CursorIndex int64
+	RowLimit    int32
+}
+
+// SearchOrdersTotalDesc
+//
+//	SELECT id, index, table_id, created, updated, status, client_name, client_comment, seen, items, eta_minutes, ready_at, prep_minutes, queue_minutes, pickup_at, discounts, total, customer_id
+//	FROM orders
+//	WHERE ($1::TEXT[] IS NULL OR status = ANY ($1::TEXT[]))
+//	  AND ($2::BOOLEAN IS NULL OR seen = $2::BOOLEAN)
+//	  AND ($3::TIMESTAMP IS NULL OR created >= $3::TIMESTAMP)
+//	  AND ($4::TIMESTAMP IS NULL OR created < $4::TIMESTAMP)
+//	  AND ($5::TEXT IS NULL OR table_id = $5::TEXT)
+//	  AND ($6::TEXT IS NULL OR client_name ILIKE $6::TEXT OR
+//	       client_comment ILIKE $6::TEXT)
+//	  AND ($7::TEXT IS NULL OR
+//	       items @> jsonb_build_array(jsonb_build_object('id', $7::TEXT)) OR
+//	       items @> jsonb_build_array(jsonb_build_object('components', jsonb_build_array(
+//	         jsonb_build_object('id', $7::TEXT)))))
+//	  AND ($8::BIGINT IS NULL OR total >= $8::BIGINT)
+//	  AND ($9::BIGINT IS NULL OR total <= $9::BIGINT)
+//	  AND ($10::UUID IS NULL OR customer_id = $10::UUID)
+//	  AND (total, index) < ($11::BIGINT, $12::BIGINT)
+//	ORDER BY total DESC, index DESC
+//	LIMIT $13
+func (q *Queries) SearchOrdersTotalDesc(ctx context.Context, arg SearchOrdersTotalDescParams) ([]Order, error) {
+	rows, err := q.db.Query(ctx, searchOrdersTotalDesc,
+		arg.Statuses,
+		arg.Seen,
+		arg.Since,
+		arg.Until,
+		arg.TableID,
+		arg.Pattern,
+		arg.ProductID,
+		arg.MinTotal,
+		arg.MaxTotal,
+		arg.CustomerID,
+		arg.CursorTotal,
+		arg.CursorIndex,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Order{}
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.Index,
+			&i.TableID,
+			&i.Created,
+			&i.Updated,
+			&i.Status,
+			&i.ClientName,
+			&i.ClientComment,
+			&i.Seen,
+			&i.Items,
+			&i.EtaMinutes,
+			&i.ReadyAt,
+			&i.PrepMinutes,
+			&i.QueueMinutes,
+			&i.PickupAt,
+			&i.Discounts,
+			&i.Total,
+			&i.CustomerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchProducts = `-- name: SearchProducts :many
+SELECT id, group_id, index, title, description, price, available, created, updated, prep_minutes, max_quantity, stock, low_stock, auto_stopped
+FROM products
+WHERE (title ILIKE '%' || $1 || '%' OR description ILIKE '%' || $1 || '%')
+  AND available = true
+ORDER BY title
+`
+
+// SearchProducts
+//
+//	SELECT id, group_id, index, title, description, price, available, created, updated, prep_minutes, max_quantity, stock, low_stock, auto_stopped
+//	FROM products
+//	WHERE (title ILIKE '%' || $1 || '%' OR description ILIKE '%' || $1 || '%')
+//	  AND available = true
+//	ORDER BY title
+func (q *Queries) SearchProducts(ctx context.Context, dollar_1 *string) ([]Product, error) {
+	rows, err := q.db.Query(ctx, searchProducts, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.GroupID,
+			&i.Index,
+			&i.Title,
+			&i.Description,
+			&i.Price,
+			&i.Available,
+			&i.Created,
+			&i.Updated,
+			&i.PrepMinutes,
+			&i.MaxQuantity,
+			&i.Stock,
+			&i.LowStock,
+			&i.AutoStopped,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setDiscountUsagesVoided = `-- name: SetDiscountUsagesVoided :exec
+UPDATE discount_usages
+SET voided = $1
+WHERE order_id = $2
+`
+
+type SetDiscountUsagesVoidedParams struct {
+	Voided  bool
+	OrderID uuid.UUID
+}
+
+// SetDiscountUsagesVoided
+//
+//	UPDATE discount_usages
+//	SET voided = $1
+//	WHERE order_id = $2
+func (q *Queries) SetDiscountUsagesVoided(ctx context.Context, arg SetDiscountUsagesVoidedParams) error {
+	_, err := q.db.Exec(ctx, setDiscountUsagesVoided, arg.Voided, arg.OrderID)
+	return err
+}
+
+const setHeaderAnnouncement = `-- name: SetHeaderAnnouncement :exec
+INSERT INTO announcements (id, text, ends)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO UPDATE SET text    = excluded.text,
+                               ends    = excluded.ends,
+                               updated = CURRENT_TIMESTAMP
+`
+
+type SetHeaderAnnouncementParams struct {
+	ID   uuid.UUID
+	Text string
+	Ends *time.Time
+}
+
+// the header banner is a public info announcement
+//
+//	INSERT INTO announcements (id, text, ends)
+//	VALUES ($1, $2, $3)
+//	ON CONFLICT (id) DO UPDATE SET text    = excluded.text,
+//	                               ends    = excluded.ends,
+//	                               updated = CURRENT_TIMESTAMP
+func (q *Queries) SetHeaderAnnouncement(ctx context.Context, arg SetHeaderAnnouncementParams) error {
+	_, err := q.db.Exec(ctx, setHeaderAnnouncement, arg.ID, arg.Text, arg.Ends)
+	return err
+}
+
+const setMigrationChecksum = `-- name: SetMigrationChecksum :exec
+UPDATE migration
+SET checksum = $2
+WHERE id = $1
+`
+
+type SetMigrationChecksumParams struct {
+	ID       string
+	Checksum *string
+}
+
+// SetMigrationChecksum
+//
+//	UPDATE migration
+//	SET checksum = $2
+//	WHERE id = $1
+func (q *Queries) SetMigrationChecksum(ctx context.Context, arg SetMigrationChecksumParams) error {
+	_, err := q.db.Exec(ctx, setMigrationChecksum, arg.ID, arg.Checksum)
+	return err
+}
+
+const setOrderEta = `-- name: SetOrderEta :exec
+UPDATE orders
+SET eta_minutes   = $2,
+    ready_at      = $3,
+    prep_minutes  = $4,
+    queue_minutes = $5
+WHERE id = $1
+`
+
+type SetOrderEtaParams struct {
+	ID           uuid.UUID
+	EtaMinutes   *int32
+	ReadyAt      *time.Time
+	PrepMinutes  *int32
+	QueueMinutes *int32
+}
+
+// SetOrderEta
+//
+//	UPDATE orders
+//	SET eta_minutes   = $2,
+//	    ready_at      = $3,
+//	    prep_minutes  = $4,
+//	    queue_minutes = $5
+//	WHERE id = $1
+func (q *Queries) SetOrderEta(ctx context.Context, arg SetOrderEtaParams) error {
+	_, err := q.db.Exec(ctx, setOrderEta,
+		arg.ID,
+		arg.EtaMinutes,
+		arg.ReadyAt,
+		arg.PrepMinutes,
+		arg.QueueMinutes,
+	)
+	return err
+}
+
+const setOrderReadyAt = `-- name: SetOrderReadyAt :exec
+UPDATE orders
+SET ready_at = $2
+WHERE id = $1
+`
+
+type SetOrderReadyAtParams struct {
+	ID      uuid.UUID
+	ReadyAt *time.Time
+}
+
+// SetOrderReadyAt
+//
+//	UPDATE orders
+//	SET ready_at = $2
+//	WHERE id = $1
+func (q *Queries) SetOrderReadyAt(ctx context.Context, arg SetOrderReadyAtParams) error {
+	_, err := q.db.Exec(ctx, setOrderReadyAt, arg.ID, arg.ReadyAt)
+	return err
+}
+
+const setOrderSeen = `-- name: SetOrderSeen :exec
+UPDATE orders
+SET seen    = true,
+    updated = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+// SetOrderSeen
+//
+//	UPDATE orders
+//	SET seen    = true,
+//	    updated = CURRENT_TIMESTAMP
+//	WHERE id = $1
+func (q *Queries) SetOrderSeen(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, setOrderSeen, id)
+	return err
+}
+
+const setParamsCapacity = `-- name: SetParamsCapacity :exec
+UPDATE params
+SET slot_minutes    = $1,
+    slot_max_orders = $2,
+    slot_max_items  = $3
+WHERE id = 1
+`
+
+type SetParamsCapacityParams struct {
+	SlotMinutes   int32
+	SlotMaxOrders *int32
+	SlotMaxItems  *int32
+}
+
+// SetParamsCapacity
+//
+//	UPDATE params
+//	SET slot_minutes    = $1,
+//	    slot_max_orders = $2,
+//	    slot_max_items  = $3
+//	WHERE id = 1
+func (q *Queries) SetParamsCapacity(ctx context.Context, arg SetParamsCapacityParams) error {
+	_, err := q.db.Exec(ctx, setParamsCapacity, arg.SlotMinutes, arg.SlotMaxOrders, arg.SlotMaxItems)
+	return err
+}
+
+const setParamsClosedUntil = `-- name: SetParamsClosedUntil :exec
+UPDATE params
+SET closed_until  = $1,
+    closed_reason = $2
+WHERE id = 1
+`
+
+type SetParamsClosedUntilParams struct {
+	ClosedUntil  *time.Time
+	ClosedReason *string
+}
+
+// SetParamsClosedUntil
+//
+//	UPDATE params
+//	SET closed_until  = $1,
+//	    closed_reason = $2
+//	WHERE id = 1
+func (q *Queries) SetParamsClosedUntil(ctx context.Context, arg SetParamsClosedUntilParams) error {
+	_, err := q.db.Exec(ctx, setParamsClosedUntil, arg.ClosedUntil, arg.ClosedReason)
+	return err
+}
+
+const setParamsLoyaltyPolicy = `-- name: SetParamsLoyaltyPolicy :exec
+UPDATE params
+SET loyalty_earn_percent       = $1,
+    loyalty_max_redeem_percent = $2,
+    loyalty_expire_days        = $3
+WHERE id = 1
+`
+
+type SetParamsLoyaltyPolicyParams struct {
+	LoyaltyEarnPercent      int32
+	LoyaltyMaxRedeemPercent int32
+	LoyaltyExpireDays       *int32
+}
+
+// SetParamsLoyaltyPolicy
+//
+//	UPDATE params
+//	SET loyalty_earn_percent       = $1,
+//	    loyalty_max_redeem_percent = $2,
+//	    loyalty_expire_days        = $3
+//	WHERE id = 1
+func (q *Queries) SetParamsLoyaltyPolicy(ctx context.Context, arg SetParamsLoyaltyPolicyParams) error {
+	_, err := q.db.Exec(ctx, setParamsLoyaltyPolicy, arg.LoyaltyEarnPercent, arg.LoyaltyMaxRedeemPercent, arg.LoyaltyExpireDays)
+	return err
+}
+
+const setParamsOrderPolicy = `-- name: SetParamsOrderPolicy :exec
+UPDATE params
+SET max_lines         = $1,
+    max_line_quantity = $2,
+    min_order_total   = $3,
+    max_order_total   = $4
+WHERE id = 1
+`
+
+type SetParamsOrderPolicyParams struct {
+	MaxLines        int32
+	MaxLineQuantity int32
+	MinOrderTotal   *money.Kopecks
+	MaxOrderTotal   *money.Kopecks
+}
+
+// SetParamsOrderPolicy
+//
+//	UPDATE params
+//	SET max_lines         = $1,
+//	    max_line_quantity = $2,
+//	    min_order_total   = $3,
+//	    max_order_total   = $4
+//	WHERE id = 1
+func (q *Queries) SetParamsOrderPolicy(ctx context.Context, arg SetParamsOrderPolicyParams) error {
+	_, err := q.db.Exec(ctx, setParamsOrderPolicy,
+		arg.MaxLines,
+		arg.MaxLineQuantity,
+		arg.MinOrderTotal,
+		arg.MaxOrderTotal,
+	)
+	return err
+}
+
+const setParamsOrderingPaused = `-- name: SetParamsOrderingPaused :exec
+UPDATE params
+SET ordering_paused = $1
+WHERE id = 1
+`
+
+// SetParamsOrderingPaused
+//
+//	UPDATE params
+//	SET ordering_paused = $1
+//	WHERE id = 1
+func (q *Queries) SetParamsOrderingPaused(ctx context.Context, orderingPaused bool) error {
+	_, err := q.db.Exec(ctx, setParamsOrderingPaused, orderingPaused)
+	return err
+}
+
+const setProductAvailability = `-- name: SetProductAvailability :exec
+UPDATE products
+SET available    = $2,
+    auto_stopped = false,
+    updated      = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+type SetProductAvailabilityParams struct {
+	ID        uuid.UUID
+	Available bool
+}
+
+// SetProductAvailability
+//
+//	UPDATE products
+//	SET available    = $2,
+//	    auto_stopped = false,
+//	    updated      = CURRENT_TIMESTAMP
+//	WHERE id = $1
+func (q *Queries) SetProductAvailability(ctx context.Context, arg SetProductAvailabilityParams) error {
+	_, err := q.db.Exec(ctx, setProductAvailability, arg.ID, arg.Available)
+	return err
+}
+
+const setProductGroupStation = `-- name: SetProductGroupStation :exec
+UPDATE product_groups
+SET station_id = $2,
+    updated    = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+type SetProductGroupStationParams struct {
+	ID        uuid.UUID
+	StationID *string
+}
+
+// SetProductGroupStation
+//
+//	UPDATE product_groups
+//	SET station_id = $2,
+//	    updated    = CURRENT_TIMESTAMP
+//	WHERE id = $1
+func (q *Queries) SetProductGroupStation(ctx context.Context, arg SetProductGroupStationParams) error {
+	_, err := q.db.Exec(ctx, setProductGroupStation, arg.ID, arg.StationID)
+	return err
+}
+
+const setProductStock = `-- name: SetProductStock :execrows
+UPDATE products
+SET stock     = $2,
+    low_stock = $3,
+    updated   = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+type SetProductStockParams struct {
+	ID       uuid.UUID
+	Stock    *int32
+	LowStock *int32
+}
+
+// SetProductStock
+//
+//	UPDATE products
+//	SET stock     = $2,
+//	    low_stock = $3,
+//	    updated   = CURRENT_TIMESTAMP
+//	WHERE id = $1
+func (q *Queries) SetProductStock(ctx context.Context, arg SetProductStockParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setProductStock, arg.ID, arg.Stock, arg.LowStock)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const startJobRun = `-- name: StartJobRun :exec
+INSERT INTO jobs (name, last_started, last_instance)
+VALUES ($1, $2, $3)
+ON CONFLICT (name) DO UPDATE SET last_started  = EXCLUDED.last_started,
+                                 last_instance = EXCLUDED.last_instance
+`
+
+type StartJobRunParams struct {
+	Name         string
+	LastStarted  *time.Time
+	LastInstance *string
+}
+
+// StartJobRun
+//
+//	INSERT INTO jobs (name, last_started, last_instance)
+//	VALUES ($1, $2, $3)
+//	ON CONFLICT (name) DO UPDATE SET last_started  = EXCLUDED.last_started,
+//	                                 last_instance = EXCLUDED.last_instance
+func (q *Queries) StartJobRun(ctx context.Context, arg StartJobRunParams) error {
+	_, err := q.db.Exec(ctx, startJobRun, arg.Name, arg.LastStarted, arg.LastInstance)
+	return err
+}
+
+const stopOutOfStockProducts = `-- name: StopOutOfStockProducts :many
+UPDATE products
+SET available    = false,
+    auto_stopped = true,
+    updated      = CURRENT_TIMESTAMP
+WHERE available
+  AND (stock = 0 OR EXISTS (SELECT 1
+                            FROM product_ingredients
+                                   JOIN ingredients ON ingredients.id = product_ingredients.ingredient_id
+                            WHERE product_ingredients.product_id = products.id
+                              AND ingredients.stock < product_ingredients.quantity))
+RETURNING title
 `
 
-// GetProductsByGroup
+// StopOutOfStockProducts
 //
-//	SELECT id, group_id, index, title, description, price, available, created, updated
-//	FROM products
-//	WHERE group_id = $1
-//	ORDER BY index
-func (q *Queries) GetProductsByGroup(ctx context.Context, groupID uuid.UUID) ([]Product, error) {
-	rows, err := q.db.Query(ctx, getProductsByGroup, groupID)
+//	UPDATE products
+//	SET available    = false,
+//	    auto_stopped = true,
+//	    updated      = CURRENT_TIMESTAMP
+//	WHERE available
+//	  AND (stock = 0 OR EXISTS (SELECT 1
+//	                            FROM product_ingredients
+//	                                   JOIN ingredients ON ingredients.id = product_ingredients.ingredient_id
+//	                            WHERE product_ingredients.product_id = products.id
+//	                              AND ingredients.stock < product_ingredients.quantity))
+//	RETURNING title
+func (q *Queries) StopOutOfStockProducts(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, stopOutOfStockProducts)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Product{}
+	items := []string{}
 	for rows.Next() {
-		var i Product
-		if err := rows.Scan(
-			&i.ID,
-			&i.GroupID,
-			&i.Index,
-			&i.Title,
-			&i.Description,
-			&i.Price,
-			&i.Available,
-			&i.Created,
-			&i.Updated,
-		); err != nil {
+		var title string
+		if err := rows.Scan(&title); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, title)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -530,113 +4887,256 @@ func (q *Queries) GetProductsByGroup(ctx context.Context, groupID uuid.UUID) ([]
 	return items, nil
 }
 
-const searchProducts = `-- name: SearchProducts :many
-SELECT id, group_id, index, title, description, price, available, created, updated
-FROM products
-WHERE (title ILIKE '%' || $1 || '%' OR description ILIKE '%' || $1 || '%')
-  AND available = true
-ORDER BY title
+const tryJobLock = `-- name: TryJobLock :one
+SELECT pg_try_advisory_lock(hashtext('job:' || $1::TEXT))::BOOLEAN AS locked
 `
 
-// SearchProducts
+// TryJobLock
 //
-//	SELECT id, group_id, index, title, description, price, available, created, updated
-//	FROM products
-//	WHERE (title ILIKE '%' || $1 || '%' OR description ILIKE '%' || $1 || '%')
-//	  AND available = true
-//	ORDER BY title
-func (q *Queries) SearchProducts(ctx context.Context, dollar_1 *string) ([]Product, error) {
-	rows, err := q.db.Query(ctx, searchProducts, dollar_1)
+//	SELECT pg_try_advisory_lock(hashtext('job:' || $1::TEXT))::BOOLEAN AS locked
+func (q *Queries) TryJobLock(ctx context.Context, name string) (bool, error) {
+	row := q.db.QueryRow(ctx, tryJobLock, name)
+	var locked bool
+	err := row.Scan(&locked)
+	return locked, err
+}
+
+const unlockJob = `-- name: UnlockJob :exec
+SELECT pg_advisory_unlock(hashtext('job:' || $1::TEXT))
+`
+
+// UnlockJob
+//
+//	SELECT pg_advisory_unlock(hashtext('job:' || $1::TEXT))
+func (q *Queries) UnlockJob(ctx context.Context, name string) error {
+	_, err := q.db.Exec(ctx, unlockJob, name)
+	return err
+}
+
+const unlockMigrations = `-- name: UnlockMigrations :exec
+SELECT pg_advisory_unlock(hashtext('migrations'))
+`
+
+// UnlockMigrations
+//
+//	SELECT pg_advisory_unlock(hashtext('migrations'))
+func (q *Queries) UnlockMigrations(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, unlockMigrations)
+	return err
+}
+
+const updateAdminPassword = `-- name: UpdateAdminPassword :execrows
+UPDATE admins
+SET password_hash = $2,
+    updated       = CURRENT_TIMESTAMP
+WHERE username = $1
+`
+
+type UpdateAdminPasswordParams struct {
+	Username     string
+	PasswordHash string
+}
+
+// UpdateAdminPassword
+//
+//	UPDATE admins
+//	SET password_hash = $2,
+//	    updated       = CURRENT_TIMESTAMP
+//	WHERE username = $1
+func (q *Queries) UpdateAdminPassword(ctx context.Context, arg UpdateAdminPasswordParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateAdminPassword, arg.Username, arg.PasswordHash)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []Product{}
-	for rows.Next() {
-		var i Product
-		if err := rows.Scan(
-			&i.ID,
-			&i.GroupID,
-			&i.Index,
-			&i.Title,
-			&i.Description,
-			&i.Price,
-			&i.Available,
-			&i.Created,
-			&i.Updated,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
+		return 0, err
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+	return result.RowsAffected(), nil
+}
+
+const updateAnnouncement = `-- name: UpdateAnnouncement :execrows
+UPDATE announcements
+SET text     = $2,
+    starts   = $3,
+    ends     = $4,
+    priority = $5,
+    severity = $6,
+    target   = $7,
+    menu_id  = $8,
+    updated  = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+type UpdateAnnouncementParams struct {
+	ID       uuid.UUID
+	Text     string
+	Starts   *time.Time
+	Ends     *time.Time
+	Priority int32
+	Severity api.AnnouncementSeverity
+	Target   api.AnnouncementTarget
+	MenuID   *string
+}
+
+// UpdateAnnouncement
+//
+//	UPDATE announcements
+//	SET text     = $2,
+//	    starts   = $3,
+//	    ends     = $4,
+//	    priority = $5,
+//	    severity = $6,
+//	    target   = $7,
+//	    menu_id  = $8,
+//	    updated  = CURRENT_TIMESTAMP
+//	WHERE id = $1
+func (q *Queries) UpdateAnnouncement(ctx context.Context, arg UpdateAnnouncementParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateAnnouncement,
+		arg.ID,
+		arg.Text,
+		arg.Starts,
+		arg.Ends,
+		arg.Priority,
+		arg.Severity,
+		arg.Target,
+		arg.MenuID,
+	)
+	if err != nil {
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const setOrderSeen = `-- name: SetOrderSeen :exec
-UPDATE orders
-SET seen    = true,
+const updateCustomerName = `-- name: UpdateCustomerName :one
+UPDATE customers
+SET name    = $2,
     updated = CURRENT_TIMESTAMP
 WHERE id = $1
+RETURNING id, phone, name, created, updated
 `
 
-// SetOrderSeen
+type UpdateCustomerNameParams struct {
+	ID   uuid.UUID
+	Name *string
+}
+
+// UpdateCustomerName
 //
-//	UPDATE orders
-//	SET seen    = true,
+//	UPDATE customers
+//	SET name    = $2,
 //	    updated = CURRENT_TIMESTAMP
 //	WHERE id = $1
-func (q *Queries) SetOrderSeen(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, setOrderSeen, id)
-	return err
+//	RETURNING id, phone, name, created, updated
+func (q *Queries) UpdateCustomerName(ctx context.Context, arg UpdateCustomerNameParams) (Customer, error) {
+	row := q.db.QueryRow(ctx, updateCustomerName, arg.ID, arg.Name)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.Phone,
+		&i.Name,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
 }
 
-const setParamsHeader = `-- name: SetParamsHeader :exec
-UPDATE params
-SET header_text = $1,
-    header_deadline = $2
-WHERE id = 1
+const updateDiscountRule = `-- name: UpdateDiscountRule :execrows
+UPDATE discount_rules
+SET title       = $2,
+    kind        = $3,
+    value       = $4,
+    group_id    = $5,
+    from_minute = $6,
+    to_minute   = $7,
+    starts      = $8,
+    ends        = $9,
+    active      = $10,
+    updated     = CURRENT_TIMESTAMP
+WHERE id = $1
 `
 
-type SetParamsHeaderParams struct {
-	HeaderText     *string
-	HeaderDeadline *time.Time
+type UpdateDiscountRuleParams struct {
+	ID         uuid.UUID
+	Title      string
+	Kind       api.DiscountKind
+	Value      int64
+	GroupID    *uuid.UUID
+	FromMinute *int32
+	ToMinute   *int32
+	Starts     *time.Time
+	Ends       *time.Time
+	Active     bool
 }
 
-// SetParamsHeader
+// UpdateDiscountRule
 //
-//	UPDATE params
-//	SET header_text = $1,
-//	    header_deadline = $2
-//	WHERE id = 1
-func (q *Queries) SetParamsHeader(ctx context.Context, arg SetParamsHeaderParams) error {
-	_, err := q.db.Exec(ctx, setParamsHeader, arg.HeaderText, arg.HeaderDeadline)
-	return err
+//	UPDATE discount_rules
+//	SET title       = $2,
+//	    kind        = $3,
+//	    value       = $4,
+//	    group_id    = $5,
+//	    from_minute = $6,
+//	    to_minute   = $7,
+//	    starts      = $8,
+//	    ends        = $9,
+//	    active      = $10,
+//	    updated     = CURRENT_TIMESTAMP
+//	WHERE id = $1
+func (q *Queries) UpdateDiscountRule(ctx context.Context, arg UpdateDiscountRuleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateDiscountRule,
+		arg.ID,
+		arg.Title,
+		arg.Kind,
+		arg.Value,
+		arg.GroupID,
+		arg.FromMinute,
+		arg.ToMinute,
+		arg.Starts,
+		arg.Ends,
+		arg.Active,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const setProductAvailability = `-- name: SetProductAvailability :exec
-UPDATE products
-SET available = $2,
+const updateIngredient = `-- name: UpdateIngredient :execrows
+UPDATE ingredients
+SET title     = $2,
+    unit      = $3,
+    stock     = $4,
+    low_stock = $5,
     updated   = CURRENT_TIMESTAMP
 WHERE id = $1
 `
 
-type SetProductAvailabilityParams struct {
-	ID        uuid.UUID
-	Available bool
+type UpdateIngredientParams struct {
+	ID       uuid.UUID
+	Title    string
+	Unit     string
+	Stock    int32
+	LowStock *int32
 }
 
-// SetProductAvailability
+// UpdateIngredient
 //
-//	UPDATE products
-//	SET available = $2,
+//	UPDATE ingredients
+//	SET title     = $2,
+//	    unit      = $3,
+//	    stock     = $4,
+//	    low_stock = $5,
 //	    updated   = CURRENT_TIMESTAMP
 //	WHERE id = $1
-func (q *Queries) SetProductAvailability(ctx context.Context, arg SetProductAvailabilityParams) error {
-	_, err := q.db.Exec(ctx, setProductAvailability, arg.ID, arg.Available)
-	return err
+func (q *Queries) UpdateIngredient(ctx context.Context, arg UpdateIngredientParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateIngredient,
+		arg.ID,
+		arg.Title,
+		arg.Unit,
+		arg.Stock,
+		arg.LowStock,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const updateOrderStatus = `-- name: UpdateOrderStatus :exec
@@ -664,11 +5164,14 @@ func (q *Queries) UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusPa
 
 const updateProduct = `-- name: UpdateProduct :exec
 UPDATE products
-SET title       = $2,
-    description = $3,
-    price       = $4,
-    available   = $5,
-    updated     = CURRENT_TIMESTAMP
+SET title        = $2,
+    description  = $3,
+    price        = $4,
+    available    = $5,
+    prep_minutes = $6,
+    max_quantity = $7,
+    auto_stopped = false,
+    updated      = CURRENT_TIMESTAMP
 WHERE id = $1
 `
 
@@ -676,18 +5179,23 @@ type UpdateProductParams struct {
 	ID          uuid.UUID
 	Title       string
 	Description string
-	Price       float64
+	Price       money.Kopecks
 	Available   bool
+	PrepMinutes *int32
+	MaxQuantity *int32
 }
 
 // UpdateProduct
 //
 //	UPDATE products
-//	SET title       = $2,
-//	    description = $3,
-//	    price       = $4,
-//	    available   = $5,
-//	    updated     = CURRENT_TIMESTAMP
+//	SET title        = $2,
+//	    description  = $3,
+//	    price        = $4,
+//	    available    = $5,
+//	    prep_minutes = $6,
+//	    max_quantity = $7,
+//	    auto_stopped = false,
+//	    updated      = CURRENT_TIMESTAMP
 //	WHERE id = $1
 func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) error {
 	_, err := q.db.Exec(ctx, updateProduct,
@@ -696,30 +5204,35 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) er
 		arg.Description,
 		arg.Price,
 		arg.Available,
+		arg.PrepMinutes,
+		arg.MaxQuantity,
 	)
 	return err
 }
 
 const updateProductGroup = `-- name: UpdateProductGroup :exec
 UPDATE product_groups
-SET title   = $2,
-    updated = CURRENT_TIMESTAMP
+SET title        = $2,
+    prep_minutes = $3,
+    updated      = CURRENT_TIMESTAMP
 WHERE id = $1
 `
 
 type UpdateProductGroupParams struct {
-	ID    uuid.UUID
-	Title string
+	ID          uuid.UUID
+	Title       string
+	PrepMinutes *int32
 }
 
 // UpdateProductGroup
 //
 //	UPDATE product_groups
-//	SET title   = $2,
-//	    updated = CURRENT_TIMESTAMP
+//	SET title        = $2,
+//	    prep_minutes = $3,
+//	    updated      = CURRENT_TIMESTAMP
 //	WHERE id = $1
 func (q *Queries) UpdateProductGroup(ctx context.Context, arg UpdateProductGroupParams) error {
-	_, err := q.db.Exec(ctx, updateProductGroup, arg.ID, arg.Title)
+	_, err := q.db.Exec(ctx, updateProductGroup, arg.ID, arg.Title, arg.PrepMinutes)
 	return err
 }
 
@@ -768,3 +5281,324 @@ func (q *Queries) UpdateProductIndex(ctx context.Context, arg UpdateProductIndex
 	_, err := q.db.Exec(ctx, updateProductIndex, arg.ID, arg.Index)
 	return err
 }
+
+const updatePromoCode = `-- name: UpdatePromoCode :execrows
+UPDATE promo_codes
+SET code                  = $2,
+    kind                  = $3,
+    value                 = $4,
+    min_order_total       = $5,
+    max_uses              = $6,
+    max_uses_per_customer = $7,
+    starts                = $8,
+    ends                  = $9,
+    active                = $10,
+    updated               = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+type UpdatePromoCodeParams struct {
+	ID                 uuid.UUID
+	Code               string
+	Kind               api.DiscountKind
+	Value              int64
+	MinOrderTotal      *money.Kopecks
+	MaxUses            *int32
+	MaxUsesPerCustomer *int32
+	Starts             *time.Time
+	Ends               *time.Time
+	Active             bool
+}
+
+// UpdatePromoCode
+//
+//	UPDATE promo_codes
+//	SET code                  = $2,
+//	    kind                  = $3,
+//	    value                 = $4,
+//	    min_order_total       = $5,
+//	    max_uses              = $6,
+//	    max_uses_per_customer = $7,
+//	    starts                = $8,
+//	    ends                  = $9,
+//	    active                = $10,
+//	    updated               = CURRENT_TIMESTAMP
+//	WHERE id = $1
+func (q *Queries) UpdatePromoCode(ctx context.Context, arg UpdatePromoCodeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updatePromoCode,
+		arg.ID,
+		arg.Code,
+		arg.Kind,
+		arg.Value,
+		arg.MinOrderTotal,
+		arg.MaxUses,
+		arg.MaxUsesPerCustomer,
+		arg.Starts,
+		arg.Ends,
+		arg.Active,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertCustomerByPhone = `-- name: UpsertCustomerByPhone :one
+INSERT INTO customers (id, phone)
+VALUES ($1, $2)
+ON CONFLICT (phone) DO UPDATE SET updated = CURRENT_TIMESTAMP
+RETURNING id, phone, name, created, updated
+`
+
+type UpsertCustomerByPhoneParams struct {
+	ID    uuid.UUID
+	Phone string
+}
+
+// UpsertCustomerByPhone
+//
+//	INSERT INTO customers (id, phone)
+//	VALUES ($1, $2)
+//	ON CONFLICT (phone) DO UPDATE SET updated = CURRENT_TIMESTAMP
+//	RETURNING id, phone, name, created, updated
+func (q *Queries) UpsertCustomerByPhone(ctx context.Context, arg UpsertCustomerByPhoneParams) (Customer, error) {
+	row := q.db.QueryRow(ctx, upsertCustomerByPhone, arg.ID, arg.Phone)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.Phone,
+		&i.Name,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const upsertCustomerOTP = `-- name: UpsertCustomerOTP :exec
+INSERT INTO customer_otps (phone, code_hash, expires)
+VALUES ($1, $2, $3)
+ON CONFLICT (phone) DO UPDATE SET code_hash = excluded.code_hash,
+                                  attempts  = 0,
+                                  expires   = excluded.expires,
+                                  created   = CURRENT_TIMESTAMP
+`
+
+type UpsertCustomerOTPParams struct {
+	Phone    string
+	CodeHash string
+	Expires  time.Time
+}
+
+// UpsertCustomerOTP
+//
+//	INSERT INTO customer_otps (phone, code_hash, expires)
+//	VALUES ($1, $2, $3)
+//	ON CONFLICT (phone) DO UPDATE SET code_hash = excluded.code_hash,
+//	                                  attempts  = 0,
+//	                                  expires   = excluded.expires,
+//	                                  created   = CURRENT_TIMESTAMP
+func (q *Queries) UpsertCustomerOTP(ctx context.Context, arg UpsertCustomerOTPParams) error {
+	_, err := q.db.Exec(ctx, upsertCustomerOTP, arg.Phone, arg.CodeHash, arg.Expires)
+	return err
+}
+
+const upsertKitchenStation = `-- name: UpsertKitchenStation :exec
+INSERT INTO kitchen_stations (id, title, printer_address)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO UPDATE SET title           = excluded.title,
+                               printer_address = excluded.printer_address
+`
+
+type UpsertKitchenStationParams struct {
+	ID             string
+	Title          string
+	PrinterAddress *string
+}
+
+// UpsertKitchenStation
+//
+//	INSERT INTO kitchen_stations (id, title, printer_address)
+//	VALUES ($1, $2, $3)
+//	ON CONFLICT (id) DO UPDATE SET title           = excluded.title,
+//	                               printer_address = excluded.printer_address
+func (q *Queries) UpsertKitchenStation(ctx context.Context, arg UpsertKitchenStationParams) error {
+	_, err := q.db.Exec(ctx, upsertKitchenStation, arg.ID, arg.Title, arg.PrinterAddress)
+	return err
+}
+
+const upsertMenu = `-- name: UpsertMenu :exec
+INSERT INTO menu (id, title)
+VALUES ($1, $2)
+ON CONFLICT (id) DO UPDATE SET title = excluded.title
+`
+
+type UpsertMenuParams struct {
+	ID    string
+	Title string
+}
+
+// UpsertMenu
+//
+//	INSERT INTO menu (id, title)
+//	VALUES ($1, $2)
+//	ON CONFLICT (id) DO UPDATE SET title = excluded.title
+func (q *Queries) UpsertMenu(ctx context.Context, arg UpsertMenuParams) error {
+	_, err := q.db.Exec(ctx, upsertMenu, arg.ID, arg.Title)
+	return err
+}
+
+const upsertOpeningException = `-- name: UpsertOpeningException :exec
+INSERT INTO opening_exceptions (day, opens, closes, title)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (day) DO UPDATE SET opens  = EXCLUDED.opens,
+                                closes = EXCLUDED.closes,
+                                title  = EXCLUDED.title
+`
+
+type UpsertOpeningExceptionParams struct {
+	Day    time.Time
+	Opens  *int32
+	Closes *int32
+	Title  *string
+}
+
+// UpsertOpeningException
+//
+//	INSERT INTO opening_exceptions (day, opens, closes, title)
+//	VALUES ($1, $2, $3, $4)
+//	ON CONFLICT (day) DO UPDATE SET opens  = EXCLUDED.opens,
+//	                                closes = EXCLUDED.closes,
+//	                                title  = EXCLUDED.title
+func (q *Queries) UpsertOpeningException(ctx context.Context, arg UpsertOpeningExceptionParams) error {
+	_, err := q.db.Exec(ctx, upsertOpeningException,
+		arg.Day,
+		arg.Opens,
+		arg.Closes,
+		arg.Title,
+	)
+	return err
+}
+
+const upsertProduct = `-- name: UpsertProduct :exec
+INSERT INTO products (id, group_id, title, description, price, available, index, prep_minutes, max_quantity)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (id) DO UPDATE SET group_id     = excluded.group_id,
+                               title        = excluded.title,
+                               description  = excluded.description,
+                               price        = excluded.price,
+                               available    = excluded.available,
+                               index        = excluded.index,
+                               prep_minutes = excluded.prep_minutes,
+                               max_quantity = excluded.max_quantity,
+                               auto_stopped = false,
+                               updated      = CURRENT_TIMESTAMP
+`
+
+type UpsertProductParams struct {
+	ID          uuid.UUID
+	GroupID     uuid.UUID
+	Title       string
+	Description string
+	Price       money.Kopecks
+	Available   bool
+	Index       int32
+	PrepMinutes *int32
+	MaxQuantity *int32
+}
+
+// UpsertProduct
+//
+//	INSERT INTO products (id, group_id, title, description, price, available, index, prep_minutes, max_quantity)
+//	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+//	ON CONFLICT (id) DO UPDATE SET group_id     = excluded.group_id,
+//	                               title        = excluded.title,
+//	                               description  = excluded.description,
+//	                               price        = excluded.price,
+//	                               available    = excluded.available,
+//	                               index        = excluded.index,
+//	                               prep_minutes = excluded.prep_minutes,
+//	                               max_quantity = excluded.max_quantity,
+//	                               auto_stopped = false,
+//	                               updated      = CURRENT_TIMESTAMP
+func (q *Queries) UpsertProduct(ctx context.Context, arg UpsertProductParams) error {
+	_, err := q.db.Exec(ctx, upsertProduct,
+		arg.ID,
+		arg.GroupID,
+		arg.Title,
+		arg.Description,
+		arg.Price,
+		arg.Available,
+		arg.Index,
+		arg.PrepMinutes,
+		arg.MaxQuantity,
+	)
+	return err
+}
+
+const upsertProductGroup = `-- name: UpsertProductGroup :exec
+INSERT INTO product_groups (id, menu_id, title, index, prep_minutes)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE SET menu_id      = excluded.menu_id,
+                               title        = excluded.title,
+                               index        = excluded.index,
+                               prep_minutes = excluded.prep_minutes,
+                               updated      = CURRENT_TIMESTAMP
+`
+
+type UpsertProductGroupParams struct {
+	ID          uuid.UUID
+	MenuID      string
+	Title       string
+	Index       int32
+	PrepMinutes *int32
+}
+
+// UpsertProductGroup
+//
+//	INSERT INTO product_groups (id, menu_id, title, index, prep_minutes)
+//	VALUES ($1, $2, $3, $4, $5)
+//	ON CONFLICT (id) DO UPDATE SET menu_id      = excluded.menu_id,
+//	                               title        = excluded.title,
+//	                               index        = excluded.index,
+//	                               prep_minutes = excluded.prep_minutes,
+//	                               updated      = CURRENT_TIMESTAMP
+func (q *Queries) UpsertProductGroup(ctx context.Context, arg UpsertProductGroupParams) error {
+	_, err := q.db.Exec(ctx, upsertProductGroup,
+		arg.ID,
+		arg.MenuID,
+		arg.Title,
+		arg.Index,
+		arg.PrepMinutes,
+	)
+	return err
+}
+
+const useCustomerOTPAttempt = `-- name: UseCustomerOTPAttempt :one
+UPDATE customer_otps
+SET attempts = attempts + 1
+WHERE phone = $1
+  AND attempts < $2
+  AND expires > $3::TIMESTAMP
+RETURNING code_hash
+`
+
+type UseCustomerOTPAttemptParams struct {
+	Phone       string
+	MaxAttempts int32
+	Now         time.Time
+}
+
+// the attempt is counted before the code is compared, so parallel guesses can't exceed the limit
+//
+//	UPDATE customer_otps
+//	SET attempts = attempts + 1
+//	WHERE phone = $1
+//	  AND attempts < $2
+//	  AND expires > $3::TIMESTAMP
+//	RETURNING code_hash
+func (q *Queries) UseCustomerOTPAttempt(ctx context.Context, arg UseCustomerOTPAttemptParams) (string, error) {
+	row := q.db.QueryRow(ctx, useCustomerOTPAttempt, arg.Phone, arg.MaxAttempts, arg.Now)
+	var code_hash string
+	err := row.Scan(&code_hash)
+	return code_hash, err
+}
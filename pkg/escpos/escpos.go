@@ -0,0 +1,104 @@
+package escpos
+
+import (
+	"bytes"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+)
+
+const (
+	esc = 0x1B
+	gs  = 0x1D
+	lf  = 0x0A
+)
+
+type Alignment byte
+
+const (
+	AlignLeft   Alignment = 0
+	AlignCenter Alignment = 1
+	AlignRight  Alignment = 2
+)
+
+// Builder renders ESC/POS commands, text is encoded as CP866 which covers Cyrillic
+type Builder struct {
+	buf     bytes.Buffer
+	encoder *encoding.Encoder
+}
+
+// New initializes the printer and selects the code page table with the given number.
+// The number of the CP866 table depends on the printer model, for most Epson-compatible printers it is 17.
+func New(codePage byte) *Builder {
+	b := &Builder{
+		buf:     bytes.Buffer{},
+		encoder: encoding.ReplaceUnsupported(charmap.CodePage866.NewEncoder()),
+	}
+
+	b.buf.Write([]byte{esc, '@'})
+	b.buf.Write([]byte{esc, 't', codePage})
+
+	return b
+}
+
+func (b *Builder) Text(s string) *Builder {
+	encoded, err := b.encoder.Bytes([]byte(s))
+	if err != nil {
+		encoded = []byte(s)
+	}
+
+	b.buf.Write(encoded)
+
+	return b
+}
+
+func (b *Builder) Line(s string) *Builder {
+	b.Text(s)
+	b.buf.WriteByte(lf)
+
+	return b
+}
+
+func (b *Builder) Align(a Alignment) *Builder {
+	b.buf.Write([]byte{esc, 'a', byte(a)})
+
+	return b
+}
+
+func (b *Builder) Bold(on bool) *Builder {
+	var n byte
+	if on {
+		n = 1
+	}
+
+	b.buf.Write([]byte{esc, 'E', n})
+
+	return b
+}
+
+// Size sets character magnification, both width and height are in range 1..8
+func (b *Builder) Size(width, height int) *Builder {
+	width = min(max(width, 1), 8)
+	height = min(max(height, 1), 8)
+
+	b.buf.Write([]byte{gs, '!', byte((width-1)<<4 | (height - 1))})
+
+	return b
+}
+
+func (b *Builder) Feed(lines int) *Builder {
+	b.buf.Write([]byte{esc, 'd', byte(min(max(lines, 0), 255))})
+
+	return b
+}
+
+// Cut feeds the paper to the cutter and performs a partial cut
+func (b *Builder) Cut() *Builder {
+	b.buf.Write([]byte{gs, 'V', 66, 0})
+
+	return b
+}
+
+func (b *Builder) Bytes() []byte {
+	return b.buf.Bytes()
+}
@@ -8,23 +8,37 @@ import (
 
 	"github.com/getsentry/sentry-go"
 	"github.com/gofiber/fiber/v2"
+	"github.com/rofleksey/meg"
 	"github.com/samber/oops"
 )
 
 func ErrorHandler(ctx *fiber.Ctx, err error) error {
 	statusCode := http.StatusInternalServerError
+	msg := err.Error()
+
+	var code *string
 
 	if oopsErr, ok := oops.AsOops(err); ok {
 		statusCodeOpt := oopsErr.Context()["status_code"]
 		if statusCodeOpt != nil {
 			statusCode, _ = statusCodeOpt.(int)
 		}
+
+		// errors meant for customers carry a ready to show message
+		if public := oopsErr.Public(); public != "" {
+			msg = public
+		}
+
+		if oopsErr.Code() != "" {
+			code = meg.ToPtr(oopsErr.Code())
+		}
 	}
 
 	general := api.General{
 		Error:      true,
-		Msg:        err.Error(),
+		Msg:        msg,
 		StatusCode: statusCode,
+		Code:       code,
 	}
 
 	switch statusCode {
@@ -10,6 +10,7 @@ import (
 	"shantaram/pkg/util"
 	"slices"
 	"strings"
+	"sync/atomic"
 
 	"github.com/elliotchance/pie/v2"
 	sentryotel "github.com/getsentry/sentry-go/otel"
@@ -18,28 +19,42 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/rofleksey/meg"
 	"github.com/samber/do"
 	slogfiber "github.com/samber/slog-fiber"
 )
 
+// customerAudience matches customer.Audience, pkg can't import app services
+const customerAudience = "customer"
+
 func FiberMiddleware(app *fiber.App, di *do.Injector) {
 	cfg := do.MustInvoke[*config.Config](di)
 	tel := do.MustInvoke[*telemetry.Telemetry](di)
 
+	configReloader := do.MustInvoke[*config.Reloader](di)
+
 	staticOrigins := []string{
 		cfg.BaseApiURL, cfg.BaseFrontURL, cfg.BaseWWWFrontURL, cfg.BaseAdminURL,
 		"capacitor://localhost", "http://localhost", "https://localhost", "http://localhost:4321", "http://localhost:5173",
 		"http://localhost:1234", "http://localhost:3000", "http://localhost:9000", "http://localhost:8080",
 	}
 
+	// configured origins are swapped on config reload
+	var extraOrigins atomic.Pointer[[]string]
+	extraOrigins.Store(&configReloader.Runtime().CORSOrigins)
+	configReloader.Subscribe(func(runtime *config.Runtime) {
+		extraOrigins.Store(&runtime.CORSOrigins)
+	})
+
 	// cors
 	app.Use(cors.New(cors.Config{
 		AllowHeaders:     "Origin, Content-Type, Accept, Authorization, Sentry-Trace, Baggage",
 		AllowMethods:     "POST, GET, OPTIONS, DELETE, PUT, PATCH, HEAD",
 		AllowCredentials: true,
 		AllowOriginsFunc: func(origin string) bool {
-			if pie.Contains(staticOrigins, origin) {
+			if pie.Contains(staticOrigins, origin) || pie.Contains(*extraOrigins.Load(), origin) {
 				return true
 			}
 
@@ -89,6 +104,11 @@ func FiberMiddleware(app *fiber.App, di *do.Injector) {
 		},
 	}))
 
+	authMiddleware(app, cfg)
+}
+
+// authMiddleware marks admin requests and resolves the customer of customer tokens
+func authMiddleware(app *fiber.App, cfg *config.Config) {
 	app.Use(jwtware.New(jwtware.Config{
 		SigningKey: jwtware.SigningKey{Key: []byte(cfg.JWT.Secret)},
 		SuccessHandler: func(ctx *fiber.Ctx) error {
@@ -97,9 +117,22 @@ func FiberMiddleware(app *fiber.App, di *do.Injector) {
 				return ctx.Next()
 			}
 
+			username := "admin"
+			if token, ok := tokenOpt.(*jwt.Token); ok {
+				// customer tokens are signed with another secret, the audience guards against a shared one
+				if isCustomerToken(token) {
+					return ctx.Next()
+				}
+
+				if sub, err := token.Claims.GetSubject(); err == nil && sub != "" {
+					username = sub
+				}
+			}
+
 			ctx.Locals("admin", true)
+			ctx.Locals(string(util.UsernameContextKey), username)
 			newUserCtx := context.WithValue(ctx.UserContext(), "admin", true)
-			newUserCtx = context.WithValue(newUserCtx, util.UsernameContextKey, "admin")
+			newUserCtx = context.WithValue(newUserCtx, util.UsernameContextKey, username)
 			ctx.SetUserContext(newUserCtx)
 
 			return ctx.Next()
@@ -110,4 +143,41 @@ func FiberMiddleware(app *fiber.App, di *do.Injector) {
 		TokenLookup: "query:token,header:Authorization",
 		AuthScheme:  "Bearer",
 	}))
+
+	app.Use(jwtware.New(jwtware.Config{
+		SigningKey: jwtware.SigningKey{Key: []byte(cfg.JWT.CustomerSecret)},
+		ContextKey: "customerToken",
+		SuccessHandler: func(ctx *fiber.Ctx) error {
+			token, ok := ctx.Locals("customerToken").(*jwt.Token)
+			if !ok || !isCustomerToken(token) {
+				return ctx.Next()
+			}
+
+			sub, err := token.Claims.GetSubject()
+			if err != nil {
+				return ctx.Next()
+			}
+
+			customerID, err := uuid.Parse(sub)
+			if err != nil {
+				return ctx.Next()
+			}
+
+			ctx.Locals("customer", customerID)
+			ctx.SetUserContext(context.WithValue(ctx.UserContext(), util.CustomerContextKey, customerID))
+
+			return ctx.Next()
+		},
+		ErrorHandler: func(ctx *fiber.Ctx, _ error) error {
+			return ctx.Next()
+		},
+		TokenLookup: "header:Authorization",
+		AuthScheme:  "Bearer",
+	}))
+}
+
+func isCustomerToken(token *jwt.Token) bool {
+	audience, err := token.Claims.GetAudience()
+
+	return err == nil && slices.Contains(audience, customerAudience)
 }
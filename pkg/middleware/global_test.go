@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"shantaram/app/service/auth"
+	"shantaram/pkg/config"
+	"shantaram/pkg/util"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+func signToken(t *testing.T, secret string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+
+	return token
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	cfg := &config.Config{} //nolint:exhaustruct
+	cfg.JWT.Secret = "admin-secret"
+	cfg.JWT.CustomerSecret = "customer-secret"
+
+	authService := &auth.Service{}
+	customerID := uuid.New()
+
+	app := fiber.New()
+	authMiddleware(app, cfg)
+	app.Get("/", func(ctx *fiber.Ctx) error {
+		ctx.Set("X-Admin", "false")
+		// the websocket handler checks the same locals
+		locals := func(key string, value ...interface{}) interface{} {
+			return ctx.Locals(key, value...)
+		}
+
+		if authService.IsAdminLocals(locals) {
+			ctx.Set("X-Admin", "true")
+		}
+
+		// websocket and SSE connections read the user from different places, they must agree
+		if user := authService.UsernameLocals(locals); user == authService.Username(ctx.UserContext()) {
+			ctx.Set("X-User", user)
+		}
+
+		if id := util.CustomerID(ctx.UserContext()); id != nil {
+			ctx.Set("X-Customer", id.String())
+		}
+
+		return ctx.SendStatus(http.StatusOK)
+	})
+
+	tests := []struct {
+		name     string
+		token    string
+		admin    bool
+		user     string
+		customer string
+	}{
+		{
+			name:  "no token",
+			token: "",
+		},
+		{
+			name:  "admin token",
+			token: signToken(t, cfg.JWT.Secret, jwt.MapClaims{"sub": "admin"}),
+			admin: true,
+			user:  "admin",
+		},
+		{
+			name:  "another admin",
+			token: signToken(t, cfg.JWT.Secret, jwt.MapClaims{"sub": "maria"}),
+			admin: true,
+			user:  "maria",
+		},
+		{
+			name:     "customer token",
+			token:    signToken(t, cfg.JWT.CustomerSecret, jwt.MapClaims{"sub": customerID.String(), "aud": customerAudience}),
+			customer: customerID.String(),
+		},
+		{
+			// a customer token must not become an admin one even if both secrets were the same
+			name:  "customer audience signed with the admin secret",
+			token: signToken(t, cfg.JWT.Secret, jwt.MapClaims{"sub": customerID.String(), "aud": customerAudience}),
+		},
+		{
+			name:  "customer audience in a list",
+			token: signToken(t, cfg.JWT.Secret, jwt.MapClaims{"sub": customerID.String(), "aud": []string{"other", customerAudience}}),
+		},
+		{
+			name:  "customer secret without audience",
+			token: signToken(t, cfg.JWT.CustomerSecret, jwt.MapClaims{"sub": customerID.String()}),
+		},
+		{
+			name:  "garbage",
+			token: "not-a-token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Test: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if got := resp.Header.Get("X-Admin") == "true"; got != tt.admin {
+				t.Errorf("admin = %v, want %v", got, tt.admin)
+			}
+
+			if got := resp.Header.Get("X-User"); got != tt.user {
+				t.Errorf("user = %q, want %q", got, tt.user)
+			}
+
+			if got := resp.Header.Get("X-Customer"); got != tt.customer {
+				t.Errorf("customer = %q, want %q", got, tt.customer)
+			}
+		})
+	}
+}
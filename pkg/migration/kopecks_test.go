@@ -0,0 +1,148 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// testDatabaseEnv points at a scratch Postgres database, tests that need one are skipped without it
+const testDatabaseEnv = "SHANTARAM_TEST_DATABASE_URL"
+
+// migrateTo runs the migrations up to and including target inside tx
+func migrateTo(ctx context.Context, t *testing.T, tx pgx.Tx, target int) {
+	t.Helper()
+
+	migrations, err := collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, m := range migrations {
+		if version(m.Id()) > target {
+			break
+		}
+
+		if err = m.Execute(ctx, nil, tx, nil); err != nil {
+			t.Fatalf("%s: %v", m.Id(), err)
+		}
+	}
+}
+
+func TestMoneyKopecksRounding(t *testing.T) {
+	dsn := os.Getenv(testDatabaseEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDatabaseEnv)
+	}
+
+	ctx := context.Background()
+
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close(ctx)
+
+	// everything happens in one transaction that is rolled back, schema included
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if _, err = tx.Exec(ctx, "CREATE SCHEMA money_kopecks_test; SET LOCAL search_path TO money_kopecks_test"); err != nil {
+		t.Fatal(err)
+	}
+
+	migrateTo(ctx, t, tx, 3)
+
+	tests := []struct {
+		name   string
+		rubles float64
+		want   int64
+	}{
+		{name: "whole rubles", rubles: 450, want: 45000},
+		{name: "kopecks", rubles: 99.99, want: 9999},
+		{name: "float sum artifact", rubles: 0.1 + 0.2, want: 30},
+		{name: "below a kopeck artifact", rubles: 12.34 * 3, want: 3702},
+		{name: "half a kopeck rounds up", rubles: 0.125, want: 13},
+		{name: "half a kopeck stored just below", rubles: 19.995, want: 2000},
+		{name: "less than half a kopeck", rubles: 10.004, want: 1000},
+		{name: "zero", rubles: 0, want: 0},
+		{name: "large", rubles: 1234567.891, want: 123456789},
+	}
+
+	menuID := "kopecks"
+	groupID := uuid.New()
+	orderID := uuid.New()
+
+	if _, err = tx.Exec(ctx, "INSERT INTO menu (id, title) VALUES ($1, $1)", menuID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = tx.Exec(ctx, "INSERT INTO product_groups (id, menu_id, index, title) VALUES ($1, $2, 0, 'group')", groupID, menuID); err != nil {
+		t.Fatal(err)
+	}
+
+	productIDs := make([]uuid.UUID, len(tests))
+	items := make([]map[string]any, len(tests))
+
+	for i, tt := range tests {
+		productIDs[i] = uuid.New()
+
+		if _, err = tx.Exec(ctx, "INSERT INTO products (id, group_id, index, title, description, price) VALUES ($1, $2, $3, $4, '', $5)",
+			productIDs[i], groupID, i, tt.name, tt.rubles); err != nil {
+			t.Fatal(err)
+		}
+
+		items[i] = map[string]any{"id": productIDs[i], "title": tt.name, "price": tt.rubles, "amount": 1}
+	}
+
+	itemsJSON, err := json.Marshal(items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = tx.Exec(ctx, "INSERT INTO orders (id, status, client_name, items) VALUES ($1, 'finished', 'test', $2::JSONB)", orderID, string(itemsJSON)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = tx.Exec(ctx, "UPDATE params SET min_order_total = $1, max_order_total = $2", 0.1+0.2, 19.995); err != nil {
+		t.Fatal(err)
+	}
+
+	migrateTo(ctx, t, tx, 4)
+
+	var orderPrices []int64
+	if err = tx.QueryRow(ctx, `SELECT array_agg((item ->> 'price')::BIGINT ORDER BY position)
+		FROM orders, jsonb_array_elements(orders.items) WITH ORDINALITY AS elements(item, position)
+		WHERE orders.id = $1`, orderID).Scan(&orderPrices); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var price int64
+			if err := tx.QueryRow(ctx, "SELECT price FROM products WHERE id = $1", productIDs[i]).Scan(&price); err != nil {
+				t.Fatal(err)
+			}
+
+			if price != tt.want {
+				t.Errorf("product price %v -> %d, want %d", tt.rubles, price, tt.want)
+			}
+			if orderPrices[i] != tt.want {
+				t.Errorf("order item price %v -> %d, want %d", tt.rubles, orderPrices[i], tt.want)
+			}
+		})
+	}
+
+	var minTotal, maxTotal int64
+	if err = tx.QueryRow(ctx, "SELECT min_order_total, max_order_total FROM params").Scan(&minTotal, &maxTotal); err != nil {
+		t.Fatal(err)
+	}
+	if minTotal != 30 || maxTotal != 2000 {
+		t.Errorf("order totals = %d, %d, want 30, 2000", minTotal, maxTotal)
+	}
+}
@@ -2,26 +2,221 @@ package migration
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io/fs"
 	"log/slog"
+	"path"
+	"regexp"
 	"shantaram/pkg/database"
+	"sort"
+	"strconv"
 	"time"
 
-	mapset "github.com/deckarep/golang-set/v2"
-	"github.com/elliotchance/pie/v2"
 	"github.com/gofiber/fiber/v2/log"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/samber/do"
 )
 
+// Migration is a schema or data change applied once, ids start with a numeric
+// version so that Go and SQL migrations are ordered together, e.g. 00002_backfill_eta
 type Migration interface {
 	Id() string
 	Execute(ctx context.Context, di *do.Injector, tx pgx.Tx, queries *database.Queries) error
 }
 
+// Reversible migrations can be rolled back with migrate down
+type Reversible interface {
+	Rollback(ctx context.Context, di *do.Injector, tx pgx.Tx, queries *database.Queries) error
+}
+
+// Checksummed migrations are verified to be unchanged after being applied
+type Checksummed interface {
+	Checksum() string
+}
+
 var allMigrations = []Migration{}
 
+// bootstrap creates the tracking table before the first migration runs,
+// older databases get the checksum column added
+var bootstrap = `
+CREATE TABLE IF NOT EXISTS migration
+(
+  id      VARCHAR(255) PRIMARY KEY,
+  applied TIMESTAMP NOT NULL
+);
+ALTER TABLE migration
+  ADD COLUMN IF NOT EXISTS checksum VARCHAR(64);
+`
+
+var idRegex = regexp.MustCompile(`^(\d+)_[a-z0-9_]+$`)
+var fileRegex = regexp.MustCompile(`^(\d+_[a-z0-9_]+)\.(up|down)\.sql$`)
+
+type sqlMigration struct {
+	id   string
+	up   string
+	down string
+}
+
+func (m *sqlMigration) Id() string {
+	return m.id
+}
+
+func (m *sqlMigration) Execute(ctx context.Context, _ *do.Injector, tx pgx.Tx, _ *database.Queries) error {
+	if _, err := tx.Exec(ctx, m.up); err != nil {
+		return fmt.Errorf("sql exec error: %w", err)
+	}
+
+	return nil
+}
+
+func (m *sqlMigration) Checksum() string {
+	sum := sha256.Sum256([]byte(m.up))
+	return hex.EncodeToString(sum[:])
+}
+
+// reversibleSQLMigration is used when a down file exists
+type reversibleSQLMigration struct {
+	*sqlMigration
+}
+
+func (m *reversibleSQLMigration) Rollback(ctx context.Context, _ *do.Injector, tx pgx.Tx, _ *database.Queries) error {
+	if _, err := tx.Exec(ctx, m.down); err != nil {
+		return fmt.Errorf("sql exec error: %w", err)
+	}
+
+	return nil
+}
+
+func version(id string) int {
+	match := idRegex.FindStringSubmatch(id)
+	if match == nil {
+		return -1
+	}
+
+	v, _ := strconv.Atoi(match[1])
+
+	return v
+}
+
+func loadSQLMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("ReadDir: %w", err)
+	}
+
+	byID := make(map[string]*sqlMigration)
+
+	for _, entry := range entries {
+		match := fileRegex.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("unexpected migration file name %s", entry.Name())
+		}
+
+		data, err := fs.ReadFile(fsys, path.Join("migrations", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("ReadFile %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byID[match[1]]
+		if !ok {
+			m = &sqlMigration{id: match[1], up: "", down: ""}
+			byID[match[1]] = m
+		}
+
+		if match[2] == "up" {
+			m.up = string(data)
+		} else {
+			m.down = string(data)
+		}
+	}
+
+	result := make([]Migration, 0, len(byID))
+
+	for _, m := range byID {
+		if m.up == "" {
+			return nil, fmt.Errorf("migration %s has no up file", m.id)
+		}
+
+		if m.down != "" {
+			result = append(result, &reversibleSQLMigration{m})
+		} else {
+			result = append(result, m)
+		}
+	}
+
+	return result, nil
+}
+
+// collect returns SQL and Go migrations ordered by version
+func collect() ([]Migration, error) {
+	migrations, err := loadSQLMigrations(database.Migrations)
+	if err != nil {
+		return nil, fmt.Errorf("loadSQLMigrations: %w", err)
+	}
+
+	migrations = append(migrations, allMigrations...)
+
+	seen := make(map[int]string, len(migrations))
+
+	for _, m := range migrations {
+		v := version(m.Id())
+		if v < 0 {
+			return nil, fmt.Errorf("invalid migration id %s, expected <version>_<name>", m.Id())
+		}
+
+		if other, ok := seen[v]; ok {
+			return nil, fmt.Errorf("migrations %s and %s share version %d", other, m.Id(), v)
+		}
+
+		seen[v] = m.Id()
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return version(migrations[i].Id()) < version(migrations[j].Id())
+	})
+
+	return migrations, nil
+}
+
+func checksumOf(m Migration) *string {
+	if c, ok := m.(Checksummed); ok {
+		sum := c.Checksum()
+		return &sum
+	}
+
+	return nil
+}
+
+// withLock serializes migrations between instances starting at the same time
+func withLock(ctx context.Context, dbConn *pgxpool.Pool, fn func() error) error {
+	conn, err := dbConn.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err = conn.Exec(ctx, bootstrap); err != nil {
+		return fmt.Errorf("failed to bootstrap migration table: %w", err)
+	}
+
+	lockQueries := database.New(conn)
+
+	if err = lockQueries.LockMigrations(ctx); err != nil {
+		return fmt.Errorf("failed to lock migrations: %w", err)
+	}
+
+	defer func() {
+		if err := lockQueries.UnlockMigrations(context.WithoutCancel(ctx)); err != nil {
+			_ = conn.Conn().Close(context.WithoutCancel(ctx))
+		}
+	}()
+
+	return fn()
+}
+
 func doExecute(
 	ctx context.Context,
 	di *do.Injector,
@@ -41,9 +236,10 @@ func doExecute(
 		return fmt.Errorf("error executing migration body: %w", err)
 	}
 
-	if _, err = queries.CreateMigration(ctx, database.CreateMigrationParams{
-		ID:      migration.Id(),
-		Applied: time.Now(),
+	if _, err = qtx.CreateMigration(ctx, database.CreateMigrationParams{
+		ID:       migration.Id(),
+		Applied:  time.Now(),
+		Checksum: checksumOf(migration),
 	}); err != nil {
 		return fmt.Errorf("error inserting migration: %w", err)
 	}
@@ -55,46 +251,268 @@ func doExecute(
 	return nil
 }
 
+func doRollback(
+	ctx context.Context,
+	di *do.Injector,
+	dbConn *pgxpool.Pool,
+	queries *database.Queries,
+	migration Migration,
+) error {
+	reversible, ok := migration.(Reversible)
+	if !ok {
+		return fmt.Errorf("migration %s has no down migration", migration.Id())
+	}
+
+	tx, err := dbConn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	qtx := queries.WithTx(tx)
+
+	if err = reversible.Rollback(ctx, di, tx, qtx); err != nil {
+		return fmt.Errorf("error executing rollback body: %w", err)
+	}
+
+	if err = qtx.DeleteMigration(ctx, migration.Id()); err != nil {
+		return fmt.Errorf("error deleting migration: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// verify fails if an applied migration was edited afterwards,
+// rows recorded before checksums existed are filled in
+func verify(ctx context.Context, queries *database.Queries, migrations []Migration, applied map[string]database.Migration) error {
+	for _, m := range migrations {
+		row, ok := applied[m.Id()]
+		if !ok {
+			continue
+		}
+
+		sum := checksumOf(m)
+		if sum == nil {
+			continue
+		}
+
+		if row.Checksum == nil {
+			if err := queries.SetMigrationChecksum(ctx, database.SetMigrationChecksumParams{
+				ID:       m.Id(),
+				Checksum: sum,
+			}); err != nil {
+				return fmt.Errorf("could not set checksum of %s: %w", m.Id(), err)
+			}
+
+			continue
+		}
+
+		if *row.Checksum != *sum {
+			return fmt.Errorf("migration %s was modified after being applied", m.Id())
+		}
+	}
+
+	return nil
+}
+
+func getApplied(ctx context.Context, queries *database.Queries) (map[string]database.Migration, error) {
+	executedMigrations, err := queries.GetMigrations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get migrations: %w", err)
+	}
+
+	applied := make(map[string]database.Migration, len(executedMigrations))
+	for _, migration := range executedMigrations {
+		applied[migration.ID] = migration
+	}
+
+	return applied, nil
+}
+
+// Migrate applies all pending migrations
 func Migrate(ctx context.Context, di *do.Injector) error {
 	slog.LogAttrs(ctx, slog.LevelInfo, "Executing migrations...")
 
 	dbConn := do.MustInvoke[*pgxpool.Pool](di)
 	queries := do.MustInvoke[*database.Queries](di)
 
-	executedMigrations, err := queries.GetMigrations(ctx)
+	migrations, err := collect()
 	if err != nil {
-		return fmt.Errorf("could not get migrations: %w", err)
+		return fmt.Errorf("could not collect migrations: %w", err)
 	}
 
-	executedIds := mapset.NewThreadUnsafeSet[string]()
-	for _, migration := range executedMigrations {
-		executedIds.Add(migration.ID)
-	}
+	return withLock(ctx, dbConn, func() error {
+		applied, err := getApplied(ctx, queries)
+		if err != nil {
+			return err
+		}
+
+		if err = verify(ctx, queries, migrations, applied); err != nil {
+			return err
+		}
+
+		known := make(map[string]bool, len(migrations))
+		for _, m := range migrations {
+			known[m.Id()] = true
+		}
+
+		for id := range applied {
+			if !known[id] {
+				slog.LogAttrs(ctx, slog.LevelWarn, "Applied migration is unknown to this build",
+					slog.String("id", id),
+				)
+			}
+		}
+
+		var pending int
+
+		for _, migration := range migrations {
+			if _, ok := applied[migration.Id()]; ok {
+				continue
+			}
+
+			pending++
+
+			slog.LogAttrs(ctx, slog.LevelInfo, "Starting migration",
+				slog.String("id", migration.Id()),
+			)
 
-	pendingMigrations := pie.Filter(allMigrations, func(m Migration) bool {
-		return !executedIds.Contains(m.Id())
+			if err = doExecute(ctx, di, dbConn, queries, migration); err != nil {
+				return fmt.Errorf("could not execute migration %v: %w", migration.Id(), err)
+			}
+
+			slog.LogAttrs(ctx, slog.LevelInfo, "Migration success",
+				slog.String("id", migration.Id()),
+			)
+		}
+
+		if pending == 0 {
+			slog.LogAttrs(ctx, slog.LevelInfo, "No pending migrations")
+			return nil
+		}
+
+		log.Info("Migrations complete")
+
+		return nil
 	})
+}
+
+// Rollback reverts the last steps applied migrations
+func Rollback(ctx context.Context, di *do.Injector, steps int) error {
+	dbConn := do.MustInvoke[*pgxpool.Pool](di)
+	queries := do.MustInvoke[*database.Queries](di)
+
+	migrations, err := collect()
+	if err != nil {
+		return fmt.Errorf("could not collect migrations: %w", err)
+	}
+
+	return withLock(ctx, dbConn, func() error {
+		applied, err := getApplied(ctx, queries)
+		if err != nil {
+			return err
+		}
+
+		if err = verify(ctx, queries, migrations, applied); err != nil {
+			return err
+		}
+
+		for i := len(migrations) - 1; i >= 0 && steps > 0; i-- {
+			migration := migrations[i]
+
+			if _, ok := applied[migration.Id()]; !ok {
+				continue
+			}
+
+			slog.LogAttrs(ctx, slog.LevelInfo, "Rolling back migration",
+				slog.String("id", migration.Id()),
+			)
+
+			if err = doRollback(ctx, di, dbConn, queries, migration); err != nil {
+				return fmt.Errorf("could not roll back migration %v: %w", migration.Id(), err)
+			}
+
+			steps--
+		}
 
-	if len(pendingMigrations) == 0 {
-		slog.LogAttrs(ctx, slog.LevelInfo, "No pending migrations")
 		return nil
+	})
+}
+
+type Status struct {
+	ID         string
+	Kind       string
+	Applied    *time.Time
+	Modified   bool
+	Reversible bool
+	Unknown    bool
+}
+
+// GetStatus lists known and applied migrations in order
+func GetStatus(ctx context.Context, di *do.Injector) ([]Status, error) {
+	dbConn := do.MustInvoke[*pgxpool.Pool](di)
+	queries := do.MustInvoke[*database.Queries](di)
+
+	migrations, err := collect()
+	if err != nil {
+		return nil, fmt.Errorf("could not collect migrations: %w", err)
+	}
+
+	if _, err = dbConn.Exec(ctx, bootstrap); err != nil {
+		return nil, fmt.Errorf("failed to bootstrap migration table: %w", err)
+	}
+
+	applied, err := getApplied(ctx, queries)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, migration := range pendingMigrations {
-		slog.LogAttrs(ctx, slog.LevelInfo, "Starting migration",
-			slog.String("id", migration.Id()),
-		)
+	result := make([]Status, 0, len(migrations))
+
+	for _, m := range migrations {
+		status := Status{
+			ID:         m.Id(),
+			Kind:       "go",
+			Applied:    nil,
+			Modified:   false,
+			Reversible: false,
+			Unknown:    false,
+		}
+
+		if _, ok := m.(Checksummed); ok {
+			status.Kind = "sql"
+		}
+
+		if _, ok := m.(Reversible); ok {
+			status.Reversible = true
+		}
+
+		if row, ok := applied[m.Id()]; ok {
+			status.Applied = &row.Applied
 
-		if err = doExecute(ctx, di, dbConn, queries, migration); err != nil {
-			return fmt.Errorf("could not execute migration %v: %w", migration.Id(), err)
+			sum := checksumOf(m)
+			status.Modified = sum != nil && row.Checksum != nil && *sum != *row.Checksum
+
+			delete(applied, m.Id())
 		}
 
-		slog.LogAttrs(ctx, slog.LevelInfo, "Migration success",
-			slog.String("id", migration.Id()),
-		)
+		result = append(result, status)
 	}
 
-	log.Info("Migrations complete")
+	for _, row := range applied {
+		result = append(result, Status{
+			ID:         row.ID,
+			Kind:       "",
+			Applied:    &row.Applied,
+			Modified:   false,
+			Reversible: false,
+			Unknown:    true,
+		})
+	}
 
-	return nil
+	return result, nil
 }
@@ -0,0 +1,23 @@
+package money
+
+import "fmt"
+
+// Kopecks is an amount of money in minor units, 100 kopecks make a ruble.
+// Prices and totals are kept as integers so that sums are exact.
+type Kopecks int64
+
+// Times returns the cost of n items priced k
+func (k Kopecks) Times(n int) Kopecks {
+	return k * Kopecks(n)
+}
+
+// String formats the amount in rubles with two decimals, e.g. 450.00
+func (k Kopecks) String() string {
+	sign := ""
+	if k < 0 {
+		sign = "-"
+		k = -k
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, k/100, k%100)
+}
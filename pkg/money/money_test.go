@@ -0,0 +1,45 @@
+package money
+
+import "testing"
+
+func TestKopecksString(t *testing.T) {
+	tests := []struct {
+		amount Kopecks
+		want   string
+	}{
+		{amount: 0, want: "0.00"},
+		{amount: 5, want: "0.05"},
+		{amount: 99, want: "0.99"},
+		{amount: 100, want: "1.00"},
+		{amount: 45050, want: "450.50"},
+		{amount: 123456789, want: "1234567.89"},
+		{amount: -5, want: "-0.05"},
+		{amount: -45050, want: "-450.50"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.amount.String(); got != tt.want {
+			t.Errorf("Kopecks(%d).String() = %s, want %s", int64(tt.amount), got, tt.want)
+		}
+	}
+}
+
+func TestKopecksTimes(t *testing.T) {
+	tests := []struct {
+		price Kopecks
+		n     int
+		want  Kopecks
+	}{
+		{price: 45050, n: 0, want: 0},
+		{price: 45050, n: 1, want: 45050},
+		{price: 10, n: 3, want: 30},
+		{price: 3333, n: 3, want: 9999},
+		{price: -500, n: 2, want: -1000},
+	}
+
+	for _, tt := range tests {
+		if got := tt.price.Times(tt.n); got != tt.want {
+			t.Errorf("Kopecks(%d).Times(%d) = %d, want %d", int64(tt.price), tt.n, int64(got), int64(tt.want))
+		}
+	}
+}
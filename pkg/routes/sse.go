@@ -0,0 +1,11 @@
+package routes
+
+import (
+	"shantaram/app/controller"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SSERoutes(app *fiber.App, sseController *controller.SSE) {
+	app.Get("/sse", sseController.Handle)
+}
@@ -1,13 +1,44 @@
 package telemetry
 
 import (
+	"context"
+	"fmt"
 	"shantaram/pkg/config"
 
+	"go.opentelemetry.io/otel/attribute"
 	otelmetric "go.opentelemetry.io/otel/metric"
 )
 
-type Metrics struct{}
+type Metrics struct {
+	realtimeDropped     otelmetric.Int64Counter
+	realtimeDisconnects otelmetric.Int64Counter
+}
+
+func NewMetrics(_ *config.Config, meter otelmetric.Meter) (*Metrics, error) {
+	realtimeDropped, err := meter.Int64Counter("realtime.messages.dropped",
+		otelmetric.WithDescription("Realtime messages dropped because the client could not keep up"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create realtime.messages.dropped counter: %w", err)
+	}
+
+	realtimeDisconnects, err := meter.Int64Counter("realtime.slow_consumer.disconnects",
+		otelmetric.WithDescription("Realtime connections closed because the client could not keep up"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create realtime.slow_consumer.disconnects counter: %w", err)
+	}
+
+	return &Metrics{
+		realtimeDropped:     realtimeDropped,
+		realtimeDisconnects: realtimeDisconnects,
+	}, nil
+}
+
+func (m *Metrics) RealtimeMessageDropped(ctx context.Context, transport string) {
+	m.realtimeDropped.Add(ctx, 1, otelmetric.WithAttributes(attribute.String("transport", transport)))
+}
 
-func NewMetrics(_ *config.Config, _ otelmetric.Meter) (*Metrics, error) {
-	return &Metrics{}, nil
+func (m *Metrics) RealtimeSlowConsumerDisconnected(ctx context.Context, transport string) {
+	m.realtimeDisconnects.Add(ctx, 1, otelmetric.WithAttributes(attribute.String("transport", transport)))
 }
@@ -1,5 +1,11 @@
 package util
 
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
 type ContextKey string
 
 func (c ContextKey) String() string {
@@ -8,3 +14,14 @@ func (c ContextKey) String() string {
 
 var UsernameContextKey ContextKey = "username"
 var IpContextKey ContextKey = "ip"
+var CustomerContextKey ContextKey = "customer"
+
+// CustomerID returns the logged in customer, nil for guests and admins
+func CustomerID(ctx context.Context) *uuid.UUID {
+	id, ok := ctx.Value(CustomerContextKey).(uuid.UUID)
+	if !ok {
+		return nil
+	}
+
+	return &id
+}
@@ -0,0 +1,170 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const contentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+</Types>`
+
+const rootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`
+
+const workbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+</Relationships>`
+
+const workbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>
+</workbook>`
+
+const sheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+const sheetEnd = `</sheetData></worksheet>`
+
+// Writer streams a workbook with a single sheet, rows are written as they come
+// so the whole sheet is never kept in memory. Strings are stored inline.
+type Writer struct {
+	zip   *zip.Writer
+	sheet *bufio.Writer
+	rows  int
+}
+
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	zw := zip.NewWriter(w)
+
+	var name strings.Builder
+	if err := xml.EscapeText(&name, []byte(sheetName)); err != nil {
+		return nil, fmt.Errorf("EscapeText: %w", err)
+	}
+
+	parts := []struct {
+		path    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypes},
+		{"_rels/.rels", rootRels},
+		{"xl/workbook.xml", fmt.Sprintf(workbook, name.String())},
+		{"xl/_rels/workbook.xml.rels", workbookRels},
+	}
+
+	for _, part := range parts {
+		pw, err := zw.Create(part.path)
+		if err != nil {
+			return nil, fmt.Errorf("Create %s: %w", part.path, err)
+		}
+
+		if _, err = io.WriteString(pw, part.content); err != nil {
+			return nil, fmt.Errorf("WriteString %s: %w", part.path, err)
+		}
+	}
+
+	sheet, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, fmt.Errorf("Create sheet: %w", err)
+	}
+
+	result := &Writer{
+		zip:   zw,
+		sheet: bufio.NewWriter(sheet),
+		rows:  0,
+	}
+
+	if _, err = result.sheet.WriteString(sheetStart); err != nil {
+		return nil, fmt.Errorf("WriteString: %w", err)
+	}
+
+	return result, nil
+}
+
+// Write appends a row. Cells may be strings, integers, floats, booleans or nil for an empty cell.
+// bufio keeps the first write error, so it is checked once per row.
+func (w *Writer) Write(cells []any) error {
+	w.rows++
+	row := strconv.Itoa(w.rows)
+
+	w.sheet.WriteString(`<row r="` + row + `">`) //nolint:errcheck
+
+	for i, cell := range cells {
+		ref := columnName(i) + row
+
+		switch value := cell.(type) {
+		case nil:
+			continue
+		case string:
+			w.sheet.WriteString(`<c r="` + ref + `" t="inlineStr"><is><t xml:space="preserve">`) //nolint:errcheck
+			if err := xml.EscapeText(w.sheet, []byte(value)); err != nil {
+				return fmt.Errorf("EscapeText: %w", err)
+			}
+			w.sheet.WriteString(`</t></is></c>`) //nolint:errcheck
+		case int:
+			w.writeNumber(ref, strconv.Itoa(value))
+		case int64:
+			w.writeNumber(ref, strconv.FormatInt(value, 10))
+		case float64:
+			w.writeNumber(ref, strconv.FormatFloat(value, 'f', -1, 64))
+		case bool:
+			v := "0"
+			if value {
+				v = "1"
+			}
+			w.sheet.WriteString(`<c r="` + ref + `" t="b"><v>` + v + `</v></c>`) //nolint:errcheck
+		default:
+			return fmt.Errorf("unsupported cell type %T", cell)
+		}
+	}
+
+	if _, err := w.sheet.WriteString(`</row>`); err != nil {
+		return fmt.Errorf("WriteString: %w", err)
+	}
+
+	return nil
+}
+
+func (w *Writer) writeNumber(ref, value string) {
+	w.sheet.WriteString(`<c r="` + ref + `"><v>` + value + `</v></c>`) //nolint:errcheck
+}
+
+// Close finishes the sheet and the archive, it doesn't close the underlying writer
+func (w *Writer) Close() error {
+	if _, err := w.sheet.WriteString(sheetEnd); err != nil {
+		return fmt.Errorf("WriteString: %w", err)
+	}
+
+	if err := w.sheet.Flush(); err != nil {
+		return fmt.Errorf("Flush: %w", err)
+	}
+
+	if err := w.zip.Close(); err != nil {
+		return fmt.Errorf("zip Close: %w", err)
+	}
+
+	return nil
+}
+
+// columnName converts a zero based column number to A, B, ..., Z, AA, AB, ...
+func columnName(i int) string {
+	name := ""
+
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+
+	return name
+}